@@ -36,10 +36,15 @@ import (
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	audit_list "github.com/radius-project/radius/pkg/cli/cmd/audit/list"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	bicep_publishextension "github.com/radius-project/radius/pkg/cli/cmd/bicep/publishextension"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/deadletter/purge"
+	deadletter_replay "github.com/radius-project/radius/pkg/cli/cmd/deadletter/replay"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/deadletter/show"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_create_preview "github.com/radius-project/radius/pkg/cli/cmd/env/create/preview"
@@ -65,9 +70,11 @@ import (
 	recipe_pack_delete "github.com/radius-project/radius/pkg/cli/cmd/recipepack/delete"
 	recipe_pack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
+	resource_cancel "github.com/radius-project/radius/pkg/cli/cmd/resource/cancel"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_plan "github.com/radius-project/radius/pkg/cli/cmd/resource/plan"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
@@ -137,6 +144,8 @@ var recipeCmd = NewRecipeCommand()
 var recipePackCmd = NewRecipePackCommand()
 var envCmd = NewEnvironmentCommand()
 var workspaceCmd = NewWorkspaceCommand()
+var deadLetterCmd = NewDeadLetterCommand()
+var auditCmd = NewAuditCommand()
 
 var ConfigHolderKey = framework.NewContextKey("config")
 var ConfigHolder = &framework.ConfigHolder{}
@@ -291,6 +300,12 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceCancelCmd, _ := resource_cancel.NewCommand(framework)
+	resourceCmd.AddCommand(resourceCancelCmd)
+
+	resourcePlanCmd, _ := resource_plan.NewCommand(framework)
+	resourceCmd.AddCommand(resourcePlanCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -315,6 +330,21 @@ func initSubCommands() {
 	resourceTypeCreateCmd, _ := resourcetype_create.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeCreateCmd)
 
+	deadLetterListCmd, _ := deadletter_list.NewCommand(framework)
+	deadLetterCmd.AddCommand(deadLetterListCmd)
+
+	deadLetterShowCmd, _ := deadletter_show.NewCommand(framework)
+	deadLetterCmd.AddCommand(deadLetterShowCmd)
+
+	deadLetterReplayCmd, _ := deadletter_replay.NewCommand(framework)
+	deadLetterCmd.AddCommand(deadLetterReplayCmd)
+
+	deadLetterPurgeCmd, _ := deadletter_purge.NewCommand(framework)
+	deadLetterCmd.AddCommand(deadLetterPurgeCmd)
+
+	auditListCmd, _ := audit_list.NewCommand(framework)
+	auditCmd.AddCommand(auditListCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
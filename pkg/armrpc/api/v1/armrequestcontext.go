@@ -148,6 +148,9 @@ type ARMRequestContext struct {
 	ClientPrincipalName string
 	ClientPrincipalID   string
 
+	// Principal represents the authenticated caller. It is nil when the request is not authenticated.
+	Principal *Principal
+
 	// APIVersion represents api-version of incoming arm request.
 	APIVersion string
 	// AcceptLanguage represents the supported language of the arm request.
@@ -213,7 +216,7 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 		ClientTenantID:      r.Header.Get(ClientTenantIDHeader),
 		ClientApplicationID: r.Header.Get(ClientApplicationIDHeader),
 		ClientObjectID:      r.Header.Get(ClientObjectIDHeader),
-		ClientPrincipalName: r.Header.Get(ClientPrincipalIDHeader),
+		ClientPrincipalName: r.Header.Get(ClientPrincipalNameHeader),
 		ClientPrincipalID:   r.Header.Get(ClientPrincipalIDHeader),
 
 		APIVersion:        r.URL.Query().Get(APIVersionParameterName),
@@ -39,4 +39,7 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// Properties represents the result of an async operation that returns a result, for example a custom action.
+	Properties any `json:"properties,omitempty"`
 }
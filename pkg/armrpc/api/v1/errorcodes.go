@@ -41,6 +41,9 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used when the caller is not allowed to perform the operation.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -61,4 +64,10 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when the caller has sent too many requests and is being throttled.
+	CodeTooManyRequests = "TooManyRequests"
+
+	// Used when a request would exceed a quota.
+	CodeQuotaExceeded = "QuotaExceeded"
 )
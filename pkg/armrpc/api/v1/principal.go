@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"strings"
+)
+
+// PrincipalType represents the type of a principal.
+type PrincipalType string
+
+const (
+	// PrincipalTypeUser represents a user.
+	PrincipalTypeUser PrincipalType = "User"
+	// PrincipalTypeGroup represents a group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeServiceAccount represents a Kubernetes service account.
+	PrincipalTypeServiceAccount PrincipalType = "ServiceAccount"
+
+	// ServiceAccountPrefix is the prefix of the user name of Kubernetes service accounts.
+	ServiceAccountPrefix = "system:serviceaccount:"
+)
+
+// Principal represents the authenticated caller of a request.
+type Principal struct {
+	// ID is the stable identifier of the principal, such as the UID of a Kubernetes user or the subject of an OIDC token.
+	// It is empty when the authenticator does not know an identifier.
+	ID string
+	// Name is the name of the principal. For Kubernetes service accounts this is 'system:serviceaccount:{namespace}:{name}'.
+	Name string
+	// Groups is the list of groups the principal belongs to.
+	Groups []string
+	// Type is the type of the principal.
+	Type PrincipalType
+}
+
+// NewPrincipal creates a Principal for the given user name and groups. The principal type is derived from the name.
+func NewPrincipal(name string, groups []string) *Principal {
+	principalType := PrincipalTypeUser
+	if strings.HasPrefix(name, ServiceAccountPrefix) {
+		principalType = PrincipalTypeServiceAccount
+	}
+
+	return &Principal{
+		Name:   name,
+		Groups: groups,
+		Type:   principalType,
+	}
+}
+
+// PrincipalFromContext returns the principal of the request in the context, or nil if the request is not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	rpcContext, ok := ctx.Value(armContextKey).(*ARMRequestContext)
+	if !ok {
+		return nil
+	}
+	return rpcContext.Principal
+}
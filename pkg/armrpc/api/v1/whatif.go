@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// WhatIfActionName is the name of the custom action that previews the changes of a create or update operation
+// to the properties and the output resources of a resource without persisting them.
+const WhatIfActionName = "whatIf"
+
+// WhatIfChangeType is the type of change that an operation would make to a resource or to one of its properties.
+type WhatIfChangeType string
+
+const (
+	// WhatIfChangeTypeCreate indicates that the resource or property does not exist and would be created.
+	WhatIfChangeTypeCreate WhatIfChangeType = "Create"
+
+	// WhatIfChangeTypeModify indicates that the resource or property exists and would be modified.
+	WhatIfChangeTypeModify WhatIfChangeType = "Modify"
+
+	// WhatIfChangeTypeDelete indicates that the resource or property exists and would be deleted.
+	WhatIfChangeTypeDelete WhatIfChangeType = "Delete"
+
+	// WhatIfChangeTypeNoChange indicates that the resource exists and would not be changed.
+	WhatIfChangeTypeNoChange WhatIfChangeType = "NoChange"
+
+	// WhatIfChangeTypeUnsupported indicates that the changes to the resource could not be previewed.
+	WhatIfChangeTypeUnsupported WhatIfChangeType = "Unsupported"
+)
+
+// WhatIfPropertyChange describes the change to a single property of a resource.
+type WhatIfPropertyChange struct {
+	// Path is the dot-separated path of the property, for example "properties.container.image".
+	Path string `json:"path"`
+
+	// ChangeType is the type of change to the property.
+	ChangeType WhatIfChangeType `json:"changeType"`
+
+	// Before is the value of the property before the change. Empty when the property is created.
+	Before any `json:"before,omitempty"`
+
+	// After is the value of the property after the change. Empty when the property is deleted.
+	After any `json:"after,omitempty"`
+}
+
+// WhatIfOutputResourceChange describes the change to an output resource of a resource, such as a Kubernetes
+// object rendered by the resource provider.
+type WhatIfOutputResourceChange struct {
+	// ID is the resource ID of the output resource.
+	ID string `json:"id"`
+
+	// ChangeType is the type of change to the output resource.
+	ChangeType WhatIfChangeType `json:"changeType"`
+}
+
+// WhatIfResourceChange describes the change that an operation would make to a resource.
+type WhatIfResourceChange struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string `json:"resourceId"`
+
+	// ChangeType is the type of change to the resource.
+	ChangeType WhatIfChangeType `json:"changeType"`
+
+	// Before is the resource before the change. Empty when the resource is created.
+	Before map[string]any `json:"before,omitempty"`
+
+	// After is the resource after the change. Empty when the resource is deleted.
+	After map[string]any `json:"after,omitempty"`
+
+	// Delta is the list of property changes of a modified resource.
+	Delta []WhatIfPropertyChange `json:"delta,omitempty"`
+
+	// OutputResources is the list of changes to the output resources of the resource. Empty when the resource
+	// provider does not render output resources for the resource type.
+	OutputResources []WhatIfOutputResourceChange `json:"outputResources,omitempty"`
+
+	// Error is the reason the changes to the resource could not be previewed, if any.
+	Error *ErrorDetails `json:"error,omitempty"`
+}
+
+// WhatIfResult is the result of previewing the changes of a deployment.
+type WhatIfResult struct {
+	// Changes is the list of changes to the resources of the deployment.
+	Changes []WhatIfResourceChange `json:"changes"`
+}
+
+// DiffProperties compares two JSON-like values and returns the list of property changes between them, sorted by
+// path. Objects are compared property by property, while arrays and other values are compared as a whole.
+func DiffProperties(before, after map[string]any) []WhatIfPropertyChange {
+	changes := diffValues("", before, after)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// DiffOutputResources compares the IDs of the output resources before and after a change and returns the list of
+// output resource changes, sorted by ID. IDs are compared case-insensitively. Output resources that exist both before
+// and after the change are reported as modified, because their contents are not compared.
+func DiffOutputResources(before, after []string) []WhatIfOutputResourceChange {
+	existing := map[string]bool{}
+	for _, id := range before {
+		existing[strings.ToLower(id)] = true
+	}
+
+	changes := []WhatIfOutputResourceChange{}
+	rendered := map[string]bool{}
+	for _, id := range after {
+		key := strings.ToLower(id)
+		if rendered[key] {
+			continue
+		}
+		rendered[key] = true
+
+		changeType := WhatIfChangeTypeCreate
+		if existing[key] {
+			changeType = WhatIfChangeTypeModify
+		}
+		changes = append(changes, WhatIfOutputResourceChange{ID: id, ChangeType: changeType})
+	}
+
+	for _, id := range before {
+		key := strings.ToLower(id)
+		if rendered[key] {
+			continue
+		}
+		rendered[key] = true
+		changes = append(changes, WhatIfOutputResourceChange{ID: id, ChangeType: WhatIfChangeTypeDelete})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ID < changes[j].ID
+	})
+	return changes
+}
+
+func diffValues(path string, before, after any) []WhatIfPropertyChange {
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		changes := []WhatIfPropertyChange{}
+		for key, beforeValue := range beforeMap {
+			afterValue, ok := afterMap[key]
+			if !ok {
+				changes = append(changes, WhatIfPropertyChange{Path: joinPath(path, key), ChangeType: WhatIfChangeTypeDelete, Before: beforeValue})
+				continue
+			}
+			changes = append(changes, diffValues(joinPath(path, key), beforeValue, afterValue)...)
+		}
+
+		for key, afterValue := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				changes = append(changes, WhatIfPropertyChange{Path: joinPath(path, key), ChangeType: WhatIfChangeTypeCreate, After: afterValue})
+			}
+		}
+
+		return changes
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+
+	return []WhatIfPropertyChange{{Path: path, ChangeType: WhatIfChangeTypeModify, Before: before, After: after}}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   map[string]any
+		after    map[string]any
+		expected []WhatIfPropertyChange
+	}{
+		{
+			name:     "no changes",
+			before:   map[string]any{"a": "b", "nested": map[string]any{"c": []any{"d"}}},
+			after:    map[string]any{"a": "b", "nested": map[string]any{"c": []any{"d"}}},
+			expected: []WhatIfPropertyChange{},
+		},
+		{
+			name:   "modified nested value",
+			before: map[string]any{"properties": map[string]any{"container": map[string]any{"image": "nginx:1"}}},
+			after:  map[string]any{"properties": map[string]any{"container": map[string]any{"image": "nginx:2"}}},
+			expected: []WhatIfPropertyChange{
+				{Path: "properties.container.image", ChangeType: WhatIfChangeTypeModify, Before: "nginx:1", After: "nginx:2"},
+			},
+		},
+		{
+			name:   "created and deleted values",
+			before: map[string]any{"properties": map[string]any{"old": "x", "same": 1.0}},
+			after:  map[string]any{"properties": map[string]any{"new": "y", "same": 1.0}},
+			expected: []WhatIfPropertyChange{
+				{Path: "properties.new", ChangeType: WhatIfChangeTypeCreate, After: "y"},
+				{Path: "properties.old", ChangeType: WhatIfChangeTypeDelete, Before: "x"},
+			},
+		},
+		{
+			name:   "arrays are compared as a whole",
+			before: map[string]any{"ports": []any{80.0}},
+			after:  map[string]any{"ports": []any{80.0, 443.0}},
+			expected: []WhatIfPropertyChange{
+				{Path: "ports", ChangeType: WhatIfChangeTypeModify, Before: []any{80.0}, After: []any{80.0, 443.0}},
+			},
+		},
+		{
+			name:   "object replaced by a value",
+			before: map[string]any{"value": map[string]any{"a": "b"}},
+			after:  map[string]any{"value": "c"},
+			expected: []WhatIfPropertyChange{
+				{Path: "value", ChangeType: WhatIfChangeTypeModify, Before: map[string]any{"a": "b"}, After: "c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DiffProperties(tt.before, tt.after))
+		})
+	}
+}
+
+func TestDiffOutputResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   []string
+		after    []string
+		expected []WhatIfOutputResourceChange
+	}{
+		{
+			name:     "no output resources",
+			expected: []WhatIfOutputResourceChange{},
+		},
+		{
+			name:  "created output resources",
+			after: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/b", "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/a"},
+			expected: []WhatIfOutputResourceChange{
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/a", ChangeType: WhatIfChangeTypeCreate},
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/b", ChangeType: WhatIfChangeTypeCreate},
+			},
+		},
+		{
+			name:   "modified and deleted output resources",
+			before: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/a", "/planes/kubernetes/local/namespaces/default/providers/core/Service/b"},
+			after:  []string{"/planes/kubernetes/local/namespaces/default/providers/apps/deployment/a"},
+			expected: []WhatIfOutputResourceChange{
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/deployment/a", ChangeType: WhatIfChangeTypeModify},
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/b", ChangeType: WhatIfChangeTypeDelete},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DiffOutputResources(tt.before, tt.after))
+		})
+	}
+}
@@ -27,6 +27,10 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ErrOperationCanceled is the cause of the context cancellation when an async operation is canceled by a client.
+// Controllers can use context.Cause to distinguish a cancellation from a timeout or a shutdown of the worker.
+var ErrOperationCanceled = errors.New("async operation was canceled")
+
 // Options represents controller options.
 type Options struct {
 	// DatabaseClient is the database client.
@@ -56,6 +56,10 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// ReadOnly is true for operations that do not change the resource, for example the plan action. The provisioning
+	// state of the resource is not updated by read-only operations.
+	ReadOnly bool `json:"readOnly,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
@@ -28,6 +28,10 @@ type Result struct {
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
+	// Properties is the result of an operation that returns a result, for example a custom action. It is saved
+	// in the operation status and returned as the operation result when the operation succeeds.
+	Properties any
+
 	// state represents the provisioning status.
 	state *v1.ProvisioningState
 }
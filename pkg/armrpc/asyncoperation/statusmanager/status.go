@@ -43,4 +43,8 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested is set when a client requested the cancellation of the async operation. The worker
+	// processing the operation observes this flag and cancels the operation.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
 }
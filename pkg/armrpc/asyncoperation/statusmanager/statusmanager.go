@@ -47,6 +47,11 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Priority specifies the priority of the async operation.
+	Priority queue.Priority
+	// ReadOnly specifies that the async operation does not change the resource, so the provisioning state of the
+	// resource is not updated while the operation runs.
+	ReadOnly bool
 }
 
 //go:generate mockgen -typed -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -57,8 +62,8 @@ type StatusManager interface {
 	Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error)
 	// QueueAsyncOperation creates an async operation status object and queue async operation.
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
-	// Update updates an async operation status.
-	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// Update updates an async operation status. properties is the result of the operation and is only saved if it is not nil.
+	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, properties any) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -115,7 +120,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := aom.databaseClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -145,7 +150,7 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
 // given parameters, and saves it back to the store.
-func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, properties any) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	obj, err := aom.databaseClient.Get(ctx, opID)
 	if err != nil {
@@ -166,6 +171,10 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		s.Error = opError
 	}
 
+	if properties != nil {
+		s.Properties = properties
+	}
+
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
@@ -180,7 +189,7 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -191,8 +200,15 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		AcceptLanguage:   sCtx.AcceptLanguage,
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
-		OperationTimeout: &operationTimeout,
+		OperationTimeout: &options.OperationTimeout,
+		ReadOnly:         options.ReadOnly,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	qmsg := queue.NewMessage(msg)
+	qmsg.Priority = options.Priority
+
+	// Operations share the processing capacity fairly between resource groups.
+	qmsg.FairnessKey = strings.ToLower(sCtx.ResourceID.RootScope())
+
+	return aom.queue.Enqueue(ctx, qmsg)
 }
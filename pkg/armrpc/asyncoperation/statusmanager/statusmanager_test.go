@@ -188,6 +188,65 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestQueueAsyncOperation_MessagePriority(t *testing.T) {
+	priorityCases := []struct {
+		desc             string
+		operationType    string
+		priority         queue.Priority
+		expectedPriority queue.Priority
+	}{
+		{
+			desc:             "put_default",
+			operationType:    "APPLICATIONS.CORE/CONTAINERS|PUT",
+			expectedPriority: queue.PriorityNormal,
+		},
+		{
+			desc:             "put_low",
+			operationType:    "APPLICATIONS.CORE/CONTAINERS|PUT",
+			priority:         queue.PriorityLow,
+			expectedPriority: queue.PriorityLow,
+		},
+		{
+			desc:             "delete_default",
+			operationType:    "APPLICATIONS.CORE/CONTAINERS|DELETE",
+			expectedPriority: queue.PriorityNormal,
+		},
+		{
+			// Deletes are not promoted, so they cannot overtake pending operations on the same resource.
+			desc:             "delete_low",
+			operationType:    "APPLICATIONS.CORE/CONTAINERS|DELETE",
+			priority:         queue.PriorityLow,
+			expectedPriority: queue.PriorityLow,
+		},
+	}
+
+	for _, tt := range priorityCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			sCtx := *reqCtx
+			sCtx.OperationType = rpctest.MustParseOperationType(tt.operationType)
+
+			aomTest.databaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			aomTest.queueClient.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, msg *queue.Message, _ ...queue.EnqueueOptions) error {
+					require.Equal(t, tt.expectedPriority, msg.Priority)
+					require.Equal(t, "/planes/radius/local/resourcegroups/radius-test-rg", msg.FairnessKey)
+					return nil
+				})
+
+			options := QueueOperationOptions{
+				OperationTimeout: operationTimeoutDuration,
+				RetryAfter:       opererationRetryAfterDuration,
+				Priority:         tt.priority,
+			}
+			err := aomTest.manager.QueueAsyncOperation(context.TODO(), &sCtx, options)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -309,7 +368,7 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 			testAos.Status = v1.ProvisioningStateSucceeded
 			rid, err := resources.ParseResource(azureEnvResourceID)
 			require.NoError(t, err)
-			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil)
+			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil, nil)
 
 			if tt.GetErr == nil && tt.SaveErr == nil {
 				require.NoError(t, err)
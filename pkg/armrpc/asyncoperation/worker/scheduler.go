@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+// scheduler orders the dequeued messages before they are processed so that the processing capacity is shared
+// fairly between the fairness buckets of the messages.
+//
+// The message with the highest priority is always selected first. When several buckets have a message with the
+// same priority, the buckets are served in a round-robin fashion so that a bucket with hundreds of pending
+// operations does not starve the other buckets. Within a bucket, messages of the same priority are processed in
+// the order they were dequeued.
+//
+// scheduler is not safe for concurrent use.
+type scheduler struct {
+	// buckets holds the buckets with pending or running messages in round-robin order.
+	buckets []*bucket
+	// cursor is the index of the bucket that is served first by the next call to next.
+	cursor int
+	// pending is the number of pending messages across all buckets.
+	pending int
+}
+
+// bucket holds the messages of a fairness key.
+type bucket struct {
+	key string
+	// pending holds the pending messages ordered by descending priority.
+	pending []*queue.Message
+	// running is the number of messages of the bucket that are being processed.
+	running int
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{}
+}
+
+// Len returns the number of pending messages.
+func (s *scheduler) Len() int {
+	return s.pending
+}
+
+// each calls fn for each pending message.
+func (s *scheduler) each(fn func(*queue.Message)) {
+	for _, b := range s.buckets {
+		for _, msg := range b.pending {
+			fn(msg)
+		}
+	}
+}
+
+// push adds a dequeued message to the scheduler.
+func (s *scheduler) push(msg *queue.Message) {
+	b := s.find(msg.FairnessKey)
+	if b == nil {
+		b = &bucket{key: msg.FairnessKey}
+		s.buckets = append(s.buckets, b)
+	}
+
+	// Insert the message after all messages with the same or higher priority.
+	i := len(b.pending)
+	for i > 0 && b.pending[i-1].Priority < msg.Priority {
+		i--
+	}
+	b.pending = append(b.pending, nil)
+	copy(b.pending[i+1:], b.pending[i:])
+	b.pending[i] = msg
+
+	s.pending++
+}
+
+// next removes the next message to process from the scheduler. It returns nil if there is no pending message.
+// The message is counted as running in its bucket until done is called.
+func (s *scheduler) next() *queue.Message {
+	selected := -1
+	for i := 0; i < len(s.buckets); i++ {
+		idx := (s.cursor + i) % len(s.buckets)
+		b := s.buckets[idx]
+		if len(b.pending) == 0 {
+			continue
+		}
+
+		if selected < 0 || b.pending[0].Priority > s.buckets[selected].pending[0].Priority {
+			selected = idx
+		}
+	}
+
+	if selected < 0 {
+		return nil
+	}
+
+	b := s.buckets[selected]
+	msg := b.pending[0]
+	b.pending = b.pending[1:]
+	b.running++
+	s.pending--
+
+	// The next call to next starts with the bucket after the selected one.
+	s.cursor = (selected + 1) % len(s.buckets)
+
+	return msg
+}
+
+// done marks a message returned by next as processed.
+func (s *scheduler) done(msg *queue.Message) {
+	for i, b := range s.buckets {
+		if b.key != msg.FairnessKey {
+			continue
+		}
+
+		b.running--
+		if b.running > 0 || len(b.pending) > 0 {
+			return
+		}
+
+		// Remove the idle bucket while preserving the round-robin order of the others.
+		s.buckets = append(s.buckets[:i], s.buckets[i+1:]...)
+		if i < s.cursor {
+			s.cursor--
+		}
+		if s.cursor >= len(s.buckets) {
+			s.cursor = 0
+		}
+		return
+	}
+}
+
+func (s *scheduler) find(key string) *bucket {
+	for _, b := range s.buckets {
+		if b.key == key {
+			return b
+		}
+	}
+
+	return nil
+}
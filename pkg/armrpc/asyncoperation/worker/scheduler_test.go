@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduledMessage(id string, key string, priority queue.Priority) *queue.Message {
+	return &queue.Message{Metadata: queue.Metadata{ID: id, FairnessKey: key, Priority: priority}}
+}
+
+func nextIDs(s *scheduler, n int) []string {
+	ids := []string{}
+	for i := 0; i < n; i++ {
+		msg := s.next()
+		if msg == nil {
+			break
+		}
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
+
+func TestScheduler_Empty(t *testing.T) {
+	s := newScheduler()
+	require.Nil(t, s.next())
+	require.Equal(t, 0, s.Len())
+}
+
+func TestScheduler_RoundRobinBetweenBuckets(t *testing.T) {
+	s := newScheduler()
+	for _, id := range []string{"a1", "a2", "a3", "a4"} {
+		s.push(newScheduledMessage(id, "a", queue.PriorityNormal))
+	}
+	s.push(newScheduledMessage("b1", "b", queue.PriorityNormal))
+	s.push(newScheduledMessage("c1", "c", queue.PriorityNormal))
+	s.push(newScheduledMessage("b2", "b", queue.PriorityNormal))
+	require.Equal(t, 7, s.Len())
+
+	require.Equal(t, []string{"a1", "b1", "c1", "a2", "b2", "a3", "a4"}, nextIDs(s, 10))
+	require.Equal(t, 0, s.Len())
+}
+
+func TestScheduler_Priority(t *testing.T) {
+	s := newScheduler()
+	s.push(newScheduledMessage("a-low", "a", queue.PriorityLow))
+	s.push(newScheduledMessage("a-normal", "a", queue.PriorityNormal))
+	s.push(newScheduledMessage("b-low", "b", queue.PriorityLow))
+	s.push(newScheduledMessage("b-high", "b", queue.PriorityHigh))
+	s.push(newScheduledMessage("a-high", "a", queue.PriorityHigh))
+
+	require.Equal(t, []string{"a-high", "b-high", "a-normal", "b-low", "a-low"}, nextIDs(s, 10))
+}
+
+func TestScheduler_Done(t *testing.T) {
+	s := newScheduler()
+	s.push(newScheduledMessage("a1", "a", queue.PriorityNormal))
+	s.push(newScheduledMessage("b1", "b", queue.PriorityNormal))
+
+	a1 := s.next()
+	require.Equal(t, "a1", a1.ID)
+
+	// The bucket of a1 is still tracked while a1 is running.
+	require.Len(t, s.buckets, 2)
+	s.done(a1)
+	require.Len(t, s.buckets, 1)
+
+	s.push(newScheduledMessage("a2", "a", queue.PriorityNormal))
+	s.push(newScheduledMessage("b2", "b", queue.PriorityNormal))
+
+	// b was not served since a1 was processed, so it goes first.
+	require.Equal(t, []string{"b1", "a2", "b2"}, nextIDs(s, 10))
+}
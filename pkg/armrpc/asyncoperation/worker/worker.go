@@ -35,9 +35,6 @@ import (
 	"github.com/radius-project/radius/pkg/logging"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
-
-	"github.com/google/uuid"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -58,6 +55,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationPollInterval is the default duration for polling the cancellation request of an operation.
+	defaultCancellationPollInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +79,14 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationPollInterval is the interval for checking whether the cancellation of a running operation was requested.
+	CancellationPollInterval time.Duration
+
+	// MaxScheduledMessages is the maximum number of dequeued messages waiting to be processed. A larger value
+	// improves the fairness of the scheduling. The leases of the messages are extended while they wait. Defaults to
+	// MaxOperationConcurrency.
+	MaxScheduledMessages int
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -87,8 +95,6 @@ type AsyncRequestProcessWorker struct {
 	sm           manager.StatusManager
 	registry     *ControllerRegistry
 	requestQueue queue.Client
-
-	sem *semaphore.Weighted
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -115,111 +121,238 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationPollInterval == time.Duration(0) {
+		options.CancellationPollInterval = defaultCancellationPollInterval
+	}
+	if options.MaxScheduledMessages == 0 {
+		options.MaxScheduledMessages = options.MaxOperationConcurrency
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
 		sm:           sm,
 		registry:     ctrlRegistry,
 		requestQueue: qu,
-		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
 	}
 }
 
 // Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
 // resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
+//
+// The dequeued messages are ordered by the scheduler before they are processed: messages with a higher priority
+// are processed first, and the processing capacity is shared fairly between the fairness buckets of the messages.
+// A message is only dequeued when the scheduler has room for it, and the leases of the scheduled messages are
+// extended until they are processed so that waiting does not count as a failed delivery.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
-	if err != nil {
-		return err
-	}
+
+	// demand asks the dequeuer for the next message. At most one request is outstanding at a time.
+	demand := make(chan struct{}, 1)
+	msgCh := w.startDequeuer(ctx, demand)
+	requested := false
+
+	sched := newScheduler()
+	running := 0
+
+	leaseTicker := time.NewTicker(w.options.MinMessageLockDuration)
+	defer leaseTicker.Stop()
+
+	// processed receives the messages whose processing has completed. It is buffered with the maximum concurrency so
+	// that the processing go routines never block, even after the message loop has stopped.
+	processed := make(chan *queue.Message, w.options.MaxOperationConcurrency)
 
 	// this loop will run until msgCh is closed (or when ctx is canceled)
-	for msg := range msgCh {
-		// This semaphore will maintain the number of go routines to process the messages concurrently.
-		if err := w.sem.Acquire(ctx, 1); err != nil {
-			break
+	for {
+		// Start processing the scheduled messages up to the maximum concurrency.
+		for running < w.options.MaxOperationConcurrency && ctx.Err() == nil {
+			msg := sched.next()
+			if msg == nil {
+				break
+			}
+
+			running++
+			metrics.DefaultAsyncOperationMetrics.RecordAsyncOperationQueueWaitDuration(ctx, msg)
+			go func(msgreq *queue.Message) {
+				defer func() { processed <- msgreq }()
+				w.processMessage(ctx, msgreq)
+			}(msg)
 		}
 
-		go func(msgreq *queue.Message) {
-			defer w.sem.Release(1)
+		// Request the next message only when the scheduler has room for it.
+		if !requested && sched.Len() < w.options.MaxScheduledMessages {
+			demand <- struct{}{}
+			requested = true
+		}
 
-			op := &ctrl.Request{}
-			if err := json.Unmarshal(msgreq.Data, op); err != nil {
-				logger.Error(err, "failed to unmarshal queue message.")
-				return
+		select {
+		case msg, ok := <-msgCh:
+			if !ok {
+				logger.Info("Message loop stopped...")
+				return nil
 			}
+			requested = false
+			sched.push(msg)
 
-			reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+		case <-leaseTicker.C:
+			sched.each(func(msg *queue.Message) {
+				w.extendScheduledMessage(ctx, msg)
+			})
 
-			// Populate the default attributes in the current context so all logs will have these fields.
-			reqCtx = ucplog.WrapLogContext(reqCtx,
-				logging.LogFieldResourceID, op.ResourceID,
-				logging.LogFieldOperationID, op.OperationID,
-				logging.LogFieldOperationType, op.OperationType,
-				logging.LogFieldDequeueCount, msgreq.DequeueCount)
+		case msg := <-processed:
+			running--
+			sched.done(msg)
+
+		case <-ctx.Done():
+			logger.Info("Message loop stopped...")
+			return nil
+		}
+	}
+}
 
-			opLogger := ucplog.FromContextOrDiscard(reqCtx)
+// startDequeuer starts a go routine which dequeues a message each time demand is signaled and sends it to the
+// returned channel. It polls the queue at the dequeue interval until a message is available. The channel is closed
+// when ctx is canceled.
+func (w *AsyncRequestProcessWorker) startDequeuer(ctx context.Context, demand <-chan struct{}) <-chan *queue.Message {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	out := make(chan *queue.Message)
 
-			armReqCtx, err := op.ARMRequestContext()
-			if err != nil {
-				opLogger.Error(err, "failed to get ARM request context.")
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
 				return
+			case <-demand:
 			}
-			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
 
-			asyncCtrl, err := w.registry.Get(armReqCtx.OperationType)
-			if err != nil {
-				opLogger.Error(err, "failed to get async controller.")
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
+			for {
+				msg, err := w.requestQueue.Dequeue(ctx, queue.QueueClientConfig{DequeueIntervalDuration: w.options.DequeueIntervalDuration})
+				if err == nil {
+					select {
+					case out <- msg:
+					case <-ctx.Done():
+						return
+					}
+					break
+				} else if !errors.Is(err, queue.ErrMessageNotFound) {
+					logger.Error(err, "fails to dequeue the message")
 				}
-				return
-			}
 
-			if asyncCtrl == nil {
-				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(w.options.DequeueIntervalDuration):
 				}
-				return
 			}
+		}
+	}()
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
-				opLogger.Error(nil, errMsg)
-				failed := ctrl.NewFailedResult(v1.ErrorDetails{
-					Code:    v1.CodeInternal,
-					Message: errMsg,
-				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.DatabaseClient())
-				return
-			}
+	return out
+}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+// extendScheduledMessage extends the lease of a message waiting in the scheduler when it is about to expire.
+func (w *AsyncRequestProcessWorker) extendScheduledMessage(ctx context.Context, msg *queue.Message) {
+	if msg.NextVisibleAt.IsZero() || time.Until(msg.NextVisibleAt) > w.options.MessageExtendMargin {
+		return
+	}
 
-			dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID)
-			if err != nil {
-				opLogger.Error(err, "failed to check potential deduplication.")
-				return
-			}
-			if dup {
-				opLogger.Info("duplicated message detected")
-				return
-			}
+	if err := w.requestQueue.ExtendMessage(ctx, msg); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "fails to extend the lock of a scheduled message", "messageID", msg.ID)
+	}
+}
 
-			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.DatabaseClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
-				return
-			}
+// processMessage processes a dequeued message.
+func (w *AsyncRequestProcessWorker) processMessage(ctx context.Context, msgreq *queue.Message) {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
-			w.runOperation(reqCtx, msgreq, asyncCtrl)
-		}(msg)
+	op := &ctrl.Request{}
+	if err := json.Unmarshal(msgreq.Data, op); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
 	}
 
-	logger.Info("Message loop stopped...")
-	return nil
+	reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+
+	// Populate the default attributes in the current context so all logs will have these fields.
+	reqCtx = ucplog.WrapLogContext(reqCtx,
+		logging.LogFieldResourceID, op.ResourceID,
+		logging.LogFieldOperationID, op.OperationID,
+		logging.LogFieldOperationType, op.OperationType,
+		logging.LogFieldDequeueCount, msgreq.DequeueCount)
+
+	opLogger := ucplog.FromContextOrDiscard(reqCtx)
+
+	// The lease of a scheduled message is extended while it waits, but the extension can fail. The message is visible
+	// again in the queue, so leave it to be dequeued again instead of processing it twice.
+	if !msgreq.NextVisibleAt.IsZero() && time.Now().After(msgreq.NextVisibleAt) {
+		opLogger.Info("The message lease expired before the operation started, skipping the message.")
+		return
+	}
+
+	armReqCtx, err := op.ARMRequestContext()
+	if err != nil {
+		opLogger.Error(err, "failed to get ARM request context.")
+		return
+	}
+	reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
+
+	asyncCtrl, err := w.registry.Get(armReqCtx.OperationType)
+	if err != nil {
+		opLogger.Error(err, "failed to get async controller.")
+		if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to finish the message")
+		}
+		return
+	}
+
+	if asyncCtrl == nil {
+		opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
+		if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to finish the message")
+		}
+		return
+	}
+
+	if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
+		errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+		opLogger.Error(nil, errMsg)
+		failed := ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: errMsg,
+		})
+
+		// Move the message to the dead-letter store instead of dropping it so that it can be inspected and replayed.
+		reason := w.deadLetterReason(reqCtx, op, errMsg)
+		w.deadLetterOperation(reqCtx, msgreq, failed, reason, asyncCtrl.DatabaseClient())
+		return
+	}
+
+	// TODO: Handle the edge cases:
+	// 1. The same message is delivered twice in multiple instances.
+	// 2. provisioningState is not matched between resource and operationStatuses
+
+	status, err := w.getOperationStatus(reqCtx, op)
+	if err != nil {
+		opLogger.Error(err, "failed to check potential deduplication.")
+		return
+	}
+	if w.isDuplicated(status, msgreq.ReplayCount > 0) {
+		opLogger.Info("duplicated message detected")
+		return
+	}
+
+	// The cancellation was requested before the operation started, so complete it without running the controller.
+	if status.CancelRequested {
+		opLogger.Info("Operation was canceled before it started.")
+		w.completeOperation(reqCtx, msgreq, newCanceledResult(op), asyncCtrl.DatabaseClient())
+		return
+	}
+
+	if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.DatabaseClient(), op, v1.ProvisioningStateUpdating, nil, nil); err != nil {
+		return
+	}
+
+	w.runOperation(reqCtx, msgreq, asyncCtrl)
 }
 
 func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
@@ -232,11 +365,11 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
-	asyncReqCtx, opCancel := context.WithCancel(ctx)
+	asyncReqCtx, opCancel := context.WithCancelCause(ctx)
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
-	defer opCancel()
+	defer opCancel(nil)
 
 	opDone := make(chan struct{}, 1)
 	opStartAt := time.Now()
@@ -285,29 +418,49 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	// The timer must survive the iterations of the loop below, otherwise the cancellation poll would restart it.
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+
+	cancellationPoll := time.NewTicker(w.options.CancellationPollInterval)
+	defer cancellationPoll.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
 
-			opCancel()
+			opCancel(context.DeadlineExceeded)
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
 			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
 			return
 
+		case <-cancellationPoll.C:
+			status, err := w.getOperationStatus(ctx, asyncReq)
+			if err != nil {
+				logger.Error(err, "failed to check the cancellation of async operation")
+				continue
+			}
+			if !status.CancelRequested {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested by the client.")
+			opCancel(ctrl.ErrOperationCanceled)
+			w.completeOperation(ctx, message, newCanceledResult(asyncReq), asyncCtrl.DatabaseClient())
+			return
+
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
 			return
@@ -323,6 +476,13 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// newCanceledResult returns the result of an async operation canceled by the client.
+func newCanceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) was canceled.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -339,7 +499,7 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
-	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, result.Properties)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
 		return
@@ -355,7 +515,45 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
-func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
+// deadLetterOperation updates the resource and operation status to the result and moves the message to the dead-letter store.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, result ctrl.Result, reason string, sc database.Client) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, result.Properties)
+	if err != nil {
+		logger.Error(err, "failed to update resource and/or operation status")
+		return
+	}
+
+	if err := w.requestQueue.DeadLetterMessage(ctx, message, reason); err != nil {
+		logger.Error(err, "failed to dead-letter the message")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// deadLetterReason returns the reason recorded with a dead-lettered message. The last error of the operation
+// is included if the operation status has one.
+func (w *AsyncRequestProcessWorker) deadLetterReason(ctx context.Context, req *ctrl.Request, errMsg string) string {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return errMsg
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil || status.Error == nil {
+		return errMsg
+	}
+
+	return fmt.Sprintf("%s: last error: %s", errMsg, status.Error.Message)
+}
+
+func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails, properties any) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	rID, err := resources.ParseResource(req.ResourceID)
@@ -364,17 +562,20 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
-	if errors.Is(err, &database.ErrNotFound{}) {
-		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
-	} else if err != nil {
-		logger.Error(err, "failed to update the provisioningState in resource.")
-		return err
+	// Read-only operations do not change the resource, so only the operationStatus is updated.
+	if !req.ReadOnly {
+		err = updateResourceState(ctx, sc, rID.String(), state)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			logger.Info("failed to update the provisioningState in resource because it no longer exists.")
+		} else if err != nil {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return err
+		}
 	}
 
 	// Otherwise we update the operationStatus to the result.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr, properties)
 	if err != nil {
 		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
 		return err
@@ -383,15 +584,21 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
-	rID, err := resources.ParseResource(resourceID)
+// getOperationStatus gets the status of the async operation of the request.
+func (w *AsyncRequestProcessWorker) getOperationStatus(ctx context.Context, req *ctrl.Request) (*manager.Status, error) {
+	rID, err := resources.ParseResource(req.ResourceID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	status, err := w.sm.Get(ctx, rID, operationID)
-	if err != nil {
-		return false, err
+	return w.sm.Get(ctx, rID, req.OperationID)
+}
+
+func (w *AsyncRequestProcessWorker) isDuplicated(status *manager.Status, replayed bool) bool {
+	// A message replayed from the dead-letter store belongs to an operation that has failed when the message was
+	// dead-lettered, so it must be processed again.
+	if replayed && status.Status == v1.ProvisioningStateFailed {
+		return false
 	}
 
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
@@ -399,10 +606,10 @@ func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID
 	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
 		status.Status.IsTerminal() {
-		return true, nil
+		return true
 	}
 
-	return false, nil
+	return false
 }
 
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
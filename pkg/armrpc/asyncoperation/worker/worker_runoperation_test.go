@@ -206,7 +206,13 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateUpdating,
+			Error:  &v1.ErrorDetails{Code: v1.CodeInternal, Message: "boom"},
+		},
+	}, nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	expectedDequeueCount := 2
 
@@ -254,6 +260,149 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	deadLetters, err := tCtx.testQueue.ListDeadLetters(ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, testMessage.ID, deadLetters[0].ID)
+	require.Equal(t, "exceeded max retry count to process async operation message: 4: last error: boom", deadLetters[0].Reason)
+}
+
+func TestStart_ReplayedMessage(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	failedStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateFailed,
+		},
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(failedStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	registry := NewControllerRegistry()
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+	}
+
+	called := make(chan bool, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called <- true
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	// Dead-letter the message and replay it. The operation has failed, but the replayed message must be processed.
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+	msg, err := tCtx.testQueue.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	err = tCtx.testQueue.DeadLetterMessage(ctx, msg, "test reason")
+	require.NoError(t, err)
+	err = tCtx.testQueue.ReplayDeadLetter(ctx, msg.ID)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	<-called
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+}
+
+func TestStart_CancelRequestedBeforeStart(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateAccepted,
+		},
+		CancelRequested: true,
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).Times(1)
+
+	updated := make(chan v1.ProvisioningState, 1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, _ *v1.ErrorDetails, _ any) error {
+			updated <- state
+			return nil
+		}).Times(1)
+
+	registry := NewControllerRegistry()
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			require.Fail(t, "controller must not run for a canceled operation")
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	require.Equal(t, v1.ProvisioningStateCanceled, <-updated)
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -267,7 +416,7 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -343,7 +492,7 @@ func TestStart_RunOperation(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -411,7 +560,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -439,6 +588,41 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_ReadOnly(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	result := map[string]any{"changes": []any{}}
+
+	// Read-only operations do not read or save the resource.
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateSucceeded), gomock.Any(), gomock.Any(), gomock.Eq(result)).Return(nil).Times(1)
+
+	opTimeout := ctrl.DefaultAsyncOperationTimeout
+	testMessage := queue.NewMessage(&ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.CORE/ENVIRONMENTS|ACTIONPLAN",
+		ResourceID:       "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+		OperationTimeout: &opTimeout,
+		ReadOnly:         true,
+	})
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.Result{Properties: result}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -449,7 +633,8 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -540,8 +725,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -579,6 +764,66 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateUpdating,
+		},
+		CancelRequested: true,
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
+			if state == v1.ProvisioningStateCanceled && opError.Message == "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) was canceled." &&
+				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
+				return nil
+			}
+			return errors.New("!!! failed to update status !!!")
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationPollInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	done := make(chan struct{}, 1)
+	var cause error
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			cause = context.Cause(ctx)
+			close(done)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.ErrorIs(t, cause, ctrl.ErrOperationCanceled)
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -24,6 +24,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -35,6 +36,8 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMessageExtendMargin, worker.options.MessageExtendMargin)
 	require.Equal(t, defaultMinMessageLockDuration, worker.options.MinMessageLockDuration)
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
+	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxScheduledMessages)
+	require.Equal(t, defaultCancellationPollInterval, worker.options.CancellationPollInterval)
 }
 
 func TestUpdateResourceState(t *testing.T) {
@@ -158,3 +161,61 @@ func TestErrorHandling(t *testing.T) {
 		require.Equal(t, tt.expectedArmErr, armErr)
 	}
 }
+
+func TestStartDequeuer_DequeuesOnDemand(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	queueClient := queue.NewMockClient(mctrl)
+
+	msg := queue.NewMessage("test")
+	queueClient.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, queue.ErrMessageNotFound)
+	queueClient.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(msg, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	worker := New(Options{DequeueIntervalDuration: time.Millisecond}, nil, queueClient, nil)
+	demand := make(chan struct{}, 1)
+	out := worker.startDequeuer(ctx, demand)
+
+	// Nothing is dequeued before a message is requested.
+	select {
+	case <-out:
+		require.Fail(t, "unexpected message")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	demand <- struct{}{}
+	select {
+	case received := <-out:
+		require.Equal(t, msg, received)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the message")
+	}
+
+	// No further message is dequeued until the next request.
+	select {
+	case <-out:
+		require.Fail(t, "unexpected message")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestExtendScheduledMessage(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	queueClient := queue.NewMockClient(mctrl)
+	worker := New(Options{}, nil, queueClient, nil)
+
+	expiring := queue.NewMessage("expiring")
+	expiring.NextVisibleAt = time.Now().Add(defaultMessageExtendMargin / 2)
+	leased := queue.NewMessage("leased")
+	leased.NextVisibleAt = time.Now().Add(5 * time.Minute)
+
+	queueClient.EXPECT().ExtendMessage(gomock.Any(), expiring).Return(nil)
+
+	sched := newScheduler()
+	sched.push(expiring)
+	sched.push(leased)
+	sched.each(func(msg *queue.Message) {
+		worker.extendScheduledMessage(context.Background(), msg)
+	})
+}
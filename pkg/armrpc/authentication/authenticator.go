@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ErrInvalidCredentials is returned by an Authenticator when the request carries credentials that are not valid.
+var ErrInvalidCredentials = errors.New("the provided credentials are not valid")
+
+// Authenticator identifies the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the principal of the request. It returns nil and no error when the request does not
+	// carry credentials understood by this authenticator.
+	Authenticate(r *http.Request) (*v1.Principal, error)
+}
+
+// Authenticate returns a middleware that identifies the caller using the given authenticators and stores
+// the principal in the ARM request context. Authenticators are tried in order and the first one which
+// recognizes the credentials wins. Requests without credentials pass through unauthenticated so that
+// authorization can decide how to handle them. ARMRequestCtx middleware must run before this middleware.
+func Authenticate(authenticators ...Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log := logr.FromContextOrDiscard(r.Context())
+			rpcContext := v1.ARMRequestContextFromContext(r.Context())
+
+			for _, authenticator := range authenticators {
+				principal, err := authenticator.Authenticate(r)
+				if err != nil {
+					log.V(ucplog.LevelDebug).Info("authentication failed", "error", err.Error())
+					handleErr(r.Context(), w, r)
+					return
+				}
+
+				if principal != nil {
+					rpcContext.Principal = principal
+					break
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken returns the bearer token in the Authorization header of the request, or an empty string.
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newFakeTokenReviewClient(tokens map[string]authenticationv1.UserInfo) (*fake.Clientset, *int) {
+	calls := 0
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		calls++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		user, ok := tokens[review.Spec.Token]
+		review.Status = authenticationv1.TokenReviewStatus{Authenticated: ok, User: user}
+		return true, review, nil
+	})
+	return client, &calls
+}
+
+func Test_Authenticate(t *testing.T) {
+	client, calls := newFakeTokenReviewClient(map[string]authenticationv1.UserInfo{
+		"valid-token": {UID: "8a5f6e1c", Username: "system:serviceaccount:radius-system:dynamic-rp", Groups: []string{"system:serviceaccounts"}},
+	})
+	authenticator := NewTokenReviewAuthenticator(client, nil)
+
+	tests := []struct {
+		name          string
+		authorization string
+		code          int
+		principal     *v1.Principal
+	}{
+		{
+			name:      "no credentials",
+			code:      http.StatusOK,
+			principal: nil,
+		},
+		{
+			name:          "non-bearer credentials",
+			authorization: "Basic dXNlcjpwYXNz",
+			code:          http.StatusOK,
+			principal:     nil,
+		},
+		{
+			name:          "valid token",
+			authorization: "Bearer valid-token",
+			code:          http.StatusOK,
+			principal: &v1.Principal{
+				ID:     "8a5f6e1c",
+				Name:   "system:serviceaccount:radius-system:dynamic-rp",
+				Groups: []string{"system:serviceaccounts"},
+				Type:   v1.PrincipalTypeServiceAccount,
+			},
+		},
+		{
+			name:          "invalid token",
+			authorization: "Bearer invalid-token",
+			code:          http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var principal *v1.Principal
+			handler := Authenticate(authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal = v1.PrincipalFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.principal, principal)
+		})
+	}
+
+	t.Run("cached", func(t *testing.T) {
+		before := *calls
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set("Authorization", "Bearer valid-token")
+
+		principal, err := authenticator.Authenticate(req)
+		require.NoError(t, err)
+		require.NotNil(t, principal)
+		require.Equal(t, before, *calls)
+	})
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// DefaultOIDCUsernameClaim is the claim used as the principal name when no claim is configured.
+	DefaultOIDCUsernameClaim = "sub"
+	// DefaultOIDCGroupsClaim is the claim used as the principal groups when no claim is configured.
+	DefaultOIDCGroupsClaim = "groups"
+
+	// reservedPrefix is the prefix of names reserved for Kubernetes identities. Tokens from an external
+	// issuer must not be able to claim these names, for example the 'system:masters' group.
+	reservedPrefix = "system:"
+
+	// oidcClockSkew is the allowed clock skew when validating the time claims of a token.
+	oidcClockSkew = 1 * time.Minute
+
+	// oidcMinKeyRefreshInterval is the minimum interval between refreshes of the key set caused by unknown key IDs.
+	oidcMinKeyRefreshInterval = 1 * time.Minute
+)
+
+// supportedSigningAlgorithms are the signing algorithms accepted for OIDC tokens.
+var supportedSigningAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// OIDCOptions configures an OIDCAuthenticator.
+type OIDCOptions struct {
+	// Issuer is the URL of the OIDC issuer. It must match the 'iss' claim of the tokens. Required.
+	Issuer string
+
+	// Audiences is the list of accepted audiences. The 'aud' claim of the tokens must contain one of them. Required.
+	Audiences []string
+
+	// JWKSURL is the URL of the JSON Web Key Set of the issuer. When empty it is discovered from the
+	// OpenID configuration document of the issuer.
+	JWKSURL string
+
+	// UsernameClaim is the claim used as the principal name. Defaults to 'sub'.
+	UsernameClaim string
+
+	// UsernamePrefix is prepended to the principal name, for example 'oidc:'. Optional.
+	UsernamePrefix string
+
+	// GroupsClaim is the claim used as the principal groups. Defaults to 'groups'.
+	GroupsClaim string
+
+	// GroupsPrefix is prepended to each of the principal groups. Optional.
+	GroupsPrefix string
+
+	// HTTPClient is the client used to fetch the OpenID configuration and the key set. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+var _ Authenticator = (*OIDCAuthenticator)(nil)
+
+// OIDCAuthenticator authenticates OIDC bearer tokens (JWTs) signed by a configured issuer.
+type OIDCAuthenticator struct {
+	options OIDCOptions
+
+	mutex       sync.Mutex
+	keys        *jose.JSONWebKeySet
+	refreshedAt time.Time
+	now         func() time.Time
+}
+
+// NewOIDCAuthenticator creates a new OIDCAuthenticator. The key set of the issuer is fetched on first use.
+func NewOIDCAuthenticator(options OIDCOptions) (*OIDCAuthenticator, error) {
+	if options.Issuer == "" {
+		return nil, errors.New("the OIDC issuer is required")
+	}
+	if len(options.Audiences) == 0 {
+		return nil, errors.New("at least one OIDC audience is required")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = DefaultOIDCUsernameClaim
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = DefaultOIDCGroupsClaim
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+
+	return &OIDCAuthenticator{options: options, now: time.Now}, nil
+}
+
+// Authenticate validates the bearer token of the request and returns the principal described by its claims.
+// It returns nil when the request does not have a bearer token or the token was not issued by the configured issuer,
+// so that other authenticators can handle it.
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) (*v1.Principal, error) {
+	raw := bearerToken(r)
+	if raw == "" {
+		return nil, nil
+	}
+
+	token, err := jwt.ParseSigned(raw, supportedSigningAlgorithms)
+	if err != nil {
+		// Not a JWT, or signed with an algorithm we don't support.
+		return nil, nil
+	}
+
+	unverified := jwt.Claims{}
+	if err := token.UnsafeClaimsWithoutVerification(&unverified); err != nil || unverified.Issuer != a.options.Issuer {
+		return nil, nil
+	}
+
+	if len(token.Headers) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+
+	key, err := a.getKey(r.Context(), token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.Claims{}
+	custom := map[string]any{}
+	if err := token.Claims(key, &claims, &custom); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	expected := jwt.Expected{
+		Issuer:      a.options.Issuer,
+		AnyAudience: jwt.Audience(a.options.Audiences),
+		Time:        a.now(),
+	}
+	if err := claims.ValidateWithLeeway(expected, oidcClockSkew); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	return a.principal(custom)
+}
+
+func (a *OIDCAuthenticator) principal(claims map[string]any) (*v1.Principal, error) {
+	username, ok := claims[a.options.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("%w: the token does not have a %q claim", ErrInvalidCredentials, a.options.UsernameClaim)
+	}
+
+	username = a.options.UsernamePrefix + username
+	if strings.HasPrefix(username, reservedPrefix) {
+		return nil, fmt.Errorf("%w: the principal name %q is reserved", ErrInvalidCredentials, username)
+	}
+
+	groups := []string{}
+	switch value := claims[a.options.GroupsClaim].(type) {
+	case string:
+		groups = append(groups, value)
+	case []any:
+		for _, item := range value {
+			if group, ok := item.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	result := []string{}
+	for _, group := range groups {
+		group = a.options.GroupsPrefix + group
+		if group == "" || strings.HasPrefix(group, reservedPrefix) {
+			continue
+		}
+		result = append(result, group)
+	}
+
+	// The subject is unique per issuer, whereas the username claim may be reassigned.
+	id, _ := claims["sub"].(string)
+
+	return &v1.Principal{
+		ID:     id,
+		Name:   username,
+		Groups: result,
+		Type:   v1.PrincipalTypeUser,
+	}, nil
+}
+
+// getKey returns the key with the given key ID. The key set is refreshed when the key is not known, which
+// handles key rotation by the issuer.
+func (a *OIDCAuthenticator) getKey(ctx context.Context, keyID string) (any, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.keys != nil {
+		if key := lookupKey(a.keys, keyID); key != nil {
+			return key, nil
+		}
+
+		if a.now().Sub(a.refreshedAt) < oidcMinKeyRefreshInterval {
+			return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidCredentials, keyID)
+		}
+	}
+
+	keys, err := a.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.keys = keys
+	a.refreshedAt = a.now()
+
+	if key := lookupKey(a.keys, keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidCredentials, keyID)
+}
+
+func lookupKey(keys *jose.JSONWebKeySet, keyID string) any {
+	if keyID == "" {
+		// Tokens without a key ID are only accepted when the issuer has a single key.
+		if len(keys.Keys) == 1 {
+			return keys.Keys[0].Public().Key
+		}
+		return nil
+	}
+
+	for _, key := range keys.Key(keyID) {
+		if key.Use == "" || key.Use == "sig" {
+			return key.Public().Key
+		}
+	}
+
+	return nil
+}
+
+func (a *OIDCAuthenticator) fetchKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	jwksURL := a.options.JWKSURL
+	if jwksURL == "" {
+		discovery := struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}{}
+		err := a.getJSON(ctx, strings.TrimSuffix(a.options.Issuer, "/")+"/.well-known/openid-configuration", &discovery)
+		if err != nil {
+			return nil, err
+		}
+
+		if discovery.Issuer != a.options.Issuer {
+			return nil, fmt.Errorf("the OpenID configuration is for issuer %q, expected %q", discovery.Issuer, a.options.Issuer)
+		}
+		if discovery.JWKSURI == "" {
+			return nil, errors.New("the OpenID configuration does not have a jwks_uri")
+		}
+
+		jwksURL = discovery.JWKSURI
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	if err := a.getJSON(ctx, jwksURL, keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (a *OIDCAuthenticator) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.options.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %q: unexpected status code %d", url, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %q: %w", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/test/testoidc"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewOIDCAuthenticator_Invalid(t *testing.T) {
+	_, err := NewOIDCAuthenticator(OIDCOptions{Audiences: []string{"radius"}})
+	require.Error(t, err)
+
+	_, err = NewOIDCAuthenticator(OIDCOptions{Issuer: "https://issuer.example.com"})
+	require.Error(t, err)
+}
+
+func Test_OIDCAuthenticator_Authenticate(t *testing.T) {
+	issuer := testoidc.NewIssuer(t)
+	other := testoidc.NewIssuer(t)
+
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{
+		Issuer:       issuer.URL,
+		Audiences:    []string{testoidc.DefaultAudience},
+		GroupsPrefix: "oidc:",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		token     string
+		principal *v1.Principal
+		err       bool
+	}{
+		{
+			name:      "no token",
+			token:     "",
+			principal: nil,
+		},
+		{
+			name:      "not a jwt",
+			token:     "opaque-token",
+			principal: nil,
+		},
+		{
+			name:      "token from another issuer",
+			token:     other.Token(testoidc.TokenOptions{Subject: "alice"}),
+			principal: nil,
+		},
+		{
+			name:  "valid token",
+			token: issuer.Token(testoidc.TokenOptions{Subject: "alice", Groups: []string{"developers", "system:masters"}}),
+			principal: &v1.Principal{
+				ID:     "alice",
+				Name:   "alice",
+				Groups: []string{"oidc:developers", "oidc:system:masters"},
+				Type:   v1.PrincipalTypeUser,
+			},
+		},
+		{
+			name:  "expired token",
+			token: issuer.Token(testoidc.TokenOptions{Subject: "alice", Expiry: time.Now().Add(-time.Hour)}),
+			err:   true,
+		},
+		{
+			name:  "wrong audience",
+			token: issuer.Token(testoidc.TokenOptions{Subject: "alice", Audience: "someone-else"}),
+			err:   true,
+		},
+		{
+			name:  "reserved name",
+			token: issuer.Token(testoidc.TokenOptions{Subject: "system:serviceaccount:radius-system:ucp"}),
+			err:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			principal, err := authenticator.Authenticate(req)
+			if tc.err {
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.principal, principal)
+		})
+	}
+}
+
+func Test_OIDCAuthenticator_ReservedGroups(t *testing.T) {
+	issuer := testoidc.NewIssuer(t)
+
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{
+		Issuer:        issuer.URL,
+		Audiences:     []string{testoidc.DefaultAudience},
+		UsernameClaim: "email",
+	})
+	require.NoError(t, err)
+
+	token := issuer.Token(testoidc.TokenOptions{
+		Subject: "1234",
+		Groups:  []string{"developers", "system:masters"},
+		Claims:  map[string]any{"email": "alice@contoso.com"},
+	})
+	req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	principal, err := authenticator.Authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, "alice@contoso.com", principal.Name)
+	require.Equal(t, []string{"developers"}, principal.Groups)
+}
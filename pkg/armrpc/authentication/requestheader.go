@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+var (
+	// DefaultUsernameHeaders is the list of headers the Kubernetes API server uses to forward the user name.
+	DefaultUsernameHeaders = []string{"X-Remote-User"}
+	// DefaultGroupHeaders is the list of headers the Kubernetes API server uses to forward the groups of the user.
+	DefaultGroupHeaders = []string{"X-Remote-Group"}
+	// DefaultUIDHeaders is the list of headers the Kubernetes API server uses to forward the UID of the user.
+	DefaultUIDHeaders = []string{"X-Remote-Uid"}
+)
+
+var _ Authenticator = (*RequestHeaderAuthenticator)(nil)
+
+// RequestHeaderOptions is the configuration of RequestHeaderAuthenticator.
+type RequestHeaderOptions struct {
+	// ClientCA is the PEM encoded CA bundle that signs the client certificate of the authenticating proxy.
+	ClientCA []byte
+
+	// AllowedNames is the list of common names accepted for the client certificate. Any name signed by
+	// ClientCA is accepted when empty.
+	AllowedNames []string
+
+	// UsernameHeaders is the list of headers to read the user name from. Defaults to DefaultUsernameHeaders.
+	UsernameHeaders []string
+
+	// GroupHeaders is the list of headers to read the groups from. Defaults to DefaultGroupHeaders.
+	GroupHeaders []string
+
+	// UIDHeaders is the list of headers to read the UID from. Defaults to DefaultUIDHeaders.
+	UIDHeaders []string
+}
+
+// RequestHeaderAuthenticator authenticates requests forwarded by an authenticating proxy, such as the Kubernetes
+// API server serving an aggregated API. The proxy identifies itself with a client certificate and forwards the
+// identity of the caller in request headers. The headers are only trusted when the client certificate is valid.
+type RequestHeaderAuthenticator struct {
+	roots           *x509.CertPool
+	allowedNames    []string
+	usernameHeaders []string
+	groupHeaders    []string
+	uidHeaders      []string
+}
+
+// NewRequestHeaderAuthenticator creates a new RequestHeaderAuthenticator.
+func NewRequestHeaderAuthenticator(options RequestHeaderOptions) (*RequestHeaderAuthenticator, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(options.ClientCA) {
+		return nil, errors.New("the client CA does not contain any PEM encoded certificates")
+	}
+
+	authenticator := &RequestHeaderAuthenticator{
+		roots:           roots,
+		allowedNames:    options.AllowedNames,
+		usernameHeaders: options.UsernameHeaders,
+		groupHeaders:    options.GroupHeaders,
+		uidHeaders:      options.UIDHeaders,
+	}
+	if len(authenticator.usernameHeaders) == 0 {
+		authenticator.usernameHeaders = DefaultUsernameHeaders
+	}
+	if len(authenticator.groupHeaders) == 0 {
+		authenticator.groupHeaders = DefaultGroupHeaders
+	}
+	if len(authenticator.uidHeaders) == 0 {
+		authenticator.uidHeaders = DefaultUIDHeaders
+	}
+
+	return authenticator, nil
+}
+
+// Authenticate returns the principal forwarded by the authenticating proxy. It returns nil when the request does
+// not carry a client certificate, so that requests made directly to the server are handled by other authenticators.
+func (a *RequestHeaderAuthenticator) Authenticate(r *http.Request) (*v1.Principal, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, nil
+	}
+
+	if err := a.verify(r.TLS.PeerCertificates); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	name := firstHeader(r.Header, a.usernameHeaders)
+	if name == "" {
+		return nil, nil
+	}
+
+	groups := []string{}
+	for _, header := range a.groupHeaders {
+		groups = append(groups, r.Header.Values(header)...)
+	}
+
+	principal := v1.NewPrincipal(name, groups)
+	principal.ID = firstHeader(r.Header, a.uidHeaders)
+	return principal, nil
+}
+
+// verify verifies that the client certificate is signed by the client CA and has one of the allowed names.
+func (a *RequestHeaderAuthenticator) verify(certificates []*x509.Certificate) error {
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, certificates[0].Subject.CommonName) {
+		return fmt.Errorf("the client certificate name %q is not allowed", certificates[0].Subject.CommonName)
+	}
+
+	return nil
+}
+
+// firstHeader returns the first non-empty value of the given headers.
+func firstHeader(header http.Header, names []string) string {
+	for _, name := range names {
+		if value := strings.TrimSpace(header.Get(name)); value != "" {
+			return value
+		}
+	}
+	return ""
+}
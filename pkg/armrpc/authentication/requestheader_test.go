@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{certificate: certificate, key: key}
+}
+
+func (ca *testCA) PEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.certificate.Raw})
+}
+
+func (ca *testCA) issue(t *testing.T, commonName string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+func Test_NewRequestHeaderAuthenticator_Invalid(t *testing.T) {
+	_, err := NewRequestHeaderAuthenticator(RequestHeaderOptions{ClientCA: []byte("not a certificate")})
+	require.Error(t, err)
+}
+
+func Test_RequestHeaderAuthenticator_Authenticate(t *testing.T) {
+	ca := newTestCA(t)
+	other := newTestCA(t)
+
+	authenticator, err := NewRequestHeaderAuthenticator(RequestHeaderOptions{
+		ClientCA:     ca.PEM(),
+		AllowedNames: []string{"front-proxy-client"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		certificate *x509.Certificate
+		headers     map[string][]string
+		principal   *v1.Principal
+		err         bool
+	}{
+		{
+			name:      "no client certificate",
+			headers:   map[string][]string{"X-Remote-User": {"system:masters"}},
+			principal: nil,
+		},
+		{
+			name:        "no user header",
+			certificate: ca.issue(t, "front-proxy-client"),
+			principal:   nil,
+		},
+		{
+			name:        "forwarded user",
+			certificate: ca.issue(t, "front-proxy-client"),
+			headers: map[string][]string{
+				"X-Remote-User":  {"system:serviceaccount:radius-system:applications-rp"},
+				"X-Remote-Group": {"system:serviceaccounts", "system:authenticated"},
+				"X-Remote-Uid":   {"8a5f6e1c"},
+			},
+			principal: &v1.Principal{
+				ID:     "8a5f6e1c",
+				Name:   "system:serviceaccount:radius-system:applications-rp",
+				Groups: []string{"system:serviceaccounts", "system:authenticated"},
+				Type:   v1.PrincipalTypeServiceAccount,
+			},
+		},
+		{
+			name:        "certificate from another CA",
+			certificate: other.issue(t, "front-proxy-client"),
+			headers:     map[string][]string{"X-Remote-User": {"alice"}},
+			err:         true,
+		},
+		{
+			name:        "name not allowed",
+			certificate: ca.issue(t, "someone-else"),
+			headers:     map[string][]string{"X-Remote-User": {"alice"}},
+			err:         true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			for name, values := range tc.headers {
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+			if tc.certificate != nil {
+				req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tc.certificate}}
+			}
+
+			principal, err := authenticator.Authenticate(req)
+			if tc.err {
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.principal, principal)
+		})
+	}
+}
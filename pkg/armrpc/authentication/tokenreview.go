@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// tokenReviewCacheTTL is the duration for which the result of a token review is cached.
+	tokenReviewCacheTTL = 1 * time.Minute
+)
+
+var _ Authenticator = (*TokenReviewAuthenticator)(nil)
+
+// TokenReviewAuthenticator authenticates bearer tokens using the Kubernetes TokenReview API. This supports Kubernetes
+// ServiceAccount tokens as well as any OIDC tokens trusted by the Kubernetes API server.
+type TokenReviewAuthenticator struct {
+	client    kubernetes.Interface
+	audiences []string
+
+	mutex sync.Mutex
+	cache map[string]tokenReviewCacheEntry
+	now   func() time.Time
+}
+
+type tokenReviewCacheEntry struct {
+	principal *v1.Principal
+	expiresAt time.Time
+}
+
+// NewTokenReviewAuthenticator creates a new TokenReviewAuthenticator. When audiences is empty the audience of the
+// Kubernetes API server is assumed.
+func NewTokenReviewAuthenticator(client kubernetes.Interface, audiences []string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{
+		client:    client,
+		audiences: audiences,
+		cache:     map[string]tokenReviewCacheEntry{},
+		now:       time.Now,
+	}
+}
+
+// Authenticate validates the bearer token of the request using the Kubernetes TokenReview API and returns the
+// principal it belongs to. It returns nil when the request does not have a bearer token.
+func (a *TokenReviewAuthenticator) Authenticate(r *http.Request) (*v1.Principal, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, nil
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if principal, ok := a.lookup(key); ok {
+		return principal, nil
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}
+	result, err := a.client.AuthenticationV1().TokenReviews().Create(r.Context(), review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+
+	if !result.Status.Authenticated {
+		return nil, ErrInvalidCredentials
+	}
+
+	principal := v1.NewPrincipal(result.Status.User.Username, result.Status.User.Groups)
+	principal.ID = result.Status.User.UID
+	a.store(key, principal)
+	return principal, nil
+}
+
+func (a *TokenReviewAuthenticator) lookup(key string) (*v1.Principal, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	entry, ok := a.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if a.now().After(entry.expiresAt) {
+		delete(a.cache, key)
+		return nil, false
+	}
+
+	return entry.principal, true
+}
+
+func (a *TokenReviewAuthenticator) store(key string, principal *v1.Principal) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	// Drop expired entries so the cache does not grow without bound.
+	now := a.now()
+	for k, entry := range a.cache {
+		if now.After(entry.expiresAt) {
+			delete(a.cache, k)
+		}
+	}
+
+	a.cache[key] = tokenReviewCacheEntry{principal: principal, expiresAt: now.Add(tokenReviewCacheTTL)}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// ActionRead is the verb of actions that read resources.
+	ActionRead = "read"
+	// ActionWrite is the verb of actions that create or update resources.
+	ActionWrite = "write"
+	// ActionDelete is the verb of actions that delete resources.
+	ActionDelete = "delete"
+	// ActionCustom is the verb suffix of custom (POST) actions, for example 'Applications.Core/environments/getMetadata/action'.
+	ActionCustom = "action"
+
+	// ResourceGroupsResourceType is the resource type used for actions on resource group collections.
+	ResourceGroupsResourceType = "System.Resources/resourceGroups"
+	// PlanesResourceType is the resource type used for actions on the collection of planes.
+	PlanesResourceType = "System.Resources/planes"
+	// OperationsResourceType is the resource type used for actions on endpoints that are not resources.
+	OperationsResourceType = "System.Resources/operations"
+)
+
+// Authorizer decides whether a principal is allowed to perform an action.
+type Authorizer interface {
+	// Authorize returns true if the principal is allowed to perform the action on the resource or scope identified by id.
+	Authorize(ctx context.Context, principal *v1.Principal, action string, id resources.ID) (bool, error)
+}
+
+// Authorize returns a middleware which checks every request using the given authorizer. Requests to the paths listed
+// in skipPaths are not checked. Requests without an authenticated principal are rejected with 401 and requests the
+// principal is not allowed to perform are rejected with 403. ARMRequestCtx middleware and the authentication
+// middleware must run before this middleware.
+func Authorize(authorizer Authorizer, skipPaths ...string) func(http.Handler) http.Handler {
+	skip := map[string]bool{}
+	for _, path := range skipPaths {
+		skip[strings.ToLower(path)] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if skip[strings.ToLower(r.URL.Path)] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			log := logr.FromContextOrDiscard(ctx)
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+			if rpcContext.Principal == nil {
+				log.V(ucplog.LevelDebug).Info("request is not authenticated")
+				applyResponse(ctx, w, r, rest.NewClientAuthenticationFailedARMResponse())
+				return
+			}
+
+			id := rpcContext.ResourceID
+			action := Action(r.Method, r.URL.Path, id)
+			allowed, err := authorizer.Authorize(ctx, rpcContext.Principal, action, id)
+			if err != nil {
+				log.Error(err, "failed to authorize request", "principal", rpcContext.Principal.Name, "action", action)
+				applyResponse(ctx, w, r, rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: err.Error(),
+					},
+				}))
+				return
+			}
+
+			if !allowed {
+				scope := id.String()
+				if id.IsEmpty() {
+					scope = "/"
+				}
+				message := fmt.Sprintf("The principal %q does not have authorization to perform action %q over scope %q.", rpcContext.Principal.Name, action, scope)
+				applyResponse(ctx, w, r, rest.NewForbiddenResponse(message))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Action returns the action performed by a request, for example 'Applications.Core/applications/write'.
+// The id must be the resource ID parsed from the request path using resources.ParseByMethod.
+func Action(method string, path string, id resources.ID) string {
+	resourceType := actionResourceType(id)
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return resourceType + "/" + ActionRead
+	case http.MethodPut, http.MethodPatch:
+		return resourceType + "/" + ActionWrite
+	case http.MethodDelete:
+		return resourceType + "/" + ActionDelete
+	default:
+		// Custom actions are the last segment of the path, for example '.../environments/env0/getMetadata'.
+		path = strings.TrimSuffix(path, "/")
+		name := path[strings.LastIndex(path, "/")+1:]
+		return resourceType + "/" + name + "/" + ActionCustom
+	}
+}
+
+func actionResourceType(id resources.ID) string {
+	if id.IsEmpty() {
+		return OperationsResourceType
+	}
+
+	if resourceType := id.Type(); resourceType != "" {
+		return resourceType
+	}
+
+	if id.IsScopeCollection() {
+		if len(id.ScopeSegments()) == 0 {
+			return PlanesResourceType
+		}
+		return ResourceGroupsResourceType
+	}
+
+	return PlanesResourceType
+}
+
+func applyResponse(ctx context.Context, w http.ResponseWriter, r *http.Request, resp rest.Response) {
+	err := resp.Apply(ctx, w, r)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "error writing response")
+		// There's no way to recover if we fail writing here, we likely partially wrote to the response stream.
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
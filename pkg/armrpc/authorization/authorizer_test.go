@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+type testAuthorizer struct {
+	allowed bool
+	err     error
+	action  string
+}
+
+func (a *testAuthorizer) Authorize(ctx context.Context, principal *v1.Principal, action string, id resources.ID) (bool, error) {
+	a.action = action
+	return a.allowed, a.err
+}
+
+func Test_Action(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodGet, "/planes", "System.Resources/planes/read"},
+		{http.MethodGet, "/planes/radius/local", "System.Radius/planes/read"},
+		{http.MethodGet, "/planes/radius/local/resourcegroups", "System.Resources/resourceGroups/read"},
+		{http.MethodPut, "/planes/radius/local/resourcegroups/rg", "System.Resources/resourceGroups/write"},
+		{http.MethodDelete, "/planes/radius/local/resourcegroups/rg", "System.Resources/resourceGroups/delete"},
+		{http.MethodGet, "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/applications", "Applications.Core/applications/read"},
+		{http.MethodPatch, "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/applications/app", "Applications.Core/applications/write"},
+		{http.MethodPost, "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/environments/env/getMetadata", "Applications.Core/environments/getMetadata/action"},
+		{http.MethodPut, "/planes/radius/local/providers/System.Authorization/roleAssignments/test", "System.Authorization/roleAssignments/write"},
+		{http.MethodGet, "invalid", "System.Resources/operations/read"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			id, _ := resources.ParseByMethod(tc.path, tc.method)
+			require.Equal(t, tc.expected, Action(tc.method, tc.path, id))
+		})
+	}
+}
+
+func Test_Authorize(t *testing.T) {
+	principal := v1.NewPrincipal("user@contoso.com", nil)
+
+	tests := []struct {
+		name       string
+		path       string
+		principal  *v1.Principal
+		authorizer *testAuthorizer
+		code       int
+	}{
+		{
+			name:       "allowed",
+			path:       "/planes/radius/local/resourcegroups/rg",
+			principal:  principal,
+			authorizer: &testAuthorizer{allowed: true},
+			code:       http.StatusOK,
+		},
+		{
+			name:       "denied",
+			path:       "/planes/radius/local/resourcegroups/rg",
+			principal:  principal,
+			authorizer: &testAuthorizer{allowed: false},
+			code:       http.StatusForbidden,
+		},
+		{
+			name:       "unauthenticated",
+			path:       "/planes/radius/local/resourcegroups/rg",
+			principal:  nil,
+			authorizer: &testAuthorizer{allowed: true},
+			code:       http.StatusUnauthorized,
+		},
+		{
+			name:       "error",
+			path:       "/planes/radius/local/resourcegroups/rg",
+			principal:  principal,
+			authorizer: &testAuthorizer{err: errors.New("database is unavailable")},
+			code:       http.StatusInternalServerError,
+		},
+		{
+			name:       "skipped path",
+			path:       "/healthz",
+			principal:  nil,
+			authorizer: &testAuthorizer{allowed: false},
+			code:       http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := Authorize(tc.authorizer, "/healthz")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			id, _ := resources.ParseByMethod(tc.path, http.MethodPut)
+			req := httptest.NewRequest(http.MethodPut, tc.path, nil)
+			req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{ResourceID: id, Principal: tc.principal}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			if tc.code == http.StatusForbidden {
+				require.Contains(t, w.Body.String(), v1.CodeAuthorizationFailed)
+				require.Contains(t, w.Body.String(), "System.Resources/resourceGroups/write")
+			}
+		})
+	}
+}
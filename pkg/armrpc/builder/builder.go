@@ -73,6 +73,22 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/%s", rootScopePath, namespace, defaultoperation.CancelOperationActionName),
+		ResourceType:      statusType,
+		Method:            v1.OperationPost,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -160,6 +160,14 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationList},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationPost},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/components/queue"
 )
 
 const customActionPrefix = "ACTION"
@@ -55,6 +56,25 @@ type Operation[T any] struct {
 	// If this is 0 then the default value of v1.DefaultRetryAfter will be used. Consider setting this to a smaller
 	// value like 5 seconds if your operations will complete quickly.
 	AsyncOperationRetryAfter time.Duration
+
+	// AsyncOperationPriority is the priority of the async operations queued for the operation. Consider setting
+	// this to queue.PriorityLow if the operation executes a recipe.
+	AsyncOperationPriority queue.Priority
+}
+
+// WhatIfOperation configures the what-if action, which previews the changes of the PUT operation without
+// persisting them.
+type WhatIfOperation[T any] struct {
+	// Disabled indicates that the what-if action is disabled. By default, the action is enabled whenever the PUT
+	// operation is enabled.
+	Disabled bool
+
+	// UpdateFilters is a slice of filters that execute after the update filters of the PUT operation. Resource types
+	// with a custom PUT controller use them to run the validation of the controller without its side effects.
+	UpdateFilters []controller.UpdateFilter[T]
+
+	// OutputResourcesPreview renders the output resources of the resource without deploying them.
+	OutputResourcesPreview controller.OutputResourcesPreview[T]
 }
 
 // ResourceOption is the option for ResourceNode. It defines model converters for request and response
@@ -94,6 +114,9 @@ type ResourceOption[P interface {
 	// Delete defines the operation for deleting a resource.
 	Delete Operation[T]
 
+	// WhatIf defines the what-if action for previewing the changes of the PUT operation.
+	WhatIf WhatIfOperation[T]
+
 	// Custom defines the custom actions.
 	Custom map[string]Operation[T]
 }
@@ -127,6 +150,7 @@ func (r *ResourceOption[P, T]) BuildHandlerOutputs(opts BuildOptions) []*Operati
 		r.putOutput,
 		r.patchOutput,
 		r.deleteOutput,
+		r.whatIfOutput,
 	}
 
 	hs := []*OperationRegistration{}
@@ -253,6 +277,7 @@ func (r *ResourceOption[P, T]) putOutput(opts BuildOptions) *OperationRegistrati
 			UpdateFilters:            r.Put.UpdateFilters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Put.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Put.AsyncOperationRetryAfter),
+			AsyncOperationPriority:   r.Put.AsyncOperationPriority,
 		}
 
 		if r.Put.AsyncJobController == nil {
@@ -291,6 +316,7 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 			UpdateFilters:            r.Patch.UpdateFilters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Patch.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Patch.AsyncOperationRetryAfter),
+			AsyncOperationPriority:   r.Patch.AsyncOperationPriority,
 		}
 
 		if r.Patch.AsyncJobController == nil {
@@ -328,6 +354,7 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 			DeleteFilters:            r.Delete.DeleteFilters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Delete.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Delete.AsyncOperationRetryAfter),
+			AsyncOperationPriority:   r.Delete.AsyncOperationPriority,
 		}
 
 		if r.Delete.AsyncJobController == nil {
@@ -344,6 +371,43 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 	return h
 }
 
+// whatIfOutput registers the what-if action which previews the changes of the PUT operation to the resource. The
+// request is converted and validated by the update filters of the PUT and what-if operations and the output resources
+// are rendered by the output resources preview, but nothing is persisted.
+//
+// A custom PUT controller is not run by the what-if action, so resource types with a custom PUT controller must
+// provide the validation of the controller as what-if update filters.
+func (r *ResourceOption[P, T]) whatIfOutput(opts BuildOptions) *OperationRegistration {
+	if r.Put.Disabled || r.WhatIf.Disabled {
+		return nil
+	}
+
+	if _, ok := r.Custom[v1.WhatIfActionName]; ok {
+		return nil
+	}
+
+	filters := []controller.UpdateFilter[T]{}
+	filters = append(filters, r.Put.UpdateFilters...)
+	filters = append(filters, r.WhatIf.UpdateFilters...)
+
+	ro := controller.ResourceOptions[T]{
+		RequestConverter:       r.RequestConverter,
+		ResponseConverter:      r.ResponseConverter,
+		UpdateFilters:          filters,
+		OutputResourcesPreview: r.WhatIf.OutputResourcesPreview,
+	}
+
+	return &OperationRegistration{
+		ResourceType:        opts.ResourceType,
+		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
+		Path:                "/" + strings.ToLower(v1.WhatIfActionName),
+		Method:              v1.OperationMethod(customActionPrefix + strings.ToUpper(v1.WhatIfActionName)),
+		APIController: func(opt controller.Options) (controller.Controller, error) {
+			return defaultoperation.NewWhatIfResource[P, T](opt, ro)
+		},
+	}
+}
+
 func (r *ResourceOption[P, T]) customActionOutputs(opts BuildOptions) []*OperationRegistration {
 	handlers := []*OperationRegistration{}
 
@@ -17,6 +17,7 @@ limitations under the License.
 package builder
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -25,6 +26,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/stretchr/testify/require"
 )
@@ -453,6 +455,91 @@ func TestResourceOption_DeleteOutput(t *testing.T) {
 	})
 }
 
+func TestResourceOption_WhatIfOutput(t *testing.T) {
+	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
+
+	t.Run("put is disabled", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			Put: Operation[rpctest.TestResourceDataModel]{
+				Disabled: true,
+			},
+		}
+		require.Nil(t, option.whatIfOutput(testBuildOptionsWithName))
+	})
+
+	t.Run("what-if is disabled", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			WhatIf: WhatIfOperation[rpctest.TestResourceDataModel]{
+				Disabled: true,
+			},
+		}
+		require.Nil(t, option.whatIfOutput(testBuildOptionsWithName))
+	})
+
+	t.Run("custom put controller", func(t *testing.T) {
+		validate := func(ctx context.Context, newResource, oldResource *rpctest.TestResourceDataModel, options *controller.Options) (rest.Response, error) {
+			return nil, nil
+		}
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			Put: Operation[rpctest.TestResourceDataModel]{
+				APIController: func(opt controller.Options) (controller.Controller, error) {
+					return nil, nil
+				},
+			},
+			WhatIf: WhatIfOperation[rpctest.TestResourceDataModel]{
+				UpdateFilters: []controller.UpdateFilter[rpctest.TestResourceDataModel]{validate},
+				OutputResourcesPreview: func(ctx context.Context, newResource, oldResource *rpctest.TestResourceDataModel, options *controller.Options) ([]v1.WhatIfOutputResourceChange, error) {
+					return nil, nil
+				},
+			},
+		}
+		h := option.whatIfOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+
+		api, err := h.APIController(controller.Options{})
+		require.NoError(t, err)
+		whatIf, ok := api.(*defaultoperation.WhatIfResource[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		require.True(t, ok)
+		require.Len(t, whatIf.UpdateFilters(), 1)
+		require.NotNil(t, whatIf.OutputResourcesPreview())
+	})
+
+	t.Run("overridden by custom action", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			Custom: map[string]Operation[rpctest.TestResourceDataModel]{
+				v1.WhatIfActionName: {
+					APIController: func(opt controller.Options) (controller.Controller, error) {
+						return nil, nil
+					},
+				},
+			},
+		}
+		require.Nil(t, option.whatIfOutput(testBuildOptionsWithName))
+	})
+
+	t.Run("default controller", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			Put:        Operation[rpctest.TestResourceDataModel]{},
+		}
+		h := option.whatIfOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+		require.Equal(t, v1.OperationMethod("ACTIONWHATIF"), h.Method)
+
+		api, err := h.APIController(controller.Options{})
+		require.NoError(t, err)
+		_, ok := api.(*defaultoperation.WhatIfResource[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		require.True(t, ok)
+		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
+		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
+		require.Equal(t, "/whatif", h.Path)
+	})
+}
+
 func TestResourceOption_CustomActionOutput(t *testing.T) {
 	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
 	t.Run("valid custom action", func(t *testing.T) {
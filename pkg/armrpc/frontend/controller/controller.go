@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/queue"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -106,6 +107,10 @@ type ResourceOptions[T any] struct {
 	// UpdateFilters is a slice of filters that execute prior to updating a resource.
 	UpdateFilters []UpdateFilter[T]
 
+	// OutputResourcesPreview renders the output resources of a resource without deploying them. It is used by the
+	// what-if operation and is optional.
+	OutputResourcesPreview OutputResourcesPreview[T]
+
 	// AsyncOperationTimeout is the default timeout duration of async put operation.
 	AsyncOperationTimeout time.Duration
 
@@ -114,6 +119,10 @@ type ResourceOptions[T any] struct {
 	// value like 5 seconds if your operations will complete quickly.
 	AsyncOperationRetryAfter time.Duration
 
+	// AsyncOperationPriority is the priority of the async operations queued by the controller. Consider setting this
+	// to queue.PriorityLow if the operation executes a recipe or otherwise runs for a long time.
+	AsyncOperationPriority queue.Priority
+
 	// ListRecursiveQuery specifies whether store query should be recursive or not. This should be set to true when the
 	// scope of the list operation does not match the scope of the underlying resource type.
 	//
@@ -249,3 +258,9 @@ type DeleteFilter[T any] func(ctx context.Context, oldResource *T, options *Opti
 // UpdateFilters should return a rest.Response to handle the request without allowing updates to occur. Any
 // errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
 type UpdateFilter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) (rest.Response, error)
+
+// OutputResourcesPreview is a function that renders the output resources of a resource, such as the Kubernetes
+// objects of a container, without deploying them, and returns the changes to the output resources of the existing
+// resource. oldResource is nil when the resource does not exist. Any errors returned are reported as part of the
+// preview instead of failing the request.
+type OutputResourcesPreview[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) ([]v1.WhatIfOutputResourceChange, error)
@@ -172,6 +172,7 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 	options := sm.QueueOperationOptions{
 		OperationTimeout: asyncTimeout,
 		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Priority:         c.resourceOptions.AsyncOperationPriority,
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
@@ -244,6 +245,11 @@ func (b *Operation[P, T]) UpdateFilters() []UpdateFilter[T] {
 	return b.resourceOptions.UpdateFilters
 }
 
+// OutputResourcesPreview returns the function to preview the output resources of the resource, if any.
+func (b *Operation[P, T]) OutputResourcesPreview() OutputResourcesPreview[T] {
+	return b.resourceOptions.OutputResourcesPreview
+}
+
 // AsyncOperationTimeout returns the timeput for the operation.
 func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	if b.resourceOptions.AsyncOperationTimeout == 0 {
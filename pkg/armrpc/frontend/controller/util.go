@@ -46,6 +46,8 @@ var (
 	ErrETagsDoNotMatch = errors.New("etags do not match")
 	// ErrResourceAlreadyExists represents the error of the resource being already existent at the moment.
 	ErrResourceAlreadyExists = errors.New("resource already exists")
+	// ErrNotModified represents the error of the resource matching the etag in the If-None-Match header of a read request.
+	ErrNotModified = errors.New("resource has not been modified")
 )
 
 // ReadJSONBody extracts the content from request - it reads the body of the request if the content type
@@ -115,6 +117,44 @@ func checkIfNoneMatchHeader(ifNoneMatchETag string, etag string) error {
 	return nil
 }
 
+// ValidateReadETag checks the If-Match and If-None-Match headers of the ARMRequestContext of a read request against
+// the provided etag. It returns ErrETagsDoNotMatch if the If-Match header does not match the etag, and ErrNotModified
+// if the If-None-Match header matches it. Unlike ValidateETag, both headers accept a comma-separated list of quoted
+// or unquoted etags, as sent by HTTP caches.
+func ValidateReadETag(armRequestContext v1.ARMRequestContext, etag string) error {
+	if armRequestContext.IfMatch != "" && !matchETag(armRequestContext.IfMatch, etag) {
+		return ErrETagsDoNotMatch
+	}
+
+	if armRequestContext.IfNoneMatch != "" && matchETag(armRequestContext.IfNoneMatch, etag) {
+		return ErrNotModified
+	}
+
+	return nil
+}
+
+// matchETag returns true if the etag matches one of the etags in the header value.
+func matchETag(header string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" {
+			return true
+		}
+
+		// Weak comparison is sufficient for reads.
+		value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+		if value == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetURLFromReqWithQueryParameters function builds a URL from the request and query parameters
 func GetURLFromReqWithQueryParameters(req *http.Request, qps url.Values) *url.URL {
 	url := url.URL{
@@ -144,5 +184,18 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 	qps.Add("skipToken", paginationToken)
 	qps.Add("top", strconv.Itoa(serviceCtx.Top))
 
-	return GetURLFromReqWithQueryParameters(req, qps).String()
+	nextLink := GetURLFromReqWithQueryParameters(req, qps)
+
+	// When the request was proxied by UCP the request URL refers to the resource provider, which
+	// the client cannot reach. The referer has the URL the client connected to, so prefer it.
+	referer, err := url.Parse(serviceCtx.ClientReferer)
+	if serviceCtx.ClientReferer != "" && err == nil && referer.Host != "" {
+		nextLink.Host = referer.Host
+		nextLink.Path = referer.Path
+		if referer.Scheme != "" {
+			nextLink.Scheme = referer.Scheme
+		}
+	}
+
+	return nextLink.String()
 }
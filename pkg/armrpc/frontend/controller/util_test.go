@@ -184,3 +184,76 @@ func TestValidateEtag_IfNoneMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateReadETag(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		ifMatch     string
+		ifNoneMatch string
+		etag        string
+		expected    error
+	}{
+		{"no-headers", "", "", tag, nil},
+		{"if-match-match", tag, "", tag, nil},
+		{"if-match-quoted-list", `"other", "` + tag + `"`, "", tag, nil},
+		{"if-match-wildcard", "*", "", tag, nil},
+		{"if-match-no-match", "other", "", tag, ErrETagsDoNotMatch},
+		{"if-match-wildcard-no-etag", "*", "", "", ErrETagsDoNotMatch},
+		{"if-none-match-match", "", tag, tag, ErrNotModified},
+		{"if-none-match-weak", "", `W/"` + tag + `"`, tag, ErrNotModified},
+		{"if-none-match-wildcard", "", "*", tag, ErrNotModified},
+		{"if-none-match-no-match", "", "other", tag, nil},
+		{"if-none-match-no-etag", "", "*", "", nil},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			armRequestContext := v1.ARMRequestContext{IfMatch: tt.ifMatch, IfNoneMatch: tt.ifNoneMatch}
+			require.Equal(t, tt.expected, ValidateReadETag(armRequestContext, tt.etag))
+		})
+	}
+}
+
+func TestGetNextLinkURL(t *testing.T) {
+	cases := []struct {
+		name     string
+		referer  string
+		token    string
+		expected string
+	}{
+		{
+			"no-token",
+			"",
+			"",
+			"",
+		},
+		{
+			"no-referer",
+			"",
+			"token",
+			"http://applications-rp:5443/planes/radius/local/resourcegroups/rg/providers/applications.core/containers?api-version=2023-10-01-preview&skipToken=token&top=10",
+		},
+		{
+			"proxied-by-ucp",
+			"https://ucp.example.com/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers?api-version=2023-10-01-preview",
+			"token",
+			"https://ucp.example.com/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers?api-version=2023-10-01-preview&skipToken=token&top=10",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "http://applications-rp:5443/planes/radius/local/resourcegroups/rg/providers/applications.core/containers", nil)
+			require.NoError(t, err)
+
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				APIVersion:    "2023-10-01-preview",
+				ClientReferer: tt.referer,
+				Top:           10,
+			})
+
+			require.Equal(t, tt.expected, GetNextLinkURL(ctx, req, tt.token))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+// CancelOperationActionName is the name of the action to cancel an async operation.
+const CancelOperationActionName = "cancel"
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to request the cancellation of an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run marks the async operation as cancel requested and returns the operation status. The worker processing the
+// operation cancels it, and the operation and the resource end in the Canceled provisioning state. A Conflict error
+// is returned if the operation has already completed, and a NotFound error if the operation is not found.
+//
+// The operation status ID is the request URL without the trailing action name because POST requests are parsed as
+// custom actions.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	id := serviceCtx.ResourceID.String()
+
+	os := &manager.Status{}
+	etag, err := e.GetResource(ctx, id, os)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %s cannot be canceled because it has already completed with status %s.", os.Name, os.Status)), nil
+	}
+
+	// Cancellation has been requested before. The request is idempotent.
+	if os.CancelRequested {
+		return rest.NewOKResponse(os.AsyncOperationStatus), nil
+	}
+
+	os.CancelRequested = true
+	_, err = e.SaveResource(ctx, id, os, etag)
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %s was modified while requesting the cancellation. Please retry the request.", os.Name)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(os.AsyncOperationStatus), nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOperationStatusID = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000"
+	testLinkedResourceID  = "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/containers/test-container"
+)
+
+func saveTestOperationStatus(t *testing.T, databaseClient database.Client, id string, state v1.ProvisioningState) {
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     id,
+				Name:   "00000000-0000-0000-0000-000000000000",
+				Status: state,
+			},
+			LinkedResourceID: testLinkedResourceID,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestCancelOperationRun(t *testing.T) {
+	newRequest := func(t *testing.T) (context.Context, *http.Request) {
+		req, err := http.NewRequest(http.MethodPost, testOperationStatusID+"/cancel?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		return rpctest.NewARMRequestContext(req), req
+	}
+
+	t.Run("cancel non-existing operation", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		ctx, req := newRequest(t)
+		w := httptest.NewRecorder()
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("cancel completed operation", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveTestOperationStatus(t, databaseClient, testOperationStatusID, v1.ProvisioningStateSucceeded)
+		ctx, req := newRequest(t)
+		w := httptest.NewRecorder()
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+
+	t.Run("cancel running operation", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveTestOperationStatus(t, databaseClient, testOperationStatusID, v1.ProvisioningStateUpdating)
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		// The request is idempotent.
+		for i := 0; i < 2; i++ {
+			ctx, req := newRequest(t)
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actualOutput := &v1.AsyncOperationStatus{}
+			err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+			require.NoError(t, err)
+			require.Equal(t, v1.ProvisioningStateUpdating, actualOutput.Status)
+		}
+
+		obj, err := databaseClient.Get(context.Background(), testOperationStatusID)
+		require.NoError(t, err)
+		status := &manager.Status{}
+		err = obj.As(status)
+		require.NoError(t, err)
+		require.True(t, status.CancelRequested)
+	})
+}
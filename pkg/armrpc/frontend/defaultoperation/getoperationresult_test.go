@@ -80,36 +80,56 @@ func TestGetOperationResultRun(t *testing.T) {
 		provisioningState v1.ProvisioningState
 		respCode          int
 		headersCheck      bool
+		properties        any
 	}{
 		{
 			"not-in-terminal-state",
 			v1.ProvisioningStateAccepted,
 			http.StatusAccepted,
 			true,
+			nil,
 		},
 		{
 			"put-succeeded-state",
 			v1.ProvisioningStateSucceeded,
 			http.StatusNoContent,
 			false,
+			nil,
 		},
 		{
 			"delete-succeeded-state",
 			v1.ProvisioningStateSucceeded,
 			http.StatusNoContent,
 			false,
+			nil,
 		},
 		{
 			"put-failed-state",
 			v1.ProvisioningStateFailed,
 			http.StatusNoContent,
 			false,
+			nil,
 		},
 		{
 			"delete-failed-state",
 			v1.ProvisioningStateFailed,
 			http.StatusNoContent,
 			false,
+			nil,
+		},
+		{
+			"action-succeeded-state",
+			v1.ProvisioningStateSucceeded,
+			http.StatusOK,
+			false,
+			map[string]any{"changes": []any{}},
+		},
+		{
+			"action-failed-state",
+			v1.ProvisioningStateFailed,
+			http.StatusNoContent,
+			false,
+			map[string]any{"changes": []any{}},
 		},
 	}
 
@@ -125,6 +145,7 @@ func TestGetOperationResultRun(t *testing.T) {
 
 			osDataModel.Status = tt.provisioningState
 			osDataModel.RetryAfter = time.Second * 5
+			osDataModel.Properties = tt.properties
 
 			databaseClient.
 				EXPECT().
@@ -153,6 +174,12 @@ func TestGetOperationResultRun(t *testing.T) {
 				require.NotNil(t, w.Header().Get("Retry-After"))
 				require.Equal(t, "5", w.Header().Get("Retry-After"))
 			}
+
+			if tt.respCode == http.StatusOK {
+				body := map[string]any{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, tt.properties, body)
+			}
 		})
 	}
 }
@@ -18,6 +18,7 @@ package defaultoperation
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -44,7 +45,9 @@ func NewGetResource[P interface {
 }
 
 // Run returns the requested resource from the datastore with etag.
-// If the resource does not exist, a not found response is returned. If an error occurs, an error is returned as an internal error.
+// If the resource does not exist, a not found response is returned. If the request has an If-None-Match header matching
+// the etag, a not modified response is returned, and if it has an If-Match header that does not match the etag, a
+// precondition failed response is returned. If an error occurs, an error is returned as an internal error.
 func (e *GetResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -56,5 +59,22 @@ func (e *GetResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req
 		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	}
 
+	if response := conditionalReadResponse(serviceCtx, etag); response != nil {
+		return response, nil
+	}
+
 	return e.ConstructSyncResponse(ctx, req.Method, etag, resource)
 }
+
+// conditionalReadResponse returns the response for a read request with If-Match or If-None-Match headers that
+// do not allow the resource to be returned, or nil if the resource should be returned.
+func conditionalReadResponse(serviceCtx *v1.ARMRequestContext, etag string) rest.Response {
+	err := ctrl.ValidateReadETag(*serviceCtx, etag)
+	if errors.Is(err, ctrl.ErrNotModified) {
+		return rest.NewNotModifiedResponse(etag)
+	} else if err != nil {
+		return rest.NewPreconditionFailedResponse(serviceCtx.ResourceID.String(), err.Error())
+	}
+
+	return nil
+}
@@ -167,4 +167,56 @@ func TestGetResourceRun(t *testing.T) {
 
 		require.Equal(t, expectedOutput, actualOutput)
 	})
+
+	conditionalCases := []struct {
+		desc        string
+		ifMatch     string
+		ifNoneMatch string
+		statusCode  int
+	}{
+		{"if-none-match-match", "", "test-etag", http.StatusNotModified},
+		{"if-none-match-no-match", "", "other-etag", http.StatusOK},
+		{"if-match-match", `"test-etag"`, "", http.StatusOK},
+		{"if-match-no-match", "other-etag", "", http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range conditionalCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			req.Header.Set("If-Match", tt.ifMatch)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			databaseClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					return &database.Object{
+						Metadata: database.Metadata{ID: id, ETag: "test-etag"},
+						Data:     testResourceDataModel,
+					}, nil
+				})
+
+			opts := ctrl.Options{
+				DatabaseClient: databaseClient,
+			}
+
+			ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+				ResponseConverter: resourceToVersioned,
+			}
+
+			ctl, err := NewGetResource(opts, ctrlOpts)
+
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+			if tt.statusCode != http.StatusPreconditionFailed {
+				require.Equal(t, "test-etag", w.Header().Get("ETag"))
+			}
+		})
+	}
 }
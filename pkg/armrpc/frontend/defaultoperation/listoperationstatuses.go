@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// ResourceIDQueryParam is the query parameter used to filter async operation statuses by the resource id.
+	ResourceIDQueryParam = "resourceId"
+
+	// linkedResourceIDField is the field of the operation status data model storing the resource id.
+	linkedResourceIDField = "resourceID"
+)
+
+var _ ctrl.Controller = (*ListOperationStatuses)(nil)
+
+// ListOperationStatuses is the controller implementation to list the async operation statuses of a resource.
+type ListOperationStatuses struct {
+	ctrl.BaseController
+}
+
+// NewListOperationStatuses creates a new ListOperationStatuses.
+func NewListOperationStatuses(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationStatuses{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the paginated list of async operation statuses of the resource given by the resourceId query parameter.
+// The resourceId must match the id of the resource exactly. A BadRequest error is returned if resourceId is not specified.
+func (e *ListOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := req.URL.Query().Get(ResourceIDQueryParam)
+	if resourceID == "" {
+		return rest.NewBadRequestResponse("The query parameter 'resourceId' is required."), nil
+	}
+
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+		Filters: []database.QueryFilter{
+			{Field: linkedResourceIDField, Value: resourceID},
+		},
+	}
+
+	result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []any{}
+	for _, item := range result.Items {
+		os := &manager.Status{}
+		if err := item.As(os); err != nil {
+			return nil, err
+		}
+		items = append(items, os.AsyncOperationStatus)
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{
+		Value:    items,
+		NextLink: ctrl.GetNextLinkURL(ctx, req, result.PaginationToken),
+	}), nil
+}
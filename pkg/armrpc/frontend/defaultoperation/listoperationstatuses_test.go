@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationStatusesRun(t *testing.T) {
+	const collectionID = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses"
+
+	databaseClient := inmemory.NewClient()
+	saveTestOperationStatus(t, databaseClient, testOperationStatusID, v1.ProvisioningStateUpdating)
+
+	ctl, err := NewListOperationStatuses(ctrl.Options{DatabaseClient: databaseClient})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		query      url.Values
+		statusCode int
+		count      int
+	}{
+		{
+			name:       "missing resourceId",
+			query:      url.Values{},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "matching resourceId",
+			query:      url.Values{ResourceIDQueryParam: []string{testLinkedResourceID}},
+			statusCode: http.StatusOK,
+			count:      1,
+		},
+		{
+			name:       "other resourceId",
+			query:      url.Values{ResourceIDQueryParam: []string{testLinkedResourceID + "-other"}},
+			statusCode: http.StatusOK,
+			count:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Set("api-version", "2023-10-01-preview")
+			req, err := http.NewRequest(http.MethodGet, collectionID+"?"+tt.query.Encode(), nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+			w := httptest.NewRecorder()
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			actualOutput := &struct {
+				Value []v1.AsyncOperationStatus `json:"value"`
+			}{}
+			err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+			require.NoError(t, err)
+			require.Len(t, actualOutput.Value, tt.count)
+			if tt.count > 0 {
+				require.Equal(t, testOperationStatusID, actualOutput.Value[0].ID)
+			}
+		})
+	}
+}
@@ -19,11 +19,13 @@ package defaultoperation
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
 )
 
 // ListResources is the controller implementation to get the list of resources in resource group.
@@ -45,6 +47,9 @@ func NewListResources[P interface {
 
 // Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
 // is returned if the query fails.
+//
+// The response has an etag computed from the etags of the resources in the page, so that the If-None-Match and If-Match
+// headers can be used in the same way as for a single resource.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -59,9 +64,28 @@ func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, re
 		return nil, err
 	}
 
+	listETag := computeListETag(serviceCtx.APIVersion, result)
+	if response := conditionalReadResponse(serviceCtx, listETag); response != nil {
+		return response, nil
+	}
+
 	pagination, err := e.createPaginationResponse(ctx, req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponseWithHeaders(pagination, map[string]string{"ETag": listETag}), nil
+}
+
+// computeListETag computes the etag of a page of resources. The etag changes when any resource in the page is
+// created, updated or deleted. The api-version is included because it determines the representation of the page.
+func computeListETag(apiVersion string, result *database.ObjectQueryResult) string {
+	parts := []string{apiVersion, result.PaginationToken}
+	for _, item := range result.Items {
+		parts = append(parts, item.ID, item.ETag)
+	}
 
-	return rest.NewOKResponse(pagination), err
+	return etag.New([]byte(strings.Join(parts, "\n")))
 }
 
 func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req *http.Request, result *database.ObjectQueryResult) (*v1.PaginatedList, error) {
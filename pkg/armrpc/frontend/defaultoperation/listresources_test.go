@@ -183,4 +183,58 @@ func TestListResourcesRun(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("list resources with if-none-match", func(t *testing.T) {
+		items := []database.Object{
+			{Metadata: database.Metadata{ID: "resource-1", ETag: "etag-1"}, Data: testResourceDataModel},
+			{Metadata: database.Metadata{ID: "resource-2", ETag: "etag-2"}, Data: testResourceDataModel},
+		}
+
+		databaseClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				return &database.ObjectQueryResult{Items: items}, nil
+			}).
+			Times(3)
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		}
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+
+		run := func(ifNoneMatch string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			req.Header.Set("If-None-Match", ifNoneMatch)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			return w
+		}
+
+		w := run("")
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		listETag := w.Header().Get("ETag")
+		require.NotEmpty(t, listETag)
+
+		w = run(listETag)
+		require.Equal(t, http.StatusNotModified, w.Result().StatusCode)
+		require.Empty(t, w.Body.Bytes())
+
+		// Updating a resource changes the etag of the list.
+		items[1].ETag = "etag-3"
+		w = run(listETag)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.NotEqual(t, listETag, w.Header().Get("ETag"))
+	})
 }
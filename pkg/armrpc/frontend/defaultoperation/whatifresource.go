@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+// whatIfIgnoredProperties are the read-only properties that are excluded when comparing the existing resource
+// with the requested resource. Their values are assigned by the resource provider.
+var whatIfIgnoredProperties = []string{"id", "name", "type", "systemData"}
+
+// whatIfIgnoredResourceProperties are the read-only properties under "properties" that are excluded when comparing
+// the existing resource with the requested resource.
+var whatIfIgnoredResourceProperties = []string{"provisioningState", "status"}
+
+// WhatIfResource is the controller implementation to preview the changes of a create or update operation to a
+// resource. The request body is converted and validated by the same update filters as the PUT operation, but the
+// resource is not saved. When the resource options define an output resources preview, the output resources of the
+// resource, such as the Kubernetes objects rendered by the backend, are rendered and compared as well.
+type WhatIfResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewWhatIfResource creates a new WhatIfResource.
+func NewWhatIfResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &WhatIfResource[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run converts the request body to the resource, runs the update filters, renders the output resources, and returns
+// the change that the create or update operation would make to the existing resource. Nothing is persisted.
+func (e *WhatIfResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := e.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := e.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range e.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	after, err := e.toMap(newResource, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	change := v1.WhatIfResourceChange{
+		ResourceID: serviceCtx.ResourceID.String(),
+		ChangeType: v1.WhatIfChangeTypeCreate,
+		After:      after,
+	}
+
+	if old != nil {
+		before, err := e.toMap(old, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Before = before
+		change.Delta = v1.DiffProperties(withoutReadOnlyProperties(before), withoutReadOnlyProperties(after))
+		if len(change.Delta) == 0 {
+			change.ChangeType = v1.WhatIfChangeTypeNoChange
+		} else {
+			change.ChangeType = v1.WhatIfChangeTypeModify
+		}
+	}
+
+	if preview := e.OutputResourcesPreview(); preview != nil {
+		outputResources, err := preview(ctx, newResource, old, e.Options())
+		if err != nil {
+			// The changes to the properties are still reported when the output resources cannot be rendered.
+			change.Error = toErrorDetails(err)
+		} else {
+			change.OutputResources = outputResources
+		}
+	}
+
+	return rest.NewOKResponse(change), nil
+}
+
+// toErrorDetails converts the error of an output resources preview to the error details of the change.
+func toErrorDetails(err error) *v1.ErrorDetails {
+	clientErr := &v1.ErrClientRP{}
+	if errors.As(err, &clientErr) {
+		return &v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
+	}
+
+	return &v1.ErrorDetails{Code: v1.CodeInternal, Message: fmt.Sprintf("failed to render the output resources: %v", err)}
+}
+
+// toMap converts the resource to the versioned model of the request and returns it as a JSON object.
+func (e *WhatIfResource[P, T]) toMap(resource *T, apiVersion string) (map[string]any, error) {
+	versioned, err := e.ResponseConverter()(resource, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// withoutReadOnlyProperties returns a shallow copy of the resource without the properties assigned by the resource provider.
+func withoutReadOnlyProperties(resource map[string]any) map[string]any {
+	result := map[string]any{}
+	for k, v := range resource {
+		result[k] = v
+	}
+
+	for _, k := range whatIfIgnoredProperties {
+		delete(result, k)
+	}
+
+	if properties, ok := result["properties"].(map[string]any); ok {
+		copy := map[string]any{}
+		for k, v := range properties {
+			copy[k] = v
+		}
+		for _, k := range whatIfIgnoredResourceProperties {
+			delete(copy, k)
+		}
+		result["properties"] = copy
+	}
+
+	return result
+}
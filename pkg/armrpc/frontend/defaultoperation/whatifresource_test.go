@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWhatIfResource_Run(t *testing.T) {
+	cases := []struct {
+		desc           string
+		propertyB      string
+		application    string
+		existing       bool
+		rCode          int
+		expectedChange v1.WhatIfChangeType
+		expectedDelta  []v1.WhatIfPropertyChange
+	}{
+		{
+			desc:           "create",
+			existing:       false,
+			rCode:          http.StatusOK,
+			expectedChange: v1.WhatIfChangeTypeCreate,
+		},
+		{
+			desc:           "no-change",
+			existing:       true,
+			rCode:          http.StatusOK,
+			expectedChange: v1.WhatIfChangeTypeNoChange,
+		},
+		{
+			desc:           "modify",
+			propertyB:      "newPropertyBValue",
+			existing:       true,
+			rCode:          http.StatusOK,
+			expectedChange: v1.WhatIfChangeTypeModify,
+			expectedDelta: []v1.WhatIfPropertyChange{
+				{Path: "properties.propertyB", ChangeType: v1.WhatIfChangeTypeModify, Before: "propertyBValue", After: "newPropertyBValue"},
+			},
+		},
+		{
+			desc:        "filter-rejects-request",
+			application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/other",
+			existing:    true,
+			rCode:       http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			reqModel, dataModel, _ := loadTestResurce()
+			if tt.propertyB != "" {
+				reqModel.Properties.PropertyB = to.Ptr(tt.propertyB)
+			}
+			if tt.application != "" {
+				reqModel.Properties.Application = to.Ptr(tt.application)
+			}
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, resourceTestHeaderFile, reqModel)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.existing {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&database.Object{Metadata: database.Metadata{ID: dataModel.ID, ETag: "etag"}, Data: dataModel}, nil).
+					Times(1)
+			} else {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil, &database.ErrNotFound{}).
+					Times(1)
+			}
+
+			// The resource must never be saved.
+			mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			opts := ctrl.Options{
+				DatabaseClient: mds,
+				StatusManager:  msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewWhatIfResource(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.rCode != http.StatusOK {
+				return
+			}
+
+			change := v1.WhatIfResourceChange{}
+			err = json.Unmarshal(w.Body.Bytes(), &change)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedChange, change.ChangeType)
+			require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0", change.ResourceID)
+			require.NotNil(t, change.After)
+			if tt.existing {
+				require.NotNil(t, change.Before)
+				require.Equal(t, tt.expectedDelta, change.Delta)
+			} else {
+				require.Nil(t, change.Before)
+				require.Empty(t, change.Delta)
+			}
+		})
+	}
+}
+
+func TestWhatIfResource_OutputResources(t *testing.T) {
+	outputResources := []v1.WhatIfOutputResourceChange{
+		{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test", ChangeType: v1.WhatIfChangeTypeCreate},
+	}
+
+	cases := []struct {
+		desc                    string
+		err                     error
+		expectedOutputResources []v1.WhatIfOutputResourceChange
+		expectedError           *v1.ErrorDetails
+	}{
+		{
+			desc:                    "rendered",
+			expectedOutputResources: outputResources,
+		},
+		{
+			desc:          "invalid request",
+			err:           v1.NewClientErrInvalidRequest("provider aws is not configured"),
+			expectedError: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: "provider aws is not configured"},
+		},
+		{
+			desc:          "render failure",
+			err:           errors.New("application not found"),
+			expectedError: &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed to render the output resources: application not found"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			reqModel, _, _ := loadTestResurce()
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, resourceTestHeaderFile, reqModel)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(nil, &database.ErrNotFound{}).
+				Times(1)
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				OutputResourcesPreview: func(ctx context.Context, newResource, oldResource *TestResourceDataModel, options *ctrl.Options) ([]v1.WhatIfOutputResourceChange, error) {
+					require.NotNil(t, newResource)
+					require.Nil(t, oldResource)
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return outputResources, nil
+				},
+			}
+
+			ctl, err := NewWhatIfResource(ctrl.Options{DatabaseClient: mds, StatusManager: msm}, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			change := v1.WhatIfResourceChange{}
+			err = json.Unmarshal(w.Body.Bytes(), &change)
+			require.NoError(t, err)
+
+			require.Equal(t, v1.WhatIfChangeTypeCreate, change.ChangeType)
+			require.Equal(t, tt.expectedOutputResources, change.OutputResources)
+			require.Equal(t, tt.expectedError, change.Error)
+		})
+	}
+}
@@ -251,6 +251,28 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, providerNamespace),
+		ResourceType:      statusRT,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/" + defaultoperation.CancelOperationActionName,
+		ResourceType:      statusRT,
+		Method:            v1.OperationPost,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/pkg/version"
@@ -45,6 +46,12 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// AuditSink records each mutating request. Optional.
+	AuditSink audit.Sink
+
+	// AuditIncludeChanges records the redacted difference between the resource before and after each request.
+	AuditIncludeChanges bool
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -64,7 +71,12 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
-
+	if options.AuditSink != nil {
+		r.Use(middleware.Audit(options.AuditSink, middleware.AuditOptions{
+			Service:        options.ServiceName,
+			IncludeChanges: options.AuditIncludeChanges,
+		}))
+	}
 	r.Get(versionEndpoint, version.ReportVersionHandler)
 	r.Get(healthzEndpoint, version.ReportVersionHandler)
 
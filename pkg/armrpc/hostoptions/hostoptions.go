@@ -127,9 +127,10 @@ func loadConfig(configPath string) (*ProviderConfig, error) {
 	return conf, nil
 }
 
-// FromContext extracts ProviderConfig from http context.
+// FromContext extracts ProviderConfig from http context. It returns nil if the context does not have ProviderConfig.
 func FromContext(ctx context.Context) *ProviderConfig {
-	return ctx.Value(v1.HostingConfigContextKey).(*ProviderConfig)
+	cfg, _ := ctx.Value(v1.HostingConfigContextKey).(*ProviderConfig)
+	return cfg
 }
 
 // WithContext injects ProviderConfig into the given http context.
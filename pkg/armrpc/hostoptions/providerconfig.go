@@ -19,6 +19,7 @@ package hostoptions
 import (
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/vault"
 )
 
 // ProviderConfig includes the resource provider configuration.
@@ -46,6 +48,13 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	RecipeDrivers    []RecipeDriverOptions                `yaml:"recipeDrivers,omitempty"`
+
+	// Audit configures the audit log of mutating requests.
+	Audit audit.Options `yaml:"audit,omitempty"`
+
+	// Vault configures the connections to the Vault servers of Vault backed secret stores.
+	Vault vault.Options `yaml:"vault,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -84,6 +93,8 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// MaxScheduledMessages is the maximum number of dequeued messages waiting to be scheduled by priority and fairness.
+	MaxScheduledMessages *int `yaml:"maxScheduledMessages,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
@@ -101,4 +112,21 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution (ERROR, DEBUG, etc.).
 	LogLevel string `yaml:"logLevel,omitempty"`
+
+	// LocalStatePath is the directory that stores the Terraform state of environments that select the "local"
+	// Terraform backend. The "local" backend is disabled when LocalStatePath is empty. The directory must be on
+	// persistent storage, otherwise the state of deployed resources is lost when the pod restarts.
+	LocalStatePath string `yaml:"localStatePath,omitempty"`
+}
+
+// RecipeDriverOptions includes options required to register an out-of-process recipe driver.
+type RecipeDriverOptions struct {
+	// Kind is the recipe kind (for example "pulumi") that is routed to the driver.
+	Kind string `yaml:"kind"`
+
+	// Socket is the path of the unix domain socket the driver listens on.
+	Socket string `yaml:"socket"`
+
+	// Timeout is the maximum duration of a single request to the driver (for example "30m"). Defaults to no timeout.
+	Timeout string `yaml:"timeout,omitempty"`
 }
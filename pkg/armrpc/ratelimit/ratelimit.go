@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit implements token-bucket rate limiting of API requests.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// anonymousPrefix is the prefix of the keys of requests that are not authenticated. The 'system:' prefix is
+	// reserved, so no principal can share a bucket with anonymous callers.
+	anonymousPrefix = "system:anonymous"
+
+	// minIdleTimeout is the minimum time after which the bucket of a key that has not been used is removed.
+	minIdleTimeout = time.Minute
+)
+
+// Options configures a Limiter.
+type Options struct {
+	// RequestsPerSecond is the rate at which tokens are added to each bucket.
+	RequestsPerSecond float64
+
+	// Burst is the size of each bucket, which is the number of requests that can be made at once.
+	Burst int
+}
+
+// Limiter is a token-bucket rate limiter with one bucket per key. Limiter is safe for concurrent use.
+type Limiter struct {
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+
+	// now returns the current time. Can be overridden for testing.
+	now func() time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter creates a new Limiter.
+func NewLimiter(options Options) (*Limiter, error) {
+	if options.RequestsPerSecond <= 0 {
+		return nil, fmt.Errorf("requests per second must be positive, got %v", options.RequestsPerSecond)
+	}
+	if options.Burst <= 0 {
+		return nil, fmt.Errorf("burst must be positive, got %d", options.Burst)
+	}
+
+	// A bucket that has not been used for the time it takes to refill is full, and can be removed without changing
+	// the behavior of the limiter.
+	idleTimeout := time.Duration(float64(options.Burst) / options.RequestsPerSecond * float64(time.Second))
+	if idleTimeout < minIdleTimeout {
+		idleTimeout = minIdleTimeout
+	}
+
+	return &Limiter{
+		limit:       rate.Limit(options.RequestsPerSecond),
+		burst:       options.Burst,
+		idleTimeout: idleTimeout,
+		buckets:     map[string]*bucket{},
+		now:         time.Now,
+	}, nil
+}
+
+// Allow takes a token from the bucket of the given key. It returns true if the request is allowed. Otherwise it
+// returns false and the duration after which a token will be available.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return true, 0
+	}
+
+	// Return the token so that rejected requests do not delay the next ones.
+	reservation.CancelAt(now)
+	return false, delay
+}
+
+// sweep removes the buckets that have not been used for the idle timeout. The caller must hold the lock.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.idleTimeout {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// Key returns the key of the bucket of a request. Requests are limited per principal, plane and resource group,
+// so a misbehaving caller cannot starve others and a busy resource group does not throttle the rest of the plane.
+// Requests that are not authenticated are limited per client address instead of the principal.
+func Key(principal *v1.Principal, remoteAddr string, id resources.ID) string {
+	name := anonymousPrefix
+	if principal != nil {
+		name = principal.Name
+	} else if remoteAddr != "" {
+		// The port changes with every connection, so only the host identifies the caller.
+		host, _, err := net.SplitHostPort(remoteAddr)
+		if err != nil {
+			host = remoteAddr
+		}
+		name = anonymousPrefix + ":" + host
+	}
+
+	plane := ""
+	resourceGroup := ""
+	if id.IsUCPQualified() && len(id.ScopeSegments()) > 0 {
+		plane = strings.ToLower(id.PlaneNamespace())
+		resourceGroup = strings.ToLower(id.FindScope(resources_radius.ScopeResourceGroups))
+	}
+
+	return name + "|" + plane + "|" + resourceGroup
+}
+
+// RateLimit returns a middleware which rejects requests that exceed the rate of the limiter with 429 and a
+// Retry-After header. Requests to the paths listed in skipPaths are not limited. ARMRequestCtx middleware and the
+// authentication middleware must run before this middleware. The client address is read from the request, or from
+// the context when RemoveRemoteAddr middleware has run.
+func RateLimit(limiter *Limiter, skipPaths ...string) func(http.Handler) http.Handler {
+	skip := map[string]bool{}
+	for _, path := range skipPaths {
+		skip[strings.ToLower(path)] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if skip[strings.ToLower(r.URL.Path)] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+			remoteAddr := r.RemoteAddr
+			if remoteAddr == "" {
+				remoteAddr = middleware.RemoteAddrFromContext(ctx)
+			}
+			key := Key(rpcContext.Principal, remoteAddr, rpcContext.ResourceID)
+			allowed, retryAfter := limiter.Allow(key)
+			if allowed {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			logr.FromContextOrDiscard(ctx).V(ucplog.LevelDebug).Info("request is throttled", "key", key, "retryAfter", retryAfter)
+			seconds := int64(math.Ceil(retryAfter.Seconds()))
+			message := fmt.Sprintf("The request is throttled because too many requests were made. Retry after %d seconds.", seconds)
+			applyResponse(ctx, w, r, rest.NewTooManyRequestsResponse(message, retryAfter))
+		})
+	}
+}
+
+func applyResponse(ctx context.Context, w http.ResponseWriter, r *http.Request, resp rest.Response) {
+	err := resp.Apply(ctx, w, r)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "error writing response")
+		// There's no way to recover if we fail writing here, we likely partially wrote to the response stream.
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewLimiter_Invalid(t *testing.T) {
+	_, err := NewLimiter(Options{RequestsPerSecond: 0, Burst: 1})
+	require.Error(t, err)
+
+	_, err = NewLimiter(Options{RequestsPerSecond: 1, Burst: 0})
+	require.Error(t, err)
+}
+
+func Test_Limiter_Allow(t *testing.T) {
+	limiter, err := NewLimiter(Options{RequestsPerSecond: 1, Burst: 2})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	// The burst is available immediately.
+	allowed, _ := limiter.Allow("a")
+	require.True(t, allowed)
+	allowed, _ = limiter.Allow("a")
+	require.True(t, allowed)
+
+	allowed, retryAfter := limiter.Allow("a")
+	require.False(t, allowed)
+	require.Equal(t, time.Second, retryAfter)
+
+	// Rejected requests do not consume tokens.
+	allowed, retryAfter = limiter.Allow("a")
+	require.False(t, allowed)
+	require.Equal(t, time.Second, retryAfter)
+
+	// Other keys have their own bucket.
+	allowed, _ = limiter.Allow("b")
+	require.True(t, allowed)
+
+	now = now.Add(time.Second)
+	allowed, _ = limiter.Allow("a")
+	require.True(t, allowed)
+}
+
+func Test_Limiter_Sweep(t *testing.T) {
+	limiter, err := NewLimiter(Options{RequestsPerSecond: 1, Burst: 1})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Allow("a")
+	limiter.Allow("b")
+	require.Len(t, limiter.buckets, 2)
+
+	now = now.Add(minIdleTimeout / 2)
+	limiter.Allow("b")
+
+	now = now.Add(minIdleTimeout / 2)
+	limiter.Allow("c")
+	require.Len(t, limiter.buckets, 2)
+	require.Contains(t, limiter.buckets, "b")
+	require.Contains(t, limiter.buckets, "c")
+}
+
+func Test_Key(t *testing.T) {
+	alice := v1.NewPrincipal("alice", nil)
+
+	tests := []struct {
+		name       string
+		principal  *v1.Principal
+		remoteAddr string
+		id         string
+		expected   string
+	}{
+		{
+			name:      "resource",
+			principal: alice,
+			id:        "/planes/radius/local/resourceGroups/RG/providers/Applications.Core/containers/c",
+			expected:  "alice|radius/local|rg",
+		},
+		{
+			name:      "plane scoped",
+			principal: alice,
+			id:        "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Core",
+			expected:  "alice|radius/local|",
+		},
+		{
+			name:      "planes collection",
+			principal: alice,
+			id:        "/planes",
+			expected:  "alice||",
+		},
+		{
+			name:       "authenticated ignores address",
+			principal:  alice,
+			remoteAddr: "10.0.0.1:52814",
+			id:         "/planes/radius/local/resourceGroups/rg",
+			expected:   "alice|radius/local|rg",
+		},
+		{
+			name:       "anonymous",
+			remoteAddr: "10.0.0.1:52814",
+			id:         "/planes/radius/local/resourceGroups/rg",
+			expected:   "system:anonymous:10.0.0.1|radius/local|rg",
+		},
+		{
+			name:       "anonymous ipv6",
+			remoteAddr: "[fd00::1]:52814",
+			id:         "/planes/radius/local/resourceGroups/rg",
+			expected:   "system:anonymous:fd00::1|radius/local|rg",
+		},
+		{
+			name:     "anonymous without address",
+			id:       "/planes/radius/local/resourceGroups/rg",
+			expected: "system:anonymous|radius/local|rg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, Key(tt.principal, tt.remoteAddr, id))
+		})
+	}
+}
+
+func Test_RateLimit(t *testing.T) {
+	limiter, err := NewLimiter(Options{RequestsPerSecond: 0.5, Burst: 1})
+	require.NoError(t, err)
+
+	handler := RateLimit(limiter, "/healthz")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		id, _ := resources.Parse(path)
+		req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{ResourceID: id}))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	const path = "/planes/radius/local/resourceGroups/rg"
+	require.Equal(t, http.StatusOK, send(path).Code)
+
+	w := send(path)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	body := v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+
+	// Skipped paths are never limited.
+	require.Equal(t, http.StatusOK, send("/healthz").Code)
+	require.Equal(t, http.StatusOK, send("/healthz").Code)
+}
+
+func Test_RateLimit_RemoteAddr(t *testing.T) {
+	limiter, err := NewLimiter(Options{RequestsPerSecond: 0.5, Burst: 1})
+	require.NoError(t, err)
+
+	handler := middleware.RemoveRemoteAddr(RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	send := func(remoteAddr string) int {
+		const path = "/planes/radius/local/resourceGroups/rg"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		id, _ := resources.Parse(path)
+		req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{ResourceID: id}))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// Anonymous callers on different hosts do not share a bucket, whereas new connections from the same host do.
+	require.Equal(t, http.StatusOK, send("10.0.0.1:52814"))
+	require.Equal(t, http.StatusOK, send("10.0.0.2:52814"))
+	require.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:52815"))
+}
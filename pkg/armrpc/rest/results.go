@@ -367,6 +367,30 @@ func (r *NoContentResponse) Apply(ctx context.Context, w http.ResponseWriter, re
 	return nil
 }
 
+// NotModifiedResponse represents an HTTP 304 without a body.
+//
+// This is used for conditional read operations when the resource matches the etag in the If-None-Match header.
+type NotModifiedResponse struct {
+	ETag string
+}
+
+// NewNotModifiedResponse creates a new NotModifiedResponse with the given etag.
+func NewNotModifiedResponse(etag string) Response {
+	return &NotModifiedResponse{ETag: etag}
+}
+
+// Apply renders NotModified HTTP Response into http.ResponseWriter.
+func (r *NotModifiedResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.V(ucplog.LevelDebug).Info(fmt.Sprintf("responding with status code: %d", http.StatusNotModified), logging.LogHTTPStatusCode, http.StatusNotModified)
+
+	if r.ETag != "" {
+		w.Header().Set("ETag", r.ETag)
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return nil
+}
+
 // BadRequestResponse represents an HTTP 400 with an error message in ARM error format.
 //
 // This is used for any operation that fails due to bad data with a simple error message.
@@ -727,6 +751,90 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given message.
+func NewForbiddenResponse(message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// TooManyRequestsResponse represents an HTTP 429 with an ARM error payload and a Retry-After header.
+type TooManyRequestsResponse struct {
+	Body       v1.ErrorResponse
+	RetryAfter time.Duration
+}
+
+// NewTooManyRequestsResponse creates a TooManyRequestsResponse with CodeTooManyRequests code, the given message and
+// the duration after which the client can retry.
+func NewTooManyRequestsResponse(message string, retryAfter time.Duration) Response {
+	return &TooManyRequestsResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeTooManyRequests,
+				Message: message,
+			},
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// Apply renders 429 Too Many Requests HTTP response into http.ResponseWriter by setting Content-Type, Retry-After
+// and serializing response. Retry-After is rounded up to whole seconds.
+func (r *TooManyRequestsResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusTooManyRequests), logging.LogHTTPStatusCode, http.StatusTooManyRequests)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	retryAfter := int64((r.RetryAfter + time.Second - 1) / time.Second)
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(retryAfter))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
@@ -290,3 +290,49 @@ func TestGetAsyncLocationPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_TooManyRequestsResponse(t *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		expected   string
+	}{
+		{retryAfter: 0, expected: "1"},
+		{retryAfter: 200 * time.Millisecond, expected: "1"},
+		{retryAfter: 2 * time.Second, expected: "2"},
+		{retryAfter: 2*time.Second + time.Millisecond, expected: "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.retryAfter.String(), func(t *testing.T) {
+			response := NewTooManyRequestsResponse("slow down", tt.retryAfter)
+
+			req := httptest.NewRequest("GET", "http://example.com", nil)
+			w := httptest.NewRecorder()
+
+			err := response.Apply(context.TODO(), w, req)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+			require.Equal(t, tt.expected, w.Header().Get("Retry-After"))
+
+			body := v1.ErrorResponse{}
+			err = json.Unmarshal(w.Body.Bytes(), &body)
+			require.NoError(t, err)
+			require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+			require.Equal(t, "slow down", body.Error.Message)
+		})
+	}
+}
+
+func Test_NotModifiedResponse(t *testing.T) {
+	response := NewNotModifiedResponse("test-etag")
+
+	req := httptest.NewRequest("GET", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Equal(t, "test-etag", w.Header().Get("ETag"))
+	require.Empty(t, w.Body.Bytes())
+}
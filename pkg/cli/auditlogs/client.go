@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auditlogs contains the client for the UCP admin API to query the audit log of mutating requests.
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+)
+
+// Query describes the audit records to list. All fields are optional.
+type Query struct {
+	// Principal lists only the records of this principal.
+	Principal string
+	// ResourceID lists only the records of this resource or scope and the resources it contains.
+	ResourceID string
+	// Since lists only the records created at or after this time.
+	Since time.Time
+	// Limit is the maximum number of records to list. The server default is used when zero.
+	Limit int
+}
+
+//go:generate mockgen -typed -destination=./mock_client.go -package=auditlogs -self_package github.com/radius-project/radius/pkg/cli/auditlogs github.com/radius-project/radius/pkg/cli/auditlogs Client
+
+// Client is used to query the audit log.
+type Client interface {
+	// List lists the audit records matching the query, most recent first.
+	List(ctx context.Context, query Query) ([]*admin.AuditRecord, error)
+}
+
+var _ Client = (*UCPClient)(nil)
+
+// UCPClient implements Client using the UCP admin API.
+type UCPClient struct {
+	Connection sdk.Connection
+}
+
+// List lists the audit records matching the query, most recent first.
+func (c *UCPClient) List(ctx context.Context, query Query) ([]*admin.AuditRecord, error) {
+	values := url.Values{}
+	if query.Principal != "" {
+		values.Set("principal", query.Principal)
+	}
+	if query.ResourceID != "" {
+		values.Set("resourceId", query.ResourceID)
+	}
+	if !query.Since.IsZero() {
+		values.Set("since", query.Since.UTC().Format(time.RFC3339))
+	}
+	if query.Limit > 0 {
+		values.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	u := strings.TrimSuffix(c.Connection.Endpoint(), "/") + "/admin/auditlogs"
+	if len(values) > 0 {
+		u += "?" + values.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		errResp := v1.ErrorResponse{}
+		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != nil {
+			return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, errResp.Error.Message)
+		}
+
+		return nil, fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	result := &admin.AuditRecordList{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
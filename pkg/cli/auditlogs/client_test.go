@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlogs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UCPClient(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		if r.URL.Path != "/apis/api.ucp.dev/v1alpha3/admin/auditlogs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("principal") == "forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeAuthorizationFailed, Message: "access denied"}})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(admin.AuditRecordList{Value: []*admin.AuditRecord{{ID: "record1", Principal: "alice"}}})
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPClient{Connection: connection}
+	ctx := testcontext.New(t)
+
+	records, err := client.List(ctx, Query{})
+	require.NoError(t, err)
+	require.Equal(t, []*admin.AuditRecord{{ID: "record1", Principal: "alice"}}, records)
+
+	_, err = client.List(ctx, Query{
+		Principal:  "alice",
+		ResourceID: "/planes/radius/local/resourcegroups/rg",
+		Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+
+	_, err = client.List(ctx, Query{Principal: "forbidden"})
+	require.EqualError(t, err, "request failed with status 403: access denied")
+
+	require.Equal(t, []string{
+		"GET /apis/api.ucp.dev/v1alpha3/admin/auditlogs",
+		"GET /apis/api.ucp.dev/v1alpha3/admin/auditlogs?limit=10&principal=alice&resourceId=%2Fplanes%2Fradius%2Flocal%2Fresourcegroups%2Frg&since=2024-01-01T00%3A00%3A00Z",
+		"GET /apis/api.ucp.dev/v1alpha3/admin/auditlogs?principal=forbidden",
+	}, requests)
+}
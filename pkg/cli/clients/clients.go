@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	radiuscore "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -97,6 +98,9 @@ type DeploymentResult struct {
 // DeploymentClient is used to deploy ARM-JSON templates (compiled Bicep output).
 type DeploymentClient interface {
 	Deploy(ctx context.Context, options DeploymentOptions) (DeploymentResult, error)
+
+	// WhatIf previews the changes that the deployment would make without applying them.
+	WhatIf(ctx context.Context, options DeploymentOptions) (v1.WhatIfResult, error)
 }
 
 //go:generate mockgen -typed -destination=./mock_diagnosticsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DiagnosticsClient
@@ -178,6 +182,14 @@ type ApplicationsManagementClient interface {
 	// DeleteResource deletes a resource by its type and name (or id).
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error)
 
+	// CancelResourceOperations requests the cancellation of the in-progress operations of a resource by its type
+	// and name (or id). It returns the names of the operations for which the cancellation was requested.
+	CancelResourceOperations(ctx context.Context, resourceType string, resourceNameOrID string) ([]string, error)
+
+	// PlanResource computes the changes that deploying the recipe of a resource would make, and the changes made
+	// to the resources deployed by the recipe outside of Radius, by its type and name (or id).
+	PlanResource(ctx context.Context, resourceType string, resourceNameOrID string) (generated.RecipePlanResult, error)
+
 	// ListApplications lists all applications in the configured scope.
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 
@@ -50,6 +50,7 @@ type UCPApplicationsManagementClient struct {
 	resourceTypeClientFactory        func() (resourceTypeClient, error)
 	apiVersionClientFactory          func() (apiVersionClient, error)
 	locationClientFactory            func() (locationClient, error)
+	operationStatusClientFactory     func() (operationStatusClient, error)
 	capture                          func(ctx context.Context, capture **http.Response) context.Context
 }
 
@@ -210,6 +211,75 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return response.StatusCode != 204, nil
 }
 
+// CancelResourceOperations requests the cancellation of the in-progress operations of a resource by its type
+// and name (or id). It returns the names of the operations for which the cancellation was requested.
+func (amc *UCPApplicationsManagementClient) CancelResourceOperations(ctx context.Context, resourceType string, resourceNameOrID string) ([]string, error) {
+	// Use the id of the stored resource because operation statuses are matched by the exact resource id.
+	resource, err := amc.GetResource(ctx, resourceType, resourceNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.ID == nil {
+		return nil, fmt.Errorf("resource %q of type %q has no id", resourceNameOrID, resourceType)
+	}
+
+	client, err := amc.createOperationStatusClient()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := client.List(ctx, *resource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled := []string{}
+	for _, status := range statuses {
+		if status.Status.IsTerminal() {
+			continue
+		}
+
+		if err := client.Cancel(ctx, status.ID); err != nil {
+			return canceled, err
+		}
+		canceled = append(canceled, status.Name)
+	}
+
+	return canceled, nil
+}
+
+// PlanResource computes the changes that deploying the recipe of a resource would make, and the changes made
+// to the resources deployed by the recipe outside of Radius, by its type and name (or id).
+func (amc *UCPApplicationsManagementClient) PlanResource(ctx context.Context, resourceType string, resourceNameOrID string) (generated.RecipePlanResult, error) {
+	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
+	if err != nil {
+		return generated.RecipePlanResult{}, err
+	}
+
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return generated.RecipePlanResult{}, err
+	}
+
+	client, err := amc.getGenericClient(scope, resourceType, apiVersions)
+	if err != nil {
+		return generated.RecipePlanResult{}, err
+	}
+
+	poller, err := client.BeginPlan(ctx, name, &generated.GenericResourcesClientBeginPlanOptions{})
+	if err != nil {
+		return generated.RecipePlanResult{}, err
+	}
+
+	response, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return generated.RecipePlanResult{}, err
+	}
+
+	return response.RecipePlanResult, nil
+}
+
 // ListApplications lists all applications in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
 	client, err := amc.createApplicationClient(amc.RootScope)
@@ -1282,6 +1352,14 @@ func (amc *UCPApplicationsManagementClient) createLocationClient() (locationClie
 	return amc.locationClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createOperationStatusClient() (operationStatusClient, error) {
+	if amc.operationStatusClientFactory == nil {
+		return newOperationStatusClient(amc.ClientOptions)
+	}
+
+	return amc.operationStatusClientFactory()
+}
+
 func (amc *UCPApplicationsManagementClient) extractScopeAndName(nameOrID string) (string, string, error) {
 	if strings.HasPrefix(nameOrID, resources.SegmentSeparator) {
 		// Treat this as a resource id.
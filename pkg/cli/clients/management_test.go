@@ -620,6 +620,83 @@ func Test_Resource(t *testing.T) {
 		require.Equal(t, expectedResource, resource)
 	})
 
+	t.Run("CancelResourceOperations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		operationStatusMock := NewMockoperationStatusClient(ctrl)
+		client := createResourceAndResourceProviderClient(mock, resourceProviderMock)
+		client.operationStatusClientFactory = func() (operationStatusClient, error) {
+			return operationStatusMock, nil
+		}
+		expectedResourceSummary := ucp.ResourceProviderSummary{
+			Name: to.Ptr("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResourceSummary}, nil)
+
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(generated.GenericResourcesClientGetResponse{GenericResource: expectedResource}, nil)
+
+		statuses := []v1.AsyncOperationStatus{
+			{ID: testScope + "/providers/Applications.Test/locations/global/operationStatuses/op1", Name: "op1", Status: v1.ProvisioningStateUpdating},
+			{ID: testScope + "/providers/Applications.Test/locations/global/operationStatuses/op2", Name: "op2", Status: v1.ProvisioningStateSucceeded},
+		}
+		operationStatusMock.EXPECT().
+			List(gomock.Any(), testResourceID).
+			Return(statuses, nil)
+		operationStatusMock.EXPECT().
+			Cancel(gomock.Any(), statuses[0].ID).
+			Return(nil)
+
+		canceled, err := client.CancelResourceOperations(context.Background(), testResourceType, testResourceName)
+		require.NoError(t, err)
+		require.Equal(t, []string{"op1"}, canceled)
+	})
+
+	t.Run("PlanResource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		client := createResourceAndResourceProviderClient(mock, resourceProviderMock)
+		expectedResourceSummary := ucp.ResourceProviderSummary{
+			Name: to.Ptr("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResourceSummary}, nil)
+
+		expectedPlan := generated.RecipePlanResult{
+			Changes: []*generated.RecipeResourceChange{
+				{Address: to.Ptr("aws_s3_bucket.test"), Action: to.Ptr("update"), ChangedProperties: []*string{to.Ptr("tags")}},
+			},
+			Drift: []*generated.RecipeResourceChange{},
+		}
+		mock.EXPECT().
+			BeginPlan(gomock.Any(), testResourceName, gomock.Any()).
+			Return(poller(&generated.GenericResourcesClientPlanResponse{RecipePlanResult: expectedPlan}), nil)
+
+		plan, err := client.PlanResource(context.Background(), testResourceType, testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, expectedPlan, plan)
+	})
+
 	t.Run("CreateOrUpdateResource", func(t *testing.T) {
 		mock := NewMockgenericResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -43,6 +43,45 @@ func (m *MockApplicationsManagementClient) EXPECT() *MockApplicationsManagementC
 	return m.recorder
 }
 
+// CancelResourceOperations mocks base method.
+func (m *MockApplicationsManagementClient) CancelResourceOperations(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelResourceOperations", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelResourceOperations indicates an expected call of CancelResourceOperations.
+func (mr *MockApplicationsManagementClientMockRecorder) CancelResourceOperations(arg0, arg1, arg2 any) *MockApplicationsManagementClientCancelResourceOperationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelResourceOperations", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CancelResourceOperations), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientCancelResourceOperationsCall{Call: call}
+}
+
+// MockApplicationsManagementClientCancelResourceOperationsCall wrap *gomock.Call
+type MockApplicationsManagementClientCancelResourceOperationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCancelResourceOperationsCall) Return(arg0 []string, arg1 error) *MockApplicationsManagementClientCancelResourceOperationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCancelResourceOperationsCall) Do(f func(context.Context, string, string) ([]string, error)) *MockApplicationsManagementClientCancelResourceOperationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCancelResourceOperationsCall) DoAndReturn(f func(context.Context, string, string) ([]string, error)) *MockApplicationsManagementClientCancelResourceOperationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateApplicationIfNotFound mocks base method.
 func (m *MockApplicationsManagementClient) CreateApplicationIfNotFound(arg0 context.Context, arg1 string, arg2 *v20231001preview.ApplicationResource) error {
 	m.ctrl.T.Helper()
@@ -1715,3 +1754,42 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PlanResource mocks base method.
+func (m *MockApplicationsManagementClient) PlanResource(arg0 context.Context, arg1, arg2 string) (generated.RecipePlanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(generated.RecipePlanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanResource indicates an expected call of PlanResource.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanResource(arg0, arg1, arg2 any) *MockApplicationsManagementClientPlanResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanResource), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientPlanResourceCall{Call: call}
+}
+
+// MockApplicationsManagementClientPlanResourceCall wrap *gomock.Call
+type MockApplicationsManagementClientPlanResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientPlanResourceCall) Return(arg0 generated.RecipePlanResult, arg1 error) *MockApplicationsManagementClientPlanResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientPlanResourceCall) Do(f func(context.Context, string, string) (generated.RecipePlanResult, error)) *MockApplicationsManagementClientPlanResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientPlanResourceCall) DoAndReturn(f func(context.Context, string, string) (generated.RecipePlanResult, error)) *MockApplicationsManagementClientPlanResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
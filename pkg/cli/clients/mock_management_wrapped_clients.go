@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,operationStatusClient
 //
 
 // Package clients is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	runtime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -122,6 +123,45 @@ func (c *MockgenericResourceClientBeginDeleteCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// BeginPlan mocks base method.
+func (m *MockgenericResourceClient) BeginPlan(ctx context.Context, resourceName string, options *generated.GenericResourcesClientBeginPlanOptions) (*runtime.Poller[generated.GenericResourcesClientPlanResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginPlan", ctx, resourceName, options)
+	ret0, _ := ret[0].(*runtime.Poller[generated.GenericResourcesClientPlanResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginPlan indicates an expected call of BeginPlan.
+func (mr *MockgenericResourceClientMockRecorder) BeginPlan(ctx, resourceName, options any) *MockgenericResourceClientBeginPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginPlan", reflect.TypeOf((*MockgenericResourceClient)(nil).BeginPlan), ctx, resourceName, options)
+	return &MockgenericResourceClientBeginPlanCall{Call: call}
+}
+
+// MockgenericResourceClientBeginPlanCall wrap *gomock.Call
+type MockgenericResourceClientBeginPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgenericResourceClientBeginPlanCall) Return(arg0 *runtime.Poller[generated.GenericResourcesClientPlanResponse], arg1 error) *MockgenericResourceClientBeginPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgenericResourceClientBeginPlanCall) Do(f func(context.Context, string, *generated.GenericResourcesClientBeginPlanOptions) (*runtime.Poller[generated.GenericResourcesClientPlanResponse], error)) *MockgenericResourceClientBeginPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgenericResourceClientBeginPlanCall) DoAndReturn(f func(context.Context, string, *generated.GenericResourcesClientBeginPlanOptions) (*runtime.Poller[generated.GenericResourcesClientPlanResponse], error)) *MockgenericResourceClientBeginPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockgenericResourceClient) Get(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (generated.GenericResourcesClientGetResponse, error) {
 	m.ctrl.T.Helper()
@@ -1468,3 +1508,103 @@ func (c *MockrecipePackResourceClientNewListByScopePagerCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockoperationStatusClient is a mock of operationStatusClient interface.
+type MockoperationStatusClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockoperationStatusClientMockRecorder
+}
+
+// MockoperationStatusClientMockRecorder is the mock recorder for MockoperationStatusClient.
+type MockoperationStatusClientMockRecorder struct {
+	mock *MockoperationStatusClient
+}
+
+// NewMockoperationStatusClient creates a new mock instance.
+func NewMockoperationStatusClient(ctrl *gomock.Controller) *MockoperationStatusClient {
+	mock := &MockoperationStatusClient{ctrl: ctrl}
+	mock.recorder = &MockoperationStatusClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoperationStatusClient) EXPECT() *MockoperationStatusClientMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockoperationStatusClient) Cancel(ctx context.Context, operationStatusID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, operationStatusID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockoperationStatusClientMockRecorder) Cancel(ctx, operationStatusID any) *MockoperationStatusClientCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockoperationStatusClient)(nil).Cancel), ctx, operationStatusID)
+	return &MockoperationStatusClientCancelCall{Call: call}
+}
+
+// MockoperationStatusClientCancelCall wrap *gomock.Call
+type MockoperationStatusClientCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoperationStatusClientCancelCall) Return(arg0 error) *MockoperationStatusClientCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoperationStatusClientCancelCall) Do(f func(context.Context, string) error) *MockoperationStatusClientCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoperationStatusClientCancelCall) DoAndReturn(f func(context.Context, string) error) *MockoperationStatusClientCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockoperationStatusClient) List(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceID)
+	ret0, _ := ret[0].([]v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockoperationStatusClientMockRecorder) List(ctx, resourceID any) *MockoperationStatusClientListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockoperationStatusClient)(nil).List), ctx, resourceID)
+	return &MockoperationStatusClientListCall{Call: call}
+}
+
+// MockoperationStatusClientListCall wrap *gomock.Call
+type MockoperationStatusClientListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoperationStatusClientListCall) Return(arg0 []v1.AsyncOperationStatus, arg1 error) *MockoperationStatusClientListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoperationStatusClientListCall) Do(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockoperationStatusClientListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoperationStatusClientListCall) DoAndReturn(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockoperationStatusClientListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
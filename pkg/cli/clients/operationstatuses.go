@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// operationStatusModuleName is the module name reported by the operation status client.
+	operationStatusModuleName = "github.com/radius-project/radius/pkg/cli/clients"
+
+	// operationStatusModuleVersion is the module version reported by the operation status client.
+	operationStatusModuleVersion = "v0.0.1"
+
+	// operationStatusAPIVersion is the api-version used for the operation status requests. Operation statuses
+	// are not versioned, so any api-version is accepted by the resource providers.
+	operationStatusAPIVersion = "2023-10-01-preview"
+
+	// operationStatusLocation is the location segment used to list operation statuses. Operation statuses are listed
+	// regardless of their location.
+	operationStatusLocation = "global"
+)
+
+// operationStatusList is the paginated list of async operation statuses.
+type operationStatusList struct {
+	Value    []v1.AsyncOperationStatus `json:"value"`
+	NextLink string                    `json:"nextLink,omitempty"`
+}
+
+// armOperationStatusClient is the operationStatusClient implementation using the ARM pipeline.
+type armOperationStatusClient struct {
+	internal *arm.Client
+}
+
+var _ operationStatusClient = (*armOperationStatusClient)(nil)
+
+// newOperationStatusClient creates a new operation status client.
+func newOperationStatusClient(options *arm.ClientOptions) (*armOperationStatusClient, error) {
+	cl, err := arm.NewClient(operationStatusModuleName, operationStatusModuleVersion, &aztoken.AnonymousCredential{}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &armOperationStatusClient{internal: cl}, nil
+}
+
+// List lists the async operation statuses of the resource.
+func (c *armOperationStatusClient) List(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("api-version", operationStatusAPIVersion)
+	query.Set("resourceId", resourceID)
+	path := fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), operationStatusLocation)
+	next := runtime.JoinPaths(c.internal.Endpoint(), path) + "?" + query.Encode()
+
+	statuses := []v1.AsyncOperationStatus{}
+	for next != "" {
+		page := operationStatusList{}
+		if err := c.do(ctx, http.MethodGet, next, &page); err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, page.Value...)
+		next = page.NextLink
+	}
+
+	return statuses, nil
+}
+
+// Cancel requests the cancellation of the async operation.
+func (c *armOperationStatusClient) Cancel(ctx context.Context, operationStatusID string) error {
+	query := url.Values{}
+	query.Set("api-version", operationStatusAPIVersion)
+	endpoint := runtime.JoinPaths(c.internal.Endpoint(), operationStatusID, "cancel") + "?" + query.Encode()
+
+	return c.do(ctx, http.MethodPost, endpoint, nil)
+}
+
+func (c *armOperationStatusClient) do(ctx context.Context, method string, endpoint string, result any) error {
+	req, err := runtime.NewRequest(ctx, method, endpoint)
+	if err != nil {
+		return err
+	}
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := c.internal.Pipeline().Do(req)
+	if err != nil {
+		return err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return runtime.NewResponseError(resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return runtime.UnmarshalAsJSON(resp, result)
+}
@@ -39,6 +39,10 @@ type GenericResourcesServer struct {
 	// ListSecrets is the fake for method GenericResourcesClient.ListSecrets
 	// HTTP status codes to indicate success: http.StatusOK
 	ListSecrets func(ctx context.Context, resourceName string, options *generated.GenericResourcesClientListSecretsOptions) (resp azfake.Responder[generated.GenericResourcesClientListSecretsResponse], errResp azfake.ErrorResponder)
+
+	// BeginPlan is the fake for method GenericResourcesClient.BeginPlan
+	// HTTP status codes to indicate success: http.StatusOK, http.StatusAccepted
+	BeginPlan func(ctx context.Context, resourceName string, options *generated.GenericResourcesClientBeginPlanOptions) (resp azfake.PollerResponder[generated.GenericResourcesClientPlanResponse], errResp azfake.ErrorResponder)
 }
 
 // NewGenericResourcesServerTransport creates a new instance of GenericResourcesServerTransport with the provided implementation.
@@ -49,6 +53,7 @@ func NewGenericResourcesServerTransport(srv *GenericResourcesServer) *GenericRes
 		srv:                     srv,
 		beginCreateOrUpdate:     newTracker[azfake.PollerResponder[generated.GenericResourcesClientCreateOrUpdateResponse]](),
 		beginDelete:             newTracker[azfake.PollerResponder[generated.GenericResourcesClientDeleteResponse]](),
+		beginPlan:               newTracker[azfake.PollerResponder[generated.GenericResourcesClientPlanResponse]](),
 		newListByRootScopePager: newTracker[azfake.PagerResponder[generated.GenericResourcesClientListByRootScopeResponse]](),
 	}
 }
@@ -59,6 +64,7 @@ type GenericResourcesServerTransport struct {
 	srv                     *GenericResourcesServer
 	beginCreateOrUpdate     *tracker[azfake.PollerResponder[generated.GenericResourcesClientCreateOrUpdateResponse]]
 	beginDelete             *tracker[azfake.PollerResponder[generated.GenericResourcesClientDeleteResponse]]
+	beginPlan               *tracker[azfake.PollerResponder[generated.GenericResourcesClientPlanResponse]]
 	newListByRootScopePager *tracker[azfake.PagerResponder[generated.GenericResourcesClientListByRootScopeResponse]]
 }
 
@@ -95,6 +101,8 @@ func (g *GenericResourcesServerTransport) dispatchToMethodFake(req *http.Request
 				res.resp, res.err = g.dispatchNewListByRootScopePager(req)
 			case "GenericResourcesClient.ListSecrets":
 				res.resp, res.err = g.dispatchListSecrets(req)
+			case "GenericResourcesClient.BeginPlan":
+				res.resp, res.err = g.dispatchBeginPlan(req)
 			default:
 				res.err = fmt.Errorf("unhandled API %s", method)
 			}
@@ -289,6 +297,46 @@ func (g *GenericResourcesServerTransport) dispatchListSecrets(req *http.Request)
 	return resp, nil
 }
 
+func (g *GenericResourcesServerTransport) dispatchBeginPlan(req *http.Request) (*http.Response, error) {
+	if g.srv.BeginPlan == nil {
+		return nil, &nonRetriableError{errors.New("fake for method BeginPlan not implemented")}
+	}
+	beginPlan := g.beginPlan.get(req)
+	if beginPlan == nil {
+		const regexStr = `/(?P<rootScope>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/(?P<resourceType>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/(?P<resourceName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/plan`
+		regex := regexp.MustCompile(regexStr)
+		matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+		if len(matches) < 4 {
+			return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+		}
+		resourceNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceName")])
+		if err != nil {
+			return nil, err
+		}
+		respr, errRespr := g.srv.BeginPlan(req.Context(), resourceNameParam, nil)
+		if respErr := server.GetError(errRespr, req); respErr != nil {
+			return nil, respErr
+		}
+		beginPlan = &respr
+		g.beginPlan.add(req, beginPlan)
+	}
+
+	resp, err := server.PollerResponderNext(beginPlan, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contains([]int{http.StatusOK, http.StatusAccepted}, resp.StatusCode) {
+		g.beginPlan.remove(req)
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK, http.StatusAccepted", resp.StatusCode)}
+	}
+	if !server.PollerResponderMore(beginPlan) {
+		g.beginPlan.remove(req)
+	}
+
+	return resp, nil
+}
+
 // set this to conditionally intercept incoming requests to GenericResourcesServerTransport
 var genericResourcesServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
@@ -353,3 +353,73 @@ func (client *GenericResourcesClient) listSecretsHandleResponse(resp *http.Respo
 	}
 	return result, nil
 }
+
+// BeginPlan - Computes the changes that deploying the recipe of a resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - resourceName - The name of the generic resource
+//   - options - GenericResourcesClientBeginPlanOptions contains the optional parameters for the
+//     GenericResourcesClient.BeginPlan method.
+func (client *GenericResourcesClient) BeginPlan(ctx context.Context, resourceName string, options *GenericResourcesClientBeginPlanOptions) (*runtime.Poller[GenericResourcesClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, resourceName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[GenericResourcesClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[GenericResourcesClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of a resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *GenericResourcesClient) plan(ctx context.Context, resourceName string, options *GenericResourcesClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "GenericResourcesClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, resourceName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *GenericResourcesClient) planCreateRequest(ctx context.Context, resourceName string, _ *GenericResourcesClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
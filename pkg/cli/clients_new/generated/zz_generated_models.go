@@ -73,6 +73,39 @@ type GenericResourcesList struct {
 	Value []*GenericResource
 }
 
+// RecipePlanResult - The changes that deploying the recipe of a resource would make.
+type RecipePlanResult struct {
+	// REQUIRED; The changes that would be made to the resources deployed by the recipe if the recipe was deployed.
+	Changes []*RecipeResourceChange
+
+	// REQUIRED; The changes made to the resources deployed by the recipe outside of the recipe since it was last deployed.
+	Drift []*RecipeResourceChange
+}
+
+// RecipeResourceChange - A change to a resource deployed by a recipe.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that will be taken on the resource, for example create, update, replace, delete, read or no-op.
+	Action *string
+
+	// REQUIRED; The address of the resource within the recipe.
+	Address *string
+
+	// The properties of the resource after the change. Sensitive values are redacted.
+	After map[string]any
+
+	// The properties of the resource before the change. Sensitive values are redacted.
+	Before map[string]any
+
+	// The top-level properties whose values differ between before and after.
+	ChangedProperties []*string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	Type *string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -17,6 +17,13 @@ type GenericResourcesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// GenericResourcesClientBeginPlanOptions contains the optional parameters for the GenericResourcesClient.BeginPlan
+// method.
+type GenericResourcesClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // GenericResourcesClientGetOptions contains the optional parameters for the GenericResourcesClient.Get method.
 type GenericResourcesClientGetOptions struct {
 	// placeholder for future optional parameters
@@ -32,3 +32,9 @@ type GenericResourcesClientListSecretsResponse struct {
 	// Response to a list secrets request
 	Value map[string]*string
 }
+
+// GenericResourcesClientPlanResponse contains the response from method GenericResourcesClient.BeginPlan.
+type GenericResourcesClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/auditlogs"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad audit list` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit records",
+		Long: `List audit records, most recent first
+
+Every create, update, delete and action request handled by Radius is recorded in the audit log together with the principal that made it and its outcome. Audit records can only be listed when the database audit sink is configured.`,
+		Example: `
+# List the most recent audit records
+rad audit list
+
+# List the audit records of a principal over the last day
+rad audit list --principal alice@contoso.com --since 24h
+
+# List the audit records of a resource group and the resources it contains
+rad audit list --resource-id /planes/radius/local/resourceGroups/prod --limit 20`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	cmd.Flags().String("principal", "", "Only list the records of the given principal")
+	cmd.Flags().String("resource-id", "", "Only list the records of the given resource or scope and the resources it contains")
+	cmd.Flags().String("since", "", "Only list the records created after the given RFC3339 time or within the given duration, for example '24h'")
+	cmd.Flags().Int("limit", 0, "The maximum number of records to list. Defaults to the server limit")
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad audit list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	Format string
+	Query  auditlogs.Query
+}
+
+// NewRunner creates an instance of the runner for the `rad audit list` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad audit list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Query.Principal, err = cmd.Flags().GetString("principal")
+	if err != nil {
+		return err
+	}
+
+	r.Query.ResourceID, err = cmd.Flags().GetString("resource-id")
+	if err != nil {
+		return err
+	}
+
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return err
+	}
+	r.Query.Since, err = parseSince(since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	r.Query.Limit, err = cmd.Flags().GetInt("limit")
+	if err != nil {
+		return err
+	}
+	if r.Query.Limit < 0 {
+		return clierrors.Message("The limit must not be negative.")
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad audit list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateAuditLogClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	records, err := client.List(ctx, r.Query)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, records, objectformats.GetAuditRecordTableFormat())
+}
+
+// parseSince parses the value of the --since flag, which is either an RFC3339 time or a duration before now.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+
+	return time.Time{}, clierrors.Message("The value %q of --since must be an RFC3339 time or a positive duration, for example '24h'.", value)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/auditlogs"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: all flags",
+			Input:         []string{"--principal", "alice", "--resource-id", "/planes/radius/local/resourceGroups/rg", "--since", "2024-01-01T00:00:00Z", "--limit", "10"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, auditlogs.Query{
+					Principal:  "alice",
+					ResourceID: "/planes/radius/local/resourceGroups/rg",
+					Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Limit:      10,
+				}, r.Query)
+			},
+		},
+		{
+			Name:          "Valid: since duration",
+			Input:         []string{"--since", "24h"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: since",
+			Input:         []string{"--since", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: negative limit",
+			Input:         []string{"--limit", "-1"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"dddd"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	records := []*admin.AuditRecord{
+		{
+			ID:         "record-1",
+			Principal:  "alice",
+			Operation:  "Applications.Core/containers/write",
+			ResourceID: "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/containers/c",
+			StatusCode: 200,
+			Outcome:    "Succeeded",
+		},
+	}
+
+	query := auditlogs.Query{Principal: "alice"}
+	client := auditlogs.NewMockClient(ctrl)
+	client.EXPECT().
+		List(gomock.Any(), query).
+		Return(records, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{AuditLogClient: client},
+		Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+		Output:            outputSink,
+		Format:            "table",
+		Query:             query,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     records,
+			Options: objectformats.GetAuditRecordTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
+
+func Test_parseSince(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	since, err := parseSince("", now)
+	require.NoError(t, err)
+	require.True(t, since.IsZero())
+
+	since, err = parseSince("24h", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), since)
+
+	_, err = parseSince("-24h", now)
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// AddQueueFlag adds a flag to the given command that allows the user to specify the async operation queue.
+func AddQueueFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("queue", "q", deadletters.DefaultQueueName, "The name of the async operation queue, for example 'radius' for the Applications resource provider or 'ucp' for UCP")
+}
+
+// RequireQueue returns the name of the async operation queue specified by the --queue flag.
+func RequireQueue(cmd *cobra.Command) (string, error) {
+	queueName, err := cmd.Flags().GetString("queue")
+	if err != nil {
+		return "", err
+	}
+
+	if queueName == "" {
+		return "", clierrors.Message("The queue name must not be empty.")
+	}
+
+	return queueName, nil
+}
+
+// NotFoundError returns the error reported when the dead-lettered operation does not exist.
+func NotFoundError(queueName string, id string) error {
+	return clierrors.Message("The dead-lettered operation %q was not found in queue %q.", id, queueName)
+}
+
+// GetDeadLetterTableFormat returns the fields to output from a dead-lettered operation.
+func GetDeadLetterTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "DEQUEUES",
+				JSONPath: "{ .DequeueCount }",
+			},
+			{
+				Heading:  "REPLAYS",
+				JSONPath: "{ .ReplayCount }",
+			},
+			{
+				Heading:  "DEAD-LETTERED AT",
+				JSONPath: "{ .DeadLetteredAt }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+		},
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	purgeConfirmation = "Are you sure you want to purge dead-lettered operation %q? The operation cannot be replayed afterwards."
+)
+
+// NewCommand creates an instance of the `rad deadletter purge` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [id]",
+		Short: "Purge a dead-lettered operation",
+		Long: `Purge a dead-lettered operation
+
+Permanently deletes a dead-lettered operation from the dead-letter store of its queue.`,
+		Example: `
+# Purge a dead-lettered operation of the Applications resource provider
+rad deadletter purge 5a4f...
+
+# Purge a dead-lettered operation (bypass confirmation)
+rad deadletter purge 5a4f... --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	common.AddQueueFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	InputPrompter     prompt.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	Confirm   bool
+	QueueName string
+	ID        string
+}
+
+// NewRunner creates an instance of the runner for the `rad deadletter purge` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		InputPrompter:     factory.GetPrompter(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.QueueName, err = common.RequireQueue(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	r.ID = args[0]
+
+	return nil
+}
+
+// Run runs the `rad deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Prompt user to confirm the purge
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(purgeConfirmation, r.ID), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	err = client.Purge(ctx, r.QueueName, r.ID)
+	if errors.Is(err, deadletters.ErrNotFound) {
+		return common.NotFoundError(r.QueueName, r.ID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Dead-lettered operation %q was purged.", r.ID)
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"message-1"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: confirmed",
+			Input:         []string{"message-1", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"message-1", "message-2"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: confirmed with flag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := deadletters.NewMockClient(ctrl)
+		client.EXPECT().
+			Purge(gomock.Any(), deadletters.DefaultQueueName, "message-1").
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			QueueName:         deadletters.DefaultQueueName,
+			ID:                "message-1",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Dead-lettered operation %q was purged.",
+				Params: []any{"message-1"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: prompt declined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := deadletters.NewMockClient(ctrl)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeConfirmation, "message-1")).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			InputPrompter:     promptMock,
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			QueueName:         deadletters.DefaultQueueName,
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Success: prompt confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := deadletters.NewMockClient(ctrl)
+		client.EXPECT().
+			Purge(gomock.Any(), deadletters.DefaultQueueName, "message-1").
+			Return(nil).
+			Times(1)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeConfirmation, "message-1")).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			InputPrompter:     promptMock,
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			QueueName:         deadletters.DefaultQueueName,
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Dead-lettered operation %q was purged.",
+				Params: []any{"message-1"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
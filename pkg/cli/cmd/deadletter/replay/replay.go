@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"errors"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad deadletter replay` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "replay [id]",
+		Short: "Replay a dead-lettered operation",
+		Long: `Replay a dead-lettered operation
+
+Moves a dead-lettered operation back to its queue so that it is processed again. The retry count of the operation is reset.`,
+		Example: `
+# Replay a dead-lettered operation of the Applications resource provider
+rad deadletter replay 5a4f...
+
+# Replay a dead-lettered operation of UCP
+rad deadletter replay 5a4f... --queue ucp`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	common.AddQueueFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad deadletter replay` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	QueueName string
+	ID        string
+}
+
+// NewRunner creates an instance of the runner for the `rad deadletter replay` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter replay` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.QueueName, err = common.RequireQueue(cmd)
+	if err != nil {
+		return err
+	}
+	r.ID = args[0]
+
+	return nil
+}
+
+// Run runs the `rad deadletter replay` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.Replay(ctx, r.QueueName, r.ID)
+	if errors.Is(err, deadletters.ErrNotFound) {
+		return common.NotFoundError(r.QueueName, r.ID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Dead-lettered operation %q was replayed.", r.ID)
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"message-1"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: not enough arguments",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"message-1", "message-2"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		deadLetter := &admin.DeadLetter{
+			ID:     "message-1",
+			Queue:  deadletters.DefaultQueueName,
+			Reason: "exceeded max retry count to process async operation message: 5",
+		}
+
+		client := deadletters.NewMockClient(ctrl)
+		client.EXPECT().
+			Get(gomock.Any(), deadletters.DefaultQueueName, "message-1").
+			Return(deadLetter, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			Format:            "json",
+			QueueName:         deadletters.DefaultQueueName,
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     deadLetter,
+				Options: common.GetDeadLetterTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := deadletters.NewMockClient(ctrl)
+		client.EXPECT().
+			Get(gomock.Any(), deadletters.DefaultQueueName, "message-1").
+			Return(nil, deadletters.ErrNotFound).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            &output.MockOutput{},
+			Format:            "table",
+			QueueName:         deadletters.DefaultQueueName,
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, common.NotFoundError(deadletters.DefaultQueueName, "message-1"), err)
+	})
+}
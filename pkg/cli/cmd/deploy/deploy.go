@@ -79,6 +79,11 @@ When passing multiple parameters in a single file, use the format described here
 
 You can specify parameters using multiple sources. Parameters can be overridden based on the 
 order they are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+Use the '--what-if' flag to preview the changes that the deployment would make without deploying anything. Each
+resource is validated by its resource provider and its properties are compared with the existing resource. The
+resources that Radius creates for containers, gateways and volumes, such as Kubernetes objects, are rendered and
+listed as well. The resources deployed by recipes are not previewed; use 'rad resource plan' to preview them.
 `,
 		Example: `
 # deploy a Bicep template
@@ -114,6 +119,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the changes of a deployment without deploying anything
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -124,6 +132,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Preview the changes of the deployment without deploying anything")
 
 	return cmd, runner
 }
@@ -145,6 +154,7 @@ type Runner struct {
 	Workspace           *workspaces.Workspace
 	Providers           *clients.Providers
 	EnvResult           *EnvironmentCheckResult
+	WhatIf              bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -247,6 +257,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// 'rad run' shares this validation but does not define the flag.
+	r.WhatIf, _ = cmd.Flags().GetBool("what-if")
+
 	return nil
 }
 
@@ -273,6 +286,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.WhatIf {
+		return r.runWhatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	// Note: This only applies when the environment already exists. If the template is creating the environment,
@@ -345,6 +362,29 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
+// runWhatIf previews the changes of the deployment. The application is not created even when it does not exist yet,
+// because a preview must not have side effects.
+func (r *Runner) runWhatIf(ctx context.Context, template map[string]any) error {
+	progressText := fmt.Sprintf(
+		"Previewing the deployment of template '%v' into environment '%v' from workspace '%v'...",
+		r.FilePath, r.EnvironmentNameOrID, r.Workspace.Name)
+
+	_, err := r.Deploy.WhatIf(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		ProgressText:      progressText,
+		CompletionText:    "What-if Complete",
+		Providers:         r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *Runner) injectAutomaticParameters(template map[string]any) error {
 	if r.Providers.Radius.EnvironmentID != "" {
 		err := bicep.InjectEnvironmentParam(template, r.Parameters, r.Providers.Radius.EnvironmentID)
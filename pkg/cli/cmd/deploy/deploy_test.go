@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/config"
@@ -646,6 +647,60 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 
+	t.Run("What-if does not deploy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		provider := &clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: radcli.TestEnvironmentID,
+			},
+		}
+
+		filePath := "app.bicep"
+		expected := deploy.Options{
+			Workspace:      *workspace,
+			Parameters:     map[string]map[string]any{},
+			CompletionText: "What-if Complete",
+			ProgressText: fmt.Sprintf(
+				"Previewing the deployment of template '%v' into environment '%v' from workspace '%v'...",
+				filePath, radcli.TestEnvironmentID, workspace.Name),
+			Template:  map[string]any{},
+			Providers: provider,
+		}
+
+		// The application must not be created and nothing must be deployed.
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			WhatIf(gomock.Any(), expected).
+			Return(v1.WhatIfResult{}, nil).
+			Times(1)
+
+		runner := &Runner{
+			Bicep:               bicep.NewMockInterface(ctrl),
+			Deploy:              deployMock,
+			Output:              &output.MockOutput{},
+			FilePath:            filePath,
+			ApplicationName:     "test-application",
+			EnvironmentNameOrID: radcli.TestEnvironmentID,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           workspace,
+			Providers:           provider,
+			Template:            map[string]any{},
+			WhatIf:              true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
 	t.Run("Environment-scoped deployment with aws provider", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
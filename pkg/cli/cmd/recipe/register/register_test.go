@@ -195,6 +195,58 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
 
+	t.Run("Register helm recipe Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Compute: &v20231001preview.KubernetesCompute{
+					Namespace: to.Ptr("default"),
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+
+		var registered *v20231001preview.EnvironmentResource
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(context.Background(), "kind-kind", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, resource *v20231001preview.EnvironmentResource) error {
+				registered = resource
+				return nil
+			}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindHelm,
+			TemplatePath:      "oci://ghcr.io/testpublicrecipe/charts/redis",
+			TemplateVersion:   "1.0.0",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+			RecipeName:        "redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := &v20231001preview.HelmRecipeProperties{
+			TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+			TemplatePath:    to.Ptr("oci://ghcr.io/testpublicrecipe/charts/redis"),
+			TemplateVersion: to.Ptr("1.0.0"),
+			PlainHTTP:       to.Ptr(false),
+			Parameters:      map[string]any{},
+		}
+		require.Equal(t, expected, registered.Properties.Recipes[ds_ctrl.RedisCachesResourceType]["redis"])
+	})
+
 	t.Run("Register recipe Failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource cancel` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel [resourceType] [resourceName]",
+		Short: "Cancel the in-progress operations of a Radius resource",
+		Long: `Cancel the in-progress operations of a Radius resource.
+
+Cancellation is cooperative: the resource provider stops the operation at the next opportunity and marks it as Canceled.
+Operations that have already completed are not affected.`,
+		Example: `
+# Cancel the in-progress deployment of a container named orders
+rad resource cancel Applications.Core/containers orders`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource cancel` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource cancel` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource cancel` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	canceled, err := client.CancelResourceOperations(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if clients.Is404Error(err) {
+		r.Output.LogInfo("Resource '%s' of type '%s' does not exist", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(canceled) == 0 {
+		r.Output.LogInfo("Resource '%s' of type '%s' has no operation in progress", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	}
+
+	for _, operation := range canceled {
+		r.Output.LogInfo("Requested cancellation of operation '%s' for resource '%s'", operation, r.ResourceName)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Cancel Command",
+			Input:         []string{"Applications.Core/containers", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Cancel Command with fallback workspace",
+			Input:         []string{"Applications.Core/containers", "foo", "-g", "my-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Cancel Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Cancel Command with insufficient args",
+			Input:         []string{"Applications.Core/containers"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Cancel Command with too many args",
+			Input:         []string{"Applications.Core/containers", "a", "b"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(client clients.ApplicationsManagementClient, outputSink *output.MockOutput) *Runner {
+		return &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Core/containers",
+			ResourceName:                   "test-container",
+			Format:                         "table",
+		}
+	}
+
+	t.Run("Success (canceled)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelResourceOperations(gomock.Any(), "Applications.Core/containers", "test-container").
+			Return([]string{"op1"}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Requested cancellation of operation '%s' for resource '%s'",
+				Params: []any{"op1", "test-container"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (nothing in progress)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelResourceOperations(gomock.Any(), "Applications.Core/containers", "test-container").
+			Return([]string{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource '%s' of type '%s' has no operation in progress",
+				Params: []any{"test-container", "Applications.Core/containers"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (non-existent)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		responseError := runtime.NewResponseError(
+			&http.Response{
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Body:       http.NoBody,
+				Request: &http.Request{
+					Method: http.MethodGet,
+					URL:    &url.URL{Path: "url"},
+				},
+			})
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelResourceOperations(gomock.Any(), "Applications.Core/containers", "test-container").
+			Return(nil, responseError).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource '%s' of type '%s' does not exist",
+				Params: []any{"test-container", "Applications.Core/containers"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource plan` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [resourceType] [resourceName]",
+		Short: "Show the changes that deploying the recipe of a Radius resource would make",
+		Long: `Show the changes that deploying the recipe of a Radius resource would make.
+
+The plan lists the changes that re-deploying the recipe would make to the resources it manages, and the changes
+made to those resources outside of Radius (drift) since the recipe was last deployed. No changes are made.
+
+Only resources provisioned by a recipe whose driver supports plans, such as Terraform, can be planned.`,
+		Example: `
+# Show the changes that deploying the recipe of a Redis cache named cache would make
+rad resource plan Applications.Datastores/redisCaches cache
+
+# Show the plan in JSON format
+rad resource plan Applications.Datastores/redisCaches cache --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource plan` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource plan` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource plan` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	plan, err := client.PlanResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q does not exist.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, plan, objectformats.GetRecipeResourceChangeTableFormat())
+	}
+
+	if len(plan.Changes) == 0 {
+		r.Output.LogInfo("No changes. Deploying the recipe of resource '%s' would not change any resources.", r.ResourceName)
+	} else {
+		r.Output.LogInfo("Deploying the recipe of resource '%s' would make the following changes:", r.ResourceName)
+		err = r.Output.WriteFormatted(r.Format, plan.Changes, objectformats.GetRecipeResourceChangeTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(plan.Drift) > 0 {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("The following resources were changed outside of Radius since the recipe was last deployed:")
+		err = r.Output.WriteFormatted(r.Format, plan.Drift, objectformats.GetRecipeResourceChangeTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
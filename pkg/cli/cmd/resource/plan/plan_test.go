@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{"Applications.Datastores/redisCaches", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with fallback workspace",
+			Input:         []string{"Applications.Datastores/redisCaches", "foo", "-g", "my-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Plan Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with insufficient args",
+			Input:         []string{"Applications.Datastores/redisCaches"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with too many args",
+			Input:         []string{"Applications.Datastores/redisCaches", "a", "b"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(client clients.ApplicationsManagementClient, outputSink *output.MockOutput, format string) *Runner {
+		return &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Datastores/redisCaches",
+			ResourceName:                   "test-cache",
+			Format:                         format,
+		}
+	}
+
+	changes := []*generated.RecipeResourceChange{
+		{Address: to.Ptr("aws_elasticache_cluster.cache"), Action: to.Ptr("update"), ChangedProperties: []*string{to.Ptr("node_type")}},
+	}
+	drift := []*generated.RecipeResourceChange{
+		{Address: to.Ptr("aws_elasticache_cluster.cache"), Action: to.Ptr("update"), ChangedProperties: []*string{to.Ptr("tags")}},
+	}
+
+	t.Run("Success (changes and drift)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "test-cache").
+			Return(generated.RecipePlanResult{Changes: changes, Drift: drift}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink, "table").Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Deploying the recipe of resource '%s' would make the following changes:",
+				Params: []any{"test-cache"},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     changes,
+				Options: objectformats.GetRecipeResourceChangeTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.LogOutput{
+				Format: "The following resources were changed outside of Radius since the recipe was last deployed:",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     drift,
+				Options: objectformats.GetRecipeResourceChangeTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (no changes)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "test-cache").
+			Return(generated.RecipePlanResult{Changes: []*generated.RecipeResourceChange{}, Drift: []*generated.RecipeResourceChange{}}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink, "table").Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No changes. Deploying the recipe of resource '%s' would not change any resources.",
+				Params: []any{"test-cache"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (json)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		plan := generated.RecipePlanResult{Changes: changes, Drift: drift}
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "test-cache").
+			Return(plan, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink, "json").Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     plan,
+				Options: objectformats.GetRecipeResourceChangeTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error (non-existent)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		responseError := runtime.NewResponseError(
+			&http.Response{
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Body:       http.NoBody,
+				Request: &http.Request{
+					Method: http.MethodPost,
+					URL:    &url.URL{Path: "url"},
+				},
+			})
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "test-cache").
+			Return(generated.RecipePlanResult{}, responseError).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink, "table").Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q does not exist.", "test-cache", "Applications.Datastores/redisCaches"), err)
+	})
+}
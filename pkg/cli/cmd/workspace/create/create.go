@@ -47,7 +47,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Create a workspace",
 		Long: `Create a workspace.
 		
-Available workspaceTypes: kubernetes
+Available workspaceTypes: kubernetes, direct
+
+A 'direct' workspace connects to a UCP endpoint exposed outside of the cluster, authenticating with a bearer token such as an OIDC token.
 
 Workspaces allow you to manage multiple Radius platforms and environments using a local configuration file. 
 
@@ -57,7 +59,9 @@ You can easily define and switch between workspaces to deploy and manage applica
 # Create a workspace with name 'myworkspace' and kubernetes context 'aks'
 rad workspace create kubernetes myworkspace --context aks
 # Create a workspace with name of current kubernetes context in current kubernetes context
-rad workspace create kubernetes`,
+rad workspace create kubernetes
+# Create a workspace with name 'myworkspace' that connects to an exposed UCP endpoint using the bearer token in a file
+rad workspace create direct myworkspace --url https://radius.contoso.com --bearer-token-file ~/.radius/token`,
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -66,6 +70,8 @@ rad workspace create kubernetes`,
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().BoolP("force", "f", false, "Overwrite existing workspace if present")
 	cmd.Flags().StringP("context", "c", "", "the Kubernetes context to use, will use the default if unset")
+	cmd.Flags().String("url", "", "the URL of the UCP endpoint, required for 'direct' workspaces")
+	cmd.Flags().String("bearer-token-file", "", "the path of a file containing the bearer token used to authenticate, for 'direct' workspaces")
 
 	return cmd, runner
 }
@@ -97,9 +103,10 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad workspace create` command.
 //
 
-// Validate checks if the given workspace name is valid, if the given Kubernetes context is valid, if the Radius
-// control plane is installed on the target platform, if the workspace already exists, if the user has specified the
-// --force flag, if the given resource group and environment exist, and returns an error if any of these checks fail.
+// Validate checks if the given workspace name is valid, if the given Kubernetes context is valid and the Radius
+// control plane is installed on the target platform (or the UCP endpoint is valid for 'direct' workspaces), if the
+// workspace already exists, if the user has specified the --force flag, if the given resource group and environment
+// exist, and returns an error if any of these checks fail.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	config := r.ConfigHolder.Config
 
@@ -108,29 +115,16 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
-	if err != nil {
-		return clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
+	var connection map[string]any
+	if args[0] == workspaces.KindDirect {
+		connection, err = r.validateDirectConnection(cmd, workspaceName)
+	} else {
+		connection, workspaceName, err = r.validateKubernetesConnection(cmd, workspaceName)
 	}
-	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
 	if err != nil {
 		return err
 	}
 
-	_, ok := kubeContextList.Contexts[context]
-	if !ok {
-		return fmt.Errorf("the kubeconfig does not contain a context called %q", context)
-	}
-
-	if workspaceName == "" {
-		workspaceName = context
-	}
-
-	state, err := r.HelmInterface.CheckRadiusInstall(context)
-	if !state.RadiusInstalled || err != nil {
-		return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
-	}
-
 	workspaceExists, err := cli.HasWorkspace(config, workspaceName)
 	if err != nil {
 		return err
@@ -155,9 +149,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Workspace = &workspaces.Workspace{}
 		r.Workspace.Name = workspaceName
 	}
-	r.Workspace.Connection = map[string]any{}
-	r.Workspace.Connection["context"] = context
-	r.Workspace.Connection["kind"] = args[0]
+	r.Workspace.Connection = connection
 
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
@@ -202,6 +194,77 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateKubernetesConnection validates the Kubernetes context of the workspace and returns the connection of
+// the workspace and the workspace name, which defaults to the name of the context.
+func (r *Runner) validateKubernetesConnection(cmd *cobra.Command, workspaceName string) (map[string]any, string, error) {
+	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
+	if err != nil {
+		return nil, "", clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
+	}
+	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, ok := kubeContextList.Contexts[context]
+	if !ok {
+		return nil, "", fmt.Errorf("the kubeconfig does not contain a context called %q", context)
+	}
+
+	if workspaceName == "" {
+		workspaceName = context
+	}
+
+	state, err := r.HelmInterface.CheckRadiusInstall(context)
+	if !state.RadiusInstalled || err != nil {
+		return nil, "", fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+	}
+
+	connection := map[string]any{
+		"context": context,
+		"kind":    workspaces.KindKubernetes,
+	}
+	return connection, workspaceName, nil
+}
+
+// validateDirectConnection validates the UCP endpoint and bearer token of a direct workspace and returns the
+// connection of the workspace.
+func (r *Runner) validateDirectConnection(cmd *cobra.Command, workspaceName string) (map[string]any, error) {
+	if workspaceName == "" {
+		return nil, clierrors.Message("The workspace name is required for 'direct' workspaces.")
+	}
+
+	endpoint, err := cmd.Flags().GetString("url")
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		return nil, clierrors.Message("The --url flag is required for 'direct' workspaces.")
+	}
+
+	bearerTokenFile, err := cmd.Flags().GetString("bearer-token-file")
+	if err != nil {
+		return nil, err
+	}
+
+	connection := map[string]any{
+		"kind": workspaces.KindDirect,
+		"url":  endpoint,
+	}
+	if bearerTokenFile != "" {
+		connection["bearerTokenFile"] = bearerTokenFile
+	}
+
+	// Validate the settings without making a request, the token may be refreshed by another process later.
+	config := &workspaces.DirectConnectionConfig{Kind: workspaces.KindDirect, URL: endpoint, BearerTokenFile: bearerTokenFile}
+	_, err = config.Connect()
+	if err != nil {
+		return nil, clierrors.Message("The UCP endpoint %q is not valid: %s", endpoint, err.Error())
+	}
+
+	return connection, nil
+}
+
 // Run runs the `rad workspace create` command.
 //
 
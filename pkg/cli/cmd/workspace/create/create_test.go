@@ -126,6 +126,45 @@ func Test_Validate(t *testing.T) {
 				mocks.ApplicationManagementClient.EXPECT().GetEnvironment(gomock.Any(), "env1").Return(corerp.EnvironmentResource{}, nil).Times(1)
 			},
 		},
+		{
+			Name:          "valid direct create command",
+			Input:         []string{"direct", "ws", "--url", "https://radius.contoso.com", "--bearer-token-file", "/tmp/token"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "direct create command without url",
+			Input:         []string{"direct", "ws"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "direct create command with bearer token over http",
+			Input:         []string{"direct", "ws", "--url", "http://radius.contoso.com", "--bearer-token-file", "/tmp/token"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid direct create command with resource group",
+			Input:         []string{"direct", "ws", "--url", "https://radius.contoso.com", "-g", "rg1"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().GetResourceGroup(gomock.Any(), "local", "rg1").Return(ucp.ResourceGroupResource{}, nil).Times(1)
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
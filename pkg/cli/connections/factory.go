@@ -21,9 +21,11 @@ import (
 	"fmt"
 
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/auditlogs"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
 	"github.com/radius-project/radius/pkg/cli/deployment"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
@@ -43,6 +45,8 @@ type Factory interface {
 	CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error)
 	CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error)
 	CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error)
+	CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletters.Client, error)
+	CreateAuditLogClient(ctx context.Context, workspace workspaces.Workspace) (auditlogs.Client, error)
 }
 
 var _ Factory = (*impl)(nil)
@@ -201,3 +205,24 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 
 	return cpClient, nil
 }
+
+// CreateDeadLetterClient connects to the workspace and returns a client for the dead-lettered async operations
+// of the UCP admin API.
+func (*impl) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletters.Client, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadletters.UCPClient{Connection: connection}, nil
+}
+
+// CreateAuditLogClient connects to the workspace and returns a client for the audit log of the UCP admin API.
+func (*impl) CreateAuditLogClient(ctx context.Context, workspace workspaces.Workspace) (auditlogs.Client, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditlogs.UCPClient{Connection: connection}, nil
+}
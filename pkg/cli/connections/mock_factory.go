@@ -19,8 +19,10 @@ package connections
 import (
 	"context"
 
+	"github.com/radius-project/radius/pkg/cli/auditlogs"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/deadletters"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 )
 
@@ -28,7 +30,9 @@ var _ Factory = (*MockFactory)(nil)
 
 type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
+	AuditLogClient               auditlogs.Client
 	CredentialManagementClient   cli_credential.CredentialManagementClient
+	DeadLetterClient             deadletters.Client
 	DiagnosticsClient            clients.DiagnosticsClient
 }
 
@@ -52,3 +56,13 @@ func (f *MockFactory) CreateApplicationsManagementClient(ctx context.Context, wo
 func (f *MockFactory) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	return f.CredentialManagementClient, nil
 }
+
+// CreateDeadLetterClient function takes in a context and a workspace and returns a deadletters.Client and does not return an error.
+func (f *MockFactory) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletters.Client, error) {
+	return f.DeadLetterClient, nil
+}
+
+// CreateAuditLogClient function takes in a context and a workspace and returns an auditlogs.Client and does not return an error.
+func (f *MockFactory) CreateAuditLogClient(ctx context.Context, workspace workspaces.Workspace) (auditlogs.Client, error) {
+	return f.AuditLogClient, nil
+}
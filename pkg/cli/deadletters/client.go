@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadletters contains the client for the UCP admin API to inspect, replay and purge dead-lettered
+// async operations.
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+)
+
+const (
+	// DefaultQueueName is the name of the queue used by the Applications resource provider.
+	DefaultQueueName = "radius"
+)
+
+// ErrNotFound is returned when the dead-lettered async operation does not exist.
+var ErrNotFound = errors.New("dead-lettered operation was not found")
+
+//go:generate mockgen -typed -destination=./mock_client.go -package=deadletters -self_package github.com/radius-project/radius/pkg/cli/deadletters github.com/radius-project/radius/pkg/cli/deadletters Client
+
+// Client is used to interact with the dead-lettered async operations of a queue.
+type Client interface {
+	// List lists the dead-lettered async operations of the queue.
+	List(ctx context.Context, queueName string) ([]*admin.DeadLetter, error)
+
+	// Get gets the dead-lettered async operation with the given id. Returns ErrNotFound if it does not exist.
+	Get(ctx context.Context, queueName string, id string) (*admin.DeadLetter, error)
+
+	// Replay moves the dead-lettered async operation back to the queue. Returns ErrNotFound if it does not exist.
+	Replay(ctx context.Context, queueName string, id string) error
+
+	// Purge permanently deletes the dead-lettered async operation. Returns ErrNotFound if it does not exist.
+	Purge(ctx context.Context, queueName string, id string) error
+}
+
+var _ Client = (*UCPClient)(nil)
+
+// UCPClient implements Client using the UCP admin API.
+type UCPClient struct {
+	Connection sdk.Connection
+}
+
+// List lists the dead-lettered async operations of the queue.
+func (c *UCPClient) List(ctx context.Context, queueName string) ([]*admin.DeadLetter, error) {
+	result := &admin.DeadLetterList{}
+	err := c.do(ctx, http.MethodGet, c.url(queueName), result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// Get gets the dead-lettered async operation with the given id.
+func (c *UCPClient) Get(ctx context.Context, queueName string, id string) (*admin.DeadLetter, error) {
+	result := &admin.DeadLetter{}
+	err := c.do(ctx, http.MethodGet, c.url(queueName, id), result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Replay moves the dead-lettered async operation back to the queue.
+func (c *UCPClient) Replay(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodPost, c.url(queueName, id, "replay"), nil)
+}
+
+// Purge permanently deletes the dead-lettered async operation.
+func (c *UCPClient) Purge(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodDelete, c.url(queueName, id), nil)
+}
+
+func (c *UCPClient) url(queueName string, segments ...string) string {
+	u := strings.TrimSuffix(c.Connection.Endpoint(), "/") + "/admin/queues/" + url.PathEscape(queueName) + "/deadletters"
+	for _, segment := range segments {
+		u += "/" + url.PathEscape(segment)
+	}
+
+	return u
+}
+
+// do sends the request and decodes the response body into result if result is non-nil.
+func (c *UCPClient) do(ctx context.Context, method string, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	} else if resp.StatusCode >= 400 {
+		errResp := v1.ErrorResponse{}
+		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != nil {
+			return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, errResp.Error.Message)
+		}
+
+		return fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UCPClient(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters":
+			_ = json.NewEncoder(w).Encode(admin.DeadLetterList{Value: []*admin.DeadLetter{{ID: "msg1", Queue: "radius"}}})
+		case "GET /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1":
+			_ = json.NewEncoder(w).Encode(admin.DeadLetter{ID: "msg1", Queue: "radius", Reason: "test reason"})
+		case "POST /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1/replay",
+			"DELETE /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /apis/api.ucp.dev/v1alpha3/admin/queues/invalid_queue/deadletters":
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: "invalid queue name"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPClient{Connection: connection}
+	ctx := testcontext.New(t)
+
+	deadLetters, err := client.List(ctx, "radius")
+	require.NoError(t, err)
+	require.Equal(t, []*admin.DeadLetter{{ID: "msg1", Queue: "radius"}}, deadLetters)
+
+	deadLetter, err := client.Get(ctx, "radius", "msg1")
+	require.NoError(t, err)
+	require.Equal(t, "test reason", deadLetter.Reason)
+
+	err = client.Replay(ctx, "radius", "msg1")
+	require.NoError(t, err)
+
+	err = client.Purge(ctx, "radius", "msg1")
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, "radius", "msg2")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.List(ctx, "invalid_queue")
+	require.EqualError(t, err, "request failed with status 400: invalid queue name")
+
+	require.Equal(t, []string{
+		"GET /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters",
+		"GET /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1",
+		"POST /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1/replay",
+		"DELETE /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg1",
+		"GET /apis/api.ucp.dev/v1alpha3/admin/queues/radius/deadletters/msg2",
+		"GET /apis/api.ucp.dev/v1alpha3/admin/queues/invalid_queue/deadletters",
+	}, requests)
+}
@@ -19,6 +19,7 @@ package deploy
 import (
 	"context"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
@@ -29,9 +30,12 @@ type Interface interface {
 	// DeployWithProgress runs a deployment and displays progress to the user. This is intended to be used
 	// from the CLI and thus logs to the console.
 	DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error)
+
+	// WhatIf previews the changes that a deployment would make and displays them to the user. Nothing is deployed.
+	WhatIf(ctx context.Context, options Options) (v1.WhatIfResult, error)
 }
 
-// Options contains options to be used with DeployWithProgress.
+// Options contains options to be used with DeployWithProgress and WhatIf.
 type Options struct {
 	// ConnectionFactory is used to create the deployment client.
 	ConnectionFactory connections.Factory
@@ -67,3 +71,8 @@ type Impl struct {
 func (*Impl) DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
 	return DeployWithProgress(ctx, options)
 }
+
+// WhatIf previews the changes that a deployment would make and displays them to the user. Nothing is deployed.
+func (*Impl) WhatIf(ctx context.Context, options Options) (v1.WhatIfResult, error) {
+	return WhatIf(ctx, options)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var whatIfSymbols = map[v1.WhatIfChangeType]string{
+	v1.WhatIfChangeTypeCreate:      "+",
+	v1.WhatIfChangeTypeModify:      "~",
+	v1.WhatIfChangeTypeDelete:      "-",
+	v1.WhatIfChangeTypeNoChange:    "=",
+	v1.WhatIfChangeTypeUnsupported: "!",
+}
+
+// WhatIf previews the changes that a deployment would make and displays them to the user. Nothing is deployed.
+func WhatIf(ctx context.Context, options Options) (v1.WhatIfResult, error) {
+	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
+	if err != nil {
+		return v1.WhatIfResult{}, err
+	}
+
+	step := output.BeginStep("%s", options.ProgressText)
+	output.LogInfo("")
+
+	result, err := deploymentClient.WhatIf(ctx, clients.DeploymentOptions{
+		Template:   options.Template,
+		Parameters: options.Parameters,
+		Providers:  options.Providers,
+	})
+	if err != nil {
+		return v1.WhatIfResult{}, err
+	}
+
+	output.CompleteStep(step)
+
+	counts := map[v1.WhatIfChangeType]int{}
+	if len(result.Changes) > 0 {
+		output.LogInfo("Resource changes:")
+		output.LogInfo("")
+	}
+
+	for _, change := range result.Changes {
+		counts[change.ChangeType]++
+		output.LogInfo("  %s %s", whatIfSymbols[change.ChangeType], formatWhatIfResource(change.ResourceID))
+
+		for _, delta := range change.Delta {
+			output.LogInfo("      %s %s", whatIfSymbols[delta.ChangeType], formatWhatIfPropertyChange(delta))
+		}
+
+		if len(change.OutputResources) > 0 {
+			output.LogInfo("      Output resources:")
+			for _, outputResource := range change.OutputResources {
+				output.LogInfo("        %s %s", whatIfSymbols[outputResource.ChangeType], outputResource.ID)
+			}
+		}
+
+		if change.Error != nil {
+			output.LogInfo("      %s", change.Error.Message)
+		}
+	}
+
+	output.LogInfo("")
+	output.LogInfo("%s: %d to create, %d to modify, %d to delete, %d unchanged, %d unsupported.",
+		options.CompletionText,
+		counts[v1.WhatIfChangeTypeCreate],
+		counts[v1.WhatIfChangeTypeModify],
+		counts[v1.WhatIfChangeTypeDelete],
+		counts[v1.WhatIfChangeTypeNoChange],
+		counts[v1.WhatIfChangeTypeUnsupported])
+
+	return result, nil
+}
+
+func formatWhatIfResource(resourceID string) string {
+	id, err := ucpresources.Parse(resourceID)
+	if err != nil || resourceID == "" {
+		return resourceID
+	}
+
+	return output.FormatResourceForDisplay(id)
+}
+
+func formatWhatIfPropertyChange(change v1.WhatIfPropertyChange) string {
+	switch change.ChangeType {
+	case v1.WhatIfChangeTypeCreate:
+		return fmt.Sprintf("%s: %s", change.Path, formatWhatIfValue(change.After))
+	case v1.WhatIfChangeTypeDelete:
+		return fmt.Sprintf("%s: %s", change.Path, formatWhatIfValue(change.Before))
+	default:
+		return fmt.Sprintf("%s: %s => %s", change.Path, formatWhatIfValue(change.Before), formatWhatIfValue(change.After))
+	}
+}
+
+func formatWhatIfValue(value any) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(b)
+}
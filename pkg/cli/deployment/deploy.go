@@ -93,8 +93,29 @@ func (dc *ResourceDeploymentClient) Deploy(ctx context.Context, options clients.
 	return summary, nil
 }
 
+// WhatIf sends the deployment to the what-if action of UCP and returns the changes that the deployment would make
+// to each of its resources. Nothing is deployed.
+func (dc *ResourceDeploymentClient) WhatIf(ctx context.Context, options clients.DeploymentOptions) (v1.WhatIfResult, error) {
+	name := fmt.Sprintf("rad-whatif-%v", uuid.New().String())
+	response, err := dc.Client.WhatIf(ctx, dc.createDeployment(options), dc.deploymentID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return v1.WhatIfResult{}, err
+	}
+
+	return response.WhatIfResult, nil
+}
+
 func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name string, options clients.DeploymentOptions) (sdkclients.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
-	var resourceId string
+	poller, err := dc.Client.CreateOrUpdate(ctx, dc.createDeployment(options), dc.deploymentID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return poller, nil
+}
+
+// deploymentID returns the resource ID of the deployment with the given name.
+func (dc *ResourceDeploymentClient) deploymentID(name string) string {
 	scopes := []ucpresources.ScopeSegment{
 		{
 			Type: "radius",
@@ -112,25 +133,19 @@ func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name st
 		},
 	}
 
-	resourceId = ucpresources.MakeUCPID(scopes, types, nil)
-	providerConfig := dc.GetProviderConfigs(options)
-
-	poller, err := dc.Client.CreateOrUpdate(ctx,
-		sdkclients.Deployment{
-			Properties: &sdkclients.DeploymentProperties{
-				Template:       options.Template,
-				Parameters:     options.Parameters,
-				ProviderConfig: providerConfig,
-				Mode:           armresources.DeploymentModeIncremental,
-			},
+	return ucpresources.MakeUCPID(scopes, types, nil)
+}
+
+// createDeployment returns the body of the deployment for the given options.
+func (dc *ResourceDeploymentClient) createDeployment(options clients.DeploymentOptions) sdkclients.Deployment {
+	return sdkclients.Deployment{
+		Properties: &sdkclients.DeploymentProperties{
+			Template:       options.Template,
+			Parameters:     options.Parameters,
+			ProviderConfig: dc.GetProviderConfigs(options),
+			Mode:           armresources.DeploymentModeIncremental,
 		},
-		resourceId,
-		sdkclients.DeploymentsClientAPIVersion)
-	if err != nil {
-		return nil, err
 	}
-
-	return poller, nil
 }
 
 // GetProviderConfigs() creates a default provider config and then updates it with any provider scopes passed in the DeploymentOptions.
@@ -17,8 +17,10 @@ limitations under the License.
 package deployment
 
 import (
+	"context"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/stretchr/testify/require"
@@ -89,3 +91,28 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_WhatIf(t *testing.T) {
+	expected := v1.WhatIfResult{
+		Changes: []v1.WhatIfResourceChange{
+			{
+				ResourceID: "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app",
+				ChangeType: v1.WhatIfChangeTypeCreate,
+			},
+		},
+	}
+
+	mock := sdkclients.NewMockResourceDeploymentsClient()
+	mock.SetWhatIfResponse(sdkclients.ClientWhatIfResponse{WhatIfResult: expected})
+
+	resourceDeploymentClient := ResourceDeploymentClient{
+		RadiusResourceGroup: "testrg",
+		Client:              mock,
+	}
+
+	result, err := resourceDeploymentClient.WhatIf(context.Background(), clients.DeploymentOptions{
+		Template: map[string]any{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
@@ -46,6 +46,26 @@ func GetResourceTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetRecipeResourceChangeTableFormat returns the fields to output from the changes of a recipe plan.
+func GetRecipeResourceChangeTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ADDRESS",
+				JSONPath: "{ .Address }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "CHANGED PROPERTIES",
+				JSONPath: "{ .ChangedProperties[*] }",
+			},
+		},
+	}
+}
+
 func GetRecipePackTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
@@ -165,3 +185,35 @@ func GetProvidersForEnvironmentTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetAuditRecordTableFormat returns the fields to output from an audit record.
+func GetAuditRecordTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIMESTAMP",
+				JSONPath: "{ .Timestamp }",
+			},
+			{
+				Heading:  "PRINCIPAL",
+				JSONPath: "{ .Principal }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Operation }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "OUTCOME",
+				JSONPath: "{ .Outcome }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .StatusCode }",
+			},
+		},
+	}
+}
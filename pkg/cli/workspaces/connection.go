@@ -28,7 +28,12 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 )
 
-const KindKubernetes string = "kubernetes"
+const (
+	KindKubernetes string = "kubernetes"
+
+	// KindDirect is the kind of connections made directly to an exposed UCP endpoint, without the Kubernetes API server.
+	KindDirect string = "direct"
+)
 
 // MakeFallbackWorkspace creates an un-named workspace that will use the current KubeContext.
 // This is is used in fallback cases where the user has no config.
@@ -88,6 +93,19 @@ func (ws Workspace) ConnectionConfig() (ConnectionConfig, error) {
 			return nil, err
 		}
 
+		return config, nil
+	case KindDirect:
+		config := &DirectConnectionConfig{}
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
+		if err != nil {
+			return nil, err
+		}
+
+		err = decoder.Decode(ws.Connection)
+		if err != nil {
+			return nil, err
+		}
+
 		return config, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection kind '%s'", kind)
@@ -128,6 +146,13 @@ func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 		}
 
 		return ws.Connection["kind"] == KindKubernetes && ws.IsSameKubernetesContext(kc.Context)
+	case KindDirect:
+		dc, ok := other.(*DirectConnectionConfig)
+		if !ok {
+			return false
+		}
+
+		return ws.Connection["kind"] == KindDirect && ws.Connection["url"] == dc.URL
 	default:
 		return false
 	}
@@ -212,3 +237,44 @@ func (c *KubernetesConnectionConfig) Connect() (sdk.Connection, error) {
 
 	return sdk.NewKubernetesConnectionFromConfig(config)
 }
+
+var _ ConnectionConfig = (*DirectConnectionConfig)(nil)
+
+type DirectConnectionConfig struct {
+	// Kind specifies the kind of connection. For DirectConnectionConfig this is always 'direct'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// URL is the URL of the UCP endpoint, for example 'https://radius.contoso.com'.
+	URL string `json:"url" mapstructure:"url" yaml:"url"`
+
+	// BearerTokenFile is the path of a file containing the bearer token used to authenticate with UCP. The file
+	// is read on every request so the token can be refreshed by another process. This field is optional.
+	BearerTokenFile string `json:"bearerTokenFile,omitempty" mapstructure:"bearerTokenFile" yaml:"bearerTokenFile,omitempty"`
+}
+
+// String returns a string that describes the direct connection configuration.
+func (c *DirectConnectionConfig) String() string {
+	return fmt.Sprintf("Direct (url=%s)", c.URL)
+}
+
+// GetKind returns the string "KindDirect" for a DirectConnectionConfig object.
+func (c *DirectConnectionConfig) GetKind() string {
+	return KindDirect
+}
+
+// Connect creates a direct connection to the UCP endpoint. Requests are authenticated with the bearer token read
+// from BearerTokenFile when it is set.
+func (c *DirectConnectionConfig) Connect() (sdk.Connection, error) {
+	strURL := strings.TrimSuffix(c.URL, "/")
+	strURL = strURL + "/apis/api.ucp.dev/v1alpha3"
+	_, err := url.ParseRequestURI(strURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.BearerTokenFile == "" {
+		return sdk.NewDirectConnection(strURL)
+	}
+
+	return sdk.NewDirectConnectionWithBearerToken(strURL, sdk.FileTokenSource(c.BearerTokenFile))
+}
@@ -43,3 +43,40 @@ func Test_IsSameKubernetesContext(t *testing.T) {
 	require.Equal(t, isSame, false)
 
 }
+
+func Test_DirectConnectionConfig(t *testing.T) {
+	ws := Workspace{
+		Name: "my_workspace",
+		Connection: map[string]any{
+			"kind":            "direct",
+			"url":             "https://radius.contoso.com/",
+			"bearerTokenFile": "/tmp/token",
+		},
+	}
+
+	config, err := ws.ConnectionConfig()
+	require.NoError(t, err)
+	require.Equal(t, &DirectConnectionConfig{
+		Kind:            KindDirect,
+		URL:             "https://radius.contoso.com/",
+		BearerTokenFile: "/tmp/token",
+	}, config)
+	require.Equal(t, "Direct (url=https://radius.contoso.com/)", config.String())
+
+	connection, err := config.Connect()
+	require.NoError(t, err)
+	require.Equal(t, "https://radius.contoso.com/apis/api.ucp.dev/v1alpha3", connection.Endpoint())
+
+	_, ok := ws.KubernetesContext()
+	require.False(t, ok)
+
+	require.True(t, ws.ConnectionConfigEquals(&DirectConnectionConfig{Kind: KindDirect, URL: "https://radius.contoso.com/"}))
+	require.False(t, ws.ConnectionConfigEquals(&KubernetesConnectionConfig{Kind: KindKubernetes}))
+}
+
+func Test_DirectConnectionConfig_PlainHTTPWithToken(t *testing.T) {
+	config := &DirectConnectionConfig{Kind: KindDirect, URL: "http://radius.contoso.com", BearerTokenFile: "/tmp/token"}
+
+	_, err := config.Connect()
+	require.Error(t, err)
+}
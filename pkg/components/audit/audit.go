@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records who changed what in Radius. Each mutating request is captured as a Record and written to
+// a pluggable Sink: stdout, a file or the Radius database.
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Outcome is the outcome of an audited request.
+type Outcome string
+
+const (
+	// OutcomeSucceeded is the outcome of requests that were accepted.
+	OutcomeSucceeded Outcome = "Succeeded"
+	// OutcomeDenied is the outcome of requests that were rejected because the caller was not authenticated or authorized.
+	OutcomeDenied Outcome = "Denied"
+	// OutcomeFailed is the outcome of requests that failed for any other reason.
+	OutcomeFailed Outcome = "Failed"
+)
+
+// ErrQueryNotSupported is returned by sinks that cannot be queried.
+var ErrQueryNotSupported = errors.New("the audit sink does not support queries")
+
+// Record is an entry of the audit log.
+type Record struct {
+	// ID is the unique id of the record. IDs are ordered by time.
+	ID string `json:"id"`
+	// Timestamp is the time when the request was received.
+	Timestamp time.Time `json:"timestamp"`
+	// Service is the name of the service that handled the request, for example 'ucp'.
+	Service string `json:"service"`
+	// Principal is the name of the caller. It is empty when the request is not authenticated.
+	Principal string `json:"principal,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Operation is the action performed by the request, for example 'Applications.Core/applications/write'.
+	Operation string `json:"operation"`
+	// ResourceID is the id of the resource or scope targeted by the request.
+	ResourceID string `json:"resourceId"`
+	// CorrelationID is the correlation id of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+	// ClientRequestID is the client request id of the request.
+	ClientRequestID string `json:"clientRequestId,omitempty"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Outcome is the outcome of the request.
+	Outcome Outcome `json:"outcome"`
+	// Changes is the redacted difference between the resource before and after the request. Optional.
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Change is a change to a single field of a resource.
+type Change struct {
+	// Path is the path of the field, for example 'properties.container.image'.
+	Path string `json:"path"`
+	// Old is the redacted value before the change. It is nil when the field was added.
+	Old any `json:"old,omitempty"`
+	// New is the redacted value after the change. It is nil when the field was removed.
+	New any `json:"new,omitempty"`
+}
+
+// Query describes the audit records to return from a query. All fields are optional.
+type Query struct {
+	// Principal returns only the records of this principal. Names are compared case-sensitively.
+	Principal string
+	// ResourceID returns only the records of this resource or scope and the resources it contains.
+	ResourceID string
+	// Since returns only the records created at or after this time.
+	Since time.Time
+	// Top is the maximum number of records to return. Zero means no limit.
+	Top int
+}
+
+// Sink stores audit records.
+type Sink interface {
+	// Write stores the record.
+	Write(ctx context.Context, record *Record) error
+
+	// Query returns the records matching the query, most recent first. Sinks that cannot be queried return
+	// ErrQueryNotSupported.
+	Query(ctx context.Context, query Query) ([]*Record, error)
+}
+
+// OutcomeFromStatusCode returns the outcome of a request with the given HTTP status code.
+func OutcomeFromStatusCode(statusCode int) Outcome {
+	switch {
+	case statusCode < 400:
+		return OutcomeSucceeded
+	case statusCode == 401 || statusCode == 403:
+		return OutcomeDenied
+	default:
+		return OutcomeFailed
+	}
+}
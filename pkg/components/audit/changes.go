@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// RedactedValue replaces the values of sensitive fields.
+	RedactedValue = "<redacted>"
+)
+
+var (
+	// sensitiveKeyFragments are the fragments of field names whose values are always redacted.
+	sensitiveKeyFragments = []string{"password", "secret", "token", "connectionstring", "credential", "privatekey", "apikey", "accesskey"}
+
+	// sensitiveKeys are field names whose values are redacted because they commonly hold secret data, for
+	// example the values of environment variables and the data of secret stores.
+	sensitiveKeys = map[string]bool{"value": true, "data": true}
+
+	// ignoredKeys are top-level fields that are not included in the changes.
+	ignoredKeys = map[string]bool{"systemData": true}
+)
+
+// Redact returns a copy of value where the values of sensitive fields are replaced with RedactedValue. The value must
+// be the result of decoding JSON, for example a map[string]any.
+func Redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			if isSensitiveKey(key) && item != nil {
+				result[key] = RedactedValue
+				continue
+			}
+			result[key] = Redact(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = Redact(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	if sensitiveKeys[lower] {
+		return true
+	}
+
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Changes returns the redacted difference between the JSON documents before and after. Either document may be empty,
+// for example when a resource is created or deleted. Documents that are not JSON objects are ignored.
+func Changes(before []byte, after []byte) []Change {
+	old := decodeObject(before)
+	new := decodeObject(after)
+	if old == nil && new == nil {
+		return nil
+	}
+
+	for key := range ignoredKeys {
+		delete(old, key)
+		delete(new, key)
+	}
+
+	changes := []Change{}
+	diff("", old, new, false, &changes)
+	return changes
+}
+
+func decodeObject(b []byte) map[string]any {
+	if len(b) == 0 {
+		return nil
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// diff compares the unredacted values so that changes to sensitive fields are detected, but only records
+// redacted values.
+func diff(path string, old any, new any, sensitive bool, changes *[]Change) {
+	if sensitive {
+		if !reflect.DeepEqual(old, new) {
+			*changes = append(*changes, Change{Path: path, Old: redactValue(old), New: redactValue(new)})
+		}
+		return
+	}
+
+	oldMap, oldIsMap := old.(map[string]any)
+	newMap, newIsMap := new.(map[string]any)
+	if oldIsMap && newIsMap {
+		keys := map[string]bool{}
+		for key := range oldMap {
+			keys[key] = true
+		}
+		for key := range newMap {
+			keys[key] = true
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			diff(child, oldMap[key], newMap[key], isSensitiveKey(key), changes)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+
+	*changes = append(*changes, Change{Path: path, Old: Redact(old), New: Redact(new)})
+}
+
+func redactValue(value any) any {
+	if value == nil {
+		return nil
+	}
+	return RedactedValue
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Redact(t *testing.T) {
+	value := map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"password": "p@ssw0rd",
+			"container": map[string]any{
+				"image": "nginx",
+				"env": map[string]any{
+					"DB_HOST": map[string]any{"value": "db.contoso.com"},
+				},
+			},
+			"secrets": map[string]any{"connectionString": "Server=..."},
+			"ports":   []any{map[string]any{"containerPort": 80.0, "apiKey": "1234"}},
+		},
+	}
+
+	expected := map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"password": RedactedValue,
+			"container": map[string]any{
+				"image": "nginx",
+				"env": map[string]any{
+					"DB_HOST": map[string]any{"value": RedactedValue},
+				},
+			},
+			"secrets": RedactedValue,
+			"ports":   []any{map[string]any{"containerPort": 80.0, "apiKey": RedactedValue}},
+		},
+	}
+
+	require.Equal(t, expected, Redact(value))
+}
+
+func Test_Changes(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		before := []byte(`{"name":"app","systemData":{"createdBy":"a"},"properties":{"image":"nginx:1","password":"old","replicas":1}}`)
+		after := []byte(`{"name":"app","systemData":{"createdBy":"b"},"properties":{"image":"nginx:2","password":"new","labels":{"team":"a"}}}`)
+
+		expected := []Change{
+			{Path: "properties.image", Old: "nginx:1", New: "nginx:2"},
+			{Path: "properties.labels", Old: nil, New: map[string]any{"team": "a"}},
+			{Path: "properties.password", Old: RedactedValue, New: RedactedValue},
+			{Path: "properties.replicas", Old: 1.0, New: nil},
+		}
+		require.Equal(t, expected, Changes(before, after))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		after := []byte(`{"properties":{"token":"abc"}}`)
+
+		expected := []Change{
+			{Path: "properties", Old: nil, New: map[string]any{"token": RedactedValue}},
+		}
+		require.Equal(t, expected, Changes(nil, after))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		before := []byte(`{"name":"app"}`)
+
+		expected := []Change{
+			{Path: "name", Old: "app", New: nil},
+		}
+		require.Equal(t, expected, Changes(before, nil))
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		require.Nil(t, Changes([]byte("not json"), nil))
+	})
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// DatabaseScope is the scope under which audit records are stored in the database.
+	DatabaseScope = "/planes/radius/local"
+
+	// DatabaseResourceType is the resource type of audit records stored in the database.
+	DatabaseResourceType = "System.Audit/auditRecords"
+
+	// pruneInterval is the minimum interval between two deletions of expired records.
+	pruneInterval = time.Hour
+
+	// pruneBatchSize is the number of expired records deleted per query.
+	pruneBatchSize = 100
+
+	// firstQueryWindow is the duration of the most recent window of records read by a query with a limit.
+	firstQueryWindow = time.Hour
+
+	// maxQueryWindow is the longest window read by a query with a limit. The records before the last window are
+	// read at once.
+	maxQueryWindow = 30 * 24 * time.Hour
+)
+
+var _ Sink = (*DatabaseSink)(nil)
+
+// DatabaseSink stores audit records in the Radius database.
+//
+// Record IDs are version 7 UUIDs, which start with the time the record was created. This allows the sink to filter
+// records by time in the database and to read the most recent records first.
+type DatabaseSink struct {
+	client    database.Client
+	retention time.Duration
+	now       func() time.Time
+
+	mutex     sync.Mutex
+	pruning   bool
+	lastPrune time.Time
+}
+
+// NewDatabaseSink creates a new DatabaseSink. Records older than retention are deleted in the background after
+// writes. Records are never deleted when retention is zero, which is used by readers of the audit log.
+func NewDatabaseSink(client database.Client, retention time.Duration) *DatabaseSink {
+	return &DatabaseSink{client: client, retention: retention, now: time.Now}
+}
+
+// Write saves the record in the database.
+func (s *DatabaseSink) Write(ctx context.Context, record *Record) error {
+	obj := &database.Object{
+		Metadata: database.Metadata{
+			ID: DatabaseScope + "/providers/" + DatabaseResourceType + "/" + record.ID,
+		},
+		Data: record,
+	}
+
+	if err := s.client.Save(ctx, obj); err != nil {
+		return err
+	}
+
+	s.schedulePrune(ctx)
+	return nil
+}
+
+// Query returns the records matching the query, most recent first.
+//
+// When the query has a limit, records are read in windows of increasing duration starting with the most recent
+// records, and no further windows are read once the limit is reached.
+func (s *DatabaseSink) Query(ctx context.Context, query Query) ([]*Record, error) {
+	filters := []database.QueryFilter{}
+	if query.Principal != "" {
+		filters = append(filters, database.QueryFilter{Field: "principal", Value: query.Principal})
+	}
+
+	// Record IDs are created before the timestamp, so the bound includes a margin. The exact time is checked by matches.
+	since := ""
+	if !query.Since.IsZero() {
+		since = recordIDBound(query.Since.Add(-time.Second))
+	}
+
+	if query.Top <= 0 {
+		records, err := s.query(ctx, query, filters, since, "")
+		if err != nil {
+			return nil, err
+		}
+		sortRecords(records)
+		return records, nil
+	}
+
+	records := []*Record{}
+	upper := ""
+	end := s.now()
+	for window := firstQueryWindow; ; window *= 2 {
+		start := end.Add(-window)
+		lower := recordIDBound(start)
+		last := false
+		if since != "" && lower <= since {
+			lower, last = since, true
+		} else if since == "" && window >= maxQueryWindow {
+			lower, last = "", true
+		}
+
+		result, err := s.query(ctx, query, filters, lower, upper)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, result...)
+
+		if last || len(records) >= query.Top {
+			break
+		}
+		upper, end = lower, start
+	}
+
+	sortRecords(records)
+	if len(records) > query.Top {
+		records = records[:query.Top]
+	}
+
+	return records, nil
+}
+
+// query returns the records matching the query whose IDs are between lower (inclusive) and upper (exclusive).
+// Empty bounds are not applied.
+func (s *DatabaseSink) query(ctx context.Context, query Query, filters []database.QueryFilter, lower string, upper string) ([]*Record, error) {
+	if lower != "" {
+		filters = append(filters, database.QueryFilter{Field: "id", Operator: database.FilterOperatorGreaterOrEqual, Value: lower})
+	}
+	if upper != "" {
+		filters = append(filters, database.QueryFilter{Field: "id", Operator: database.FilterOperatorLess, Value: upper})
+	}
+
+	q := database.Query{
+		RootScope:    DatabaseScope,
+		ResourceType: DatabaseResourceType,
+		Filters:      filters,
+	}
+
+	records := []*Record{}
+	token := ""
+	for {
+		result, err := s.client.Query(ctx, q, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			record := &Record{}
+			if err := item.As(record); err != nil {
+				return nil, err
+			}
+
+			if matches(record, query) {
+				records = append(records, record)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return records, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// Prune deletes the records older than the retention of the sink.
+func (s *DatabaseSink) Prune(ctx context.Context) error {
+	if s.retention <= 0 {
+		return nil
+	}
+
+	q := database.Query{
+		RootScope:    DatabaseScope,
+		ResourceType: DatabaseResourceType,
+		Filters: []database.QueryFilter{
+			{Field: "id", Operator: database.FilterOperatorLess, Value: recordIDBound(s.now().Add(-s.retention))},
+		},
+	}
+
+	for {
+		// Deleted records no longer match, so the first page is read until no expired records are left.
+		result, err := s.client.Query(ctx, q, database.WithMaxQueryItemCount(pruneBatchSize))
+		if err != nil {
+			return err
+		}
+
+		if len(result.Items) == 0 {
+			return nil
+		}
+
+		for _, item := range result.Items {
+			err := s.client.Delete(ctx, item.ID)
+			if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+				return err
+			}
+		}
+	}
+}
+
+// schedulePrune deletes the expired records in the background, at most once per pruneInterval.
+func (s *DatabaseSink) schedulePrune(ctx context.Context) {
+	if s.retention <= 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if s.pruning || now.Sub(s.lastPrune) < pruneInterval {
+		return
+	}
+	s.pruning = true
+	s.lastPrune = now
+
+	go func() {
+		defer func() {
+			s.mutex.Lock()
+			s.pruning = false
+			s.mutex.Unlock()
+		}()
+
+		ctx := context.WithoutCancel(ctx)
+		if err := s.Prune(ctx); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "failed to delete expired audit records")
+		}
+	}()
+}
+
+// recordIDBound returns the smallest version 7 UUID created at t. Version 7 UUIDs start with the Unix time in
+// milliseconds, so comparing a record ID with the bound compares the time the record was created.
+func recordIDBound(t time.Time) string {
+	ms := t.UnixMilli()
+	if ms < 0 {
+		ms = 0
+	}
+	return fmt.Sprintf("%08x-%04x-7000-8000-000000000000", uint64(ms)>>16, uint64(ms)&0xffff)
+}
+
+// sortRecords sorts the records most recent first.
+func sortRecords(records []*Record) {
+	// Record IDs are ordered by time, so sorting by ID is stable for records created at the same time.
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID > records[j].ID
+	})
+}
+
+// matches checks the filters of the query which cannot be evaluated by the database.
+func matches(record *Record, query Query) bool {
+	if query.ResourceID != "" {
+		scope := strings.ToLower(strings.TrimSuffix(query.ResourceID, "/"))
+		id := strings.ToLower(record.ResourceID)
+		if id != scope && !strings.HasPrefix(id, scope+"/") {
+			return false
+		}
+	}
+
+	if !query.Since.IsZero() && record.Timestamp.Before(query.Since) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient counts the queries sent to the database.
+type countingClient struct {
+	database.Client
+	queries int
+}
+
+func (c *countingClient) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	c.queries++
+	return c.Client.Query(ctx, query, options...)
+}
+
+func newTestRecord(timestamp time.Time, principal string, resourceID string) *Record {
+	return &Record{
+		ID:         recordIDBound(timestamp),
+		Timestamp:  timestamp,
+		Principal:  principal,
+		Method:     "PUT",
+		ResourceID: resourceID,
+		StatusCode: 200,
+		Outcome:    OutcomeSucceeded,
+	}
+}
+
+func Test_DatabaseSink(t *testing.T) {
+	ctx := context.Background()
+	client := &countingClient{Client: inmemory.NewClient()}
+	sink := NewDatabaseSink(client, 0)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sink.now = func() time.Time { return now }
+
+	records := []*Record{
+		newTestRecord(now.Add(-72*time.Hour), "alice", "/planes/radius/local/resourceGroups/a/providers/Applications.Core/applications/app"),
+		newTestRecord(now.Add(-2*time.Hour), "bob", "/planes/radius/local/resourceGroups/b/providers/Applications.Core/applications/app"),
+		newTestRecord(now.Add(-time.Minute), "alice", "/planes/radius/local/resourceGroups/b"),
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(ctx, record))
+	}
+
+	ids := func(records []*Record) []string {
+		result := []string{}
+		for _, record := range records {
+			result = append(result, record.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected []*Record
+		queries  int
+	}{
+		{name: "all", query: Query{}, expected: []*Record{records[2], records[1], records[0]}, queries: 1},
+		{name: "principal", query: Query{Principal: "alice"}, expected: []*Record{records[2], records[0]}, queries: 1},
+		{name: "principal is case-sensitive", query: Query{Principal: "Alice"}, expected: []*Record{}, queries: 1},
+		{name: "resource group", query: Query{ResourceID: "/planes/radius/local/resourcegroups/b"}, expected: []*Record{records[2], records[1]}, queries: 1},
+		{name: "since", query: Query{Since: now.Add(-2 * time.Hour)}, expected: []*Record{records[2], records[1]}, queries: 1},
+		{name: "top in first window", query: Query{Top: 1}, expected: []*Record{records[2]}, queries: 1},
+		{name: "top in second window", query: Query{Top: 2}, expected: []*Record{records[2], records[1]}, queries: 2},
+		{name: "top with since", query: Query{Top: 5, Since: now.Add(-150 * time.Minute)}, expected: []*Record{records[2], records[1]}, queries: 2},
+		{name: "top beyond last window", query: Query{Top: 5}, expected: []*Record{records[2], records[1], records[0]}, queries: 11},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client.queries = 0
+			result, err := sink.Query(ctx, tc.query)
+			require.NoError(t, err)
+			require.Equal(t, ids(tc.expected), ids(result))
+			require.Equal(t, tc.queries, client.queries)
+		})
+	}
+}
+
+func Test_DatabaseSink_Prune(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	sink := NewDatabaseSink(client, 90*24*time.Hour)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	sink.now = func() time.Time { return now }
+
+	// Writing schedules a prune in the background, which is not needed by this test.
+	sink.lastPrune = now
+
+	expired := newTestRecord(now.Add(-91*24*time.Hour), "alice", "/planes/radius/local/resourceGroups/a")
+	kept := newTestRecord(now.Add(-89*24*time.Hour), "alice", "/planes/radius/local/resourceGroups/a")
+	require.NoError(t, sink.Write(ctx, expired))
+	require.NoError(t, sink.Write(ctx, kept))
+
+	require.NoError(t, sink.Prune(ctx))
+
+	result, err := sink.Query(ctx, Query{})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, kept.ID, result[0].ID)
+}
+
+func Test_recordIDBound(t *testing.T) {
+	earlier := recordIDBound(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := recordIDBound(time.Date(2024, 1, 1, 0, 0, 0, int(time.Millisecond), time.UTC))
+
+	require.Equal(t, "018cc251-f400-7000-8000-000000000000", earlier)
+	require.Less(t, earlier, later)
+}
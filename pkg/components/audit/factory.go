@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+// NewSink creates the sink configured by options. It returns nil when audit logging is disabled. The database
+// client is only used by the database sink.
+func NewSink(ctx context.Context, options Options, databaseClient database.Client) (Sink, error) {
+	switch options.Provider {
+	case TypeNone:
+		return nil, nil
+	case TypeStdout:
+		return NewStdoutSink(), nil
+	case TypeFile:
+		return NewFileSink(options.File.Path)
+	case TypeDatabase:
+		if databaseClient == nil {
+			return nil, errors.New("the database audit sink requires a database client")
+		}
+		return NewDatabaseSink(databaseClient, options.Retention()), nil
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %q", options.Provider)
+	}
+}
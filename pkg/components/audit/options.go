@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import "time"
+
+const (
+	// DefaultRetentionDays is the number of days records are kept by the database sink when it is not configured.
+	DefaultRetentionDays = 90
+)
+
+// SinkType represents the types of audit sink.
+type SinkType string
+
+const (
+	// TypeNone disables audit logging.
+	TypeNone SinkType = ""
+	// TypeStdout writes audit records to stdout as JSON lines.
+	TypeStdout SinkType = "stdout"
+	// TypeFile appends audit records to a file as JSON lines.
+	TypeFile SinkType = "file"
+	// TypeDatabase stores audit records in the Radius database. This is the only sink that can be queried.
+	TypeDatabase SinkType = "database"
+)
+
+// Options represents the audit options.
+type Options struct {
+	// Provider configures the audit sink. Audit logging is disabled when empty.
+	Provider SinkType `yaml:"provider"`
+
+	// File configures the file sink. (Optional)
+	File FileOptions `yaml:"file,omitempty"`
+
+	// IncludeChanges records the redacted difference between the resource before and after each request. This
+	// requires an additional read of the resource for every mutating request.
+	IncludeChanges bool `yaml:"includeChanges,omitempty"`
+
+	// RetentionDays is the number of days records are kept by the database sink. Defaults to 90 days.
+	RetentionDays int `yaml:"retentionDays,omitempty"`
+}
+
+// Retention returns the duration records are kept by the database sink.
+func (o Options) Retention() time.Duration {
+	if o.RetentionDays <= 0 {
+		return DefaultRetentionDays * 24 * time.Hour
+	}
+	return time.Duration(o.RetentionDays) * 24 * time.Hour
+}
+
+// FileOptions represents the options of the file sink.
+type FileOptions struct {
+	// Path is the path of the file. The file is created if it does not exist.
+	Path string `yaml:"path"`
+}
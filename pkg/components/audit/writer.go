@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var _ Sink = (*WriterSink)(nil)
+
+// WriterSink writes audit records to an io.Writer as JSON lines.
+type WriterSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterSink creates a new WriterSink.
+func NewWriterSink(writer io.Writer) *WriterSink {
+	return &WriterSink{writer: writer}
+}
+
+// NewStdoutSink creates a sink that writes audit records to stdout.
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink(os.Stdout)
+}
+
+// NewFileSink creates a sink that appends audit records to the file at path.
+func NewFileSink(path string) (*WriterSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("the path of the audit file is required")
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+
+	return NewWriterSink(file), nil
+}
+
+// Write writes the record as a single line of JSON.
+func (s *WriterSink) Write(ctx context.Context, record *Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, err = s.writer.Write(append(b, '\n'))
+	return err
+}
+
+// Query is not supported by WriterSink.
+func (s *WriterSink) Query(ctx context.Context, query Query) ([]*Record, error) {
+	return nil, ErrQueryNotSupported
+}
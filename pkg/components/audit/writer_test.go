@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriterSink(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	sink := NewWriterSink(buffer)
+
+	err := sink.Write(context.Background(), &Record{ID: "1", Method: "PUT", Outcome: OutcomeSucceeded, StatusCode: 200})
+	require.NoError(t, err)
+
+	require.Equal(t, `{"id":"1","timestamp":"0001-01-01T00:00:00Z","service":"","method":"PUT","operation":"","resourceId":"","statusCode":200,"outcome":"Succeeded"}`+"\n", buffer.String())
+
+	_, err = sink.Query(context.Background(), Query{})
+	require.ErrorIs(t, err, ErrQueryNotSupported)
+}
+
+func Test_NewSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewSink(context.Background(), Options{Provider: TypeFile, File: FileOptions{Path: path}}, nil)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), &Record{ID: "1"}))
+	require.NoError(t, sink.Write(context.Background(), &Record{ID: "2"}))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, bytes.Count(b, []byte("\n")))
+
+	sink, err = NewSink(context.Background(), Options{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, sink)
+
+	_, err = NewSink(context.Background(), Options{Provider: TypeDatabase}, nil)
+	require.Error(t, err)
+
+	_, err = NewSink(context.Background(), Options{Provider: "invalid"}, nil)
+	require.Error(t, err)
+}
@@ -44,6 +44,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -110,6 +111,8 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
 	}
 
+	config := database.NewQueryConfig(options...)
+
 	selector, err := createLabelSelector(query)
 	if err != nil {
 		return nil, err
@@ -121,7 +124,9 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 		return nil, err
 	}
 
-	results := database.ObjectQueryResult{}
+	// The Kubernetes API cannot page over individual entries since each object may hold several
+	// entries, so we page over the filtered results instead.
+	items := []database.Object{}
 	for _, resource := range rs.Items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
@@ -146,12 +151,12 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 					continue
 				}
 
-				results.Items = append(results.Items, *converted)
+				items = append(items, *converted)
 			}
 		}
 	}
 
-	return &results, nil
+	return databaseutil.Paginate(items, config)
 }
 
 // Get retrieves an object from the store given its ID, or returns an error if the object does not exist or if an error occurs.
@@ -353,6 +358,62 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 	return err
 }
 
+// Transact applies a set of Save and Delete operations.
+//
+// The Kubernetes API Server cannot update multiple objects atomically, so Transact is best-effort. ETags and the
+// existence of resources are checked for every operation before any changes are made, and then the operations are
+// applied in order. If an operation fails while being applied (for example due to a concurrent write), the operations
+// before it are NOT rolled back, and the ETags of the objects saved so far are updated.
+func (c *APIServerClient) Transact(ctx context.Context, operations ...database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	_, err := databaseutil.ParseOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	// Check up front to reduce the chance of failing part way through. We can only check resources that are
+	// not modified by an earlier operation in the same transaction.
+	touched := map[string]bool{}
+	for i, operation := range operations {
+		key := strings.ToLower(operation.ResourceID())
+		if touched[key] {
+			continue
+		}
+		touched[key] = true
+
+		if operation.ETag == "" && operation.Type == database.OperationTypeSave {
+			continue
+		}
+
+		existing, err := c.Get(ctx, operation.ResourceID())
+		if errors.Is(err, &database.ErrNotFound{}) && operation.ETag != "" {
+			return databaseutil.OperationError(i, operation, &database.ErrConcurrency{})
+		} else if err != nil {
+			return databaseutil.OperationError(i, operation, err)
+		} else if operation.ETag != "" && operation.ETag != existing.ETag {
+			return databaseutil.OperationError(i, operation, &database.ErrConcurrency{})
+		}
+	}
+
+	for i, operation := range operations {
+		switch operation.Type {
+		case database.OperationTypeSave:
+			err = c.Save(ctx, operation.Object, database.WithETag(operation.ETag))
+		case database.OperationTypeDelete:
+			err = c.Delete(ctx, operation.ID, database.WithETag(operation.ETag))
+		}
+
+		if err != nil {
+			return databaseutil.OperationError(i, operation, err)
+		}
+	}
+
+	return nil
+}
+
 func (c *APIServerClient) doWithRetry(action func() (bool, error)) error {
 	for i := 0; i < RetryCount; i++ {
 		retryable, err := action()
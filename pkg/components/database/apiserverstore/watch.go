@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ database.Watcher = (*APIServerClient)(nil)
+
+// Watch implements database.Watcher.
+//
+// Each Kubernetes object may hold several entries, so the watch keeps track of the entries in each
+// object and reports the difference when an object changes. If the Kubernetes watch ends, the objects
+// are listed again and any changes that were missed are reported before the watch is restarted.
+func (c *APIServerClient) Watch(ctx context.Context, query database.Query) (<-chan database.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	client, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("watch is not supported: the Kubernetes client does not support watching")
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &apiServerWatch{client: client, namespace: c.namespace, query: query, selector: selector}
+
+	// The initial list and watch happen before we return so that no changes made after Watch returns are missed.
+	_, err = w.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan database.WatchEvent)
+	go func() {
+		defer close(events)
+		defer w.stop()
+		w.run(ctx, events)
+	}()
+
+	return events, nil
+}
+
+// apiServerWatch is the state of a single call to Watch.
+type apiServerWatch struct {
+	client    runtimeclient.WithWatch
+	namespace string
+	query     database.Query
+	selector  labels.Selector
+
+	// entries is the last known set of entries, indexed by Kubernetes object name and then by the
+	// case-insensitive resource id.
+	entries map[string]map[string]ucpv1alpha1.ResourceEntry
+
+	// watcher is the current Kubernetes watch.
+	watcher watch.Interface
+}
+
+// start lists the current objects and starts a Kubernetes watch from the resulting resource version. Any
+// differences from the previously known entries are returned as events.
+func (w *apiServerWatch) start(ctx context.Context) ([]database.WatchEvent, error) {
+	rs := ucpv1alpha1.ResourceList{}
+	err := w.client.List(ctx, &rs, runtimeclient.InNamespace(w.namespace), runtimeclient.MatchingLabelsSelector{Selector: w.selector})
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := w.client.Watch(
+		ctx,
+		&ucpv1alpha1.ResourceList{},
+		runtimeclient.InNamespace(w.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: w.selector},
+		&runtimeclient.ListOptions{Raw: &v1.ListOptions{ResourceVersion: rs.ResourceVersion}})
+	if err != nil {
+		return nil, err
+	}
+
+	w.watcher = watcher
+
+	current := map[string]map[string]ucpv1alpha1.ResourceEntry{}
+	for _, resource := range rs.Items {
+		current[resource.Name] = indexEntries(resource.Entries)
+	}
+
+	events := []database.WatchEvent{}
+	if w.entries != nil {
+		for name := range w.entries {
+			if _, ok := current[name]; !ok {
+				events = append(events, w.diff(ctx, name, nil)...)
+			}
+		}
+		for _, resource := range rs.Items {
+			events = append(events, w.diff(ctx, resource.Name, resource.Entries)...)
+		}
+	}
+
+	w.entries = current
+	return events, nil
+}
+
+func (w *apiServerWatch) stop() {
+	if w.watcher != nil {
+		w.watcher.Stop()
+	}
+}
+
+func (w *apiServerWatch) run(ctx context.Context, events chan<- database.WatchEvent) {
+	for {
+		pending := []database.WatchEvent{}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-w.watcher.ResultChan():
+			if !ok || event.Type == watch.Error {
+				// The Kubernetes watch has ended, this happens routinely when the API Server times out the watch.
+				// List again to catch up on changes that we missed.
+				w.stop()
+
+				missed, err := w.restart(ctx)
+				if err != nil {
+					return
+				}
+
+				pending = missed
+				break
+			}
+
+			resource, ok := event.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				continue
+			}
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				pending = w.diff(ctx, resource.Name, resource.Entries)
+				w.entries[resource.Name] = indexEntries(resource.Entries)
+
+			case watch.Deleted:
+				// This is also reported when an object no longer matches the label selector.
+				pending = w.diff(ctx, resource.Name, nil)
+				delete(w.entries, resource.Name)
+			}
+		}
+
+		for _, event := range pending {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// restart lists and watches again, retrying until the context is cancelled.
+func (w *apiServerWatch) restart(ctx context.Context) ([]database.WatchEvent, error) {
+	var err error
+	for range RetryCount {
+		var events []database.WatchEvent
+		events, err = w.start(ctx)
+		if err == nil {
+			return events, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to restart watch", "namespace", w.namespace)
+	}
+
+	return nil, err
+}
+
+// diff compares the entries of a Kubernetes object with the last known entries and returns the
+// matching events.
+func (w *apiServerWatch) diff(ctx context.Context, name string, entries []ucpv1alpha1.ResourceEntry) []database.WatchEvent {
+	previous := w.entries[name]
+	current := indexEntries(entries)
+
+	candidates := []database.WatchEvent{}
+	for key, entry := range current {
+		old, ok := previous[key]
+		if ok && old.ETag == entry.ETag {
+			continue
+		}
+
+		obj, err := readEntry(&entry)
+		if err != nil {
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Error(err, "found an invalid resource entry as part of a watch", "name", name, "namespace", w.namespace)
+			continue
+		}
+
+		eventType := database.WatchEventUpdated
+		if !ok {
+			eventType = database.WatchEventCreated
+		}
+
+		candidates = append(candidates, database.WatchEvent{Type: eventType, Object: *obj})
+	}
+
+	for key, entry := range previous {
+		if _, ok := current[key]; ok {
+			continue
+		}
+
+		obj := database.Object{Metadata: database.Metadata{ID: entry.ID, ETag: entry.ETag}}
+		candidates = append(candidates, database.WatchEvent{Type: database.WatchEventDeleted, Object: obj})
+	}
+
+	events := []database.WatchEvent{}
+	for _, event := range candidates {
+		match, err := databaseutil.EventMatchesQuery(event, w.query)
+		if err != nil || !match {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func indexEntries(entries []ucpv1alpha1.ResourceEntry) map[string]ucpv1alpha1.ResourceEntry {
+	index := map[string]ucpv1alpha1.ResourceEntry{}
+	for _, entry := range entries {
+		index[strings.ToLower(entry.ID)] = entry
+	}
+
+	return index
+}
@@ -54,6 +54,10 @@ type Client interface {
 	// Query executes a query against the data store and returns the results.
 	//
 	// Queries must provide a root scope and a resource type. Other fields are optional.
+	//
+	// Use WithMaxQueryItemCount to limit the number of items returned. When there are more items, the result
+	// will contain a PaginationToken that can be passed to WithPaginationToken to retrieve the next page.
+	// Pagination tokens are opaque and specific to the data store implementation.
 	Query(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error)
 
 	// Get retrieves a single resource from the data store by its resource id.
@@ -80,6 +84,19 @@ type Client interface {
 	// When providing an ETag, Save will return ErrConcurrency if the resource has been
 	// modified OR deleted since the ETag was retrieved.
 	Save(ctx context.Context, obj *Object, options ...SaveOptions) error
+
+	// Transact applies a set of Save and Delete operations atomically. Either all of the operations
+	// are applied or none of them are.
+	//
+	// Operations are applied in order and each operation observes the effects of the operations before it.
+	// Each operation is checked the same way as the corresponding call to Save or Delete, including
+	// ETag checks. If any operation fails, Transact returns an error wrapping the error from that operation
+	// (eg: ErrNotFound or ErrConcurrency), and the data store is unchanged.
+	//
+	// The ETag field of each saved object is updated only if the transaction succeeds.
+	//
+	// Implementations that cannot provide atomicity document their behavior. See apiserverstore.
+	Transact(ctx context.Context, operations ...Operation) error
 }
 
 // Query specifies the structure of a query. RootScope and ResourceType are required and other fields are optional.
@@ -146,13 +163,46 @@ func (q Query) Validate() error {
 	}
 
 	for _, filter := range q.Filters {
-		err = errors.Join(filter.Validate())
+		err = errors.Join(err, filter.Validate())
 	}
 
 	return err
 }
 
+// FilterOperator is the comparison performed by a QueryFilter.
+type FilterOperator string
+
+const (
+	// FilterOperatorEqual matches when the field is a string equal to Value. This is the default operator.
+	FilterOperatorEqual FilterOperator = "eq"
+
+	// FilterOperatorNotEqual matches when the field is NOT a string equal to Value. This includes the case
+	// where the field does not exist or is not a string.
+	FilterOperatorNotEqual FilterOperator = "ne"
+
+	// FilterOperatorIn matches when the field is a string equal to one of Values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorExists matches when the field exists, regardless of its type or value. Value is ignored.
+	FilterOperatorExists FilterOperator = "exists"
+
+	// FilterOperatorPrefix matches when the field is a string that starts with Value.
+	FilterOperatorPrefix FilterOperator = "prefix"
+
+	// FilterOperatorContains matches when the field is an array that contains a string element equal to Value.
+	FilterOperatorContains FilterOperator = "contains"
+
+	// FilterOperatorGreaterOrEqual matches when the field is a string that sorts at or after Value. Strings are
+	// compared byte by byte.
+	FilterOperatorGreaterOrEqual FilterOperator = "ge"
+
+	// FilterOperatorLess matches when the field is a string that sorts before Value. Strings are compared byte by byte.
+	FilterOperatorLess FilterOperator = "lt"
+)
+
 // QueryFilter is the filter which filters property in resource entity.
+//
+// All comparisons are case-sensitive and only match string values.
 type QueryFilter struct {
 	// Field specifies the property name to filter.
 	//
@@ -162,9 +212,23 @@ type QueryFilter struct {
 	//	- "properties.application"
 	Field string
 
-	// Value specifies the value to filter. The value must be a string and will be
-	// compared case-insentively with the property value.
+	// Operator specifies the comparison to perform. If Operator is empty then FilterOperatorEqual is used.
+	Operator FilterOperator
+
+	// Value specifies the value to filter. The value must be a string.
 	Value string
+
+	// Values specifies the set of values to filter when using FilterOperatorIn.
+	Values []string
+}
+
+// EffectiveOperator returns the operator of the filter, applying the default if it is unset.
+func (f QueryFilter) EffectiveOperator() FilterOperator {
+	if f.Operator == "" {
+		return FilterOperatorEqual
+	}
+
+	return f.Operator
 }
 
 // Validate validates the QueryFilter.
@@ -178,7 +242,17 @@ func (f QueryFilter) Validate() error {
 		err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Field is invalid in filter: %+v", f)})
 	}
 
-	// Value can be blank. If it is blank, the filter will match the empty string in the target property.
+	switch f.EffectiveOperator() {
+	case FilterOperatorEqual, FilterOperatorNotEqual, FilterOperatorPrefix, FilterOperatorContains, FilterOperatorExists,
+		FilterOperatorGreaterOrEqual, FilterOperatorLess:
+		// Value can be blank. If it is blank, the filter will match the empty string in the target property.
+	case FilterOperatorIn:
+		if len(f.Values) == 0 {
+			err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Values is required for operator 'in' in filter: %+v", f)})
+		}
+	default:
+		err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Operator is invalid in filter: %+v", f)})
+	}
 
 	return err
 }
@@ -57,6 +57,15 @@ func TestQuery_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "First filter is invalid",
+			query: Query{
+				ResourceType: "Applications.Core/applications",
+				RootScope:    "/planes",
+				Filters:      []QueryFilter{{Field: "invalid field!", Value: "some value"}, {Field: "location", Value: "some value"}},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Valid",
 			query: Query{
@@ -106,6 +115,26 @@ func TestQueryFilter_Validate(t *testing.T) {
 			filter:  QueryFilter{Field: "properties.application.some.other.thing", Value: "some value"},
 			wantErr: false,
 		},
+		{
+			name:    "Operator is invalid",
+			filter:  QueryFilter{Field: "location", Operator: "like", Value: "some value"},
+			wantErr: true,
+		},
+		{
+			name:    "Operator in requires values",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorIn},
+			wantErr: true,
+		},
+		{
+			name:    "Operator in is valid",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorIn, Values: []string{"a", "b"}},
+			wantErr: false,
+		},
+		{
+			name:    "Operator exists is valid",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorExists},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
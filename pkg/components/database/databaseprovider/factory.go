@@ -28,6 +28,7 @@ import (
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/components/database/postgres"
+	"github.com/radius-project/radius/pkg/components/database/sqlite"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -41,6 +42,7 @@ var databaseClientFactory = map[DatabaseProviderType]databaseClientFactoryFunc{
 	TypeAPIServer:  initAPIServerClient,
 	TypeInMemory:   initInMemoryClient,
 	TypePostgreSQL: initPostgreSQLClient,
+	TypeSQLite:     initSQLiteClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error) {
@@ -70,7 +72,8 @@ func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error)
 		Scheme: scheme,
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The APIServer client supports database.Watcher, which requires a client that can watch.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -105,3 +108,16 @@ func initPostgreSQLClient(ctx context.Context, opt Options) (store.Client, error
 
 	return postgres.NewPostgresClient(pool), nil
 }
+
+func initSQLiteClient(ctx context.Context, opt Options) (store.Client, error) {
+	if opt.SQLite.Path == "" {
+		return nil, errors.New("failed to initialize SQLite client: path is required")
+	}
+
+	client, err := sqlite.Open(ctx, opt.SQLite.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize SQLite client: %w", err)
+	}
+
+	return client, nil
+}
@@ -29,6 +29,9 @@ type Options struct {
 
 	// PostgreSQL configures options for connecting to a PostgreSQL database. Will be ignored if another store is configured.
 	PostgreSQL PostgreSQLOptions `yaml:"postgresql,omitempty"`
+
+	// SQLite configures options for the embedded SQLite database. Will be ignored if another store is configured.
+	SQLite SQLiteOptions `yaml:"sqlite,omitempty"`
 }
 
 // APIServerOptions represents options for the configuring the Kubernetes APIServer store.
@@ -57,3 +60,11 @@ type PostgreSQLOptions struct {
 	// 	${ENV_VAR_NAME}
 	URL string `yaml:"url"`
 }
+
+type SQLiteOptions struct {
+	// Path is the path of the SQLite database file. The file and its schema will be created if they do not exist.
+	// The directory containing the file must already exist.
+	//
+	// Use ":memory:" for a non-persistent database. This is only useful for testing.
+	Path string `yaml:"path"`
+}
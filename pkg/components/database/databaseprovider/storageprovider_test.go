@@ -19,6 +19,7 @@ package databaseprovider
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/components/database"
@@ -127,3 +128,22 @@ func TestInitialize(t *testing.T) {
 	require.NoError(t, result.err)
 	require.NotNil(t, result.client)
 }
+
+func TestInitialize_SQLite(t *testing.T) {
+	options := Options{Provider: TypeSQLite, SQLite: SQLiteOptions{Path: filepath.Join(t.TempDir(), "radius.db")}}
+	provider := FromOptions(options)
+
+	result := provider.initialize(context.Background())
+
+	require.NoError(t, result.err)
+	require.NotNil(t, result.client)
+}
+
+func TestInitialize_SQLite_MissingPath(t *testing.T) {
+	provider := FromOptions(Options{Provider: TypeSQLite})
+
+	result := provider.initialize(context.Background())
+
+	require.Error(t, result.err)
+	require.Equal(t, "failed to initialize database client: failed to initialize SQLite client: path is required", result.err.Error())
+}
@@ -28,4 +28,7 @@ const (
 
 	// TypePostgreSQL represents the PostgreSQL provider.
 	TypePostgreSQL DatabaseProviderType = "postgresql"
+
+	// TypeSQLite represents the embedded SQLite provider.
+	TypeSQLite DatabaseProviderType = "sqlite"
 )
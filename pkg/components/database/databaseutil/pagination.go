@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+// Paginate applies the pagination options to a set of query results and returns a single page.
+//
+// This is intended for data stores that cannot page natively. The items are sorted by their
+// case-insensitive resource id, and the pagination token encodes the id of the last item on the page.
+// This keyset approach is stable across concurrent writes: items that are created or deleted between
+// calls will not cause other items to be skipped or duplicated.
+//
+// A pagination token is only returned when there are more items after the current page.
+func Paginate(items []database.Object, config database.DatabaseOptions) (*database.ObjectQueryResult, error) {
+	after := ""
+	if config.PaginationToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
+		}
+		after = string(decoded)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return strings.ToLower(items[i].ID) < strings.ToLower(items[j].ID)
+	})
+
+	start := 0
+	if after != "" {
+		start = sort.Search(len(items), func(i int) bool {
+			return strings.ToLower(items[i].ID) > after
+		})
+	}
+
+	result := &database.ObjectQueryResult{Items: items[start:]}
+	if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+		result.Items = result.Items[:config.MaxQueryItemCount]
+
+		last := result.Items[len(result.Items)-1]
+		result.PaginationToken = base64.StdEncoding.EncodeToString([]byte(strings.ToLower(last.ID)))
+	}
+
+	if len(result.Items) == 0 {
+		// Preserve the existing behavior of returning nil when there are no results.
+		result.Items = nil
+	}
+
+	return result, nil
+}
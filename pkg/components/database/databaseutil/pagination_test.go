@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Paginate(t *testing.T) {
+	items := func() []database.Object {
+		return []database.Object{
+			{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/c"}},
+			{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/A"}},
+			{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/b"}},
+		}
+	}
+
+	t.Run("no_limit", func(t *testing.T) {
+		result, err := Paginate(items(), database.DatabaseOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		require.Empty(t, result.PaginationToken)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/A", result.Items[0].ID)
+	})
+
+	t.Run("exact_page", func(t *testing.T) {
+		result, err := Paginate(items(), database.DatabaseOptions{MaxQueryItemCount: 3})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		require.Empty(t, result.PaginationToken)
+	})
+
+	t.Run("multiple_pages", func(t *testing.T) {
+		ids := []string{}
+		token := ""
+		for {
+			result, err := Paginate(items(), database.DatabaseOptions{MaxQueryItemCount: 2, PaginationToken: token})
+			require.NoError(t, err)
+			for _, item := range result.Items {
+				ids = append(ids, item.ID)
+			}
+
+			token = result.PaginationToken
+			if token == "" {
+				break
+			}
+		}
+
+		require.Equal(t, []string{
+			"/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/A",
+			"/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/b",
+			"/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/c",
+		}, ids)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result, err := Paginate(nil, database.DatabaseOptions{MaxQueryItemCount: 2})
+		require.NoError(t, err)
+		require.Empty(t, result.Items)
+		require.Empty(t, result.PaginationToken)
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		result, err := Paginate(items(), database.DatabaseOptions{PaginationToken: "not base64!"})
+		require.ErrorIs(t, err, &database.ErrInvalid{})
+		require.Nil(t, result)
+	})
+}
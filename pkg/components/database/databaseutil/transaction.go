@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ParseOperations validates the operations of a transaction and returns the converted resource id of each
+// operation, see ConvertScopeIDToResourceID. Validation is done up front so that an invalid operation
+// cannot leave a transaction partially applied.
+func ParseOperations(operations []database.Operation) ([]resources.ID, error) {
+	ids := make([]resources.ID, len(operations))
+	for i, operation := range operations {
+		err := operation.Validate()
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Operation %d is invalid: %s", i, err.Error())}
+		}
+
+		parsed, err := resources.Parse(operation.ResourceID())
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Operation %d must have a valid resource id", i)}
+		}
+		if parsed.IsEmpty() {
+			return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Operation %d must not have an empty resource id", i)}
+		}
+		if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+			return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Operation %d must refer to a named resource, not a collection", i)}
+		}
+
+		converted, err := ConvertScopeIDToResourceID(parsed)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[i] = converted
+	}
+
+	return ids, nil
+}
+
+// OperationError wraps the error returned by a failed operation of a transaction.
+func OperationError(index int, operation database.Operation, err error) error {
+	return fmt.Errorf("operation %d (%s %s) failed: %w", index, operation.Type, operation.ResourceID(), err)
+}
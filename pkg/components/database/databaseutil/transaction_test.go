@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseOperations(t *testing.T) {
+	obj := &database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/a"}}
+
+	t.Run("valid", func(t *testing.T) {
+		ids, err := ParseOperations([]database.Operation{
+			database.SaveOperation(obj),
+			database.DeleteOperation("/planes/radius/local/resourceGroups/rg"),
+		})
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/a", ids[0].String())
+		require.Equal(t, "/planes/radius/local/providers/System.Resources/resourceGroups/rg", ids[1].String())
+	})
+
+	cases := []struct {
+		name      string
+		operation database.Operation
+	}{
+		{name: "missing_type", operation: database.Operation{ID: obj.ID}},
+		{name: "save_missing_object", operation: database.SaveOperation(nil)},
+		{name: "delete_missing_id", operation: database.DeleteOperation("")},
+		{name: "invalid_id", operation: database.DeleteOperation("not a resource id")},
+		{name: "collection", operation: database.DeleteOperation("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseOperations([]database.Operation{database.SaveOperation(obj), tc.operation})
+			require.ErrorIs(t, err, &database.ErrInvalid{})
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// EventMatchesQuery checks if the given watch event matches the given query. See database.Watcher for
+// the matching rules.
+func EventMatchesQuery(event database.WatchEvent, query database.Query) (bool, error) {
+	id, err := resources.Parse(event.Object.ID)
+	if err != nil {
+		return false, nil
+	}
+
+	if !IDMatchesQuery(id, query) {
+		return false, nil
+	}
+
+	if event.Type == database.WatchEventDeleted {
+		return true, nil
+	}
+
+	return event.Object.MatchesFilters(query.Filters)
+}
+
+// WatchHub distributes watch events to subscribers. This can be used to implement database.Watcher
+// for data stores that observe every change in-process.
+//
+// Publish never blocks. Each subscriber has its own unbounded queue so that a slow consumer cannot
+// block writes to the data store or other subscribers.
+type WatchHub struct {
+	// mutex is used to synchronize access to the subscribers map.
+	mutex sync.Mutex
+
+	// subscribers is the set of active subscribers.
+	subscribers map[*watchSubscriber]struct{}
+}
+
+// NewWatchHub creates a new WatchHub.
+func NewWatchHub() *WatchHub {
+	return &WatchHub{subscribers: map[*watchSubscriber]struct{}{}}
+}
+
+// Subscribe registers a new subscriber for events that match the query. The returned channel is closed
+// when the context is cancelled.
+func (h *WatchHub) Subscribe(ctx context.Context, query database.Query) (<-chan database.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	subscriber := &watchSubscriber{query: query, signal: make(chan struct{}, 1)}
+
+	h.mutex.Lock()
+	h.subscribers[subscriber] = struct{}{}
+	h.mutex.Unlock()
+
+	out := make(chan database.WatchEvent)
+	go func() {
+		defer close(out)
+		defer func() {
+			h.mutex.Lock()
+			delete(h.subscribers, subscriber)
+			h.mutex.Unlock()
+		}()
+
+		subscriber.run(ctx, out)
+	}()
+
+	return out, nil
+}
+
+// Publish delivers an event to all matching subscribers. The event is copied for each subscriber so
+// the caller may continue to use it.
+func (h *WatchHub) Publish(event database.WatchEvent) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for subscriber := range h.subscribers {
+		match, err := EventMatchesQuery(event, subscriber.query)
+		if err != nil || !match {
+			continue
+		}
+
+		copy, err := event.Object.DeepCopy()
+		if err != nil {
+			continue
+		}
+
+		subscriber.enqueue(database.WatchEvent{Type: event.Type, Object: *copy})
+	}
+}
+
+// watchSubscriber is a single subscriber of a WatchHub.
+type watchSubscriber struct {
+	// query is the query used to filter events.
+	query database.Query
+
+	// mutex is used to synchronize access to the queue.
+	mutex sync.Mutex
+
+	// queue is the list of events waiting to be delivered.
+	queue []database.WatchEvent
+
+	// signal is used to wake the subscriber when new events are queued.
+	signal chan struct{}
+}
+
+func (s *watchSubscriber) enqueue(event database.WatchEvent) {
+	s.mutex.Lock()
+	s.queue = append(s.queue, event)
+	s.mutex.Unlock()
+
+	// Non-blocking, the subscriber only needs to be woken once.
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (s *watchSubscriber) run(ctx context.Context, out chan<- database.WatchEvent) {
+	for {
+		s.mutex.Lock()
+		pending := s.queue
+		s.queue = nil
+		s.mutex.Unlock()
+
+		for _, event := range pending {
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-s.signal:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
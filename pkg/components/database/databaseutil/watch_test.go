@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EventMatchesQuery(t *testing.T) {
+	query := database.Query{
+		RootScope:    "/planes/radius/local/resourceGroups/rg",
+		ResourceType: "Applications.Test/tests",
+		Filters:      []database.QueryFilter{{Field: "value", Value: "1"}},
+	}
+
+	matching := database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/a"}, Data: map[string]any{"value": "1"}}
+	filtered := database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/b"}, Data: map[string]any{"value": "2"}}
+	otherScope := database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/other/providers/Applications.Test/tests/a"}, Data: map[string]any{"value": "1"}}
+
+	cases := []struct {
+		name     string
+		event    database.WatchEvent
+		expected bool
+	}{
+		{name: "created_match", event: database.WatchEvent{Type: database.WatchEventCreated, Object: matching}, expected: true},
+		{name: "updated_filtered", event: database.WatchEvent{Type: database.WatchEventUpdated, Object: filtered}, expected: false},
+		{name: "created_other_scope", event: database.WatchEvent{Type: database.WatchEventCreated, Object: otherScope}, expected: false},
+		{name: "deleted_ignores_filters", event: database.WatchEvent{Type: database.WatchEventDeleted, Object: database.Object{Metadata: filtered.Metadata}}, expected: true},
+		{name: "deleted_other_scope", event: database.WatchEvent{Type: database.WatchEventDeleted, Object: database.Object{Metadata: otherScope.Metadata}}, expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := EventMatchesQuery(tc.event, query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, match)
+		})
+	}
+}
+
+func Test_WatchHub(t *testing.T) {
+	hub := NewWatchHub()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	query := database.Query{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: "Applications.Test/tests"}
+	events, err := hub.Subscribe(ctx, query)
+	require.NoError(t, err)
+
+	// Publishing must not block even though nothing is reading from the channel yet.
+	for _, name := range []string{"a", "b", "c"} {
+		hub.Publish(database.WatchEvent{
+			Type:   database.WatchEventCreated,
+			Object: database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/" + name}},
+		})
+	}
+
+	for _, name := range []string{"a", "b", "c"} {
+		select {
+		case event := <-events:
+			require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/tests/"+name, event.Object.ID)
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for watch event")
+		}
+	}
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-events
+		return !ok
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		hub.mutex.Lock()
+		defer hub.mutex.Unlock()
+		return len(hub.subscribers) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
@@ -18,6 +18,7 @@ package database
 
 import (
 	"reflect"
+	"slices"
 	"strings"
 )
 
@@ -44,37 +45,94 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	}
 
 	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if !value.IsValid() {
-				// Field doesn't exist, no match
-				return false, nil
-			}
+		value, found := lookupField(reflect.ValueOf(data), filter.Field)
+		if !matchesFilter(filter, value, found) {
+			return false, nil
+		}
+	}
 
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
-			}
+	return true, nil
+}
+
+// lookupField navigates the '.' separated field path and returns the value and whether it was found.
+func lookupField(value reflect.Value, field string) (reflect.Value, bool) {
+	for _, segment := range strings.Split(field, ".") {
+		value = unwrap(value)
+		if !value.IsValid() || value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+			// Can't navigate into anything that's not a map, no match
+			return reflect.Value{}, false
 		}
-		comparator := reflect.ValueOf(filter.Value)
 
-		if value.Type().Kind() == reflect.Interface {
-			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+		value = value.MapIndex(reflect.ValueOf(segment).Convert(value.Type().Key()))
+		if !value.IsValid() {
+			// Field doesn't exist, no match
+			return reflect.Value{}, false
 		}
+	}
 
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
-			return false, nil
+	return unwrap(value), true
+}
+
+// unwrap returns the value stored in an interface{}.
+func unwrap(value reflect.Value) reflect.Value {
+	if value.IsValid() && value.Kind() == reflect.Interface {
+		return reflect.ValueOf(value.Interface())
+	}
+
+	return value
+}
+
+// stringValue returns the string stored in value, and false if value is not a string.
+func stringValue(value reflect.Value) (string, bool) {
+	value = unwrap(value)
+	if !value.IsValid() || value.Kind() != reflect.String {
+		return "", false
+	}
+
+	return value.String(), true
+}
+
+// matchesFilter evaluates a single filter against the value of its field.
+func matchesFilter(filter QueryFilter, value reflect.Value, found bool) bool {
+	str, isString := stringValue(value)
+
+	switch filter.EffectiveOperator() {
+	case FilterOperatorEqual:
+		return found && isString && str == filter.Value
+
+	case FilterOperatorNotEqual:
+		return !found || !isString || str != filter.Value
+
+	case FilterOperatorIn:
+		return found && isString && slices.Contains(filter.Values, str)
+
+	case FilterOperatorExists:
+		return found
+
+	case FilterOperatorPrefix:
+		return found && isString && strings.HasPrefix(str, filter.Value)
+
+	case FilterOperatorGreaterOrEqual:
+		return found && isString && str >= filter.Value
+
+	case FilterOperatorLess:
+		return found && isString && str < filter.Value
+
+	case FilterOperatorContains:
+		if !found || !value.IsValid() || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+			return false
 		}
 
-		if value.String() != comparator.String() {
-			// not the same value!
-			return false, nil
+		for i := 0; i < value.Len(); i++ {
+			element, ok := stringValue(value.Index(i))
+			if ok && element == filter.Value {
+				return true
+			}
 		}
+
+		return false
 	}
 
-	return true, nil
+	// Unknown operators are rejected by Validate, so they never match.
+	return false
 }
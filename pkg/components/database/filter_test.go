@@ -119,6 +119,128 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "value", Value: "hot"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "field_parent_not_a_map",
+			Obj:           &Object{Data: map[string]any{"properties": "cool"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+
+		// Operators
+		{
+			Description:   "explicit_equal_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorEqual, Value: "cool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equal_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEqual, Value: "uncool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equal_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEqual, Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "not_equal_match_field_does_not_exist",
+			Obj:           &Object{Data: map[string]any{"another": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEqual, Value: "cool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"environment": "env2"}}},
+			Filters:       []QueryFilter{{Field: "properties.environment", Operator: FilterOperatorIn, Values: []string{"env1", "env2"}}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"environment": "env3"}}},
+			Filters:       []QueryFilter{{Field: "properties.environment", Operator: FilterOperatorIn, Values: []string{"env1", "env2"}}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_match",
+			Obj:           &Object{Data: map[string]any{"value": 3}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_match_null",
+			Obj:           &Object{Data: map[string]any{"value": nil}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_not_match",
+			Obj:           &Object{Data: map[string]any{"another": 3}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "prefix_match",
+			Obj:           &Object{Data: map[string]any{"value": "/planes/radius/local/resourceGroups/rg"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorPrefix, Value: "/planes/radius/local/"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "prefix_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "/planes/aws/aws"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorPrefix, Value: "/planes/radius/local/"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "greater_or_equal_match",
+			Obj:           &Object{Data: map[string]any{"value": "0190"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorGreaterOrEqual, Value: "0190"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "greater_or_equal_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "018f"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorGreaterOrEqual, Value: "0190"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "less_match",
+			Obj:           &Object{Data: map[string]any{"value": "018f"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorLess, Value: "0190"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "less_not_match_not_a_string",
+			Obj:           &Object{Data: map[string]any{"value": 3}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorLess, Value: "0190"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_match",
+			Obj:           &Object{Data: map[string]any{"tags": []any{"a", 3, "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "b"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "contains_match_typed_slice",
+			Obj:           &Object{Data: map[string]any{"tags": []string{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "a"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "contains_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": []any{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "c"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_not_match_not_an_array",
+			Obj:           &Object{Data: map[string]any{"tags": "a"}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "a"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -31,6 +31,7 @@ import (
 )
 
 var _ database.Client = (*Client)(nil)
+var _ database.Watcher = (*Client)(nil)
 
 // Client is an in-memory implementation of database.Client.
 type Client struct {
@@ -44,6 +45,9 @@ type Client struct {
 	//
 	// The Query method will iterate over all entries in the map to find the matching ones.
 	resources map[string]entry
+
+	// hub is used to distribute change notifications to watchers.
+	hub *databaseutil.WatchHub
 }
 
 // entry stores the commonly-used fields (extracted from the resource ID) for comparison in queries.
@@ -84,6 +88,7 @@ func NewClient() *Client {
 	return &Client{
 		mutex:     sync.Mutex{},
 		resources: map[string]entry{},
+		hub:       databaseutil.NewWatchHub(),
 	}
 }
 
@@ -162,6 +167,8 @@ func (c *Client) Delete(ctx context.Context, id string, options ...database.Dele
 
 	delete(c.resources, strings.ToLower(converted.String()))
 
+	c.hub.Publish(database.WatchEvent{Type: database.WatchEventDeleted, Object: entry.obj})
+
 	return nil
 }
 
@@ -176,10 +183,12 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
 	}
 
+	config := database.NewQueryConfig(options...)
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	result := &database.ObjectQueryResult{}
+	items := []database.Object{}
 	for _, entry := range c.resources {
 		// Check root scope.
 		if query.ScopeRecursive && !strings.HasPrefix(entry.rootScope, databaseutil.NormalizePart(query.RootScope)) {
@@ -217,10 +226,10 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 			return nil, err
 		}
 
-		result.Items = append(result.Items, *copy)
+		items = append(items, *copy)
 	}
 
-	return result, nil
+	return databaseutil.Paginate(items, config)
 }
 
 // Save implements database.Client.
@@ -277,9 +286,123 @@ func (c *Client) Save(ctx context.Context, obj *database.Object, options ...data
 
 	c.resources[strings.ToLower(converted.String())] = entry
 
+	eventType := database.WatchEventUpdated
+	if !ok {
+		eventType = database.WatchEventCreated
+	}
+	c.hub.Publish(database.WatchEvent{Type: eventType, Object: entry.obj})
+
 	return nil
 }
 
+// Transact implements database.Client.
+func (c *Client) Transact(ctx context.Context, operations ...database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	ids, err := databaseutil.ParseOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Changes are staged and only applied once every operation has succeeded. A nil value
+	// in the staged map represents a deletion.
+	staged := map[string]*entry{}
+	lookup := func(key string) (entry, bool) {
+		if e, ok := staged[key]; ok {
+			if e == nil {
+				return entry{}, false
+			}
+			return *e, true
+		}
+
+		e, ok := c.resources[key]
+		return e, ok
+	}
+
+	events := []database.WatchEvent{}
+	etags := make([]database.ETag, len(operations))
+	for i, operation := range operations {
+		converted := ids[i]
+		key := strings.ToLower(converted.String())
+
+		existing, ok := lookup(key)
+		if !ok && operation.ETag != "" {
+			return databaseutil.OperationError(i, operation, &database.ErrConcurrency{})
+		} else if ok && operation.ETag != "" && operation.ETag != existing.obj.ETag {
+			return databaseutil.OperationError(i, operation, &database.ErrConcurrency{})
+		}
+
+		if operation.Type == database.OperationTypeDelete {
+			if !ok {
+				return databaseutil.OperationError(i, operation, &database.ErrNotFound{ID: operation.ID})
+			}
+
+			staged[key] = nil
+			events = append(events, database.WatchEvent{Type: database.WatchEventDeleted, Object: existing.obj})
+			continue
+		}
+
+		if !ok {
+			// New entry, initialize it.
+			existing.rootScope = databaseutil.NormalizePart(converted.RootScope())
+			existing.resourceType = databaseutil.NormalizePart(converted.Type())
+			existing.routingScope = databaseutil.NormalizePart(converted.RoutingScope())
+		}
+
+		raw, err := json.Marshal(operation.Object.Data)
+		if err != nil {
+			return err
+		}
+
+		// Make a defensive copy so users can't modify the data in the store.
+		copy, err := operation.Object.DeepCopy()
+		if err != nil {
+			return err
+		}
+
+		etags[i] = etag.New(raw)
+		copy.ETag = etags[i]
+		existing.obj = *copy
+		staged[key] = &existing
+
+		eventType := database.WatchEventUpdated
+		if !ok {
+			eventType = database.WatchEventCreated
+		}
+		events = append(events, database.WatchEvent{Type: eventType, Object: existing.obj})
+	}
+
+	for key, e := range staged {
+		if e == nil {
+			delete(c.resources, key)
+		} else {
+			c.resources[key] = *e
+		}
+	}
+
+	for i, operation := range operations {
+		if operation.Type == database.OperationTypeSave {
+			operation.Object.ETag = etags[i]
+		}
+	}
+
+	for _, event := range events {
+		c.hub.Publish(event)
+	}
+
+	return nil
+}
+
+// Watch implements database.Watcher.
+func (c *Client) Watch(ctx context.Context, query database.Query) (<-chan database.WatchEvent, error) {
+	return c.hub.Subscribe(ctx, query)
+}
+
 // Clear can be used to clear all stored data.
 func (c *Client) Clear() {
 	c.mutex.Lock()
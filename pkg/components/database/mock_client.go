@@ -212,3 +212,46 @@ func (c *MockClientSaveCall) DoAndReturn(f func(context.Context, *Object, ...Sav
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Transact mocks base method.
+func (m *MockClient) Transact(arg0 context.Context, arg1 ...Operation) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Transact", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transact indicates an expected call of Transact.
+func (mr *MockClientMockRecorder) Transact(arg0 any, arg1 ...any) *MockClientTransactCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transact", reflect.TypeOf((*MockClient)(nil).Transact), varargs...)
+	return &MockClientTransactCall{Call: call}
+}
+
+// MockClientTransactCall wrap *gomock.Call
+type MockClientTransactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientTransactCall) Return(arg0 error) *MockClientTransactCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientTransactCall) Do(f func(context.Context, ...Operation) error) *MockClientTransactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientTransactCall) DoAndReturn(f func(context.Context, ...Operation) error) *MockClientTransactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -59,7 +59,8 @@ func (o *Object) DeepCopy() (*Object, error) {
 
 // ObjectQueryResult represents the result of Query().
 type ObjectQueryResult struct {
-	// PaginationToken represents the token for pagination, such as continuation token.
+	// PaginationToken represents the token for pagination, such as continuation token. It is empty when there
+	// are no more results.
 	PaginationToken string
 	// Items represents the list of documents.
 	Items []Object
@@ -22,14 +22,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/database/databaseutil"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
 )
 
@@ -43,6 +46,8 @@ type PostgresAPI interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	// Query executes a query that returns rows.
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	// Begin starts a transaction.
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 // NewPostgresClient creates a new PostgresClient.
@@ -80,11 +85,24 @@ func (p *PostgresClient) Delete(ctx context.Context, id string, options ...datab
 	}
 
 	config := database.NewDeleteConfig(options...)
-	var etag *string
-	if config.ETag != "" {
-		etag = &config.ETag
+
+	deleted, err := p.delete(ctx, p.api, id, converted, config.ETag)
+	if err != nil {
+		return err
 	}
 
+	err = p.notify(ctx, p.api, database.WatchEventDeleted, deleted.ID, deleted.ETag)
+	if err != nil {
+		// The delete has already been committed, so we don't report this to the caller.
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to send change notification", "id", deleted.ID)
+	}
+
+	return nil
+}
+
+// delete deletes a resource using the provided API and returns the id and ETag of the deleted resource.
+func (p *PostgresClient) delete(ctx context.Context, api PostgresAPI, id string, converted resources.ID, expectedETag database.ETag) (database.Metadata, error) {
 	// We need different SQL for the case where an etag is provided vs not provided.
 	//
 	// The key behavior difference is that if an etag is provided, should report failure differently.
@@ -92,46 +110,51 @@ func (p *PostgresClient) Delete(ctx context.Context, id string, options ...datab
 WITH deleted AS (
 	DELETE FROM resources
 	WHERE id = $1
-	RETURNING id
+	RETURNING original_id, etag
 )
 SELECT
 CASE
 	WHEN EXISTS (SELECT 1 FROM deleted) THEN 'Success'
 	WHEN EXISTS (SELECT 1 FROM resources WHERE id = $1) THEN 'ErrConcurrency'
 	ELSE 'ErrNotFound'
-END AS result;`
+END AS result,
+(SELECT original_id FROM deleted) AS original_id,
+(SELECT etag FROM deleted) AS etag;`
 
 	args := []any{databaseutil.NormalizePart(converted.String())}
 
-	if config.ETag != "" {
+	if expectedETag != "" {
 		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
 		sql = `
 WITH deleted AS (
 	DELETE FROM resources
 	WHERE id = $1 AND etag = $2
-	RETURNING id
+	RETURNING original_id, etag
 )
 SELECT
 CASE
 	WHEN EXISTS (SELECT 1 FROM deleted) THEN 'Success'
 	WHEN EXISTS (SELECT 1 FROM resources WHERE id = $1) THEN 'ErrConcurrency'
 	ELSE 'ErrConcurrency'
-END AS result;`
+END AS result,
+(SELECT original_id FROM deleted) AS original_id,
+(SELECT etag FROM deleted) AS etag;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), etag}
+		args = []any{databaseutil.NormalizePart(converted.String()), expectedETag}
 	}
 
 	result := ""
-	err = p.api.QueryRow(ctx, sql, args...).Scan(&result)
+	var deletedID, deletedETag *string
+	err := api.QueryRow(ctx, sql, args...).Scan(&result, &deletedID, &deletedETag)
 	if err != nil {
-		return err
+		return database.Metadata{}, err
 	} else if result == "ErrNotFound" {
-		return &database.ErrNotFound{ID: id}
+		return database.Metadata{}, &database.ErrNotFound{ID: id}
 	} else if result == "ErrConcurrency" {
-		return &database.ErrConcurrency{}
+		return database.Metadata{}, &database.ErrConcurrency{}
 	}
 
-	return nil
+	return database.Metadata{ID: to.String(deletedID), ETag: to.String(deletedETag)}, nil
 }
 
 // Get implements database.Client.
@@ -200,41 +223,41 @@ func (p *PostgresClient) Query(ctx context.Context, query database.Query, option
 		routingScopePrefixFilter = to.Ptr(databaseutil.NormalizePart(query.RoutingScopePrefix))
 	}
 
-	var timestampFilter *string
+	var cursor *paginationToken
 	if config.PaginationToken != "" {
-		ts, err := p.parsePaginationToken(config.PaginationToken)
+		cursor, err = p.parsePaginationToken(config.PaginationToken)
 		if err != nil {
 			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
 		}
-		timestampFilter = &ts
 	}
 
-	var limitFilter *int
-	if config.MaxQueryItemCount > 0 {
-		limitFilter = &config.MaxQueryItemCount
+	var timestampFilter *string
+	var idFilter *string
+	if cursor != nil {
+		timestampFilter = &cursor.CreatedAt
+		idFilter = &cursor.ID
 	}
 
-	// For a scope query, we need to perform the same normalization as we do for other operations on scopes.
-	if query.IsScopeQuery {
-		var err error
-		query.ResourceType, err = databaseutil.ConvertScopeTypeToResourceType(query.ResourceType)
-		if err != nil {
-			return nil, err
-		}
+	// We request one more row than the page size so we know whether there is another page.
+	var limitFilter *int
+	if config.MaxQueryItemCount > 0 {
+		limitFilter = to.Ptr(config.MaxQueryItemCount + 1)
 	}
 
 	// NOTE: building SQL by concatenating strings is hard to do safely and should be avoided.
 	// If you need to work on this code MAKE SURE you use SQL parameters
 	// for any user input.
-	sql := `
-SELECT original_id, etag, resource_data, created_at 
+	//
+	// The cursor is the (created_at, id) pair of the last row on the previous page. created_at alone is not
+	// unique, so id is used as a tie-breaker to guarantee a stable ordering.
+	sb := strings.Builder{}
+	sb.WriteString(`
+SELECT original_id, etag, resource_data, created_at, id
 FROM resources
 WHERE ((root_scope = $1) OR ($2 AND (root_scope LIKE $1 || '%'))) AND 
 	resource_type = $3 AND 
 	((routing_scope LIKE $4 || '%') OR $4 IS NULL) AND 
-	(created_at > $5::TIMESTAMP OR $5 IS NULL)
-ORDER BY created_at ASC
-LIMIT $6`
+	($5::TIMESTAMPTZ IS NULL OR (created_at, id) > ($5::TIMESTAMPTZ, $6::TEXT))`)
 
 	args := []any{
 		// If ScopeRecursive is false, the RootScope must match exactly.
@@ -244,38 +267,42 @@ LIMIT $6`
 		resourceType,
 		routingScopePrefixFilter, // RoutingScopePrefix is optional and always treated as as prefix.
 		timestampFilter,          // Optional for pagination.
-		limitFilter,              // NOTE: Postgres allows LIMIT to be set with a NULL value to mean no limit.
+		idFilter,                 // Optional for pagination.
+	}
+
+	// Filters are evaluated in SQL so that each page is filled with matching rows. They have the same
+	// semantics as database.Object.MatchesFilters.
+	for _, filter := range query.Filters {
+		var clause string
+		clause, args = filterClause(filter, args)
+		sb.WriteString(" AND\n\t(" + clause + ")")
 	}
 
-	rows, err := p.api.Query(ctx, sql, args...)
+	// NOTE: Postgres allows LIMIT to be set with a NULL value to mean no limit.
+	args = append(args, limitFilter)
+	sb.WriteString(fmt.Sprintf("\nORDER BY created_at ASC, id ASC\nLIMIT $%d", len(args)))
+
+	rows, err := p.api.Query(ctx, sb.String(), args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	// Capture the last timestamp so we can use it for pagination.
-	var timestamp *time.Time
+	// Capture the cursor of each row so we can use the last one for pagination.
+	cursors := []paginationToken{}
 
 	result := database.ObjectQueryResult{}
 	for rows.Next() {
 		obj := database.Object{}
-		err := rows.Scan(&obj.ID, &obj.ETag, &obj.Data, &timestamp)
-		if err != nil {
-			return nil, err
-		}
-
-		// We could improve this by moving the filter logic to the SQL query.
-		//
-		// The problem is that the current filter logic is not well documented or tested, and
-		// we want to stay compatible with the existing implementation for now.
-		match, err := obj.MatchesFilters(query.Filters)
+		timestamp := time.Time{}
+		id := ""
+		err := rows.Scan(&obj.ID, &obj.ETag, &obj.Data, &timestamp, &id)
 		if err != nil {
 			return nil, err
-		} else if !match {
-			continue
 		}
 
 		result.Items = append(result.Items, obj)
+		cursors = append(cursors, paginationToken{CreatedAt: timestamp.UTC().Format(time.RFC3339Nano), ID: id})
 	}
 
 	err = rows.Err()
@@ -283,14 +310,11 @@ LIMIT $6`
 		return nil, err
 	}
 
-	if len(result.Items) < config.MaxQueryItemCount && config.MaxQueryItemCount > 0 {
-		// No more rows, so no need for pagination.
-		return &result, nil
-	}
+	if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+		// There is at least one more row, so trim the extra row and return a token for the next page.
+		result.Items = result.Items[:config.MaxQueryItemCount]
 
-	if timestamp != nil {
-		// Will be empty if there were no rows.
-		token, err := p.createPaginationToken(*timestamp)
+		token, err := p.createPaginationToken(cursors[config.MaxQueryItemCount-1])
 		if err != nil {
 			return nil, err
 		}
@@ -327,13 +351,33 @@ func (p *PostgresClient) Save(ctx context.Context, obj *database.Object, options
 
 	config := database.NewSaveConfig(options...)
 
+	eventType, newETag, err := p.save(ctx, p.api, obj, converted, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = newETag
+
+	err = p.notify(ctx, p.api, eventType, obj.ID, obj.ETag)
+	if err != nil {
+		// The save has already been committed, so we don't report this to the caller.
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to send change notification", "id", obj.ID)
+	}
+
+	return nil
+}
+
+// save saves a resource using the provided API. It returns whether the resource was created or updated and the
+// new ETag. The ETag of obj is not modified.
+func (p *PostgresClient) save(ctx context.Context, api PostgresAPI, obj *database.Object, converted resources.ID, expectedETag database.ETag) (database.WatchEventType, database.ETag, error) {
 	// Compute ETag for the current state of the object.
 	raw, err := json.Marshal(obj.Data)
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
-	obj.ETag = etag.New(raw)
+	newETag := etag.New(raw)
 
 	// We need different SQL for the case where an etag is provided vs not provided.
 	//
@@ -345,15 +389,16 @@ WITH updated AS (
 	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
 	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	ON CONFLICT (id) 
-	DO UPDATE SET resource_data = $7
-	RETURNING id
+	DO UPDATE SET resource_data = $7, etag = $6
+	RETURNING id, (xmax = 0) AS inserted
 )
 SELECT
 CASE
 	WHEN EXISTS (SELECT 1 FROM updated) THEN 'Success'
 	WHEN EXISTS (SELECT 1 FROM resources WHERE id = $1) THEN 'ErrConcurrency'
 	ELSE 'ErrNotFound'
-END AS result;`
+END AS result,
+COALESCE((SELECT inserted FROM updated), FALSE) AS inserted;`
 
 	args := []any{
 		databaseutil.NormalizePart(converted.String()),
@@ -361,62 +406,323 @@ END AS result;`
 		databaseutil.NormalizePart(converted.Type()),
 		databaseutil.NormalizePart(converted.RootScope()),
 		databaseutil.NormalizePart(converted.RoutingScope()),
-		obj.ETag,
+		newETag,
 		obj.Data,
 	}
 
-	if config.ETag != "" {
+	if expectedETag != "" {
 		// This is the simpler query that only performs updates. It requires an etag.
 		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
 		sql = `
 WITH updated AS (
-	UPDATE resources SET resource_data = $2
+	UPDATE resources SET resource_data = $2, etag = $4
 	WHERE id = $1 AND etag = $3
-	RETURNING id
+	RETURNING id, FALSE AS inserted
 )
 SELECT
 CASE
 	WHEN EXISTS (SELECT 1 FROM updated) THEN 'Success'
 	WHEN EXISTS (SELECT 1 FROM resources WHERE id = $1) THEN 'ErrConcurrency'
 	ELSE 'ErrConcurrency'
-END AS result;`
+END AS result,
+COALESCE((SELECT inserted FROM updated), FALSE) AS inserted;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag}
+		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, expectedETag, newETag}
 	}
 
 	result := ""
-	err = p.api.QueryRow(ctx, sql, args...).Scan(&result)
+	inserted := false
+	err = api.QueryRow(ctx, sql, args...).Scan(&result, &inserted)
 	if err != nil {
-		return err
+		return "", "", err
 	} else if result == "ErrNotFound" {
-		return &database.ErrNotFound{ID: obj.ID}
+		return "", "", &database.ErrNotFound{ID: obj.ID}
 	} else if result == "ErrConcurrency" {
-		return &database.ErrConcurrency{}
+		return "", "", &database.ErrConcurrency{}
+	}
+
+	if inserted {
+		return database.WatchEventCreated, newETag, nil
+	}
+
+	return database.WatchEventUpdated, newETag, nil
+}
+
+// Transact implements database.Client.
+func (p *PostgresClient) Transact(ctx context.Context, operations ...database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	ids, err := databaseutil.ParseOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.api.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Rollback is a no-op if the transaction has been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	etags := make([]database.ETag, len(operations))
+	for i, operation := range operations {
+		switch operation.Type {
+		case database.OperationTypeSave:
+			eventType, newETag, err := p.save(ctx, tx, operation.Object, ids[i], operation.ETag)
+			if err != nil {
+				return databaseutil.OperationError(i, operation, err)
+			}
+
+			etags[i] = newETag
+
+			// Notifications sent inside a transaction are only delivered if the transaction commits.
+			err = p.notify(ctx, tx, eventType, operation.Object.ID, newETag)
+			if err != nil {
+				return err
+			}
+
+		case database.OperationTypeDelete:
+			deleted, err := p.delete(ctx, tx, operation.ID, ids[i], operation.ETag)
+			if err != nil {
+				return databaseutil.OperationError(i, operation, err)
+			}
+
+			err = p.notify(ctx, tx, database.WatchEventDeleted, deleted.ID, deleted.ETag)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, operation := range operations {
+		if operation.Type == database.OperationTypeSave {
+			operation.Object.ETag = etags[i]
+		}
 	}
 
 	return nil
 }
 
-// createPaginationToken converts a timestamp to a base64 encoded string.
+// NotificationChannel is the name of the PostgreSQL NOTIFY channel used to report changes to resources.
+const NotificationChannel = "radius_resources"
+
+// notification is the payload sent on NotificationChannel. The payload of a NOTIFY is limited to 8000 bytes
+// so the resource data is not included. Watchers read the current state of the resource instead.
+type notification struct {
+	Type database.WatchEventType `json:"type"`
+	ID   string                  `json:"id"`
+	ETag string                  `json:"etag"`
+}
+
+// notify sends a change notification for watchers using the provided API. When called inside a transaction
+// the notification is only delivered if the transaction commits.
+func (p *PostgresClient) notify(ctx context.Context, api PostgresAPI, eventType database.WatchEventType, id string, etag string) error {
+	payload, err := json.Marshal(notification{Type: eventType, ID: id, ETag: etag})
+	if err != nil {
+		return err
+	}
+
+	_, err = api.Exec(ctx, "SELECT pg_notify($1, $2)", NotificationChannel, string(payload))
+	return err
+}
+
+// PostgresConnectionAcquirer is implemented by PostgresAPI implementations that can provide a dedicated
+// connection, such as pgxpool.Pool. This is required for Watch because LISTEN is scoped to a connection.
+type PostgresConnectionAcquirer interface {
+	// Acquire returns a connection from the pool.
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+var _ database.Watcher = (*PostgresClient)(nil)
+
+// Watch implements database.Watcher.
+//
+// Watch uses LISTEN/NOTIFY and so reports changes made by any PostgresClient connected to the same database.
+// Each watch holds a dedicated connection until the context is cancelled.
+func (p *PostgresClient) Watch(ctx context.Context, query database.Query) (<-chan database.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	acquirer, ok := p.api.(PostgresConnectionAcquirer)
+	if !ok {
+		return nil, errors.New("watch is not supported: the PostgreSQL connection does not support acquiring a dedicated connection")
+	}
+
+	pooled, err := acquirer.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The connection is taken out of the pool so that it is never reused while it is still listening.
+	conn := pooled.Hijack()
+
+	// LISTEN must complete before we return so that no changes made after Watch returns are missed.
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{NotificationChannel}.Sanitize())
+	if err != nil {
+		_ = conn.Close(context.Background())
+		return nil, err
+	}
+
+	events := make(chan database.WatchEvent)
+	go func() {
+		defer close(events)
+		defer func() { _ = conn.Close(context.Background()) }()
+
+		for {
+			received, err := conn.WaitForNotification(ctx)
+			if err != nil {
+				// Either the context was cancelled or the connection was lost.
+				return
+			}
+
+			event, ok := p.readEvent(ctx, received.Payload)
+			if !ok {
+				continue
+			}
+
+			match, err := databaseutil.EventMatchesQuery(event, query)
+			if err != nil || !match {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// readEvent converts a notification payload to a watch event. The result is false if the event should be skipped.
+func (p *PostgresClient) readEvent(ctx context.Context, payload string) (database.WatchEvent, bool) {
+	n := notification{}
+	err := json.Unmarshal([]byte(payload), &n)
+	if err != nil {
+		return database.WatchEvent{}, false
+	}
+
+	if n.Type == database.WatchEventDeleted {
+		return database.WatchEvent{Type: n.Type, Object: database.Object{Metadata: database.Metadata{ID: n.ID, ETag: n.ETag}}}, true
+	}
+
+	obj, err := p.Get(ctx, n.ID)
+	if err != nil {
+		// The resource may have been deleted already, in which case we will receive a Deleted event.
+		return database.WatchEvent{}, false
+	}
+
+	// The resource has changed again since this notification was sent. Skip it, the later notification
+	// will report the current state.
+	if obj.ETag != n.ETag {
+		return database.WatchEvent{}, false
+	}
+
+	return database.WatchEvent{Type: n.Type, Object: *obj}, true
+}
+
+// filterClause returns the SQL condition for a query filter and the updated list of arguments.
 //
-// We use ISO8601/RFC3339 format which postgres understands and can be used for comparison.
-// We also add microseconds to the timestamp to ensure uniqueness.
-func (p *PostgresClient) createPaginationToken(timestamp time.Time) (string, error) {
-	return base64.StdEncoding.EncodeToString([]byte(timestamp.UTC().Format(time.RFC3339Nano))), nil
+// The field path is passed as a TEXT[] parameter and used with the JSONB path operators:
+//
+//   - '#>' returns the JSONB value at the path (or NULL if it does not exist).
+//   - '#>>' returns the value at the path as TEXT.
+func filterClause(filter database.QueryFilter, args []any) (string, []any) {
+	args = append(args, strings.Split(filter.Field, "."))
+	value := fmt.Sprintf("(resource_data #> $%d::TEXT[])", len(args))
+	text := fmt.Sprintf("(resource_data #>> $%d::TEXT[])", len(args))
+	isString := fmt.Sprintf("jsonb_typeof(%s) = 'string'", value)
+
+	switch filter.EffectiveOperator() {
+	case database.FilterOperatorNotEqual:
+		args = append(args, filter.Value)
+		return fmt.Sprintf("NOT COALESCE(%s AND %s = $%d, FALSE)", isString, text, len(args)), args
+
+	case database.FilterOperatorIn:
+		args = append(args, filter.Values)
+		return fmt.Sprintf("%s AND %s = ANY($%d::TEXT[])", isString, text, len(args)), args
+
+	case database.FilterOperatorExists:
+		return fmt.Sprintf("%s IS NOT NULL", value), args
+
+	case database.FilterOperatorPrefix:
+		args = append(args, filter.Value)
+		return fmt.Sprintf("%s AND starts_with(%s, $%d)", isString, text, len(args)), args
+
+	case database.FilterOperatorGreaterOrEqual:
+		// The "C" collation compares bytes, matching the other data stores regardless of the database locale.
+		args = append(args, filter.Value)
+		return fmt.Sprintf(`%s AND %s COLLATE "C" >= $%d`, isString, text, len(args)), args
+
+	case database.FilterOperatorLess:
+		args = append(args, filter.Value)
+		return fmt.Sprintf(`%s AND %s COLLATE "C" < $%d`, isString, text, len(args)), args
+
+	case database.FilterOperatorContains:
+		args = append(args, filter.Value)
+		return fmt.Sprintf("jsonb_typeof(%s) = 'array' AND %s @> jsonb_build_array($%d::TEXT)", value, value, len(args)), args
+
+	default:
+		args = append(args, filter.Value)
+		return fmt.Sprintf("%s AND %s = $%d", isString, text, len(args)), args
+	}
 }
 
-// parsePaginationToken converts a base64 encoded string to a timestamp.
-func (p *PostgresClient) parsePaginationToken(token string) (string, error) {
-	data, err := base64.StdEncoding.DecodeString(token)
+// paginationToken is the cursor encoded in the pagination token returned by Query.
+type paginationToken struct {
+	// CreatedAt is the created_at value of the last row in ISO8601/RFC3339 format which postgres understands
+	// and can be used for comparison.
+	CreatedAt string `json:"createdAt"`
+
+	// ID is the (normalized) id of the last row.
+	ID string `json:"id"`
+}
+
+// createPaginationToken converts a cursor to a base64 encoded string.
+func (p *PostgresClient) createPaginationToken(cursor paginationToken) (string, error) {
+	b, err := json.Marshal(cursor)
 	if err != nil {
 		return "", err
 	}
 
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// parsePaginationToken converts a base64 encoded string to a cursor.
+func (p *PostgresClient) parsePaginationToken(token string) (*paginationToken, error) {
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := paginationToken{}
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	// Roundtripping to ensure that we understand the data.
-	parsed, err := time.Parse(time.RFC3339Nano, string(data))
+	parsed, err := time.Parse(time.RFC3339Nano, cursor.CreatedAt)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	cursor.CreatedAt = parsed.UTC().Format(time.RFC3339Nano)
 
-	return parsed.UTC().Format(time.RFC3339Nano), nil
+	return &cursor, nil
 }
@@ -85,3 +85,14 @@ func (l *postgresLogger) QueryRow(ctx context.Context, sql string, args ...any)
 	l.t.Logf("Args:\n%s", spew.Sdump(args...))
 	return l.pool.QueryRow(ctx, sql, args...)
 }
+
+// Begin implements PostgresAPI.
+func (l *postgresLogger) Begin(ctx context.Context) (pgx.Tx, error) {
+	l.t.Logf("Beginning transaction")
+	return l.pool.Begin(ctx)
+}
+
+// Acquire implements PostgresConnectionAcquirer.
+func (l *postgresLogger) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	return l.pool.Acquire(ctx)
+}
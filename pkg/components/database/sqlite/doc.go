@@ -0,0 +1,21 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// sqlite contains an implementation of the Radius data store interface that stores data in an
+// embedded SQLite database file. This is suitable for single-node installations where neither
+// PostgreSQL nor the Kubernetes API Server are available. The driver is pure Go and does not
+// require cgo.
+package sqlite
@@ -0,0 +1,611 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+
+	// Registers the pure-Go "sqlite" driver with database/sql.
+	_ "modernc.org/sqlite"
+)
+
+// schema is the DDL used to initialize the database. It mirrors the PostgreSQL schema in deploy/init-db/db.sql.txt.
+//
+// The main differences are:
+//
+//   - 'sequence' replaces 'created_at' as the cursor for pagination. SQLite timestamps only have
+//     millisecond precision which is not enough to guarantee a stable ordering.
+//   - 'resource_data' is stored as TEXT and queried using the built-in JSON functions.
+const schema = `
+CREATE TABLE IF NOT EXISTS resources (
+	sequence INTEGER PRIMARY KEY AUTOINCREMENT,
+	id TEXT NOT NULL UNIQUE,
+	original_id TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	root_scope TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	etag TEXT NOT NULL,
+	resource_data TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_resource_query ON resources (resource_type, root_scope);`
+
+// Open opens (or creates) the SQLite database file at the provided path and returns a client for it.
+// The schema is created if it does not already exist.
+//
+// The special path ":memory:" can be used to create a non-persistent database for testing.
+func Open(ctx context.Context, path string) (*SQLiteClient, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	// busy_timeout lets concurrent processes wait for the file lock instead of failing immediately.
+	dsn := path
+	if path != ":memory:" {
+		dsn = "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	}
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite only supports a single writer at a time. Using a single connection serializes
+	// access within the process and is required for ":memory:" databases, where every connection
+	// would otherwise see its own private database.
+	db.SetMaxOpenConns(1)
+
+	client := NewSQLiteClient(db)
+	err = client.Initialize(ctx)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// NewSQLiteClient creates a new SQLiteClient. The caller is responsible for calling Initialize
+// before using the client if the schema may not exist.
+func NewSQLiteClient(db *sql.DB) *SQLiteClient {
+	return &SQLiteClient{db: db, hub: databaseutil.NewWatchHub()}
+}
+
+var _ database.Client = (*SQLiteClient)(nil)
+var _ database.Watcher = (*SQLiteClient)(nil)
+
+// SQLiteClient is a database client that uses SQLite as the backend.
+type SQLiteClient struct {
+	db *sql.DB
+
+	// hub distributes changes made through this client to watchers.
+	hub *databaseutil.WatchHub
+}
+
+// Initialize creates the database schema if it does not exist.
+func (c *SQLiteClient) Initialize(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, schema)
+	if err != nil {
+		return fmt.Errorf("failed to initialize SQLite schema: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the underlying database.
+func (c *SQLiteClient) Close() error {
+	return c.db.Close()
+}
+
+// Delete implements database.Client.
+func (c *SQLiteClient) Delete(ctx context.Context, id string, options ...database.DeleteOptions) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return &database.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return &database.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	converted, err := databaseutil.ConvertScopeIDToResourceID(parsed)
+	if err != nil {
+		return err
+	}
+
+	config := database.NewDeleteConfig(options...)
+
+	deleted, err := c.delete(ctx, c.db, id, converted, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	c.hub.Publish(database.WatchEvent{Type: database.WatchEventDeleted, Object: deleted})
+	return nil
+}
+
+// executor is the subset of the database/sql API shared by *sql.DB and *sql.Tx.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// delete deletes a resource and returns the last known state of the resource for watchers.
+func (c *SQLiteClient) delete(ctx context.Context, exec executor, id string, converted resources.ID, expectedETag database.ETag) (database.Object, error) {
+	// RETURNING gives us the last known state of the object for watchers.
+	statement := "DELETE FROM resources WHERE id = ? RETURNING original_id, etag"
+	args := []any{databaseutil.NormalizePart(converted.String())}
+	if expectedETag != "" {
+		statement = "DELETE FROM resources WHERE id = ? AND etag = ? RETURNING original_id, etag"
+		args = append(args, expectedETag)
+	}
+
+	deleted := database.Object{}
+	err := exec.QueryRowContext(ctx, statement, args...).Scan(&deleted.ID, &deleted.ETag)
+	if errors.Is(err, sql.ErrNoRows) && expectedETag != "" {
+		// When an ETag is provided we report ErrConcurrency for all failure cases, including a missing resource.
+		return database.Object{}, &database.ErrConcurrency{}
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return database.Object{}, &database.ErrNotFound{ID: id}
+	} else if err != nil {
+		return database.Object{}, err
+	}
+
+	return deleted, nil
+}
+
+// Get implements database.Client.
+func (c *SQLiteClient) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	converted, err := databaseutil.ConvertScopeIDToResourceID(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := database.Object{}
+	raw := ""
+	err = c.db.QueryRowContext(
+		ctx,
+		"SELECT original_id, etag, resource_data FROM resources WHERE id = ?",
+		databaseutil.NormalizePart(converted.String())).Scan(&obj.ID, &obj.ETag, &raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &database.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(raw), &obj.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Query implements database.Client.
+func (c *SQLiteClient) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	config := database.NewQueryConfig(options...)
+
+	// For a scope query, we need to perform the same normalization as we do for other operations on scopes.
+	resourceType := query.ResourceType
+	if query.IsScopeQuery {
+		resourceType, err = databaseutil.ConvertScopeTypeToResourceType(query.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cursor *int64
+	if config.PaginationToken != "" {
+		sequence, err := c.parsePaginationToken(config.PaginationToken)
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
+		}
+		cursor = &sequence
+	}
+
+	// NOTE: building SQL by concatenating strings is hard to do safely and should be avoided.
+	// If you need to work on this code MAKE SURE you use SQL parameters
+	// for any user input.
+	//
+	// We use substr() rather than LIKE for prefix matching because LIKE treats '_' and '%' as wildcards.
+	rootScope := databaseutil.NormalizePart(query.RootScope)
+	sb := strings.Builder{}
+	sb.WriteString(`
+SELECT sequence, original_id, etag, resource_data
+FROM resources
+WHERE resource_type = ?`)
+	args := []any{databaseutil.NormalizePart(resourceType)}
+
+	if query.ScopeRecursive {
+		// If ScopeRecursive is true, the RootScope must be a prefix of the stored RootScope.
+		sb.WriteString(" AND substr(root_scope, 1, length(?)) = ?")
+		args = append(args, rootScope, rootScope)
+	} else {
+		// If ScopeRecursive is false, the RootScope must match exactly.
+		sb.WriteString(" AND root_scope = ?")
+		args = append(args, rootScope)
+	}
+
+	if query.RoutingScopePrefix != "" {
+		// RoutingScopePrefix is optional and always treated as as prefix.
+		prefix := databaseutil.NormalizePart(query.RoutingScopePrefix)
+		sb.WriteString(" AND substr(routing_scope, 1, length(?)) = ?")
+		args = append(args, prefix, prefix)
+	}
+
+	// Filters have the same semantics as database.Object.MatchesFilters.
+	for _, filter := range query.Filters {
+		var clause string
+		clause, args = filterClause(filter, args)
+		sb.WriteString(" AND (" + clause + ")")
+	}
+
+	if cursor != nil {
+		sb.WriteString(" AND sequence > ?")
+		args = append(args, *cursor)
+	}
+
+	sb.WriteString(" ORDER BY sequence ASC")
+
+	// We request one more row than the page size so we know whether there is another page.
+	if config.MaxQueryItemCount > 0 {
+		sb.WriteString(" LIMIT ?")
+		args = append(args, config.MaxQueryItemCount+1)
+	}
+
+	rows, err := c.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Capture the sequence of each row so we can use the last one for pagination.
+	sequences := []int64{}
+
+	result := database.ObjectQueryResult{}
+	for rows.Next() {
+		obj := database.Object{}
+		raw := ""
+		sequence := int64(0)
+		err := rows.Scan(&sequence, &obj.ID, &obj.ETag, &raw)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+
+		err = json.Unmarshal([]byte(raw), &obj.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Items = append(result.Items, obj)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+		// There is at least one more row, so trim the extra row and return a token for the next page.
+		result.Items = result.Items[:config.MaxQueryItemCount]
+		result.PaginationToken = c.createPaginationToken(sequences[config.MaxQueryItemCount-1])
+	}
+
+	return &result, nil
+}
+
+// Save implements database.Client.
+func (c *SQLiteClient) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'obj.ID' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return &database.ErrInvalid{Message: "invalid argument. 'obj.ID' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return &database.ErrInvalid{Message: "invalid argument. 'obj.ID' must refer to a named resource, not a collection"}
+	}
+
+	converted, err := databaseutil.ConvertScopeIDToResourceID(parsed)
+	if err != nil {
+		return err
+	}
+
+	config := database.NewSaveConfig(options...)
+
+	// The save may need two statements, so it runs in a transaction.
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	eventType, newETag, err := c.save(ctx, tx, obj, converted, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = newETag
+
+	c.hub.Publish(database.WatchEvent{Type: eventType, Object: *obj})
+	return nil
+}
+
+// save saves a resource and reports whether it was created or updated along with the new ETag. The ETag
+// of obj is not modified.
+//
+// When no ETag is provided, the insert and update are separate statements so that we can tell them apart
+// for watchers. The caller must run save in a transaction. Since the first statement is a write the
+// transaction holds the write lock for its entire duration.
+func (c *SQLiteClient) save(ctx context.Context, exec executor, obj *database.Object, converted resources.ID, expectedETag database.ETag) (database.WatchEventType, database.ETag, error) {
+	// Compute ETag for the current state of the object.
+	raw, err := json.Marshal(obj.Data)
+	if err != nil {
+		return "", "", err
+	}
+
+	newETag := etag.New(raw)
+	id := databaseutil.NormalizePart(converted.String())
+
+	if expectedETag != "" {
+		// If an etag is provided, we should not perform inserts, only updates.
+		result, err := exec.ExecContext(
+			ctx,
+			"UPDATE resources SET original_id = ?, etag = ?, resource_data = ? WHERE id = ? AND etag = ?",
+			obj.ID, newETag, string(raw), id, expectedETag)
+		if err != nil {
+			return "", "", err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return "", "", err
+		}
+
+		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
+		if affected == 0 {
+			return "", "", &database.ErrConcurrency{}
+		}
+
+		return database.WatchEventUpdated, newETag, nil
+	}
+
+	result, err := exec.ExecContext(
+		ctx,
+		`INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO NOTHING`,
+		id,
+		obj.ID, // MUST NOT BE NORMALIZED. Preserve the original casing and format.
+		databaseutil.NormalizePart(converted.Type()),
+		databaseutil.NormalizePart(converted.RootScope()),
+		databaseutil.NormalizePart(converted.RoutingScope()),
+		newETag,
+		string(raw))
+	if err != nil {
+		return "", "", err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return "", "", err
+	}
+
+	if inserted > 0 {
+		return database.WatchEventCreated, newETag, nil
+	}
+
+	_, err = exec.ExecContext(
+		ctx,
+		"UPDATE resources SET original_id = ?, etag = ?, resource_data = ? WHERE id = ?",
+		obj.ID, newETag, string(raw), id)
+	if err != nil {
+		return "", "", err
+	}
+
+	return database.WatchEventUpdated, newETag, nil
+}
+
+// Transact implements database.Client.
+func (c *SQLiteClient) Transact(ctx context.Context, operations ...database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	ids, err := databaseutil.ParseOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	events := make([]database.WatchEvent, len(operations))
+	for i, operation := range operations {
+		switch operation.Type {
+		case database.OperationTypeSave:
+			eventType, newETag, err := c.save(ctx, tx, operation.Object, ids[i], operation.ETag)
+			if err != nil {
+				return databaseutil.OperationError(i, operation, err)
+			}
+
+			events[i] = database.WatchEvent{Type: eventType, Object: database.Object{Metadata: database.Metadata{ID: operation.Object.ID, ETag: newETag}}}
+
+		case database.OperationTypeDelete:
+			deleted, err := c.delete(ctx, tx, operation.ID, ids[i], operation.ETag)
+			if err != nil {
+				return databaseutil.OperationError(i, operation, err)
+			}
+
+			events[i] = database.WatchEvent{Type: database.WatchEventDeleted, Object: deleted}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	for i, operation := range operations {
+		if operation.Type == database.OperationTypeSave {
+			operation.Object.ETag = events[i].Object.ETag
+			events[i].Object = *operation.Object
+		}
+
+		c.hub.Publish(events[i])
+	}
+
+	return nil
+}
+
+// Watch implements database.Watcher.
+//
+// SQLite has no change notification mechanism that works across processes, so only changes made through
+// this client are reported.
+func (c *SQLiteClient) Watch(ctx context.Context, query database.Query) (<-chan database.WatchEvent, error) {
+	return c.hub.Subscribe(ctx, query)
+}
+
+// filterClause returns the SQL condition for a query filter and the updated list of arguments.
+func filterClause(filter database.QueryFilter, args []any) (string, []any) {
+	path := jsonPath(filter.Field)
+	const isString = "json_type(resource_data, ?) = 'text'"
+	const text = "json_extract(resource_data, ?)"
+
+	switch filter.EffectiveOperator() {
+	case database.FilterOperatorNotEqual:
+		return "NOT COALESCE(" + isString + " AND " + text + " = ?, 0)", append(args, path, path, filter.Value)
+
+	case database.FilterOperatorIn:
+		args = append(args, path, path)
+		placeholders := make([]string, len(filter.Values))
+		for i, value := range filter.Values {
+			placeholders[i] = "?"
+			args = append(args, value)
+		}
+		return isString + " AND " + text + " IN (" + strings.Join(placeholders, ", ") + ")", args
+
+	case database.FilterOperatorExists:
+		return "json_type(resource_data, ?) IS NOT NULL", append(args, path)
+
+	case database.FilterOperatorPrefix:
+		return isString + " AND substr(" + text + ", 1, length(?)) = ?", append(args, path, path, filter.Value, filter.Value)
+
+	case database.FilterOperatorGreaterOrEqual:
+		return isString + " AND " + text + " >= ?", append(args, path, path, filter.Value)
+
+	case database.FilterOperatorLess:
+		return isString + " AND " + text + " < ?", append(args, path, path, filter.Value)
+
+	case database.FilterOperatorContains:
+		return "json_type(resource_data, ?) = 'array' AND EXISTS (SELECT 1 FROM json_each(resource_data, ?) WHERE json_each.type = 'text' AND json_each.value = ?)",
+			append(args, path, path, filter.Value)
+
+	default:
+		return isString + " AND " + text + " = ?", append(args, path, path, filter.Value)
+	}
+}
+
+// jsonPath converts a '.' separated field path into a SQLite JSON path. Each segment is quoted
+// so that characters like '$' are treated literally.
+//
+// The field has already been validated by database.QueryFilter.Validate so it cannot contain quotes.
+func jsonPath(field string) string {
+	sb := strings.Builder{}
+	sb.WriteString("$")
+	for _, segment := range strings.Split(field, ".") {
+		sb.WriteString(`."`)
+		sb.WriteString(segment)
+		sb.WriteString(`"`)
+	}
+
+	return sb.String()
+}
+
+// createPaginationToken converts a sequence number to a base64 encoded string.
+func (c *SQLiteClient) createPaginationToken(sequence int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(sequence, 10)))
+}
+
+// parsePaginationToken converts a base64 encoded string to a sequence number.
+func (c *SQLiteClient) parsePaginationToken(token string) (int64, error) {
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(data), 10, 64)
+}
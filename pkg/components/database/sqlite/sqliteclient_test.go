@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+)
+
+func Test_SQLiteClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	client, err := Open(ctx, filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	clear := func(t *testing.T) {
+		_, err := client.db.ExecContext(ctx, "DELETE FROM resources")
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+}
+
+func Test_SQLiteClient_Persistence(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	path := filepath.Join(t.TempDir(), "radius.db")
+
+	client, err := Open(ctx, path)
+	require.NoError(t, err)
+
+	obj := database.Object{Metadata: database.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data1}
+	err = client.Save(ctx, &obj)
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+
+	// Reopening the same file must preserve the data and schema.
+	client, err = Open(ctx, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	got, err := client.Get(ctx, shared.Resource1ID.String())
+	require.NoError(t, err)
+	require.Equal(t, obj.ETag, got.ETag)
+	require.Equal(t, obj.ID, got.ID)
+}
+
+func Test_SQLiteClient_Open_RequiresPath(t *testing.T) {
+	client, err := Open(testcontext.New(t), "")
+	require.Error(t, err)
+	require.Nil(t, client)
+}
+
+func Test_jsonPath(t *testing.T) {
+	require.Equal(t, `$."location"`, jsonPath("location"))
+	require.Equal(t, `$."properties"."application"`, jsonPath("properties.application"))
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import "errors"
+
+// OperationType is the type of a single operation in a transaction.
+type OperationType string
+
+const (
+	// OperationTypeSave saves an object. See Client.Save.
+	OperationTypeSave OperationType = "Save"
+
+	// OperationTypeDelete deletes an object. See Client.Delete.
+	OperationTypeDelete OperationType = "Delete"
+)
+
+// Operation is a single save or delete as part of a call to Client.Transact.
+//
+// Use SaveOperation and DeleteOperation to create operations.
+type Operation struct {
+	// Type is the type of the operation.
+	Type OperationType
+
+	// Object is the object to save. This is required for Save operations. The ETag field of the object
+	// is updated only if the transaction succeeds.
+	Object *Object
+
+	// ID is the resource id to delete. This is required for Delete operations.
+	ID string
+
+	// ETag is the optional ETag used for optimistic concurrency control. Behaves the same way as
+	// WithETag for Save and Delete.
+	ETag ETag
+}
+
+// SaveOperation creates an Operation that saves obj.
+func SaveOperation(obj *Object, options ...SaveOptions) Operation {
+	config := NewSaveConfig(options...)
+	return Operation{Type: OperationTypeSave, Object: obj, ETag: config.ETag}
+}
+
+// DeleteOperation creates an Operation that deletes the resource with the given id.
+func DeleteOperation(id string, options ...DeleteOptions) Operation {
+	config := NewDeleteConfig(options...)
+	return Operation{Type: OperationTypeDelete, ID: id, ETag: config.ETag}
+}
+
+// ResourceID returns the resource id the operation applies to.
+func (o Operation) ResourceID() string {
+	if o.Type == OperationTypeSave && o.Object != nil {
+		return o.Object.ID
+	}
+
+	return o.ID
+}
+
+// Validate validates the operation.
+func (o Operation) Validate() error {
+	switch o.Type {
+	case OperationTypeSave:
+		if o.Object == nil {
+			return errors.New("'Object' is required for Save operations")
+		}
+	case OperationTypeDelete:
+		if o.ID == "" {
+			return errors.New("'ID' is required for Delete operations")
+		}
+	default:
+		return errors.New("'Type' must be either Save or Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import "context"
+
+// Watcher is an optional interface implemented by Client implementations that can stream changes.
+//
+// Callers should use a type assertion to check whether a Client supports watching:
+//
+//	if watcher, ok := client.(database.Watcher); ok {
+//		events, err := watcher.Watch(ctx, query)
+//		...
+//	}
+type Watcher interface {
+	// Watch streams changes to objects that match the query. The scope, resource type, and routing scope
+	// fields of the query are applied to all events. Filters are applied to Created and Updated events.
+	// Deleted events are delivered for every object that matches the scope and type because the data of
+	// the object may no longer be available.
+	//
+	// Watch only reports changes that occur after it returns, it does not replay existing objects. Use Query
+	// to read the current state before or after starting a watch. Consumers should treat Created and Updated
+	// events as upserts, since some implementations may coalesce rapid changes.
+	//
+	// The returned channel is closed when the context is cancelled or the watch can no longer be continued.
+	// Pagination options are not supported.
+	Watch(ctx context.Context, query Query) (<-chan WatchEvent, error)
+}
+
+// WatchEventType is the type of change reported by a WatchEvent.
+type WatchEventType string
+
+const (
+	// WatchEventCreated is reported when an object is created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated is reported when an existing object is updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted is reported when an object is deleted.
+	WatchEventDeleted WatchEventType = "Deleted"
+)
+
+// WatchEvent describes a change to an object in the data store.
+type WatchEvent struct {
+	// Type is the type of change.
+	Type WatchEventType
+
+	// Object is the object that was changed. For Created and Updated events the Object contains the
+	// data and ETag after the change. For Deleted events the Object contains the ID and the last known ETag,
+	// the Data may be nil.
+	Object Object
+}
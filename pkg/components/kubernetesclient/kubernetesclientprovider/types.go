@@ -30,6 +30,8 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	csidriver "sigs.k8s.io/secrets-store-csi-driver/apis/v1alpha1"
 
 	// Import kubernetes auth plugins
@@ -179,6 +181,8 @@ func (k *KubernetesClientProvider) RuntimeClient() (runtimeclient.Client, error)
 	utilruntime.Must(csidriver.AddToScheme(scheme))
 	utilruntime.Must(apiextv1.AddToScheme(scheme))
 	utilruntime.Must(contourv1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1beta1.Install(scheme))
 
 	return runtimeclient.New(k.Config(), runtimeclient.Options{Scheme: scheme})
 }
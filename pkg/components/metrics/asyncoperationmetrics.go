@@ -18,11 +18,13 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 
 	"go.opentelemetry.io/otel"
@@ -42,6 +44,10 @@ const (
 
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// AsyncOperationQueueWaitDuration is the metric name for the time an async operation waited in the queue
+	// before it started processing.
+	AsyncOperationQueueWaitDuration = "asyncoperation.queue.wait.duration"
 )
 
 type asyncOperationMetrics struct {
@@ -82,6 +88,11 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.valueRecorders[AsyncOperationQueueWaitDuration], err = meter.Float64Histogram(AsyncOperationQueueWaitDuration)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -123,6 +134,30 @@ func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context
 	}
 }
 
+// RecordAsyncOperationQueueWaitDuration records the time in milliseconds that the async operation message waited
+// between being enqueued and starting processing, with the resource type, the operation type and the priority of the
+// message as attributes. The fairness key is not used as an attribute because it identifies a resource group or an
+// environment, so the number of series would be unbounded.
+func (a *asyncOperationMetrics) RecordAsyncOperationQueueWaitDuration(ctx context.Context, msg *queue.Message) {
+	if a.valueRecorders[AsyncOperationQueueWaitDuration] != nil {
+		elapsedTime := float64(time.Since(msg.EnqueueAt)) / float64(time.Millisecond)
+		a.valueRecorders[AsyncOperationQueueWaitDuration].Record(ctx, elapsedTime,
+			metric.WithAttributes(newAsyncOperationQueueWaitAttributes(msg)...),
+		)
+	}
+}
+
+func newAsyncOperationQueueWaitAttributes(msg *queue.Message) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{operationPriorityAttrKey.String(msg.Priority.String())}
+
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(msg.Data, req); err == nil {
+		attrs = append(attrs, newAsyncOperationCommonAttributes(req, nil)...)
+	}
+
+	return attrs
+}
+
 func newAsyncOperationCommonAttributes(req *ctrl.Request, res *ctrl.Result) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
 
@@ -33,6 +33,9 @@ const (
 	// operationErrorCodeAttrKey is the attribute name for the operation error code.
 	operationErrorCodeAttrKey = attribute.Key("operation_error_code")
 
+	// operationPriorityAttrKey is the attribute name for the priority of an async operation.
+	operationPriorityAttrKey = attribute.Key("operation_priority")
+
 	// recipeNameAttrKey is the attribute name for the recipe name.
 	recipeNameAttrKey = attribute.Key("recipe_name")
 
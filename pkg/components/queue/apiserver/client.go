@@ -49,6 +49,15 @@ limitations under the License.
 // and checks if its dequeue count matches the dequeue count of Message Client A currently have. We are using DequeueCount as a
 // revision number of message here. If it is mismatched, it means that Client B already leased the message. In this case,
 // ExtendMessage returns ErrDequeuedMessage to prevent Client A from extending lock.
+//
+// The priority class of the message (high, normal or low) is stored in the `ucp.dev/priority` label so that Dequeue can
+// query the oldest message of the highest class without listing the whole queue. Messages without the label, such as
+// the messages enqueued by a previous version, are treated as normal priority. The fairness key is stored in an
+// annotation and returned with the message, the worker shares the processing capacity between fairness keys.
+//
+// Dead-lettered messages stay in place and are marked with the `ucp.dev/deadletter` label, which excludes them from
+// Dequeue. The reason and time are stored in annotations. Replaying a dead-lettered message removes the label and
+// resets DequeueCount so that the message is processed as if it was newly enqueued.
 
 package apiserver
 
@@ -62,6 +71,7 @@ import (
 
 	v1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/queue"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -75,6 +85,21 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelPriority is the label representing the priority class of the message.
+	LabelPriority = "ucp.dev/priority"
+	// LabelDeadLetter is the label marking the message as dead-lettered.
+	LabelDeadLetter = "ucp.dev/deadletter"
+
+	// AnnotationDeadLetterReason is the annotation representing the reason why the message was dead-lettered.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
+	// AnnotationDeadLetteredAt is the annotation representing the time when the message was dead-lettered.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
+	// AnnotationReplayCount is the annotation representing the number of times the message was replayed.
+	AnnotationReplayCount = "ucp.dev/replaycount"
+	// AnnotationPriority is the annotation representing the priority of the message.
+	AnnotationPriority = "ucp.dev/priority"
+	// AnnotationFairnessKey is the annotation representing the fairness bucket of the message.
+	AnnotationFairnessKey = "ucp.dev/fairnesskey"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
@@ -123,12 +148,21 @@ func copyMessage(msg *queue.Message, queueMessage *v1alpha1.QueueMessage) {
 		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		ReplayCount:   int(mustParseInt64(queueMessage.Annotations[AnnotationReplayCount])),
+		Priority:      queue.Priority(mustParseInt64(queueMessage.Annotations[AnnotationPriority])),
+		FairnessKey:   queueMessage.Annotations[AnnotationFairnessKey],
 	}
 	msg.ContentType = queue.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
 	copy(msg.Data, queueMessage.Spec.Data.Raw)
 }
 
+func copyDeadLetter(deadLetter *queue.DeadLetter, queueMessage *v1alpha1.QueueMessage) {
+	copyMessage(&deadLetter.Message, queueMessage)
+	deadLetter.Reason = queueMessage.Annotations[AnnotationDeadLetterReason]
+	deadLetter.DeadLetteredAt = getTimeFromString(queueMessage.Annotations[AnnotationDeadLetteredAt]).UTC()
+}
+
 // New creates the queue backed by Kubernetes API server KV store. name is unique name for each service which will consume the queue.
 func New(client runtimeclient.Client, options Options) (*Client, error) {
 	if options.Name == "" || options.Namespace == "" {
@@ -176,6 +210,11 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 			Labels: map[string]string{
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     c.opts.Name,
+				LabelPriority:      msg.Priority.String(),
+			},
+			Annotations: map[string]string{
+				AnnotationPriority:    int64toa(int64(msg.Priority)),
+				AnnotationFairnessKey: msg.FairnessKey,
 			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
@@ -190,8 +229,8 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 	return c.client.Create(ctx, resource)
 }
 
-func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error) {
-	selector := labels.NewSelector()
+func newMessageLabelSelector(now time.Time, name string, priority ...labels.Requirement) (labels.Selector, error) {
+	selector := labels.NewSelector().Add(priority...)
 
 	// To determine whether the message is currently leased by client or not, it uses NextVisibleAt timestamp.
 	// For example, if NextVisibleAt time is less than current time, the message has been requeued or never
@@ -203,6 +242,12 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	}
 	selector = selector.Add(*nextVisibleLabel)
 
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel)
+
 	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
 	if err != nil {
 		return nil, err
@@ -211,13 +256,81 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	return selector.Add(*nameLabel), nil
 }
 
-// getQueueMessage fetches the first item which is the message in the current queue. We can
+func newDeadLetterLabelSelector(name string) (labels.Selector, error) {
+	selector := labels.NewSelector()
+
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel)
+
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*nameLabel), nil
+}
+
+// newPriorityRequirement returns the label requirement matching the messages of the given priority class. Messages
+// without the priority label match the normal priority class.
+func newPriorityRequirement(priority queue.Priority) (*labels.Requirement, error) {
+	switch priority.String() {
+	case queue.PriorityNormal.String():
+		return labels.NewRequirement(LabelPriority, selection.NotIn, []string{queue.PriorityHigh.String(), queue.PriorityLow.String()})
+	default:
+		return labels.NewRequirement(LabelPriority, selection.Equals, []string{priority.String()})
+	}
+}
+
+// getQueueMessage fetches the oldest message of the highest priority class in the current queue. We can
 // determine whether the message is leased by another client by checking if `NextVisibleAt“
 // value is less than `now`.
+//
+// The oldest message of any class is fetched first, so that an empty queue needs a single query. Higher classes
+// are only queried if that message does not belong to the highest class.
 func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.QueueMessage, error) {
+	oldest, err := c.getOldestQueueMessage(ctx, now, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	oldestPriority := queue.Priority(mustParseInt64(oldest.Annotations[AnnotationPriority]))
+	for _, priority := range []queue.Priority{queue.PriorityHigh, queue.PriorityNormal} {
+		if oldestPriority.String() == priority.String() {
+			return oldest, nil
+		}
+
+		requirement, err := newPriorityRequirement(priority)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := c.getOldestQueueMessage(ctx, now, requirement)
+		if errors.Is(err, queue.ErrMessageNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return item, nil
+	}
+
+	return oldest, nil
+}
+
+// getOldestQueueMessage fetches the first visible message in the current queue, optionally restricted to a
+// priority class.
+func (c *Client) getOldestQueueMessage(ctx context.Context, now time.Time, priority *labels.Requirement) (*v1alpha1.QueueMessage, error) {
 	ql := &v1alpha1.QueueMessageList{}
 
-	selector, err := newMessageLabelSelector(now, c.opts.Name)
+	requirements := []labels.Requirement{}
+	if priority != nil {
+		requirements = append(requirements, *priority)
+	}
+
+	selector, err := newMessageLabelSelector(now, c.opts.Name, requirements...)
 	if err != nil {
 		return nil, err
 	}
@@ -355,3 +468,129 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(getErr) {
+			return queue.ErrInvalidMessage
+		} else if getErr != nil {
+			return getErr
+		}
+
+		if _, ok := result.Labels[LabelDeadLetter]; ok {
+			return queue.ErrInvalidMessage
+		}
+
+		// Ensure that it doesn't dead-letter the message that another client leased.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return queue.ErrDequeuedMessage
+		}
+
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Labels[LabelDeadLetter] = "true"
+		result.Annotations[AnnotationDeadLetterReason] = reason
+		result.Annotations[AnnotationDeadLetteredAt] = int64toa(time.Now().UnixNano())
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	selector, err := newDeadLetterLabelSelector(c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*queue.DeadLetter{}
+	for i := range ql.Items {
+		deadLetter := &queue.DeadLetter{}
+		copyDeadLetter(deadLetter, &ql.Items[i])
+		result = append(result, deadLetter)
+	}
+
+	return result, nil
+}
+
+// getDeadLetter fetches the dead-lettered message with the given id. It returns ErrDeadLetterNotFound if the
+// message does not exist, belongs to another queue, or is not dead-lettered.
+func (c *Client) getDeadLetter(ctx context.Context, id string, result *v1alpha1.QueueMessage) error {
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return queue.ErrDeadLetterNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if _, ok := result.Labels[LabelDeadLetter]; !ok || result.Labels[LabelQueueName] != c.opts.Name {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	result := &v1alpha1.QueueMessage{}
+	if err := c.getDeadLetter(ctx, id, result); err != nil {
+		return nil, err
+	}
+
+	deadLetter := &queue.DeadLetter{}
+	copyDeadLetter(deadLetter, result)
+	return deadLetter, nil
+}
+
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.getDeadLetter(ctx, id, result); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		replayCount := mustParseInt64(result.Annotations[AnnotationReplayCount]) + 1
+
+		delete(result.Labels, LabelDeadLetter)
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		delete(result.Annotations, AnnotationDeadLetteredAt)
+		result.Annotations[AnnotationReplayCount] = int64toa(replayCount)
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		result.Spec.DequeueCount = 0
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.getDeadLetter(ctx, id, result); err != nil {
+			return err
+		}
+
+		options := &runtimeclient.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID:             &result.UID,
+				ResourceVersion: &result.ResourceVersion,
+			},
+		}
+		return c.client.Delete(ctx, result, options)
+	})
+}
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestMustParseInt64(t *testing.T) {
@@ -87,6 +88,65 @@ func TestCopyMessage(t *testing.T) {
 	require.Equal(t, getTimeFromString(queueM.ObjectMeta.Labels[LabelNextVisibleAt]), msg.NextVisibleAt)
 }
 
+func TestCopyDeadLetter(t *testing.T) {
+	now := time.Now()
+	queueM := &v1alpha1.QueueMessage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "applications.core.10101010",
+			Namespace: "radius-test",
+			Labels: map[string]string{
+				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelQueueName:     "applications.core",
+				LabelDeadLetter:    "true",
+			},
+			Annotations: map[string]string{
+				AnnotationDeadLetterReason: "test reason",
+				AnnotationDeadLetteredAt:   int64toa(now.UnixNano()),
+				AnnotationReplayCount:      "2",
+			},
+		},
+		Spec: v1alpha1.QueueMessageSpec{
+			DequeueCount: 4,
+			EnqueueAt:    metav1.Time{Time: now.UTC()},
+			ExpireAt:     metav1.Time{Time: now.Add(10 * time.Second).UTC()},
+			ContentType:  queue.JSONContentType,
+			Data:         &runtime.RawExtension{Raw: []byte("hello world")},
+		},
+	}
+
+	deadLetter := &queue.DeadLetter{}
+	copyDeadLetter(deadLetter, queueM)
+
+	require.Equal(t, queueM.ObjectMeta.Name, deadLetter.ID)
+	require.Equal(t, 4, deadLetter.DequeueCount)
+	require.Equal(t, 2, deadLetter.ReplayCount)
+	require.Equal(t, "test reason", deadLetter.Reason)
+	require.Equal(t, now.UnixNano(), deadLetter.DeadLetteredAt.UnixNano())
+	require.Equal(t, queueM.Spec.Data.Raw, deadLetter.Data)
+}
+
+func TestMessageLabelSelector(t *testing.T) {
+	now := time.Now()
+
+	selector, err := newMessageLabelSelector(now, "applications.core")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("!%s,%s<%d,%s=applications.core", LabelDeadLetter, LabelNextVisibleAt, now.UnixNano(), LabelQueueName), selector.String())
+
+	requirement, err := newPriorityRequirement(queue.PriorityHigh)
+	require.NoError(t, err)
+	selector, err = newMessageLabelSelector(now, "applications.core", *requirement)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("!%s,%s<%d,%s=high,%s=applications.core", LabelDeadLetter, LabelNextVisibleAt, now.UnixNano(), LabelPriority, LabelQueueName), selector.String())
+
+	requirement, err = newPriorityRequirement(queue.PriorityNormal)
+	require.NoError(t, err)
+	require.Equal(t, LabelPriority+" notin (high,low)", requirement.String())
+
+	selector, err = newDeadLetterLabelSelector("applications.core")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s,%s=applications.core", LabelDeadLetter, LabelQueueName), selector.String())
+}
+
 func TestGenerateID(t *testing.T) {
 	cli, err := New(nil, Options{Name: "applications.core", Namespace: "test"})
 	require.NoError(t, err)
@@ -96,6 +156,48 @@ func TestGenerateID(t *testing.T) {
 	require.Equal(t, 61, len(id))
 }
 
+func TestDequeue_Priority(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	ctx := testcontext.New(t)
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cli, err := New(rc, Options{Name: "applications.core", Namespace: "radius-test"})
+	require.NoError(t, err)
+
+	// The first message has no priority label, as if it was enqueued by a previous version.
+	legacy := queue.NewMessage("{}")
+	require.NoError(t, cli.Enqueue(ctx, legacy))
+	ql := &v1alpha1.QueueMessageList{}
+	require.NoError(t, rc.List(ctx, ql))
+	require.Len(t, ql.Items, 1)
+	delete(ql.Items[0].Labels, LabelPriority)
+	require.NoError(t, rc.Update(ctx, &ql.Items[0]))
+
+	for _, priority := range []queue.Priority{queue.PriorityLow, queue.PriorityHigh, queue.PriorityNormal} {
+		msg := queue.NewMessage("{}")
+		msg.Priority = priority
+		msg.FairnessKey = "test-bucket-" + priority.String()
+		require.NoError(t, cli.Enqueue(ctx, msg))
+	}
+
+	dequeued := []string{}
+	for i := 0; i < 4; i++ {
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		dequeued = append(dequeued, msg.FairnessKey)
+	}
+
+	// The order of messages enqueued within the same second is not defined.
+	require.Equal(t, "test-bucket-high", dequeued[0])
+	require.ElementsMatch(t, []string{"", "test-bucket-normal"}, dequeued[1:3])
+	require.Equal(t, "test-bucket-low", dequeued[3])
+
+	_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+}
+
 func TestClient(t *testing.T) {
 	rc, env, err := kubeenv.StartEnvironment([]string{filepath.Join("..", "..", "..", "..", "deploy", "Chart", "crds", "ucpd")})
 
@@ -39,6 +39,9 @@ var (
 
 	// ErrEmptyMessage represents nil or empty Message.
 	ErrEmptyMessage = errors.New("message must not be nil or message is empty")
+
+	// ErrDeadLetterNotFound represents the error when the message is not found in the dead-letter store.
+	ErrDeadLetterNotFound = errors.New("message was not found in the dead-letter store")
 )
 
 //go:generate mockgen -typed -destination=./mock_client.go -package=queue -self_package github.com/radius-project/radius/pkg/components/queue github.com/radius-project/radius/pkg/components/queue Client
@@ -56,6 +59,23 @@ type Client interface {
 
 	// ExtendMessage extends the message lock.
 	ExtendMessage(ctx context.Context, msg *Message) error
+
+	// DeadLetterMessage moves the leased message to the dead-letter store and records the reason. The message
+	// will not be dequeued again unless it is replayed.
+	DeadLetterMessage(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetters lists the messages in the dead-letter store.
+	ListDeadLetters(ctx context.Context) ([]*DeadLetter, error)
+
+	// GetDeadLetter gets the message with the given id from the dead-letter store.
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+
+	// ReplayDeadLetter moves the message with the given id from the dead-letter store back to the queue.
+	// The dequeue count of the message is reset and the replay count is incremented.
+	ReplayDeadLetter(ctx context.Context, id string) error
+
+	// PurgeDeadLetter deletes the message with the given id from the dead-letter store.
+	PurgeDeadLetter(ctx context.Context, id string) error
 }
 
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
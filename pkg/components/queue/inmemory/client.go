@@ -89,3 +89,32 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter store.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, reason)
+}
+
+// ListDeadLetters lists the messages in the dead-letter store.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetter gets the message with the given id from the dead-letter store.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	return c.queue.GetDeadLetter(id)
+}
+
+// ReplayDeadLetter moves the message with the given id from the dead-letter store back to the queue.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	return c.queue.ReplayDeadLetter(id)
+}
+
+// PurgeDeadLetter deletes the message with the given id from the dead-letter store.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	return c.queue.PurgeDeadLetter(id)
+}
@@ -43,12 +43,16 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters holds the dead-lettered messages in the order they were dead-lettered. It is guarded by vMu.
+	deadLetters *list.List
+
 	lockDuration time.Duration
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
+		deadLetters:  &list.List{},
 		lockDuration: lockDuration,
 	}
 }
@@ -63,6 +67,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	_ = q.deadLetters.Init()
 }
 
 func (q *InmemQueue) Enqueue(msg *queue.Message) {
@@ -79,23 +84,29 @@ func (q *InmemQueue) Enqueue(msg *queue.Message) {
 	q.v.PushBack(&element{val: msg, visible: true})
 }
 
+// Dequeue leases the oldest visible message with the highest priority.
 func (q *InmemQueue) Dequeue() *queue.Message {
 	q.updateQueue()
 
-	var found *queue.Message
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
 
-	q.elementRange(func(e *list.Element, elem *element) bool {
-		if elem.visible {
-			elem.val.DequeueCount++
-			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
-			elem.visible = false
-			found = elem.val
-			return true
+	var found *element
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
+		if elem.visible && (found == nil || elem.val.Priority > found.val.Priority) {
+			found = elem
 		}
-		return false
-	})
+	}
+
+	if found == nil {
+		return nil
+	}
 
-	return found
+	found.val.DequeueCount++
+	found.val.NextVisibleAt = time.Now().Add(q.lockDuration)
+	found.visible = false
+	return found.val
 }
 
 func (q *InmemQueue) Complete(msg *queue.Message) error {
@@ -141,6 +152,105 @@ func (q *InmemQueue) Extend(msg *queue.Message) error {
 	return nil
 }
 
+// DeadLetter moves the leased message to the dead-letter list.
+func (q *InmemQueue) DeadLetter(msg *queue.Message, reason string) error {
+	var err error = queue.ErrInvalidMessage
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID != msg.ID {
+			return false
+		}
+
+		if elem.val.DequeueCount != msg.DequeueCount {
+			err = queue.ErrDequeuedMessage
+			return true
+		}
+
+		q.v.Remove(e)
+		q.deadLetters.PushBack(&queue.DeadLetter{
+			Message:        *elem.val,
+			Reason:         reason,
+			DeadLetteredAt: time.Now().UTC(),
+		})
+		err = nil
+		return true
+	})
+
+	return err
+}
+
+// DeadLetters returns a copy of the dead-lettered messages.
+func (q *InmemQueue) DeadLetters() []*queue.DeadLetter {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	result := []*queue.DeadLetter{}
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		copied := *e.Value.(*queue.DeadLetter)
+		result = append(result, &copied)
+	}
+
+	return result
+}
+
+// GetDeadLetter returns a copy of the dead-lettered message with the given id.
+func (q *InmemQueue) GetDeadLetter(id string) (*queue.DeadLetter, error) {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return nil, queue.ErrDeadLetterNotFound
+	}
+
+	copied := *e.Value.(*queue.DeadLetter)
+	return &copied, nil
+}
+
+// ReplayDeadLetter moves the dead-lettered message with the given id back to the queue.
+func (q *InmemQueue) ReplayDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return queue.ErrDeadLetterNotFound
+	}
+	q.deadLetters.Remove(e)
+
+	msg := e.Value.(*queue.DeadLetter).Message
+	msg.DequeueCount = 0
+	msg.ReplayCount++
+	msg.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	msg.NextVisibleAt = time.Time{}
+
+	q.v.PushBack(&element{val: &msg, visible: true})
+	return nil
+}
+
+// PurgeDeadLetter deletes the dead-lettered message with the given id.
+func (q *InmemQueue) PurgeDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	q.deadLetters.Remove(e)
+	return nil
+}
+
+func (q *InmemQueue) findDeadLetter(id string) *list.Element {
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		if e.Value.(*queue.DeadLetter).ID == id {
+			return e
+		}
+	}
+
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
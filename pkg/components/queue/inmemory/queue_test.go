@@ -45,6 +45,21 @@ func TestEnqueueDequeueMulti(t *testing.T) {
 	require.Nil(t, q.v.Front())
 }
 
+func TestDequeuePriority(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+	q.Enqueue(&queue.Message{Data: []byte("low"), Metadata: queue.Metadata{Priority: queue.PriorityLow}})
+	q.Enqueue(&queue.Message{Data: []byte("normal1")})
+	q.Enqueue(&queue.Message{Data: []byte("high"), Metadata: queue.Metadata{Priority: queue.PriorityHigh}})
+	q.Enqueue(&queue.Message{Data: []byte("normal2")})
+
+	for _, expected := range []string{"high", "normal1", "normal2", "low"} {
+		msg := q.Dequeue()
+		require.Equal(t, []byte(expected), msg.Data)
+	}
+
+	require.Nil(t, q.Dequeue())
+}
+
 func TestMessageLock(t *testing.T) {
 	q := NewInMemQueue(2 * time.Millisecond)
 
@@ -101,3 +116,48 @@ func TestComplete(t *testing.T) {
 	msg2 := q.Dequeue()
 	require.Nil(t, msg2)
 }
+
+func TestDeadLetter(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.Enqueue(&queue.Message{
+		Data: []byte("test"),
+	})
+
+	msg := q.Dequeue()
+	err := q.DeadLetter(msg, "test reason")
+	require.NoError(t, err)
+	require.Equal(t, 0, q.Len())
+	require.Nil(t, q.Dequeue())
+
+	deadLetters := q.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, msg.ID, deadLetters[0].ID)
+	require.Equal(t, "test reason", deadLetters[0].Reason)
+	require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+	err = q.DeadLetter(msg, "test reason")
+	require.ErrorIs(t, err, queue.ErrInvalidMessage)
+
+	err = q.ReplayDeadLetter(msg.ID)
+	require.NoError(t, err)
+	require.Empty(t, q.DeadLetters())
+
+	replayed := q.Dequeue()
+	require.NotNil(t, replayed)
+	require.Equal(t, msg.ID, replayed.ID)
+	require.Equal(t, 1, replayed.DequeueCount)
+	require.Equal(t, 1, replayed.ReplayCount)
+
+	err = q.DeadLetter(replayed, "test reason")
+	require.NoError(t, err)
+
+	err = q.PurgeDeadLetter(replayed.ID)
+	require.NoError(t, err)
+	require.Empty(t, q.DeadLetters())
+
+	_, err = q.GetDeadLetter(replayed.ID)
+	require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+	require.ErrorIs(t, q.PurgeDeadLetter(replayed.ID), queue.ErrDeadLetterNotFound)
+	require.ErrorIs(t, q.ReplayDeadLetter(replayed.ID), queue.ErrDeadLetterNotFound)
+}
@@ -26,6 +26,31 @@ const (
 	JSONContentType = "application/json"
 )
 
+// Priority represents the priority of a message. Messages with a higher priority are processed before
+// messages with a lower priority. The zero value is PriorityNormal.
+type Priority int
+
+const (
+	// PriorityLow is the priority of long-running operations such as recipe executions.
+	PriorityLow Priority = -1
+	// PriorityNormal is the default priority.
+	PriorityNormal Priority = 0
+	// PriorityHigh is the priority of operations that should not wait behind long-running operations.
+	PriorityHigh Priority = 1
+)
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	switch {
+	case p < PriorityNormal:
+		return "low"
+	case p > PriorityNormal:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
 // Message represents message managed by queue.
 type Message struct {
 	Metadata
@@ -46,6 +71,23 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// ReplayCount represents the number of times the message was replayed from the dead-letter store.
+	ReplayCount int
+	// Priority represents the priority of the message.
+	Priority Priority
+	// FairnessKey represents the bucket used to share the processing capacity fairly, for example the resource group
+	// of the resource. Messages with an empty key share the same bucket.
+	FairnessKey string
+}
+
+// DeadLetter represents a message in the dead-letter store.
+type DeadLetter struct {
+	Message
+
+	// Reason is the reason why the message was dead-lettered, typically the last error.
+	Reason string
+	// DeadLetteredAt represents the time when the message was moved to the dead-letter store.
+	DeadLetteredAt time.Time
 }
 
 // NewMessage creates Message.
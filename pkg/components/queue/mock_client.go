@@ -39,6 +39,44 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DeadLetterMessage mocks base method.
+func (m *MockClient) DeadLetterMessage(arg0 context.Context, arg1 *Message, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetterMessage indicates an expected call of DeadLetterMessage.
+func (mr *MockClientMockRecorder) DeadLetterMessage(arg0, arg1, arg2 any) *MockClientDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterMessage", reflect.TypeOf((*MockClient)(nil).DeadLetterMessage), arg0, arg1, arg2)
+	return &MockClientDeadLetterMessageCall{Call: call}
+}
+
+// MockClientDeadLetterMessageCall wrap *gomock.Call
+type MockClientDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientDeadLetterMessageCall) Return(arg0 error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientDeadLetterMessageCall) Do(f func(context.Context, *Message, string) error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientDeadLetterMessageCall) DoAndReturn(f func(context.Context, *Message, string) error) *MockClientDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Dequeue mocks base method.
 func (m *MockClient) Dequeue(arg0 context.Context, arg1 QueueClientConfig) (*Message, error) {
 	m.ctrl.T.Helper()
@@ -196,3 +234,157 @@ func (c *MockClientFinishMessageCall) DoAndReturn(f func(context.Context, *Messa
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetDeadLetter mocks base method.
+func (m *MockClient) GetDeadLetter(arg0 context.Context, arg1 string) (*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockClientMockRecorder) GetDeadLetter(arg0, arg1 any) *MockClientGetDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockClient)(nil).GetDeadLetter), arg0, arg1)
+	return &MockClientGetDeadLetterCall{Call: call}
+}
+
+// MockClientGetDeadLetterCall wrap *gomock.Call
+type MockClientGetDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientGetDeadLetterCall) Return(arg0 *DeadLetter, arg1 error) *MockClientGetDeadLetterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientGetDeadLetterCall) Do(f func(context.Context, string) (*DeadLetter, error)) *MockClientGetDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientGetDeadLetterCall) DoAndReturn(f func(context.Context, string) (*DeadLetter, error)) *MockClientGetDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockClient) ListDeadLetters(arg0 context.Context) ([]*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0)
+	ret0, _ := ret[0].([]*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockClientMockRecorder) ListDeadLetters(arg0 any) *MockClientListDeadLettersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockClient)(nil).ListDeadLetters), arg0)
+	return &MockClientListDeadLettersCall{Call: call}
+}
+
+// MockClientListDeadLettersCall wrap *gomock.Call
+type MockClientListDeadLettersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientListDeadLettersCall) Return(arg0 []*DeadLetter, arg1 error) *MockClientListDeadLettersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientListDeadLettersCall) Do(f func(context.Context) ([]*DeadLetter, error)) *MockClientListDeadLettersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientListDeadLettersCall) DoAndReturn(f func(context.Context) ([]*DeadLetter, error)) *MockClientListDeadLettersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PurgeDeadLetter mocks base method.
+func (m *MockClient) PurgeDeadLetter(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeadLetter indicates an expected call of PurgeDeadLetter.
+func (mr *MockClientMockRecorder) PurgeDeadLetter(arg0, arg1 any) *MockClientPurgeDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetter", reflect.TypeOf((*MockClient)(nil).PurgeDeadLetter), arg0, arg1)
+	return &MockClientPurgeDeadLetterCall{Call: call}
+}
+
+// MockClientPurgeDeadLetterCall wrap *gomock.Call
+type MockClientPurgeDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientPurgeDeadLetterCall) Return(arg0 error) *MockClientPurgeDeadLetterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientPurgeDeadLetterCall) Do(f func(context.Context, string) error) *MockClientPurgeDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientPurgeDeadLetterCall) DoAndReturn(f func(context.Context, string) error) *MockClientPurgeDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReplayDeadLetter mocks base method.
+func (m *MockClient) ReplayDeadLetter(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter.
+func (mr *MockClientMockRecorder) ReplayDeadLetter(arg0, arg1 any) *MockClientReplayDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockClient)(nil).ReplayDeadLetter), arg0, arg1)
+	return &MockClientReplayDeadLetterCall{Call: call}
+}
+
+// MockClientReplayDeadLetterCall wrap *gomock.Call
+type MockClientReplayDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientReplayDeadLetterCall) Return(arg0 error) *MockClientReplayDeadLetterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientReplayDeadLetterCall) Do(f func(context.Context, string) error) *MockClientReplayDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientReplayDeadLetterCall) DoAndReturn(f func(context.Context, string) error) *MockClientReplayDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgres is a PostgreSQL based queue implementation. Messages are stored in the 'queue_messages' table,
+// see deploy/init-db/db.sql.txt for the schema.
+//
+// We need four operations for the queue:
+//
+//  1. Enqueue: Inserts a row into the table.
+//  2. Dequeue: Leases the oldest visible message with the highest priority by incrementing its dequeue count and
+//     setting next_visible_at to the end of the lease. The message is selected with 'FOR UPDATE SKIP LOCKED' so that concurrent
+//     clients never block each other or lease the same message.
+//  3. FinishMessage: Deletes the message.
+//  4. ExtendMessage: Extends the lease of a message that is still leased by the caller.
+//
+// Dead-lettered messages stay in the table with 'dead_lettered_at' set, which excludes them from Dequeue and
+// expiry. Replaying a dead-lettered message clears 'dead_lettered_at' and resets the dequeue count.
+//
+// The dequeue count is used as a revision number of the message, just like the apiserver implementation. A client can
+// only extend a message if the dequeue count matches, which means that no other client has leased the message since.
+//
+// All timestamps are computed by the database server, so clock skew between clients is not a concern.
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+// PostgresAPI defines the API surface from pgx that we use. This is used to allow for easier testing.
+//
+// Keep these definitions in sync with pgxpool.Pool and pgx.Conn.
+type PostgresAPI interface {
+	// Exec executes a query without returning any rows.
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	// Query executes a query that returns rows.
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	// QueryRow executes a query that is expected to return at most one row.
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+var _ queue.Client = (*Client)(nil)
+
+// Client is the queue client backed by PostgreSQL.
+type Client struct {
+	api PostgresAPI
+
+	opts Options
+}
+
+// Options is the options to create PostgreSQL queue client.
+type Options struct {
+	// Name represents the name of queue. Multiple queues can share the same table.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by PostgreSQL. name is unique name for each service which will consume the queue.
+func New(api PostgresAPI, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	return &Client{api: api, opts: options}, nil
+}
+
+// Enqueue implements queue.Client.
+func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return queue.ErrEmptyMessage
+	}
+
+	if msg.ContentType != queue.JSONContentType {
+		return queue.ErrUnsupportedContentType
+	}
+
+	sql := `
+INSERT INTO queue_messages (id, queue_name, dequeue_count, enqueue_at, expire_at, next_visible_at, priority, fairness_key, content_type, data)
+VALUES ($1, $2, 0, now(), now() + $3::BIGINT * INTERVAL '1 microsecond', now(), $4, $5, $6, $7)
+RETURNING enqueue_at, expire_at, next_visible_at;`
+
+	id := uuid.NewString()
+	metadata := queue.Metadata{ID: id, Priority: msg.Priority, FairnessKey: msg.FairnessKey}
+	err := c.api.QueryRow(ctx, sql, id, c.opts.Name, c.opts.ExpiryDuration.Microseconds(), int(msg.Priority), msg.FairnessKey, msg.ContentType, msg.Data).
+		Scan(&metadata.EnqueueAt, &metadata.ExpireAt, &metadata.NextVisibleAt)
+	if err != nil {
+		return err
+	}
+
+	msg.Metadata = metadata
+	return nil
+}
+
+// Dequeue implements queue.Client.
+func (c *Client) Dequeue(ctx context.Context, cfg queue.QueueClientConfig) (*queue.Message, error) {
+	// The inner SELECT locks the first visible message. SKIP LOCKED means that concurrent clients will
+	// skip over the message instead of waiting for the lock, so each client leases a different message.
+	sql := `
+UPDATE queue_messages
+SET dequeue_count = dequeue_count + 1, next_visible_at = now() + $2::BIGINT * INTERVAL '1 microsecond'
+WHERE id = (
+	SELECT id FROM queue_messages
+	WHERE queue_name = $1 AND dead_lettered_at IS NULL AND next_visible_at <= now() AND expire_at > now()
+	ORDER BY priority DESC, next_visible_at, enqueue_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id, dequeue_count, enqueue_at, expire_at, next_visible_at, replay_count, priority, fairness_key, content_type, data;`
+
+	msg := &queue.Message{}
+	err := c.api.QueryRow(ctx, sql, c.opts.Name, c.opts.MessageLockDuration.Microseconds()).Scan(
+		&msg.ID,
+		&msg.DequeueCount,
+		&msg.EnqueueAt,
+		&msg.ExpireAt,
+		&msg.NextVisibleAt,
+		&msg.ReplayCount,
+		&msg.Priority,
+		&msg.FairnessKey,
+		&msg.ContentType,
+		&msg.Data)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The queue is idle, this is a good time to clean up expired messages.
+		err = c.deleteExpired(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, queue.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// deleteExpired deletes messages that have expired. Messages that are locked by another client are skipped.
+func (c *Client) deleteExpired(ctx context.Context) error {
+	sql := `
+DELETE FROM queue_messages
+WHERE id IN (
+	SELECT id FROM queue_messages
+	WHERE queue_name = $1 AND dead_lettered_at IS NULL AND expire_at <= now()
+	FOR UPDATE SKIP LOCKED
+);`
+
+	_, err := c.api.Exec(ctx, sql, c.opts.Name)
+	return err
+}
+
+// FinishMessage implements queue.Client.
+func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	tag, err := c.api.Exec(ctx, "DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL", msg.ID, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return queue.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// ExtendMessage implements queue.Client.
+func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	// The message can only be extended if the lease is still valid and no other client has leased it since.
+	sql := `
+WITH extended AS (
+	UPDATE queue_messages
+	SET next_visible_at = now() + $3::BIGINT * INTERVAL '1 microsecond'
+	WHERE id = $1 AND queue_name = $2 AND dequeue_count = $4 AND next_visible_at >= now() AND dead_lettered_at IS NULL
+	RETURNING next_visible_at
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM extended) THEN 'Success'
+	WHEN EXISTS (SELECT 1 FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dequeue_count <> $4) THEN 'ErrDequeuedMessage'
+	ELSE 'ErrInvalidMessage'
+END AS result,
+(SELECT next_visible_at FROM extended) AS next_visible_at;`
+
+	result := ""
+	var nextVisibleAt *time.Time
+	err := c.api.QueryRow(ctx, sql, msg.ID, c.opts.Name, c.opts.MessageLockDuration.Microseconds(), msg.DequeueCount).Scan(&result, &nextVisibleAt)
+	if err != nil {
+		return err
+	} else if result == "ErrDequeuedMessage" {
+		return queue.ErrDequeuedMessage
+	} else if result == "ErrInvalidMessage" {
+		return queue.ErrInvalidMessage
+	}
+
+	msg.NextVisibleAt = *nextVisibleAt
+	return nil
+}
+
+// DeadLetterMessage implements queue.Client.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	// Like ExtendMessage, the message can only be dead-lettered if no other client has leased it since.
+	sql := `
+WITH deadlettered AS (
+	UPDATE queue_messages
+	SET dead_lettered_at = now(), dead_letter_reason = $3
+	WHERE id = $1 AND queue_name = $2 AND dequeue_count = $4 AND dead_lettered_at IS NULL
+	RETURNING id
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM deadlettered) THEN 'Success'
+	WHEN EXISTS (SELECT 1 FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL) THEN 'ErrDequeuedMessage'
+	ELSE 'ErrInvalidMessage'
+END AS result;`
+
+	result := ""
+	err := c.api.QueryRow(ctx, sql, msg.ID, c.opts.Name, reason, msg.DequeueCount).Scan(&result)
+	if err != nil {
+		return err
+	} else if result == "ErrDequeuedMessage" {
+		return queue.ErrDequeuedMessage
+	} else if result == "ErrInvalidMessage" {
+		return queue.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+const deadLetterColumns = "id, dequeue_count, enqueue_at, expire_at, next_visible_at, replay_count, priority, fairness_key, content_type, data, dead_letter_reason, dead_lettered_at"
+
+func scanDeadLetter(row pgx.Row) (*queue.DeadLetter, error) {
+	deadLetter := &queue.DeadLetter{}
+	err := row.Scan(
+		&deadLetter.ID,
+		&deadLetter.DequeueCount,
+		&deadLetter.EnqueueAt,
+		&deadLetter.ExpireAt,
+		&deadLetter.NextVisibleAt,
+		&deadLetter.ReplayCount,
+		&deadLetter.Priority,
+		&deadLetter.FairnessKey,
+		&deadLetter.ContentType,
+		&deadLetter.Data,
+		&deadLetter.Reason,
+		&deadLetter.DeadLetteredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+// ListDeadLetters implements queue.Client.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	sql := "SELECT " + deadLetterColumns + " FROM queue_messages WHERE queue_name = $1 AND dead_lettered_at IS NOT NULL ORDER BY dead_lettered_at"
+
+	rows, err := c.api.Query(ctx, sql, c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*queue.DeadLetter{}
+	for rows.Next() {
+		deadLetter, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, deadLetter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetDeadLetter implements queue.Client.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	sql := "SELECT " + deadLetterColumns + " FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL"
+
+	deadLetter, err := scanDeadLetter(c.api.QueryRow(ctx, sql, id, c.opts.Name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, queue.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+// ReplayDeadLetter implements queue.Client.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	sql := `
+UPDATE queue_messages
+SET dequeue_count = 0, replay_count = replay_count + 1, next_visible_at = now(),
+	expire_at = now() + $3::BIGINT * INTERVAL '1 microsecond', dead_lettered_at = NULL, dead_letter_reason = NULL
+WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL;`
+
+	tag, err := c.api.Exec(ctx, sql, id, c.opts.Name, c.opts.ExpiryDuration.Microseconds())
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeadLetter implements queue.Client.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	tag, err := c.api.Exec(ctx, "DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL", id, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, Options{})
+	require.Error(t, err)
+
+	cli, err := New(nil, Options{Name: "applications.core"})
+	require.NoError(t, err)
+	require.Equal(t, defaultMessageLockDuration, cli.opts.MessageLockDuration)
+	require.Equal(t, defaultExpiryDuration, cli.opts.ExpiryDuration)
+}
+
+func TestClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	// You can get the right value for this by running the command: make db-init
+	url := os.Getenv("TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("TEST_POSTGRES_URL is not set.")
+		return
+	}
+
+	pool, err := pgxpool.New(ctx, url)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	cli, err := New(pool, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		_, err := pool.Exec(ctx, "DELETE FROM queue_messages")
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+
+	t.Run("ExtendMessage fails when another client leased the message", func(t *testing.T) {
+		clear(t)
+
+		client1, err := New(pool, Options{Name: "applications.core", MessageLockDuration: 10 * time.Millisecond})
+		require.NoError(t, err)
+		client2, err := New(pool, Options{Name: "applications.core", MessageLockDuration: time.Minute})
+		require.NoError(t, err)
+
+		err = client1.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+
+		msg1, err := client1.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// Wait for the lock of client1 to expire so that client2 can lease the message.
+		var msg2 *queue.Message
+		require.Eventually(t, func() bool {
+			msg2, err = client2.Dequeue(ctx, queue.QueueClientConfig{})
+			return err == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Equal(t, msg1.ID, msg2.ID)
+		require.Equal(t, 2, msg2.DequeueCount)
+
+		err = client1.ExtendMessage(ctx, msg1)
+		require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+
+		err = client2.ExtendMessage(ctx, msg2)
+		require.NoError(t, err)
+	})
+
+	t.Run("Dequeue returns the message with the highest priority first", func(t *testing.T) {
+		clear(t)
+
+		low := queue.NewMessage("{}")
+		low.Priority = queue.PriorityLow
+		err = cli.Enqueue(ctx, low)
+		require.NoError(t, err)
+
+		high := queue.NewMessage("{}")
+		high.Priority = queue.PriorityHigh
+		high.FairnessKey = "/planes/radius/local/resourcegroups/test"
+		err = cli.Enqueue(ctx, high)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, high.ID, msg.ID)
+		require.Equal(t, queue.PriorityHigh, msg.Priority)
+		require.Equal(t, high.FairnessKey, msg.FairnessKey)
+
+		msg, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, low.ID, msg.ID)
+	})
+
+	t.Run("queues are isolated by name", func(t *testing.T) {
+		clear(t)
+
+		other, err := New(pool, Options{Name: "other"})
+		require.NoError(t, err)
+
+		err = other.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+	})
+}
@@ -29,6 +29,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// PostgreSQL configures options for the PostgreSQL queue. (Optional)
+	PostgreSQL PostgreSQLOptions `yaml:"postgresql,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +47,14 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// PostgreSQLOptions represents options for the PostgreSQL queue.
+type PostgreSQLOptions struct {
+	// URL is the connection information for the PostgreSQL database in URL format.
+	//
+	// The URL should be formatted according to:
+	// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING-URIS
+	//
+	// The database must contain the 'queue_messages' table, see deploy/init-db/db.sql.txt.
+	URL string `yaml:"url"`
+}
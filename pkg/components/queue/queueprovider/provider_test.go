@@ -47,3 +47,28 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedQueueProvider, err)
 }
+
+func TestGetClient_PostgreSQL(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypePostgreSQL,
+		PostgreSQL: PostgreSQLOptions{
+			// The connection is established lazily so no server is required.
+			URL: "postgresql://postgres@localhost:5432/ucp",
+		},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, cli)
+}
+
+func TestGetClient_PostgreSQL_MissingURL(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypePostgreSQL,
+	})
+
+	_, err := p.GetClient(context.TODO())
+	require.EqualError(t, err, "failed to initialize PostgreSQL queue client: URL is required")
+}
@@ -743,6 +743,17 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Replicas: c.Replicas,
 			},
 		}
+	case *AutoscalingExtension:
+		return datamodel.Extension{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MinReplicas:             c.MinReplicas,
+				MaxReplicas:             to.Int32(c.MaxReplicas),
+				TargetCPUUtilization:    c.TargetCPUUtilization,
+				TargetMemoryUtilization: c.TargetMemoryUtilization,
+				Metrics:                 toAutoscalingMetricsDataModel(c.Metrics),
+			},
+		}
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -774,6 +785,15 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.Autoscaling:
+		return &AutoscalingExtension{
+			Kind:                    to.Ptr(string(e.Kind)),
+			MinReplicas:             e.Autoscaling.MinReplicas,
+			MaxReplicas:             to.Ptr(e.Autoscaling.MaxReplicas),
+			TargetCPUUtilization:    e.Autoscaling.TargetCPUUtilization,
+			TargetMemoryUtilization: e.Autoscaling.TargetMemoryUtilization,
+			Metrics:                 fromAutoscalingMetricsDataModel(e.Autoscaling.Metrics),
+		}
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
@@ -794,6 +814,62 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 	return nil
 }
 
+func toAutoscalingMetricsDataModel(metrics []*AutoscalingMetric) []datamodel.AutoscalingMetric {
+	if metrics == nil {
+		return nil
+	}
+	converted := []datamodel.AutoscalingMetric{}
+	for _, m := range metrics {
+		if m == nil {
+			continue
+		}
+		converted = append(converted, datamodel.AutoscalingMetric{
+			Name:               to.String(m.Name),
+			Kind:               toAutoscalingMetricKindDataModel(m.Kind),
+			TargetAverageValue: to.String(m.TargetAverageValue),
+		})
+	}
+	return converted
+}
+
+func fromAutoscalingMetricsDataModel(metrics []datamodel.AutoscalingMetric) []*AutoscalingMetric {
+	if metrics == nil {
+		return nil
+	}
+	converted := []*AutoscalingMetric{}
+	for _, m := range metrics {
+		converted = append(converted, &AutoscalingMetric{
+			Name:               to.Ptr(m.Name),
+			Kind:               fromAutoscalingMetricKindDataModel(m.Kind),
+			TargetAverageValue: to.Ptr(m.TargetAverageValue),
+		})
+	}
+	return converted
+}
+
+func toAutoscalingMetricKindDataModel(kind *AutoscalingMetricKind) datamodel.AutoscalingMetricKind {
+	if kind == nil {
+		return datamodel.AutoscalingMetricKindPods
+	}
+	switch *kind {
+	case AutoscalingMetricKindExternal:
+		return datamodel.AutoscalingMetricKindExternal
+	default:
+		return datamodel.AutoscalingMetricKindPods
+	}
+}
+
+func fromAutoscalingMetricKindDataModel(kind datamodel.AutoscalingMetricKind) *AutoscalingMetricKind {
+	var k AutoscalingMetricKind
+	switch kind {
+	case datamodel.AutoscalingMetricKindExternal:
+		k = AutoscalingMetricKindExternal
+	default:
+		k = AutoscalingMetricKindPods
+	}
+	return &k
+}
+
 func toHealthProbeBase(h HealthProbeProperties) datamodel.HealthProbeBase {
 	return datamodel.HealthProbeBase{
 		FailureThreshold:    h.FailureThreshold,
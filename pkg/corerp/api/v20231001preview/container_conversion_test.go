@@ -271,6 +271,58 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 
 }
 
+func TestContainerConvertAutoscalingExtension(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-autoscaling.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MinReplicas:          to.Ptr[int32](2),
+				MaxReplicas:          10,
+				TargetCPUUtilization: to.Ptr[int32](70),
+				Metrics: []datamodel.AutoscalingMetric{
+					{
+						Name:               "http_requests_per_second",
+						Kind:               datamodel.AutoscalingMetricKindPods,
+						TargetAverageValue: "100",
+					},
+					{
+						Name:               "queue_messages_ready",
+						Kind:               datamodel.AutoscalingMetricKindExternal,
+						TargetAverageValue: "30",
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Len(t, versioned.Properties.Extensions, 1)
+
+	ext, ok := versioned.Properties.Extensions[0].(*AutoscalingExtension)
+	require.True(t, ok)
+	require.Equal(t, "autoscaling", to.String(ext.Kind))
+	require.Equal(t, int32(2), to.Int32(ext.MinReplicas))
+	require.Equal(t, int32(10), to.Int32(ext.MaxReplicas))
+	require.Equal(t, int32(70), to.Int32(ext.TargetCPUUtilization))
+	require.Nil(t, ext.TargetMemoryUtilization)
+	require.Len(t, ext.Metrics, 2)
+	require.Equal(t, AutoscalingMetricKindPods, *ext.Metrics[0].Kind)
+	require.Equal(t, AutoscalingMetricKindExternal, *ext.Metrics[1].Kind)
+	require.Equal(t, "30", to.String(ext.Metrics[1].TargetAverageValue))
+}
+
 func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 	// arrange
 	rawPayload := testutil.ReadFixture("containerresourcenegativetest.json")
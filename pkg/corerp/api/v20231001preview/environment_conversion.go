@@ -64,6 +64,13 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 	converted.Properties.Compute = *envCompute
 	converted.Properties.RecipeConfig = toRecipeConfigDatamodel(src.Properties.RecipeConfig)
 
+	if src.Properties.Gateway != nil {
+		converted.Properties.Gateway = datamodel.GatewayConfigProperties{
+			Kind:             to.String((*string)(src.Properties.Gateway.Kind)),
+			GatewayClassName: to.String(src.Properties.Gateway.GatewayClassName),
+		}
+	}
+
 	if src.Properties.Recipes != nil {
 		envRecipes := make(map[string]map[string]datamodel.EnvironmentRecipeProperties)
 		for resourceType, recipes := range src.Properties.Recipes {
@@ -75,7 +82,7 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 				if recipeDetails != nil {
 					if recipeDetails.GetRecipeProperties().TemplateKind == nil || !isValidTemplateKind(*recipeDetails.GetRecipeProperties().TemplateKind) {
 						formats := []string{}
-						for _, format := range types.SupportedTemplateKind {
+						for _, format := range types.SupportedTemplateKinds() {
 							formats = append(formats, fmt.Sprintf("%q", format))
 						}
 						return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid template kind. Allowed formats: %s", strings.Join(formats, ", ")))
@@ -153,6 +160,16 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	}
 	dst.Properties.RecipeConfig = fromRecipeConfigDatamodel(env.Properties.RecipeConfig)
 
+	if env.Properties.Gateway != (datamodel.GatewayConfigProperties{}) {
+		dst.Properties.Gateway = &GatewayConfigProperties{}
+		if env.Properties.Gateway.Kind != "" {
+			dst.Properties.Gateway.Kind = to.Ptr(GatewayKind(env.Properties.Gateway.Kind))
+		}
+		if env.Properties.Gateway.GatewayClassName != "" {
+			dst.Properties.Gateway.GatewayClassName = to.Ptr(env.Properties.Gateway.GatewayClassName)
+		}
+	}
+
 	if env.Properties.Providers != (datamodel.Providers{}) {
 		dst.Properties.Providers = &Providers{}
 		if env.Properties.Providers.Azure != (datamodel.ProvidersAzure{}) {
@@ -205,6 +222,14 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+
+			if config.Terraform.Backend != nil {
+				recipeConfig.Terraform.Backend = &datamodel.TerraformBackendConfig{
+					Kind:                 to.String((*string)(config.Terraform.Backend.Kind)),
+					AdditionalProperties: config.Terraform.Backend.AdditionalProperties,
+					Secrets:              toSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+			}
 		}
 
 		if config.Bicep != nil {
@@ -250,6 +275,14 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+
+			if config.Terraform.Backend != nil {
+				recipeConfig.Terraform.Backend = &TerraformBackendConfig{
+					Kind:                 to.Ptr(TerraformBackendKind(config.Terraform.Backend.Kind)),
+					AdditionalProperties: config.Terraform.Backend.AdditionalProperties,
+					Secrets:              fromSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+			}
 		}
 
 		if !reflect.DeepEqual(config.Bicep, datamodel.BicepConfigProperties{}) {
@@ -453,6 +486,21 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			PlainHTTP:    to.Bool(c.PlainHTTP),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			PlainHTTP:       to.Bool(c.PlainHTTP),
+			Parameters:      c.Parameters,
+		}, nil
+	case *RecipeProperties:
+		// Template kinds served by out-of-process recipe drivers only support the common recipe properties.
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind: to.String(c.TemplateKind),
+			TemplatePath: to.String(c.TemplatePath),
+			Parameters:   c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -473,6 +521,22 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			Parameters:   e.Parameters,
 			PlainHTTP:    to.Ptr(e.PlainHTTP),
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+			PlainHTTP:       to.Ptr(e.PlainHTTP),
+		}
+	default:
+		if types.IsSupportedTemplateKind(e.TemplateKind) {
+			return &RecipeProperties{
+				TemplateKind: to.Ptr(e.TemplateKind),
+				TemplatePath: to.Ptr(e.TemplatePath),
+				Parameters:   e.Parameters,
+			}
+		}
 	}
 
 	return nil
@@ -380,11 +380,11 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
@@ -1175,3 +1175,138 @@ func Test_fromSecretReferenceDatamodel(t *testing.T) {
 		})
 	}
 }
+
+func Test_RecipeConfigTerraformBackend(t *testing.T) {
+	versioned := &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Backend: &TerraformBackendConfig{
+				Kind:                 to.Ptr(TerraformBackendKindS3),
+				AdditionalProperties: map[string]any{"bucket": "tfstate", "region": "us-west-2"},
+				Secrets: map[string]*SecretReference{
+					"secret_key": {
+						Source: to.Ptr("/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate"),
+						Key:    to.Ptr("secret-key"),
+					},
+				},
+			},
+		},
+	}
+
+	dm := datamodel.RecipeConfigProperties{
+		Terraform: datamodel.TerraformConfigProperties{
+			Backend: &datamodel.TerraformBackendConfig{
+				Kind:                 "s3",
+				AdditionalProperties: map[string]any{"bucket": "tfstate", "region": "us-west-2"},
+				Secrets: map[string]datamodel.SecretReference{
+					"secret_key": {
+						Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate",
+						Key:    "secret-key",
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, dm, toRecipeConfigDatamodel(versioned))
+	require.Equal(t, versioned.Terraform, fromRecipeConfigDatamodel(dm).Terraform)
+
+	// The backend settings are serialized next to the kind and secrets.
+	b, err := json.Marshal(versioned.Terraform.Backend)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind":"s3","bucket":"tfstate","region":"us-west-2","secrets":{"secret_key":{"source":"/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfstate","key":"secret-key"}}}`, string(b))
+
+	backend := &TerraformBackendConfig{}
+	require.NoError(t, json.Unmarshal(b, backend))
+	require.Equal(t, versioned.Terraform.Backend, backend)
+}
+
+func Test_HelmRecipeProperties(t *testing.T) {
+	raw := `{"templateKind":"helm","templatePath":"oci://ghcr.io/sampleregistry/charts/redis","templateVersion":"1.0.0","plainHttp":true,"parameters":{"replicas":2}}`
+
+	versioned, err := unmarshalRecipePropertiesClassification(json.RawMessage(raw))
+	require.NoError(t, err)
+	require.IsType(t, &HelmRecipeProperties{}, versioned)
+
+	dm := datamodel.EnvironmentRecipeProperties{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+		TemplateVersion: "1.0.0",
+		PlainHTTP:       true,
+		Parameters:      map[string]any{"replicas": float64(2)},
+	}
+
+	converted, err := toEnvironmentRecipeProperties(versioned)
+	require.NoError(t, err)
+	require.Equal(t, dm, converted)
+	require.Equal(t, versioned, fromRecipePropertiesClassificationDatamodel(dm))
+
+	b, err := json.Marshal(versioned)
+	require.NoError(t, err)
+	require.JSONEq(t, raw, string(b))
+}
+
+func Test_RegisteredTemplateKindRecipeProperties(t *testing.T) {
+	raw := `{"templateKind":"test-external","templatePath":"registry.example.com/recipes/redis:1.0","parameters":{"replicas":2}}`
+
+	versioned, err := unmarshalRecipePropertiesClassification(json.RawMessage(raw))
+	require.NoError(t, err)
+	require.IsType(t, &RecipeProperties{}, versioned)
+
+	dm := datamodel.EnvironmentRecipeProperties{
+		TemplateKind: "test-external",
+		TemplatePath: "registry.example.com/recipes/redis:1.0",
+		Parameters:   map[string]any{"replicas": float64(2)},
+	}
+
+	t.Run("unregistered kind", func(t *testing.T) {
+		require.False(t, isValidTemplateKind("test-external"))
+		require.Nil(t, fromRecipePropertiesClassificationDatamodel(dm))
+	})
+
+	t.Run("registered kind", func(t *testing.T) {
+		recipes.RegisterTemplateKind("test-external")
+		require.True(t, isValidTemplateKind("test-external"))
+
+		converted, err := toEnvironmentRecipeProperties(versioned)
+		require.NoError(t, err)
+		require.Equal(t, dm, converted)
+		require.Equal(t, versioned, fromRecipePropertiesClassificationDatamodel(dm))
+	})
+}
+
+func Test_EnvironmentGatewayConfig(t *testing.T) {
+	versioned := &EnvironmentResource{
+		ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"),
+		Name:     to.Ptr("env0"),
+		Type:     to.Ptr("Applications.Core/environments"),
+		Location: to.Ptr("global"),
+		Properties: &EnvironmentProperties{
+			Compute: &KubernetesCompute{
+				Kind:      to.Ptr(EnvironmentComputeKindKubernetes),
+				Namespace: to.Ptr("default"),
+			},
+			Gateway: &GatewayConfigProperties{
+				Kind:             to.Ptr(GatewayKindGatewayAPI),
+				GatewayClassName: to.Ptr("istio"),
+			},
+		},
+	}
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	env := dm.(*datamodel.Environment)
+	require.Equal(t, datamodel.GatewayConfigProperties{Kind: datamodel.GatewayKindGatewayAPI, GatewayClassName: "istio"}, env.Properties.Gateway)
+
+	converted := &EnvironmentResource{}
+	err = converted.ConvertFrom(env)
+	require.NoError(t, err)
+	require.Equal(t, versioned.Properties.Gateway, converted.Properties.Gateway)
+
+	// The gateway configuration is omitted when it is not set.
+	env.Properties.Gateway = datamodel.GatewayConfigProperties{}
+	converted = &EnvironmentResource{}
+	err = converted.ConvertFrom(env)
+	require.NoError(t, err)
+	require.Nil(t, converted.Properties.Gateway)
+}
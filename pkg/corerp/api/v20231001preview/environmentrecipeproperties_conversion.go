@@ -43,6 +43,9 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
 	case types.TemplateKindBicep:
 		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
+	case types.TemplateKindHelm:
+		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
+		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
 	}
 	dst.Parameters = recipe.Parameters
 	return nil
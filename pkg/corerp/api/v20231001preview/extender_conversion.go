@@ -136,10 +136,14 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 }
 
 func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
-	return &Recipe{
+	recipe := &Recipe{
 		Name:       to.Ptr(r.Name),
 		Parameters: r.Parameters,
 	}
+	if r.Force {
+		recipe.Force = to.Ptr(r.Force)
+	}
+	return recipe
 }
 
 func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
@@ -157,5 +161,6 @@ func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
 	}
+	recipe.Force = to.Bool(r.Force)
 	return recipe
 }
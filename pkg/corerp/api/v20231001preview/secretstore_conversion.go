@@ -46,6 +46,7 @@ func (src *SecretStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 			Resource: to.String(src.Properties.Resource),
 			Type:     toSecretStoreDataTypeDataModel(src.Properties.Type),
 			Data:     toSecretValuePropertiesDataModel(src.Properties.Data),
+			Provider: toSecretStoreProviderDataModel(src.Properties.Provider),
 		},
 	}
 	return converted, nil
@@ -73,6 +74,7 @@ func (dst *SecretStoreResource) ConvertFrom(src v1.DataModelInterface) error {
 		Type:              fromSecretStoreDataTypeDataModel(ss.Properties.Type),
 		Resource:          to.Ptr(ss.Properties.Resource),
 		Data:              fromSecretStoreDataPropertiesDataModel(ss.Properties.Data),
+		Provider:          fromSecretStoreProviderDataModel(ss.Properties.Provider),
 	}
 
 	return nil
@@ -133,6 +135,50 @@ func fromSecretStoreDataTypeDataModel(src datamodel.SecretType) *SecretStoreData
 	return nil
 }
 
+func toSecretStoreProviderDataModel(src *SecretStoreProviderProperties) *datamodel.SecretStoreProvider {
+	if src == nil {
+		return nil
+	}
+
+	dst := &datamodel.SecretStoreProvider{
+		Kind: datamodel.SecretStoreProviderKubernetes,
+	}
+	if src.Kind != nil && *src.Kind == SecretStoreProviderKindVault {
+		dst.Kind = datamodel.SecretStoreProviderVault
+	}
+
+	if src.Vault != nil {
+		dst.Vault = &datamodel.VaultSecretStoreProvider{
+			Address:     to.String(src.Vault.Address),
+			MountPath:   to.String(src.Vault.MountPath),
+			TokenSecret: to.String(src.Vault.TokenSecret),
+		}
+	}
+	return dst
+}
+
+func fromSecretStoreProviderDataModel(src *datamodel.SecretStoreProvider) *SecretStoreProviderProperties {
+	if src == nil {
+		return nil
+	}
+
+	dst := &SecretStoreProviderProperties{
+		Kind: to.Ptr(SecretStoreProviderKindKubernetes),
+	}
+	if src.Kind == datamodel.SecretStoreProviderVault {
+		dst.Kind = to.Ptr(SecretStoreProviderKindVault)
+	}
+
+	if src.Vault != nil {
+		dst.Vault = &VaultSecretStoreProviderProperties{
+			Address:     to.Ptr(src.Vault.Address),
+			MountPath:   to.Ptr(src.Vault.MountPath),
+			TokenSecret: to.Ptr(src.Vault.TokenSecret),
+		}
+	}
+	return dst
+}
+
 func toSecretValuePropertiesDataModel(src map[string]*SecretValueProperties) map[string]*datamodel.SecretStoreDataValue {
 	if src == nil {
 		return nil
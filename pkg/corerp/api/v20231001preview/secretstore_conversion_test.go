@@ -85,6 +85,33 @@ func TestSecretStoreConvertVersionedToDataModel(t *testing.T) {
 
 		require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.KeyVault/vaults/vault0", ct.Properties.Resource)
 	})
+
+	t.Run("vault provider", func(t *testing.T) {
+		// arrange
+		rawPayload := testutil.ReadFixture("secretstore-versioned-vault.json")
+		r := &SecretStoreResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		// act
+		dm, err := r.ConvertTo()
+
+		// assert
+		require.NoError(t, err)
+		ct := dm.(*datamodel.SecretStore)
+		require.Equal(t, "apps/db", ct.Properties.Resource)
+		require.Equal(t, datamodel.SecretStoreProviderVault, ct.Properties.ProviderKind())
+		require.Equal(t, &datamodel.VaultSecretStoreProvider{
+			Address:     "https://vault.example.com:8200",
+			MountPath:   "kv",
+			TokenSecret: "radius-system/vault-token",
+		}, ct.Properties.Provider.Vault)
+
+		versioned := &SecretStoreResource{}
+		err = versioned.ConvertFrom(ct)
+		require.NoError(t, err)
+		require.Equal(t, r.Properties.Provider, versioned.Properties.Provider)
+	})
 }
 
 func TestSecretStoreConvertDataModelToVersioned(t *testing.T) {
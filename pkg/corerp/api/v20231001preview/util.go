@@ -21,7 +21,6 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
-	"golang.org/x/exp/slices"
 )
 
 func toProvisioningStateDataModel(state *ProvisioningState) v1.ProvisioningState {
@@ -126,7 +125,7 @@ func stringSlice(s []*string) []string {
 }
 
 func isValidTemplateKind(templateKind string) bool {
-	return slices.Contains(recipes.SupportedTemplateKind, templateKind)
+	return recipes.IsSupportedTemplateKind(templateKind)
 }
 
 func toOutputResourcesDataModel(outputResources []rpv1.OutputResource) []*OutputResource {
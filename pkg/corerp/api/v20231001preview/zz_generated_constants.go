@@ -18,6 +18,24 @@ func PossibleActionTypeValues() []ActionType {
 	}
 }
 
+// AutoscalingMetricKind - The source of a custom autoscaling metric.
+type AutoscalingMetricKind string
+
+const (
+	// AutoscalingMetricKindExternal - The metric is not associated with any Kubernetes object, such as the length of a queue.
+	AutoscalingMetricKindExternal AutoscalingMetricKind = "external"
+	// AutoscalingMetricKindPods - The metric describes each replica of the container.
+	AutoscalingMetricKindPods AutoscalingMetricKind = "pods"
+)
+
+// PossibleAutoscalingMetricKindValues returns the possible values for the AutoscalingMetricKind const type.
+func PossibleAutoscalingMetricKindValues() []AutoscalingMetricKind {
+	return []AutoscalingMetricKind{
+		AutoscalingMetricKindExternal,
+		AutoscalingMetricKindPods,
+	}
+}
+
 // CertificateFormats - Represents certificate formats
 type CertificateFormats string
 
@@ -134,6 +152,24 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// GatewayKind - The kind of Kubernetes resources used to implement Gateways.
+type GatewayKind string
+
+const (
+	// GatewayKindContour - Gateways are implemented with Contour HTTPProxy resources.
+	GatewayKindContour GatewayKind = "contour"
+	// GatewayKindGatewayAPI - Gateways are implemented with Kubernetes Gateway API Gateway and HTTPRoute resources.
+	GatewayKindGatewayAPI GatewayKind = "gatewayApi"
+)
+
+// PossibleGatewayKindValues returns the possible values for the GatewayKind const type.
+func PossibleGatewayKindValues() []GatewayKind {
+	return []GatewayKind{
+		GatewayKindContour,
+		GatewayKindGatewayAPI,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -361,6 +397,24 @@ func PossibleSecretStoreDataTypeValues() []SecretStoreDataType {
 	}
 }
 
+// SecretStoreProviderKind - The kind of the secret store backend.
+type SecretStoreProviderKind string
+
+const (
+	// SecretStoreProviderKindKubernetes - The secret values are stored in a Kubernetes secret.
+	SecretStoreProviderKindKubernetes SecretStoreProviderKind = "kubernetes"
+	// SecretStoreProviderKindVault - The secret values are stored in the KV version 2 secrets engine of HashiCorp Vault.
+	SecretStoreProviderKindVault SecretStoreProviderKind = "vault"
+)
+
+// PossibleSecretStoreProviderKindValues returns the possible values for the SecretStoreProviderKind const type.
+func PossibleSecretStoreProviderKindValues() []SecretStoreProviderKind {
+	return []SecretStoreProviderKind{
+		SecretStoreProviderKindKubernetes,
+		SecretStoreProviderKindVault,
+	}
+}
+
 // SecretValueEncoding - The type of SecretValue Encoding
 type SecretValueEncoding string
 
@@ -397,6 +451,30 @@ func PossibleTLSMinVersionValues() []TLSMinVersion {
 	}
 }
 
+// TerraformBackendKind - The kind of the Terraform backend.
+type TerraformBackendKind string
+
+const (
+	// TerraformBackendKindKubernetes - State is stored in a Kubernetes secret.
+	TerraformBackendKindKubernetes TerraformBackendKind = "kubernetes"
+	// TerraformBackendKindLocal - State is stored on the local filesystem of the resource provider. Must be enabled by the server operator.
+	TerraformBackendKindLocal TerraformBackendKind = "local"
+	// TerraformBackendKindPg - State is stored in a PostgreSQL database.
+	TerraformBackendKindPg TerraformBackendKind = "pg"
+	// TerraformBackendKindS3 - State is stored in an S3-compatible object storage bucket.
+	TerraformBackendKindS3 TerraformBackendKind = "s3"
+)
+
+// PossibleTerraformBackendKindValues returns the possible values for the TerraformBackendKind const type.
+func PossibleTerraformBackendKindValues() []TerraformBackendKind {
+	return []TerraformBackendKind{
+		TerraformBackendKindKubernetes,
+		TerraformBackendKindLocal,
+		TerraformBackendKindPg,
+		TerraformBackendKindS3,
+	}
+}
+
 // VolumePermission - The persistent volume permission
 type VolumePermission string
 
@@ -341,6 +341,75 @@ func (client *ExtendersClient) listSecretsHandleResponse(resp *http.Response) (E
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified Extender resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - extenderName - The name of the Extender portable resource
+//   - options - ExtendersClientBeginPlanOptions contains the optional parameters for the ExtendersClient.BeginPlan
+//     method.
+func (client *ExtendersClient) BeginPlan(ctx context.Context, extenderName string, options *ExtendersClientBeginPlanOptions) (*runtime.Poller[ExtendersClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, extenderName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ExtendersClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[ExtendersClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified Extender resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ExtendersClient) plan(ctx context.Context, extenderName string, options *ExtendersClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "ExtendersClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, extenderName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *ExtendersClient) planCreateRequest(ctx context.Context, extenderName string, _ *ExtendersClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/extenders/{extenderName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if extenderName == "" {
+		return nil, errors.New("parameter extenderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{extenderName}", url.PathEscape(extenderName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a ExtenderResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -16,8 +16,8 @@ type EnvironmentComputeClassification interface {
 // ExtensionClassification provides polymorphic access to related types.
 // Call the interface's GetExtension() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *AzureContainerInstanceExtension, *DaprSidecarExtension, *Extension, *KubernetesMetadataExtension, *KubernetesNamespaceExtension,
-// - *ManualScalingExtension
+// - *AutoscalingExtension, *AzureContainerInstanceExtension, *DaprSidecarExtension, *Extension, *KubernetesMetadataExtension,
+// - *KubernetesNamespaceExtension, *ManualScalingExtension
 type ExtensionClassification interface {
 	// GetExtension returns the Extension content of the underlying type.
 	GetExtension() *Extension
@@ -35,7 +35,7 @@ type HealthProbePropertiesClassification interface {
 // RecipePropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetRecipeProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *BicepRecipeProperties, *RecipeProperties, *TerraformRecipeProperties
+// - *BicepRecipeProperties, *HelmRecipeProperties, *RecipeProperties, *TerraformRecipeProperties
 type RecipePropertiesClassification interface {
 	// GetRecipeProperties returns the RecipeProperties content of the underlying type.
 	GetRecipeProperties() *RecipeProperties
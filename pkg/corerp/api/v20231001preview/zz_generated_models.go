@@ -133,6 +133,48 @@ type AuthConfig struct {
 	Git *GitAuthConfig
 }
 
+// AutoscalingExtension - Autoscaling Extension. Scales the container horizontally between a minimum and maximum replica count
+// based on resource utilization or custom metrics.
+type AutoscalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; Maximum replica count.
+	MaxReplicas *int32
+
+	// Custom metric targets used to scale the container.
+	Metrics []*AutoscalingMetric
+
+	// Minimum replica count. Defaults to 1.
+	MinReplicas *int32
+
+	// Target average CPU utilization across all replicas, as a percentage of the requested CPU.
+	TargetCPUUtilization *int32
+
+	// Target average memory utilization across all replicas, as a percentage of the requested memory.
+	TargetMemoryUtilization *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoscalingExtension.
+func (a *AutoscalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
+// AutoscalingMetric - A custom metric target used to scale the container.
+type AutoscalingMetric struct {
+	// REQUIRED; The name of the metric.
+	Name *string
+
+	// REQUIRED; The target average value of the metric across all replicas, expressed as a Kubernetes quantity. For example:
+	// 100 or 500m.
+	TargetAverageValue *string
+
+	// The source of the metric. Defaults to pods.
+	Kind *AutoscalingMetricKind
+}
+
 // AzureContainerInstanceCompute - The Azure container instance compute configuration
 type AzureContainerInstanceCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -487,6 +529,10 @@ type EnvironmentProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// Configuration for Gateways. Defines which Kubernetes resources implement Applications.Core/gateways resources in the
+	// environment.
+	Gateway *GatewayConfigProperties
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
@@ -742,6 +788,16 @@ type Extension struct {
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayConfigProperties - Configuration for Gateways. Defines which Kubernetes resources implement Applications.Core/gateways
+// resources in the environment.
+type GatewayConfigProperties struct {
+	// The name of the GatewayClass referenced by the generated Gateway resources. Only applicable when kind is gatewayApi.
+	GatewayClassName *string
+
+	// The kind of Kubernetes resources used to implement Gateways. Defaults to contour.
+	Kind *GatewayKind
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -940,6 +996,34 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm chart recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the OCI registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
+	// for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Version of the chart to deploy. Defaults to the tag of the OCI reference, or to the latest version of the chart.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters:   h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1184,6 +1268,9 @@ type Recipe struct {
 	// REQUIRED; The name of the recipe within the environment to use
 	Name *string
 
+	// Execute the recipe even if the recipe, its parameters and the resource have not changed since the previous deployment
+	Force *bool
+
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
 }
@@ -1234,7 +1321,7 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1249,6 +1336,39 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
+// RecipePlanResult - The changes that deploying the recipe of a resource would make.
+type RecipePlanResult struct {
+	// REQUIRED; The changes that would be made to the resources deployed by the recipe if the recipe was deployed.
+	Changes []*RecipeResourceChange
+
+	// REQUIRED; The changes made to the resources deployed by the recipe outside of the recipe since it was last deployed.
+	Drift []*RecipeResourceChange
+}
+
+// RecipeResourceChange - A change to a resource deployed by a recipe.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that will be taken on the resource, for example create, update, replace, delete, read or no-op.
+	Action *string
+
+	// REQUIRED; The address of the resource within the recipe.
+	Address *string
+
+	// The properties of the resource after the change. Sensitive values are redacted.
+	After map[string]any
+
+	// The properties of the resource before the change. Sensitive values are redacted.
+	Before map[string]any
+
+	// The top-level properties whose values differ between before and after.
+	ChangedProperties []*string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	Type *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1363,6 +1483,9 @@ type SecretStoreProperties struct {
 	// Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
+	// The backend that stores the secret values. Defaults to Kubernetes secrets.
+	Provider *SecretStoreProviderProperties
+
 	// The resource id of external secret store.
 	Resource *string
 
@@ -1376,6 +1499,15 @@ type SecretStoreProperties struct {
 	Status *ResourceStatus
 }
 
+// SecretStoreProviderProperties - The backend that stores the secret values of a secret store.
+type SecretStoreProviderProperties struct {
+	// REQUIRED; The kind of the secret store backend.
+	Kind *SecretStoreProviderKind
+
+	// The HashiCorp Vault backend configuration. Required when kind is vault.
+	Vault *VaultSecretStoreProviderProperties
+}
+
 // SecretStoreResource - Concrete tracked resource types can be created by aliasing this type using a specific property type.
 type SecretStoreResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -1493,12 +1625,29 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration for the Terraform backend that stores the state of Terraform Recipes. Backend
+// specific settings are passed to the Terraform backend configuration.
+type TerraformBackendConfig struct {
+	// REQUIRED; The kind of the Terraform backend.
+	Kind *TerraformBackendKind
+
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// Sensitive data in backend configuration can be stored as secrets. The secrets are stored in Applications.Core/SecretStores
+	// resource.
+	Secrets map[string]*SecretReference
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
 	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
 	Authentication *AuthConfig
 
+	// Configuration for the Terraform backend that stores the state of Terraform Recipes. Defaults to a Kubernetes secret.
+	Backend *TerraformBackendConfig
+
 	// Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 	// other APIs. For more information, please see:
 	// https://developer.hashicorp.com/terraform/language/providers/configuration.
@@ -1561,6 +1710,19 @@ type ValueFromProperties struct {
 	Version *string
 }
 
+// VaultSecretStoreProviderProperties - The HashiCorp Vault secret store backend configuration. When resource is set, it references
+// the path of an existing secret in the KV secrets engine.
+type VaultSecretStoreProviderProperties struct {
+	// REQUIRED; The address of the Vault server. For example: https://vault.example.com:8200.
+	Address *string
+
+	// REQUIRED; The Kubernetes secret which has the Vault token in the 'token' key, in the form of <namespace>/<name>.
+	TokenSecret *string
+
+	// The mount path of the KV version 2 secrets engine. Defaults to 'secret'.
+	MountPath *string
+}
+
 // Volume - Specifies a volume for a container
 type Volume struct {
 	// REQUIRED; Discriminator property for Volume.
@@ -369,6 +369,88 @@ func (a *AuthConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AutoscalingExtension.
+func (a AutoscalingExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "autoscaling"
+	populate(objectMap, "maxReplicas", a.MaxReplicas)
+	populate(objectMap, "metrics", a.Metrics)
+	populate(objectMap, "minReplicas", a.MinReplicas)
+	populate(objectMap, "targetCpuUtilization", a.TargetCPUUtilization)
+	populate(objectMap, "targetMemoryUtilization", a.TargetMemoryUtilization)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoscalingExtension.
+func (a *AutoscalingExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+			err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "maxReplicas":
+			err = unpopulate(val, "MaxReplicas", &a.MaxReplicas)
+			delete(rawMsg, key)
+		case "metrics":
+			err = unpopulate(val, "Metrics", &a.Metrics)
+			delete(rawMsg, key)
+		case "minReplicas":
+			err = unpopulate(val, "MinReplicas", &a.MinReplicas)
+			delete(rawMsg, key)
+		case "targetCpuUtilization":
+			err = unpopulate(val, "TargetCPUUtilization", &a.TargetCPUUtilization)
+			delete(rawMsg, key)
+		case "targetMemoryUtilization":
+			err = unpopulate(val, "TargetMemoryUtilization", &a.TargetMemoryUtilization)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoscalingMetric.
+func (a AutoscalingMetric) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", a.Kind)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "targetAverageValue", a.TargetAverageValue)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoscalingMetric.
+func (a *AutoscalingMetric) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+			err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "targetAverageValue":
+			err = unpopulate(val, "TargetAverageValue", &a.TargetAverageValue)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureContainerInstanceCompute.
 func (a AzureContainerInstanceCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1070,6 +1152,7 @@ func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
@@ -1093,6 +1176,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+			err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 			err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1711,6 +1797,37 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayConfigProperties.
+func (g GatewayConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", g.GatewayClassName)
+	populate(objectMap, "kind", g.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayConfigProperties.
+func (g *GatewayConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+			err = unpopulate(val, "GatewayClassName", &g.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+			err = unpopulate(val, "Kind", &g.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
 func (g GatewayHostname) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2160,6 +2277,49 @@ func (h *HealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipeProperties.
+func (h HelmRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", h.Parameters)
+	populate(objectMap, "plainHttp", h.PlainHTTP)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+			err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+			err = unpopulate(val, "PlainHTTP", &h.PlainHTTP)
+			delete(rawMsg, key)
+		case "templateKind":
+			err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+			err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+			err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type IamProperties.
 func (i IamProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2749,6 +2909,7 @@ func (p *ProvidersAzure) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type Recipe.
 func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "force", r.Force)
 	populate(objectMap, "name", r.Name)
 	populate(objectMap, "parameters", r.Parameters)
 	return json.Marshal(objectMap)
@@ -2763,6 +2924,9 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "force":
+			err = unpopulate(val, "Force", &r.Force)
+			delete(rawMsg, key)
 		case "name":
 			err = unpopulate(val, "Name", &r.Name)
 			delete(rawMsg, key)
@@ -2925,6 +3089,88 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResult.
+func (r RecipePlanResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", r.Changes)
+	populate(objectMap, "drift", r.Drift)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResult.
+func (r *RecipePlanResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+			err = unpopulate(val, "Changes", &r.Changes)
+			delete(rawMsg, key)
+		case "drift":
+			err = unpopulate(val, "Drift", &r.Drift)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "action", r.Action)
+	populate(objectMap, "address", r.Address)
+	populate(objectMap, "after", r.After)
+	populate(objectMap, "before", r.Before)
+	populate(objectMap, "changedProperties", r.ChangedProperties)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "action":
+			err = unpopulate(val, "Action", &r.Action)
+			delete(rawMsg, key)
+		case "address":
+			err = unpopulate(val, "Address", &r.Address)
+			delete(rawMsg, key)
+		case "after":
+			err = unpopulate(val, "After", &r.After)
+			delete(rawMsg, key)
+		case "before":
+			err = unpopulate(val, "Before", &r.Before)
+			delete(rawMsg, key)
+		case "changedProperties":
+			err = unpopulate(val, "ChangedProperties", &r.ChangedProperties)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "type":
+			err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
 func (r RecipeStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3253,6 +3499,7 @@ func (s SecretStoreProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "application", s.Application)
 	populate(objectMap, "data", s.Data)
 	populate(objectMap, "environment", s.Environment)
+	populate(objectMap, "provider", s.Provider)
 	populate(objectMap, "provisioningState", s.ProvisioningState)
 	populate(objectMap, "resource", s.Resource)
 	populate(objectMap, "status", s.Status)
@@ -3278,6 +3525,9 @@ func (s *SecretStoreProperties) UnmarshalJSON(data []byte) error {
 		case "environment":
 			err = unpopulate(val, "Environment", &s.Environment)
 			delete(rawMsg, key)
+		case "provider":
+			err = unpopulate(val, "Provider", &s.Provider)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &s.ProvisioningState)
 			delete(rawMsg, key)
@@ -3298,6 +3548,37 @@ func (s *SecretStoreProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreProviderProperties.
+func (s SecretStoreProviderProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", s.Kind)
+	populate(objectMap, "vault", s.Vault)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreProviderProperties.
+func (s *SecretStoreProviderProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+			err = unpopulate(val, "Kind", &s.Kind)
+			delete(rawMsg, key)
+		case "vault":
+			err = unpopulate(val, "Vault", &s.Vault)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretStoreResource.
 func (s SecretStoreResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3552,10 +3833,57 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendConfig.
+func (t TerraformBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "secrets", t.Secrets)
+	if t.AdditionalProperties != nil {
+		for key, val := range t.AdditionalProperties {
+			objectMap[key] = val
+		}
+	}
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendConfig.
+func (t *TerraformBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+			err = unpopulate(val, "Kind", &t.Kind)
+			delete(rawMsg, key)
+		case "secrets":
+			err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		default:
+			if t.AdditionalProperties == nil {
+				t.AdditionalProperties = map[string]any{}
+			}
+			if val != nil {
+				var aux any
+				err = json.Unmarshal(val, &aux)
+				t.AdditionalProperties[key] = aux
+			}
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
 func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
 	populate(objectMap, "providers", t.Providers)
 	return json.Marshal(objectMap)
 }
@@ -3572,6 +3900,9 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 		case "authentication":
 			err = unpopulate(val, "Authentication", &t.Authentication)
 			delete(rawMsg, key)
+		case "backend":
+			err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
 		case "providers":
 			err = unpopulate(val, "Providers", &t.Providers)
 			delete(rawMsg, key)
@@ -3700,6 +4031,41 @@ func (v *ValueFromProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type VaultSecretStoreProviderProperties.
+func (v VaultSecretStoreProviderProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "address", v.Address)
+	populate(objectMap, "mountPath", v.MountPath)
+	populate(objectMap, "tokenSecret", v.TokenSecret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type VaultSecretStoreProviderProperties.
+func (v *VaultSecretStoreProviderProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", v, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "address":
+			err = unpopulate(val, "Address", &v.Address)
+			delete(rawMsg, key)
+		case "mountPath":
+			err = unpopulate(val, "MountPath", &v.MountPath)
+			delete(rawMsg, key)
+		case "tokenSecret":
+			err = unpopulate(val, "TokenSecret", &v.TokenSecret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", v, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Volume.
 func (v Volume) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -106,6 +106,12 @@ type ExtendersClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// ExtendersClientBeginPlanOptions contains the optional parameters for the ExtendersClient.BeginPlan method.
+type ExtendersClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // ExtendersClientBeginUpdateOptions contains the optional parameters for the ExtendersClient.BeginUpdate method.
 type ExtendersClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
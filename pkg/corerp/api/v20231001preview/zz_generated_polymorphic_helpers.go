@@ -41,6 +41,8 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 	switch m["kind"] {
 	case "aci":
 		b = &AzureContainerInstanceExtension{}
+	case "autoscaling":
+		b = &AutoscalingExtension{}
 	case "daprSidecar":
 		b = &DaprSidecarExtension{}
 	case "kubernetesMetadata":
@@ -114,6 +116,8 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
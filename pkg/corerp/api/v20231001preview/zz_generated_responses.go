@@ -132,6 +132,12 @@ type ExtendersClientListSecretsResponse struct {
 	Value map[string]any
 }
 
+// ExtendersClientPlanResponse contains the response from method ExtendersClient.BeginPlan.
+type ExtendersClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // ExtendersClientUpdateResponse contains the response from method ExtendersClient.BeginUpdate.
 type ExtendersClientUpdateResponse struct {
 	// ExtenderResource portable resource
@@ -145,6 +145,8 @@ type RecipeKind string
 const (
 	// RecipeKindBicep - Bicep recipe
 	RecipeKindBicep RecipeKind = "bicep"
+	// RecipeKindHelm - Helm chart recipe
+	RecipeKindHelm RecipeKind = "helm"
 	// RecipeKindTerraform - Terraform recipe
 	RecipeKindTerraform RecipeKind = "terraform"
 )
@@ -153,6 +155,7 @@ const (
 func PossibleRecipeKindValues() []RecipeKind {
 	return []RecipeKind{
 		RecipeKindBicep,
+		RecipeKindHelm,
 		RecipeKindTerraform,
 	}
 }
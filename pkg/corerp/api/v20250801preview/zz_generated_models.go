@@ -420,7 +420,7 @@ type ProvidersKubernetes struct {
 
 // RecipeDefinition - Recipe definition for a specific resource type
 type RecipeDefinition struct {
-	// REQUIRED; The type of recipe (e.g., Terraform, Bicep)
+	// REQUIRED; The type of recipe (e.g., Terraform, Bicep, Helm)
 	RecipeKind *RecipeKind
 
 	// REQUIRED; URL path to the recipe
@@ -43,6 +43,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/vault"
 
 	"github.com/go-openapi/jsonpointer"
 	corev1 "k8s.io/api/core/v1"
@@ -53,14 +54,15 @@ import (
 //go:generate mockgen -typed -destination=./mock_deploymentprocessor.go -package=deployment -self_package github.com/radius-project/radius/pkg/corerp/backend/deployment github.com/radius-project/radius/pkg/corerp/backend/deployment DeploymentProcessor
 type DeploymentProcessor interface {
 	Render(ctx context.Context, id resources.ID, resource v1.DataModelInterface) (renderers.RendererOutput, error)
+	Preview(ctx context.Context, id resources.ID, resource rpv1.RadiusResourceModel) (renderers.RendererOutput, error)
 	Deploy(ctx context.Context, id resources.ID, rendererOutput renderers.RendererOutput) (rpv1.DeploymentOutput, error)
 	Delete(ctx context.Context, id resources.ID, outputResources []rpv1.OutputResource) error
 	FetchSecrets(ctx context.Context, resourceData ResourceData) (map[string]any, error)
 }
 
 // NewDeploymentProcessor creates a new instance of the DeploymentProcessor struct with the given parameters.
-func NewDeploymentProcessor(appmodel model.ApplicationModel, databaseClient database.Client, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface) DeploymentProcessor {
-	return &deploymentProcessor{appmodel: appmodel, databaseClient: databaseClient, k8sClient: k8sClient, k8sClientSet: k8sClientSet}
+func NewDeploymentProcessor(appmodel model.ApplicationModel, databaseClient database.Client, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface, vaultOptions vault.Options) DeploymentProcessor {
+	return &deploymentProcessor{appmodel: appmodel, databaseClient: databaseClient, k8sClient: k8sClient, k8sClientSet: k8sClientSet, vaultOptions: vaultOptions}
 }
 
 var _ DeploymentProcessor = (*deploymentProcessor)(nil)
@@ -72,6 +74,8 @@ type deploymentProcessor struct {
 	k8sClient controller_runtime.Client
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+	// vaultOptions configures the connections to the Vault servers of Vault backed secret stores.
+	vaultOptions vault.Options
 }
 
 type ResourceData struct {
@@ -100,6 +104,32 @@ func (dp *deploymentProcessor) Render(ctx context.Context, resourceID resources.
 		return renderers.RendererOutput{}, err
 	}
 
+	return dp.render(ctx, renderer, resource, app, env)
+}
+
+// Preview renders the given resource like Render, but reads the application of the resource from the resource itself
+// instead of the database, so that a resource which has not been saved yet can be rendered. Nothing is deployed.
+func (dp *deploymentProcessor) Preview(ctx context.Context, resourceID resources.ID, resource rpv1.RadiusResourceModel) (renderers.RendererOutput, error) {
+	renderer, err := dp.getResourceRenderer(resourceID)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	appID := resource.ResourceMetadata().ApplicationID()
+	if appID == "" {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("application ID is not set for the resource %q", resourceID.String()))
+	}
+
+	app, env, err := dp.getApplicationAndEnvironment(ctx, appID)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	return dp.render(ctx, renderer, resource, app, env)
+}
+
+// render renders the resource for the given application and environment.
+func (dp *deploymentProcessor) render(ctx context.Context, renderer renderers.Renderer, resource v1.DataModelInterface, app *corerp_dm.Application, env *corerp_dm.Environment) (renderers.RendererOutput, error) {
 	// Get resources that the resource being deployed has connection with.
 	requiredResources, _, err := renderer.GetDependencyIDs(ctx, resource)
 	if err != nil {
@@ -223,12 +253,17 @@ func (dp *deploymentProcessor) getApplicationAndEnvironmentForResourceID(ctx con
 		return nil, nil, err
 	}
 
-	// 2. fetch the application properties from the DB
-	app := &corerp_dm.Application{}
 	if res.AppID == nil {
 		return nil, nil, fmt.Errorf("application ID is not set for the resource %q", id.String())
 	}
-	err = rp_util.FetchScopeResource(ctx, dp.databaseClient, res.AppID.String(), app)
+
+	return dp.getApplicationAndEnvironment(ctx, res.AppID.String())
+}
+
+func (dp *deploymentProcessor) getApplicationAndEnvironment(ctx context.Context, appID string) (*corerp_dm.Application, *corerp_dm.Environment, error) {
+	// 2. fetch the application properties from the DB
+	app := &corerp_dm.Application{}
+	err := rp_util.FetchScopeResource(ctx, dp.databaseClient, appID, app)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -430,6 +465,14 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	envOpts.Gateway = renderers.GatewayOptions{
+		Kind:             env.Properties.Gateway.Kind,
+		GatewayClassName: env.Properties.Gateway.GatewayClassName,
+	}
+	if envOpts.Gateway.Kind == "" {
+		envOpts.Gateway.Kind = corerp_dm.GatewayKindContour
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -445,16 +488,16 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			port = ""
 		}
 
-		envOpts.Gateway = renderers.GatewayOptions{
-			PublicEndpointOverride: true,
-			Hostname:               hostname,
-			Port:                   port,
-		}
+		envOpts.Gateway.PublicEndpointOverride = true
+		envOpts.Gateway.Hostname = hostname
+		envOpts.Gateway.Port = port
 
 		return envOpts, nil
 	}
 
-	if dp.k8sClient != nil {
+	// The public endpoint of a Gateway API implementation is only known once its Gateway is programmed,
+	// so the contour-envoy service is only used to compute the public endpoint of Contour gateways.
+	if dp.k8sClient != nil && envOpts.Gateway.Kind == corerp_dm.GatewayKindContour {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
 		err := dp.k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
@@ -465,11 +508,8 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		for _, service := range services.Items {
 			if service.Name == "contour-envoy" {
 				for _, in := range service.Status.LoadBalancer.Ingress {
-					envOpts.Gateway = renderers.GatewayOptions{
-						PublicEndpointOverride: false,
-						Hostname:               in.Hostname,
-						ExternalIP:             in.IP,
-					}
+					envOpts.Gateway.Hostname = in.Hostname
+					envOpts.Gateway.ExternalIP = in.IP
 					return envOpts, nil
 				}
 			}
@@ -527,7 +567,14 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 		if err = resource.As(obj); err != nil {
 			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
 		}
-		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, obj.ComputedValues, obj.SecretValues, portableresources.RecipeData{})
+		computedValues := obj.ComputedValues
+		if obj.Properties.ProviderKind() == corerp_dm.SecretStoreProviderVault {
+			computedValues, err = dp.getVaultSecretValues(ctx, obj)
+			if err != nil {
+				return ResourceData{}, err
+			}
+		}
+		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, computedValues, obj.SecretValues, portableresources.RecipeData{})
 	case strings.ToLower(ds_ctrl.MongoDatabasesResourceType):
 		obj := &dsrp_dm.MongoDatabase{}
 		if err = resource.As(obj); err != nil {
@@ -594,6 +641,41 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 	}
 }
 
+// getVaultSecretValues reads the values of the Vault backed secret store and returns them with the computed values of the
+// secret store. The values are keyed by the secret store data keys.
+func (dp *deploymentProcessor) getVaultSecretValues(ctx context.Context, secretStore *corerp_dm.SecretStore) (map[string]any, error) {
+	config := secretStore.Properties.Provider.Vault
+	if config == nil {
+		return nil, fmt.Errorf("vault provider configuration is not specified for secret store %q", secretStore.ID)
+	}
+
+	client, err := vault.NewClientFromSecret(ctx, dp.k8sClient, config.Address, config.MountPath, config.TokenSecret, dp.vaultOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := client.Read(ctx, secretStore.Properties.Resource)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("vault secret %q referenced by secret store %q is not found", secretStore.Properties.Resource, secretStore.ID))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read vault secret for secret store %q: %w", secretStore.ID, err)
+	}
+
+	values := map[string]any{}
+	for k, v := range secretStore.ComputedValues {
+		values[k] = v
+	}
+	for k, d := range secretStore.Properties.Data {
+		val, ok := secret[d.SourceKey(k)]
+		if !ok {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("vault secret %q referenced by secret store %q does not have key, '%s'", secretStore.Properties.Resource, secretStore.ID, d.SourceKey(k)))
+		}
+		values[k] = val
+	}
+
+	return values, nil
+}
+
 func (dp *deploymentProcessor) buildResourceDependency(resourceID resources.ID, applicationID string, resource v1.DataModelInterface, outputResources []rpv1.OutputResource, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference, recipeData portableresources.RecipeData) (ResourceData, error) {
 	var appID *resources.ID
 	// Application id is mandatory for some of the core resource types and is a required field.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/vault"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/radius-project/radius/test/testutil"
 
@@ -329,7 +331,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -403,7 +405,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success lowercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getLowerCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -453,7 +455,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success uppercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getUpperCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -503,7 +505,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -551,7 +553,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Resource not found in data store", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -566,7 +568,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Data store access error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -580,7 +582,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testInvalidResourceID := "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.foo/foo/foo"
 		testResource := getTestResource()
@@ -592,7 +594,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -614,7 +616,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -635,7 +637,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -657,7 +659,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -708,7 +710,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Unsupported output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -821,11 +823,76 @@ func setupDeployMocks(mocks SharedMocks, simulated bool) {
 	mocks.databaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&er, nil)
 }
 
+func Test_Preview(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	env := datamodel.Environment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/environments/env0",
+			},
+		},
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-test",
+				},
+			},
+		},
+	}
+
+	application := datamodel.Application{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/applications/test-application",
+			},
+		},
+		Properties: datamodel.ApplicationProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: env.ID,
+			},
+		},
+	}
+
+	t.Run("verify preview of an unsaved resource", func(t *testing.T) {
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
+
+		testResource := getTestResource()
+		testRendererOutput := getTestRendererOutput()
+		resourceID := getTestResourceID(testResource.ID)
+
+		mocks.renderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(testRendererOutput, nil)
+		mocks.renderer.EXPECT().GetDependencyIDs(gomock.Any(), gomock.Any()).Times(1).Return([]resources.ID{}, nil, nil)
+
+		// The resource itself is not read from the database.
+		mocks.databaseClient.EXPECT().Get(gomock.Any(), application.ID).Times(1).Return(&database.Object{Metadata: database.Metadata{ID: application.ID}, Data: application}, nil)
+		mocks.databaseClient.EXPECT().Get(gomock.Any(), env.ID).Times(1).Return(&database.Object{Metadata: database.Metadata{ID: env.ID}, Data: env}, nil)
+
+		rendererOutput, err := dp.Preview(ctx, resourceID, &testResource)
+		require.NoError(t, err)
+		require.Equal(t, len(testRendererOutput.Resources), len(rendererOutput.Resources))
+	})
+
+	t.Run("verify preview without an application", func(t *testing.T) {
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
+
+		testResource := getTestResource()
+		testResource.Properties.Application = ""
+		resourceID := getTestResourceID(testResource.ID)
+
+		_, err := dp.Preview(ctx, resourceID, &testResource)
+		require.Equal(t, v1.NewClientErrInvalidRequest(fmt.Sprintf("application ID is not set for the resource %q", resourceID.String())), err)
+	})
+}
+
 func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -865,7 +932,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success with simulated env", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -883,7 +950,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -900,7 +967,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Output resource dependency missing local ID", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -918,7 +985,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Invalid output resource type", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -936,7 +1003,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Missing output resource identity", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -962,7 +1029,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -976,7 +1043,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -990,7 +1057,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete with no output resources", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -1006,7 +1073,7 @@ func Test_Delete(t *testing.T) {
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, vault.Options{}}
 
 	env := &datamodel.Environment{
 		BaseResource: v1.BaseResource{
@@ -1068,10 +1135,60 @@ func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	})
 }
 
+func Test_getEnvOptions_Gateway(t *testing.T) {
+	ctx := testcontext.New(t)
+	mocks := setup(t)
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, vault.Options{}}
+
+	env := &datamodel.Environment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				Name: "test-env",
+			},
+		},
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-system",
+				},
+			},
+		},
+	}
+
+	t.Run("Verify getEnvOptions defaults to contour", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+		require.Equal(t, datamodel.GatewayKindContour, options.Gateway.Kind)
+		require.Empty(t, options.Gateway.GatewayClassName)
+	})
+
+	t.Run("Verify getEnvOptions keeps the gateway configuration with public endpoint override", func(t *testing.T) {
+		os.Setenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE", "localhost:8000")
+		defer os.Unsetenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE")
+
+		env.Properties.Gateway = datamodel.GatewayConfigProperties{
+			Kind:             datamodel.GatewayKindGatewayAPI,
+			GatewayClassName: "istio",
+		}
+
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+		require.Equal(t, renderers.GatewayOptions{
+			Kind:                   datamodel.GatewayKindGatewayAPI,
+			GatewayClassName:       "istio",
+			PublicEndpointOverride: true,
+			Hostname:               "localhost",
+			Port:                   "8000",
+		}, options.Gateway)
+	})
+}
+
 func Test_getResourceDataByID(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+	dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, vault.Options{}}
 
 	t.Run("Get recipe data from connected mongoDB resources", func(t *testing.T) {
 		depId, _ := resources.ParseResource("/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Datastores/mongoDatabases/test-mongo")
@@ -1115,7 +1232,7 @@ func Test_fetchSecrets(t *testing.T) {
 	ctx := testcontext.New(t)
 
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, vault.Options{}}
 
 	t.Run("Get secrets from recipe data when resource has associated recipe", func(t *testing.T) {
 		mongoResource := buildMongoDBResourceDataWithRecipeAndSecrets()
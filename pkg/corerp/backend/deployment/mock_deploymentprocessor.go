@@ -159,6 +159,45 @@ func (c *MockDeploymentProcessorFetchSecretsCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// Preview mocks base method.
+func (m *MockDeploymentProcessor) Preview(arg0 context.Context, arg1 resources.ID, arg2 v10.RadiusResourceModel) (renderers.RendererOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", arg0, arg1, arg2)
+	ret0, _ := ret[0].(renderers.RendererOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockDeploymentProcessorMockRecorder) Preview(arg0, arg1, arg2 any) *MockDeploymentProcessorPreviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockDeploymentProcessor)(nil).Preview), arg0, arg1, arg2)
+	return &MockDeploymentProcessorPreviewCall{Call: call}
+}
+
+// MockDeploymentProcessorPreviewCall wrap *gomock.Call
+type MockDeploymentProcessorPreviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDeploymentProcessorPreviewCall) Return(arg0 renderers.RendererOutput, arg1 error) *MockDeploymentProcessorPreviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDeploymentProcessorPreviewCall) Do(f func(context.Context, resources.ID, v10.RadiusResourceModel) (renderers.RendererOutput, error)) *MockDeploymentProcessorPreviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDeploymentProcessorPreviewCall) DoAndReturn(f func(context.Context, resources.ID, v10.RadiusResourceModel) (renderers.RendererOutput, error)) *MockDeploymentProcessorPreviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Render mocks base method.
 func (m *MockDeploymentProcessor) Render(arg0 context.Context, arg1 resources.ID, arg2 v1.DataModelInterface) (renderers.RendererOutput, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +281,32 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoscalingMetricKind represents the source of a custom autoscaling metric.
+type AutoscalingMetricKind string
+
+const (
+	// AutoscalingMetricKindPods represents a metric that describes each replica of the container.
+	AutoscalingMetricKindPods AutoscalingMetricKind = "pods"
+	// AutoscalingMetricKindExternal represents a metric that is not associated with any Kubernetes object.
+	AutoscalingMetricKindExternal AutoscalingMetricKind = "external"
+)
+
+// AutoscalingExtension - Autoscaling Extension
+type AutoscalingExtension struct {
+	MinReplicas             *int32              `json:"minReplicas,omitempty"`
+	MaxReplicas             int32               `json:"maxReplicas"`
+	TargetCPUUtilization    *int32              `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization *int32              `json:"targetMemoryUtilization,omitempty"`
+	Metrics                 []AutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoscalingMetric - A custom metric target used to scale the container.
+type AutoscalingMetric struct {
+	Name               string                `json:"name"`
+	Kind               AutoscalingMetricKind `json:"kind,omitempty"`
+	TargetAverageValue string                `json:"targetAverageValue"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
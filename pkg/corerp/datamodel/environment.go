@@ -23,6 +23,14 @@ import (
 
 const EnvironmentResourceType = "Applications.Core/environments"
 
+const (
+	// GatewayKindContour is the gateway kind that implements Gateways with Contour HTTPProxy resources. This is the default.
+	GatewayKindContour = "contour"
+
+	// GatewayKindGatewayAPI is the gateway kind that implements Gateways with Kubernetes Gateway API Gateway and HTTPRoute resources.
+	GatewayKindGatewayAPI = "gatewayApi"
+)
+
 // Environment represents Application environment resource.
 type Environment struct {
 	v1.BaseResource
@@ -42,6 +50,7 @@ type EnvironmentProperties struct {
 	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
 	Providers    Providers                                         `json:"providers,omitempty"`
 	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	Gateway      GatewayConfigProperties                           `json:"gateway,omitempty"`
 	Extensions   []Extension                                       `json:"extensions,omitempty"`
 	Simulated    bool                                              `json:"simulated,omitempty"`
 }
@@ -82,6 +91,15 @@ type Providers struct {
 	AWS ProvidersAWS `json:"aws,omitempty"`
 }
 
+// GatewayConfigProperties represents the configuration of the Gateways deployed to the environment.
+type GatewayConfigProperties struct {
+	// Kind is the kind of Kubernetes resources used to implement Gateways, for example "contour" or "gatewayApi".
+	Kind string `json:"kind,omitempty"`
+
+	// GatewayClassName is the name of the GatewayClass referenced by the generated Gateway resources.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
 // ProvidersAzure represents the azure provider configs
 type ProvidersAzure struct {
 	// Scope is the target level for deploying the azure resources
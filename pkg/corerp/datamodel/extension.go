@@ -21,6 +21,7 @@ type ExtensionKind string
 
 const (
 	ManualScaling                ExtensionKind = "manualScaling"
+	Autoscaling                  ExtensionKind = "autoscaling"
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
@@ -31,6 +32,7 @@ const (
 type Extension struct {
 	Kind                   ExtensionKind                    `json:"kind,omitempty"`
 	ManualScaling          *ManualScalingExtension          `json:"manualScaling,omitempty"`
+	Autoscaling            *AutoscalingExtension            `json:"autoscaling,omitempty"`
 	DaprSidecar            *DaprSidecarExtension            `json:"daprSidecar,omitempty"`
 	KubernetesMetadata     *KubeMetadataExtension           `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace    *KubeNamespaceExtension          `json:"kubernetesNamespace,omitempty"`
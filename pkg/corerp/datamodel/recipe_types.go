@@ -40,6 +40,21 @@ type TerraformConfigProperties struct {
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Backend specifies the Terraform backend that stores the state of Terraform Recipes. Defaults to a Kubernetes secret when nil.
+	Backend *TerraformBackendConfig `json:"backend,omitempty"`
+}
+
+// TerraformBackendConfig - Configuration for the Terraform backend that stores the state of Terraform Recipes.
+type TerraformBackendConfig struct {
+	// Kind is the kind of the Terraform backend, for example "kubernetes", "pg", "s3" or "local".
+	Kind string `json:"kind"`
+
+	// AdditionalProperties represents the non-sensitive backend specific settings.
+	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
+
+	// Secrets represents the backend specific settings that are stored as secrets.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
 }
 
 // BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep plans and applies templates as part of Recipe
@@ -53,6 +53,16 @@ const (
 	SecretTypeAWSIRSA SecretType = "awsIRSA"
 )
 
+// SecretStoreProviderKind represents the kind of the backend that stores secret values.
+type SecretStoreProviderKind string
+
+const (
+	// SecretStoreProviderKubernetes stores secret values in a Kubernetes secret.
+	SecretStoreProviderKubernetes SecretStoreProviderKind = "kubernetes"
+	// SecretStoreProviderVault stores secret values in the KV version 2 secrets engine of HashiCorp Vault.
+	SecretStoreProviderVault SecretStoreProviderKind = "vault"
+)
+
 // SecretStore represents secret store resource.
 type SecretStore struct {
 	v1.BaseResource
@@ -99,6 +109,35 @@ type SecretStoreProperties struct {
 
 	// Resource is the resource id of an external secret store.
 	Resource string `json:"resource,omitempty"`
+
+	// Provider is the backend that stores the secret values. Kubernetes secrets are used if it is nil.
+	Provider *SecretStoreProvider `json:"provider,omitempty"`
+}
+
+// ProviderKind returns the kind of the backend that stores the secret values.
+func (s *SecretStoreProperties) ProviderKind() SecretStoreProviderKind {
+	if s.Provider == nil || s.Provider.Kind == "" {
+		return SecretStoreProviderKubernetes
+	}
+	return s.Provider.Kind
+}
+
+// SecretStoreProvider represents the backend that stores the secret values of a secret store.
+type SecretStoreProvider struct {
+	// Kind is the kind of the backend.
+	Kind SecretStoreProviderKind `json:"kind"`
+	// Vault is the HashiCorp Vault backend configuration.
+	Vault *VaultSecretStoreProvider `json:"vault,omitempty"`
+}
+
+// VaultSecretStoreProvider represents the HashiCorp Vault backend configuration.
+type VaultSecretStoreProvider struct {
+	// Address is the address of the Vault server.
+	Address string `json:"address"`
+	// MountPath is the mount path of the KV version 2 secrets engine.
+	MountPath string `json:"mountPath,omitempty"`
+	// TokenSecret is the Kubernetes secret which has the Vault token, in the form of <namespace>/<name>.
+	TokenSecret string `json:"tokenSecret"`
 }
 
 // SecretStoreDataValue represents the value of the secret store data.
@@ -111,6 +150,14 @@ type SecretStoreDataValue struct {
 	ValueFrom *SecretStoreDataValueFrom `json:"valueFrom,omitempty"`
 }
 
+// SourceKey returns the name of the secret in the secret store backend for the data entry with the given key.
+func (s *SecretStoreDataValue) SourceKey(key string) string {
+	if s.ValueFrom != nil && s.ValueFrom.Name != "" {
+		return s.ValueFrom.Name
+	}
+	return key
+}
+
 // SecretStoreDataValueFrom represents the secret reference in the secret store.
 type SecretStoreDataValueFrom struct {
 	// Name is the name of the secret.
@@ -23,6 +23,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -34,8 +35,9 @@ import (
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty   = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty        = "$.properties.runtimes.kubernetes.pod"
+	extensionsTargetProperty = "$.properties.extensions"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +54,10 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateExtensions(newResource.Properties.Extensions); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(*v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +94,68 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+func errInvalidExtension(message string) *v1.ErrorDetails {
+	return &v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  extensionsTargetProperty,
+		Message: message,
+	}
+}
+
+// validateExtensions validates the container extensions. manualScaling and autoscaling extensions are mutually
+// exclusive because both control the replica count of the container.
+func validateExtensions(extensions []datamodel.Extension) error {
+	autoscaling := datamodel.FindExtension(extensions, datamodel.Autoscaling)
+	if autoscaling == nil || autoscaling.Autoscaling == nil {
+		return nil
+	}
+
+	if datamodel.FindExtension(extensions, datamodel.ManualScaling) != nil {
+		return errInvalidExtension("manualScaling and autoscaling extensions cannot be used together.")
+	}
+
+	return validateAutoscalingExtension(autoscaling.Autoscaling)
+}
+
+// validateAutoscalingExtension validates the replica bounds and scaling targets of the autoscaling extension.
+func validateAutoscalingExtension(ext *datamodel.AutoscalingExtension) error {
+	if ext.MaxReplicas < 1 {
+		return errInvalidExtension(fmt.Sprintf("autoscaling maxReplicas must be at least 1, but got %d.", ext.MaxReplicas))
+	}
+
+	if ext.MinReplicas != nil {
+		if *ext.MinReplicas < 1 {
+			return errInvalidExtension(fmt.Sprintf("autoscaling minReplicas must be at least 1, but got %d.", *ext.MinReplicas))
+		}
+		if *ext.MinReplicas > ext.MaxReplicas {
+			return errInvalidExtension(fmt.Sprintf("autoscaling minReplicas %d must not be greater than maxReplicas %d.", *ext.MinReplicas, ext.MaxReplicas))
+		}
+	}
+
+	if ext.TargetCPUUtilization == nil && ext.TargetMemoryUtilization == nil && len(ext.Metrics) == 0 {
+		return errInvalidExtension("autoscaling requires at least one of targetCpuUtilization, targetMemoryUtilization, or metrics.")
+	}
+
+	if ext.TargetCPUUtilization != nil && *ext.TargetCPUUtilization < 1 {
+		return errInvalidExtension(fmt.Sprintf("autoscaling targetCpuUtilization must be greater than 0, but got %d.", *ext.TargetCPUUtilization))
+	}
+
+	if ext.TargetMemoryUtilization != nil && *ext.TargetMemoryUtilization < 1 {
+		return errInvalidExtension(fmt.Sprintf("autoscaling targetMemoryUtilization must be greater than 0, but got %d.", *ext.TargetMemoryUtilization))
+	}
+
+	for i, metric := range ext.Metrics {
+		if metric.Name == "" {
+			return errInvalidExtension(fmt.Sprintf("autoscaling metrics[%d] must have a name.", i))
+		}
+		if _, err := resource.ParseQuantity(metric.TargetAverageValue); err != nil {
+			return errInvalidExtension(fmt.Sprintf("autoscaling metric %s has invalid targetAverageValue %q: %s.", metric.Name, metric.TargetAverageValue, err.Error()))
+		}
+	}
+
+	return nil
+}
+
 func errMultipleResources(typeName string, num int) *v1.ErrorDetails {
 	return &v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
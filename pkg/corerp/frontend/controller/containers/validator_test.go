@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -444,3 +445,105 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateExtensions(t *testing.T) {
+	validMetrics := []datamodel.AutoscalingMetric{
+		{Name: "http_requests_per_second", Kind: datamodel.AutoscalingMetricKindPods, TargetAverageValue: "500m"},
+	}
+
+	extensionTests := []struct {
+		name       string
+		extensions []datamodel.Extension
+		message    string
+	}{
+		{
+			name:       "no extensions",
+			extensions: nil,
+		},
+		{
+			name: "manual scaling only",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+			},
+		},
+		{
+			name: "valid autoscaling with cpu target",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MinReplicas: to.Ptr[int32](2), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](80)}},
+			},
+		},
+		{
+			name: "valid autoscaling with custom metrics",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5, Metrics: validMetrics}},
+			},
+		},
+		{
+			name: "manual scaling and autoscaling",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](80)}},
+			},
+			message: "manualScaling and autoscaling extensions cannot be used together.",
+		},
+		{
+			name: "invalid maxReplicas",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 0, TargetCPUUtilization: to.Ptr[int32](80)}},
+			},
+			message: "autoscaling maxReplicas must be at least 1, but got 0.",
+		},
+		{
+			name: "minReplicas greater than maxReplicas",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MinReplicas: to.Ptr[int32](6), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](80)}},
+			},
+			message: "autoscaling minReplicas 6 must not be greater than maxReplicas 5.",
+		},
+		{
+			name: "no scaling target",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5}},
+			},
+			message: "autoscaling requires at least one of targetCpuUtilization, targetMemoryUtilization, or metrics.",
+		},
+		{
+			name: "invalid memory target",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](0)}},
+			},
+			message: "autoscaling targetMemoryUtilization must be greater than 0, but got 0.",
+		},
+		{
+			name: "metric without name",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5, Metrics: []datamodel.AutoscalingMetric{{TargetAverageValue: "1"}}}},
+			},
+			message: "autoscaling metrics[0] must have a name.",
+		},
+	}
+
+	for _, tc := range extensionTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateExtensions(tc.extensions)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsTargetProperty,
+				Message: tc.message,
+			}, err)
+		})
+	}
+
+	t.Run("invalid metric target value", func(t *testing.T) {
+		err := validateExtensions([]datamodel.Extension{
+			{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 5, Metrics: []datamodel.AutoscalingMetric{{Name: "queue", TargetAverageValue: "lots"}}}},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.(*v1.ErrorDetails).Message, "autoscaling metric queue has invalid targetAverageValue \"lots\"")
+	})
+}
@@ -76,28 +76,8 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return r, err
 	}
 
-	if err := newResource.Properties.Compute.Identity.Validate(); err != nil {
-		return rest.NewBadRequestResponse(err.Error()), nil
-	}
-
-	// Create Query filter to query kubernetes namespace used by the other environment resources.
-	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
-	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.DatabaseClient())
-	if err != nil {
-		return nil, err
-	}
-
-	if len(result.Items) > 0 {
-		env := &datamodel.Environment{}
-		if err := result.Items[0].As(env); err != nil {
-			return nil, err
-		}
-
-		// If a different resource has the same namespace, return a conflict
-		// Otherwise, continue and update the resource
-		if (old == nil || env.ID != old.ID) && env.Properties.Compute.Kind != rpv1.ACIComputeKind {
-			return rest.NewConflictResponse(fmt.Sprintf("Environment %s with the same namespace (%s) already exists", env.ID, namespace)), nil
-		}
+	if r, err := ValidateRequest(ctx, newResource, old, e.Options()); r != nil || err != nil {
+		return r, err
 	}
 
 	if newResource.Properties.Compute.Kind == rpv1.ACIComputeKind {
@@ -106,6 +86,7 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		}
 	} else if newResource.Properties.Compute.Kind == rpv1.KubernetesComputeKind {
 		// Create environment namespace if it doesn't exist.
+		namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 		err = e.Options().KubeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
 		if apierrors.IsAlreadyExists(err) {
 			logger.Info("Using existing namespace", "namespace", namespace)
@@ -125,6 +106,38 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
 
+// ValidateRequest validates the identity of the environment and that its Kubernetes namespace is not used by another
+// environment.
+func ValidateRequest(ctx context.Context, newResource *datamodel.Environment, oldResource *datamodel.Environment, options *ctrl.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	if err := newResource.Properties.Compute.Identity.Validate(); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// Create Query filter to query kubernetes namespace used by the other environment resources.
+	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
+	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, options.DatabaseClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Items) > 0 {
+		env := &datamodel.Environment{}
+		if err := result.Items[0].As(env); err != nil {
+			return nil, err
+		}
+
+		// If a different resource has the same namespace, return a conflict
+		// Otherwise, continue and update the resource
+		if (oldResource == nil || env.ID != oldResource.ID) && env.Properties.Compute.Kind != rpv1.ACIComputeKind {
+			return rest.NewConflictResponse(fmt.Sprintf("Environment %s with the same namespace (%s) already exists", env.ID, namespace)), nil
+		}
+	}
+
+	return nil, nil
+}
+
 const (
 	ApplicationAddressSpace = "10.1.0.0/16"
 	LBAddressSpace          = "172.16.0.0/19"
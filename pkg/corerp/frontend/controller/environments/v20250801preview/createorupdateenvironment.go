@@ -18,12 +18,16 @@ package v20250801preview
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
@@ -68,10 +72,128 @@ func (e *CreateOrUpdateEnvironmentv20250801preview) Run(ctx context.Context, w h
 		return resp, err
 	}
 
+	if resp, err := ValidateRequest(ctx, newResource, old, e.Options()); resp != nil || err != nil {
+		return resp, err
+	}
+
+	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
+
+	references, err := updateRecipePackReferences(ctx, serviceCtx.ResourceID.String(), newResource, old, e.DatabaseClient())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(references) == 0 {
+		newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+	}
+
+	// Save the environment and the references of its recipe packs together, so that a failure cannot leave a recipe
+	// pack referencing an environment which does not use it or the other way around.
+	obj := &database.Object{
+		Metadata: database.Metadata{
+			ID: serviceCtx.ResourceID.String(),
+		},
+		Data: newResource,
+	}
+	operations := append([]database.Operation{database.SaveOperation(obj, database.WithETag(etag))}, references...)
+	if err := e.DatabaseClient().Transact(ctx, operations...); err != nil {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, obj.ETag, newResource)
+}
+
+// updateRecipePackReferences returns the operations which add the environment to the ReferencedBy list of the recipe
+// packs it starts using and remove it from the recipe packs it stops using. Recipe packs which do not exist are skipped.
+func updateRecipePackReferences(ctx context.Context, environmentID string, newResource *datamodel.Environment_v20250801preview, oldResource *datamodel.Environment_v20250801preview, databaseClient database.Client) ([]database.Operation, error) {
+	added := recipePackSet(newResource.Properties.RecipePacks)
+	removed := map[string]string{}
+	if oldResource != nil {
+		removed = recipePackSet(oldResource.Properties.RecipePacks)
+	}
+
+	for key := range added {
+		if _, ok := removed[key]; ok {
+			delete(added, key)
+			delete(removed, key)
+		}
+	}
+
+	operations := []database.Operation{}
+	update := func(recipePackID string, reference func([]string) []string) error {
+		obj, err := databaseClient.Get(ctx, recipePackID)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		recipePack := &datamodel.RecipePack{}
+		if err := obj.As(recipePack); err != nil {
+			return err
+		}
+
+		recipePack.Properties.ReferencedBy = reference(recipePack.Properties.ReferencedBy)
+		operations = append(operations, database.SaveOperation(&database.Object{Metadata: obj.Metadata, Data: recipePack}, database.WithETag(obj.ETag)))
+		return nil
+	}
+
+	for _, recipePackID := range sortedValues(added) {
+		err := update(recipePackID, func(referencedBy []string) []string {
+			if slices.ContainsFunc(referencedBy, func(id string) bool { return strings.EqualFold(id, environmentID) }) {
+				return referencedBy
+			}
+			return append(referencedBy, environmentID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, recipePackID := range sortedValues(removed) {
+		err := update(recipePackID, func(referencedBy []string) []string {
+			return slices.DeleteFunc(referencedBy, func(id string) bool { return strings.EqualFold(id, environmentID) })
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return operations, nil
+}
+
+// recipePackSet returns the recipe pack IDs keyed by their lowercase form.
+func recipePackSet(recipePacks []string) map[string]string {
+	result := map[string]string{}
+	for _, recipePackID := range recipePacks {
+		result[strings.ToLower(recipePackID)] = recipePackID
+	}
+	return result
+}
+
+func sortedValues(values map[string]string) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, value)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// ValidateRequest validates that the Kubernetes namespace of the environment exists and is not used by another
+// environment, and that the recipe packs of the environment do not define recipes for the same resource type.
+func ValidateRequest(ctx context.Context, newResource *datamodel.Environment_v20250801preview, oldResource *datamodel.Environment_v20250801preview, options *ctrl.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	if newResource.Properties.Providers != nil && newResource.Properties.Providers.Kubernetes != nil {
 		namespace := newResource.Properties.Providers.Kubernetes.Namespace
-		result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.providers.kubernetes.namespace", namespace, e.DatabaseClient())
+		result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.providers.kubernetes.namespace", namespace, options.DatabaseClient)
 		if err != nil {
 			return nil, err
 		}
@@ -84,13 +206,13 @@ func (e *CreateOrUpdateEnvironmentv20250801preview) Run(ctx context.Context, w h
 
 			// If a different resource has the same namespace, return a conflict
 			// Otherwise, continue and update the resource
-			if old == nil || env.ID != old.ID {
+			if oldResource == nil || env.ID != oldResource.ID {
 				return rest.NewConflictResponse(fmt.Sprintf("Environment %s with the same namespace (%s) already exists", env.ID, namespace)), nil
 			}
 		}
 
 		ns := &corev1.Namespace{}
-		err = e.Options().KubeClient.Get(ctx, client.ObjectKey{Name: namespace}, ns)
+		err = options.KubeClient.Get(ctx, client.ObjectKey{Name: namespace}, ns)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
 				return rest.NewBadRequestResponse(fmt.Sprintf("Namespace '%s' does not exist in the Kubernetes cluster. Please create it before proceeding.", namespace)), nil
@@ -99,21 +221,11 @@ func (e *CreateOrUpdateEnvironmentv20250801preview) Run(ctx context.Context, w h
 		}
 	}
 
-	if resp, err := e.validateRecipePacks(ctx, newResource.Properties.RecipePacks); resp != nil || err != nil {
-		return resp, err
-	}
-
-	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
-	newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
-	if err != nil {
-		return nil, err
-	}
-
-	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+	return validateRecipePacks(ctx, newResource.Properties.RecipePacks, options.DatabaseClient)
 }
 
 // Validate recipe packs ensures that no two recipe packs define recipe for the same resource type.
-func (e *CreateOrUpdateEnvironmentv20250801preview) validateRecipePacks(ctx context.Context, recipePacks []string) (rest.Response, error) {
+func validateRecipePacks(ctx context.Context, recipePacks []string, databaseClient database.Client) (rest.Response, error) {
 	if len(recipePacks) <= 1 {
 		return nil, nil
 	}
@@ -128,7 +240,7 @@ func (e *CreateOrUpdateEnvironmentv20250801preview) validateRecipePacks(ctx cont
 		}
 
 		// Get the recipe pack resource
-		obj, err := e.DatabaseClient().Get(ctx, id.String())
+		obj, err := databaseClient.Get(ctx, id.String())
 		if err != nil {
 			return rest.NewBadRequestResponse(fmt.Sprintf("Failed to retrieve recipe pack %s: %v", recipePackID, err)), nil
 		}
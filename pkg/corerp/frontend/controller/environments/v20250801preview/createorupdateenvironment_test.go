@@ -25,6 +25,7 @@ import (
 	"strings"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
@@ -91,12 +92,27 @@ func TestCreateOrUpdateEnvironmentRun_20250801Preview(t *testing.T) {
 			expectedOutput.SystemData.CreatedByType = expectedOutput.SystemData.LastModifiedByType
 
 			if !tt.shouldFail {
+				// The new environment is added to the references of its recipe pack in the same transaction.
 				databaseClient.
 					EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
-						obj.ETag = "new-resource-etag"
-						obj.Data = envDataModel
+					Get(gomock.Any(), "/planes/radius/local/providers/Radius.Core/recipePacks/kubernetes-pack").
+					Return(&database.Object{
+						Metadata: database.Metadata{ID: "/planes/radius/local/providers/Radius.Core/recipePacks/kubernetes-pack", ETag: "pack-etag"},
+						Data:     &datamodel.RecipePack{},
+					}, nil)
+
+				databaseClient.
+					EXPECT().
+					Transact(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+						require.Len(t, operations, 2)
+						require.Equal(t, database.OperationTypeSave, operations[1].Type)
+						require.Equal(t, database.ETag("pack-etag"), operations[1].ETag)
+						recipePack := operations[1].Object.Data.(*datamodel.RecipePack)
+						require.Equal(t, []string{v1.ARMRequestContextFromContext(ctx).ResourceID.String()}, recipePack.Properties.ReferencedBy)
+
+						operations[0].Object.ETag = "new-resource-etag"
+						operations[0].Object.Data = envDataModel
 						return nil
 					})
 			}
@@ -365,9 +381,14 @@ func TestCreateOrUpdateEnvironment_RecipePackValidation(t *testing.T) {
 		expectedError      string
 	}{
 		{
-			desc:               "single-recipe-pack-no-validation",
-			recipePacks:        []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Radius.Core/recipePacks/pack1"},
-			setupMockDB:        func(*database.MockClient) {}, // No recipe pack validation for single pack
+			desc:        "single-recipe-pack-no-validation",
+			recipePacks: []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Radius.Core/recipePacks/pack1"},
+			setupMockDB: func(databaseClient *database.MockClient) {
+				// No recipe pack validation for single pack. The recipe pack is only read to update its references.
+				databaseClient.EXPECT().
+					Get(gomock.Any(), "/subscriptions/sub1/resourceGroups/rg1/providers/Radius.Core/recipePacks/pack1").
+					Return(&database.Object{Data: &datamodel.RecipePack{}}, nil)
+			},
 			expectedStatusCode: 200,
 		},
 		{
@@ -397,11 +418,13 @@ func TestCreateOrUpdateEnvironment_RecipePackValidation(t *testing.T) {
 
 				databaseClient.EXPECT().
 					Get(gomock.Any(), "/subscriptions/sub1/resourceGroups/rg1/providers/Radius.Core/recipePacks/pack1").
-					Return(&database.Object{Data: pack1}, nil)
+					Return(&database.Object{Data: pack1}, nil).
+					Times(2)
 
 				databaseClient.EXPECT().
 					Get(gomock.Any(), "/subscriptions/sub1/resourceGroups/rg1/providers/Radius.Core/recipePacks/pack2").
-					Return(&database.Object{Data: pack2}, nil)
+					Return(&database.Object{Data: pack2}, nil).
+					Times(2)
 			},
 			expectedStatusCode: 200,
 		},
@@ -517,13 +540,14 @@ func TestCreateOrUpdateEnvironment_RecipePackValidation(t *testing.T) {
 				Query(gomock.Any(), gomock.Any()).
 				Return(&database.ObjectQueryResult{Items: []database.Object{}}, nil).MaxTimes(1)
 
-			// Mock Save only for successful cases
+			// Mock Transact only for successful cases. The environment and the references of its recipe packs are saved together.
 			if tt.expectedStatusCode == 200 {
 				databaseClient.EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
-						obj.ETag = "new-resource-etag"
-						obj.Data = envDataModel
+					Transact(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+						require.Len(t, operations, len(tt.recipePacks)+1)
+						operations[0].Object.ETag = "new-resource-etag"
+						operations[0].Object.Data = envDataModel
 						return nil
 					}).MaxTimes(1)
 			}
@@ -553,3 +577,87 @@ func TestCreateOrUpdateEnvironment_RecipePackValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateEnvironment_RecipePackReferences(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+	databaseClient := database.NewMockClient(mctrl)
+
+	oldPackID := "/planes/radius/local/providers/Radius.Core/recipePacks/old-pack"
+	newPackID := "/planes/radius/local/providers/Radius.Core/recipePacks/new-pack"
+	missingPackID := "/planes/radius/local/providers/Radius.Core/recipePacks/missing-pack"
+
+	envInput, envDataModel, _ := getTestModelsv20250801preview()
+	envInput.Properties.RecipePacks = []*string{&newPackID, &missingPackID}
+	envDataModel.Properties.RecipePacks = []string{oldPackID}
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderfilev20250801preview, envInput)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	envID := v1.ARMRequestContextFromContext(ctx).ResourceID.String()
+
+	databaseClient.EXPECT().
+		Get(gomock.Any(), envID).
+		Return(&database.Object{Metadata: database.Metadata{ID: envID, ETag: "env-etag"}, Data: envDataModel}, nil)
+	databaseClient.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		Return(&database.ObjectQueryResult{Items: []database.Object{}}, nil)
+
+	// Validation of the recipe packs reads both new recipe packs first.
+	databaseClient.EXPECT().
+		Get(gomock.Any(), newPackID).
+		Return(&database.Object{Metadata: database.Metadata{ID: newPackID, ETag: "new-pack-etag"}, Data: &datamodel.RecipePack{}}, nil).
+		Times(2)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), missingPackID).
+		Return(&database.Object{Metadata: database.Metadata{ID: missingPackID}, Data: &datamodel.RecipePack{}}, nil)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), missingPackID).
+		Return(nil, &database.ErrNotFound{ID: missingPackID})
+	databaseClient.EXPECT().
+		Get(gomock.Any(), oldPackID).
+		Return(&database.Object{
+			Metadata: database.Metadata{ID: oldPackID, ETag: "old-pack-etag"},
+			Data:     &datamodel.RecipePack{Properties: datamodel.RecipePackProperties{ReferencedBy: []string{"/planes/radius/local/resourceGroups/testGroup/providers/Radius.Core/environments/other", envID}}},
+		}, nil)
+
+	databaseClient.EXPECT().
+		Transact(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+			require.Len(t, operations, 3)
+
+			require.Equal(t, envID, operations[0].ResourceID())
+			require.Equal(t, database.ETag("env-etag"), operations[0].ETag)
+
+			require.Equal(t, newPackID, operations[1].ResourceID())
+			require.Equal(t, database.ETag("new-pack-etag"), operations[1].ETag)
+			require.Equal(t, []string{envID}, operations[1].Object.Data.(*datamodel.RecipePack).Properties.ReferencedBy)
+
+			require.Equal(t, oldPackID, operations[2].ResourceID())
+			require.Equal(t, database.ETag("old-pack-etag"), operations[2].ETag)
+			require.Equal(t, []string{"/planes/radius/local/resourceGroups/testGroup/providers/Radius.Core/environments/other"}, operations[2].Object.Data.(*datamodel.RecipePack).Properties.ReferencedBy)
+
+			operations[0].Object.ETag = "updated-env-etag"
+			return nil
+		})
+
+	defaultNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	}
+	opts := ctrl.Options{
+		DatabaseClient: databaseClient,
+		KubeClient:     k8sutil.NewFakeKubeClient(nil, defaultNamespace),
+	}
+
+	ctl, err := NewCreateOrUpdateEnvironmentv20250801preview(opts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	require.Equal(t, "updated-env-etag", w.Header().Get("ETag"))
+}
@@ -64,6 +64,13 @@ func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWrite
 		return resp, err
 	}
 
+	// The environments referencing the recipe pack are tracked by the environments controller and saved in the same
+	// transaction as the environment. Keep them when the recipe pack is updated.
+	newResource.Properties.ReferencedBy = nil
+	if old != nil {
+		newResource.Properties.ReferencedBy = old.Properties.ReferencedBy
+	}
+
 	logger.Info("Creating or updating recipe pack", "resourceID", serviceCtx.ResourceID.String())
 
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
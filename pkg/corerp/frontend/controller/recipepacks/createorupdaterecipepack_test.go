@@ -114,6 +114,9 @@ func TestCreateOrUpdateRecipePackRun_UpdateExisting(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	ctx := rpctest.NewARMRequestContext(req)
 
+	referencedBy := []string{"/planes/radius/local/resourceGroups/default/providers/Radius.Core/environments/env0"}
+	recipePackDataModel.Properties.ReferencedBy = referencedBy
+
 	databaseClient.
 		EXPECT().
 		Get(gomock.Any(), gomock.Any()).
@@ -127,6 +130,8 @@ func TestCreateOrUpdateRecipePackRun_UpdateExisting(t *testing.T) {
 		EXPECT().
 		Save(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			// The references of the environments are kept when the recipe pack is updated.
+			require.Equal(t, referencedBy, obj.Data.(*datamodel.RecipePack).Properties.ReferencedBy)
 			obj.Data = recipePackDataModel
 			return nil
 		})
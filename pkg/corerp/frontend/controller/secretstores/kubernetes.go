@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/vault"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -99,20 +100,34 @@ func ValidateAndMutateRequest(ctx context.Context, newResource *datamodel.Secret
 			return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.type cannot change from '%s' to '%s'.", oldResource.Properties.Type, newResource.Properties.Type)), nil
 		}
 
+		if oldResource.Properties.ProviderKind() != newResource.Properties.ProviderKind() {
+			return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.provider.kind cannot change from '%s' to '%s'.", oldResource.Properties.ProviderKind(), newResource.Properties.ProviderKind())), nil
+		}
+
 		if newResource.Properties.Resource == "" {
 			newResource.Properties.Resource = oldResource.Properties.Resource
 		}
 	}
 
-	refResourceID := newResource.Properties.Resource
-	if _, _, err := fromResourceID(refResourceID); err != nil {
-		return nil, err
+	providerKind := newResource.Properties.ProviderKind()
+	switch providerKind {
+	case datamodel.SecretStoreProviderKubernetes:
+		if _, _, err := fromResourceID(newResource.Properties.Resource); err != nil {
+			return nil, err
+		}
+	case datamodel.SecretStoreProviderVault:
+		if msg := validateVaultProvider(newResource.Properties.Provider.Vault, vaultOptions(ctx)); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+	default:
+		return rest.NewBadRequestResponse(fmt.Sprintf("'%s' is the invalid secret store provider.", providerKind)), nil
 	}
 
+	refResourceID := newResource.Properties.Resource
 	for k, secret := range newResource.Properties.Data {
 		// Kubernetes secret does not support valueFrom. Note that this property is reserved to
 		// reference the secret in the external secret stores, such as Azure KeyVault and AWS secrets manager.
-		if secret.ValueFrom != nil && secret.ValueFrom.Name != "" {
+		if providerKind == datamodel.SecretStoreProviderKubernetes && secret.ValueFrom != nil && secret.ValueFrom.Name != "" {
 			return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.data[%s].valueFrom.Name is specified. Kubernetes secret resource doesn't support secret reference. ", k)), nil
 		}
 
@@ -138,6 +153,32 @@ func ValidateAndMutateRequest(ctx context.Context, newResource *datamodel.Secret
 	return nil, nil
 }
 
+// validateVaultProvider validates the Vault provider configuration and sets the default mount path. It returns
+// the validation error message if the configuration is invalid.
+func validateVaultProvider(config *datamodel.VaultSecretStoreProvider, options vault.Options) string {
+	if config == nil {
+		return "$.properties.provider.vault must be specified for vault provider."
+	}
+
+	if err := options.ValidateAddress(config.Address); err != nil {
+		if options.AllowInsecureHTTP {
+			return fmt.Sprintf("$.properties.provider.vault.address '%s' must be an absolute http or https URL.", config.Address)
+		}
+		return fmt.Sprintf("$.properties.provider.vault.address '%s' must be an absolute https URL.", config.Address)
+	}
+
+	ns, name, err := fromResourceID(config.TokenSecret)
+	if err != nil || ns == "" || name == "" {
+		return fmt.Sprintf("$.properties.provider.vault.tokenSecret '%s' must be in the form of <namespace>/<name>.", config.TokenSecret)
+	}
+
+	if config.MountPath == "" {
+		config.MountPath = vault.DefaultMountPath
+	}
+
+	return ""
+}
+
 func getNamespace(ctx context.Context, res *datamodel.SecretStore, options *controller.Options) (string, error) {
 	prop := res.Properties
 	if prop.Application != "" {
@@ -200,9 +241,14 @@ func fromResourceID(id string) (ns string, name string, err error) {
 	return
 }
 
-// UpsertSecret creates or updates a Kubernetes secret based on the incoming request and returns the secret's location in
+var _ secretProvider = (*kubernetesProvider)(nil)
+
+// kubernetesProvider is the secretProvider which stores secret values in Kubernetes secrets.
+type kubernetesProvider struct{}
+
+// Upsert creates or updates a Kubernetes secret based on the incoming request and returns the secret's location in
 // the output resource.
-func UpsertSecret(ctx context.Context, newResource, old *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+func (p *kubernetesProvider) Upsert(ctx context.Context, newResource, old *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
 	ref := newResource.Properties.Resource
 	if ref == "" && old != nil {
 		ref = old.Properties.Resource
@@ -315,27 +361,60 @@ func UpsertSecret(ctx context.Context, newResource, old *datamodel.SecretStore,
 	return nil, nil
 }
 
-// DeleteRadiusSecret deletes the Kubernetes secret associated with the given secret store if it is a
-// Radius managed resource.
-func DeleteRadiusSecret(ctx context.Context, oldResource *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
-	ksecret, err := getSecretFromOutputResources(oldResource.Properties.Status.OutputResources, options)
+// Delete deletes the Kubernetes secret associated with the given secret store if it is a Radius managed resource.
+func (p *kubernetesProvider) Delete(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) error {
+	ksecret, err := getSecretFromOutputResources(ctx, resource.Properties.Status.OutputResources, kubeClient)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if ksecret != nil {
 		// Delete only Radius managed resource.
 		if _, ok := ksecret.Labels[kubernetes.LabelRadiusResourceType]; ok {
-			if err := options.KubeClient.Delete(ctx, ksecret); err != nil {
-				return nil, err
+			if err := kubeClient.Delete(ctx, ksecret); err != nil {
+				return err
 			}
 		}
 	}
 
-	return nil, nil
+	return nil
+}
+
+// ListSecrets retrieves the values of the referenced Kubernetes secret.
+func (p *kubernetesProvider) ListSecrets(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) (map[string]string, error) {
+	ksecret, err := getSecretFromOutputResources(ctx, resource.Properties.Status.OutputResources, kubeClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret from output resource: %w", err)
+	}
+
+	if ksecret == nil {
+		return nil, errors.New("referenced secret is not found")
+	}
+
+	values := map[string]string{}
+	for k, d := range resource.Properties.Data {
+		key := d.SourceKey(k)
+
+		val, ok := ksecret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("cannot find %s key from secret data", key)
+		}
+
+		// Kubernetes secret data is always base64-encoded. If the encoding is raw, we need to decode it.
+		if d.Encoding == datamodel.SecretValueEncodingRaw {
+			val, err = base64.StdEncoding.DecodeString(string(val))
+			if err != nil {
+				return nil, fmt.Errorf("%s is the invalid base64 encoded value: %w", key, err)
+			}
+		}
+
+		values[k] = string(val)
+	}
+
+	return values, nil
 }
 
-func getSecretFromOutputResources(resources []rpv1.OutputResource, options *controller.Options) (*corev1.Secret, error) {
+func getSecretFromOutputResources(ctx context.Context, resources []rpv1.OutputResource, kubeClient runtimeclient.Client) (*corev1.Secret, error) {
 	name, ns := "", ""
 	for _, resource := range resources {
 		if strings.EqualFold(resource.ID.Type(), "core/Secret") {
@@ -345,7 +424,7 @@ func getSecretFromOutputResources(resources []rpv1.OutputResource, options *cont
 	}
 
 	ksecret := &corev1.Secret{}
-	err := options.KubeClient.Get(context.Background(), runtimeclient.ObjectKey{Namespace: ns, Name: name}, ksecret)
+	err := kubeClient.Get(ctx, runtimeclient.ObjectKey{Namespace: ns, Name: name}, ksecret)
 	if apierrors.IsNotFound(err) {
 		return nil, nil
 	} else if err != nil {
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/vault"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/stretchr/testify/require"
@@ -56,6 +57,8 @@ const (
 	testFileBasicAuthenticationInvalid = "secretstores_datamodel_basicauth_invalid.json"
 	testFileAWSIRSA                    = "secretstores_datamodel_awsirsa.json"
 	testFileAzureWorkloadIdentity      = "secretstores_datamodel_azwi.json"
+
+	testFileVault = "secretstores_datamodel_vault.json"
 )
 
 func TestGetNamespace(t *testing.T) {
@@ -343,6 +346,75 @@ func TestValidateAndMutateRequest(t *testing.T) {
 				require.True(t, r.Body.Error.Message == "$.properties.data must contain 'password' key for basicAuthentication type.")
 			},
 		},
+		{
+			name:     "new vault resource sets default mount path",
+			testFile: testFileVault,
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				require.Nil(t, resp)
+				require.Equal(t, vault.DefaultMountPath, newResource.Properties.Provider.Vault.MountPath)
+			},
+		},
+		{
+			name:     "vault resource allows valueFrom",
+			testFile: testFileVault,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Resource = "apps/db"
+				newResource.Properties.Data["password"] = &datamodel.SecretStoreDataValue{
+					ValueFrom: &datamodel.SecretStoreDataValueFrom{Name: "db-password"},
+				}
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				require.Nil(t, resp)
+			},
+		},
+		{
+			name:     "vault resource without vault configuration",
+			testFile: testFileVault,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Provider.Vault = nil
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.provider.vault must be specified for vault provider.", r.Body.Error.Message)
+			},
+		},
+		{
+			name:     "vault resource with invalid address",
+			testFile: testFileVault,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Provider.Vault.Address = "vault:8200"
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.provider.vault.address 'vault:8200' must be an absolute https URL.", r.Body.Error.Message)
+			},
+		},
+		{
+			name:     "vault resource with token secret without namespace",
+			testFile: testFileVault,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Provider.Vault.TokenSecret = "vault-token"
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.provider.vault.tokenSecret 'vault-token' must be in the form of <namespace>/<name>.", r.Body.Error.Message)
+			},
+		},
+		{
+			name:        "update the existing resource - provider not matched",
+			testFile:    testFileVault,
+			oldResource: testutil.MustGetTestData[datamodel.SecretStore](testFileGenericValue),
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.provider.kind cannot change from 'kubernetes' to 'vault'.", r.Body.Error.Message)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -357,6 +429,27 @@ func TestValidateAndMutateRequest(t *testing.T) {
 	}
 }
 
+func TestValidateVaultProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		options vault.Options
+		msg     string
+	}{
+		{name: "https address", address: "https://vault:8200"},
+		{name: "http address", address: "http://vault:8200", msg: "$.properties.provider.vault.address 'http://vault:8200' must be an absolute https URL."},
+		{name: "http address allowed by operator", address: "http://vault:8200", options: vault.Options{AllowInsecureHTTP: true}},
+		{name: "invalid address allowing http", address: "vault:8200", options: vault.Options{AllowInsecureHTTP: true}, msg: "$.properties.provider.vault.address 'vault:8200' must be an absolute http or https URL."},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &datamodel.VaultSecretStoreProvider{Address: tc.address, TokenSecret: "default/vault-token"}
+			require.Equal(t, tc.msg, validateVaultProvider(config, tc.options))
+		})
+	}
+}
+
 func TestUpsertSecret(t *testing.T) {
 	t.Run("not found referenced key", func(t *testing.T) {
 		newResource := testutil.MustGetTestData[datamodel.SecretStore](testFileCertValueFrom)
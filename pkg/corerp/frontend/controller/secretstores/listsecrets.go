@@ -18,9 +18,6 @@ package secretstores
 
 import (
 	"context"
-	"encoding/base64"
-	"errors"
-	"fmt"
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -52,8 +49,8 @@ func NewListSecrets(opts ctrl.Options) (ctrl.Controller, error) {
 	}, nil
 }
 
-// Run retrieves the values of the referenced secret from Kubernetes and returns them in a response. If the
-// secret is not found, an error is returned. Currently, we support only kubernetes secret store.
+// Run retrieves the values of the referenced secret from the backend of the secret store and returns them in a
+// response. If the secret is not found, an error is returned.
 func (l *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	resource, _, err := l.GetResource(ctx, serviceCtx.ResourceID)
@@ -65,13 +62,9 @@ func (l *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.
 		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	}
 
-	ksecret, err := getSecretFromOutputResources(resource.Properties.Status.OutputResources, l.Options())
+	values, err := GetSecretValues(ctx, resource, l.Options().KubeClient)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get secret from output resource: %w", err)
-	}
-
-	if ksecret == nil {
-		return nil, errors.New("referenced secret is not found")
+		return nil, err
 	}
 
 	resp := &datamodel.SecretStoreListSecrets{
@@ -80,27 +73,9 @@ func (l *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.
 	}
 
 	for k, d := range resource.Properties.Data {
-		key := k
-		if d.ValueFrom != nil {
-			key = d.ValueFrom.Name
-		}
-
-		val, ok := ksecret.Data[key]
-		if !ok {
-			return nil, fmt.Errorf("cannot find %s key from secret data", key)
-		}
-
-		// Kubernetes secret data is always base64-encoded. If the encoding is raw, we need to decode it.
-		if d.Encoding == datamodel.SecretValueEncodingRaw {
-			val, err = base64.StdEncoding.DecodeString(string(val))
-			if err != nil {
-				return nil, fmt.Errorf("%s is the invalid base64 encoded value: %w", key, err)
-			}
-		}
-
 		resp.Data[k] = &datamodel.SecretStoreDataValue{
 			Encoding: d.Encoding,
-			Value:    to.Ptr(string(val)),
+			Value:    to.Ptr(values[k]),
 		}
 	}
 
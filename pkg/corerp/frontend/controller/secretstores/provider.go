@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// secretProvider stores and retrieves the values of a secret store in its backend.
+type secretProvider interface {
+	// Upsert creates or updates the secret values of newResource in the backend. The values are removed from
+	// newResource once they are stored.
+	Upsert(ctx context.Context, newResource, old *datamodel.SecretStore, options *controller.Options) (rest.Response, error)
+
+	// Delete deletes the secret of the resource from the backend if it is managed by Radius.
+	Delete(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) error
+
+	// ListSecrets returns the values of the resource keyed by the secret store data keys. Each value is encoded
+	// using the encoding of the corresponding data entry.
+	ListSecrets(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) (map[string]string, error)
+}
+
+var providers = map[datamodel.SecretStoreProviderKind]secretProvider{
+	datamodel.SecretStoreProviderKubernetes: &kubernetesProvider{},
+	datamodel.SecretStoreProviderVault:      &vaultProvider{},
+}
+
+func getProvider(resource *datamodel.SecretStore) (secretProvider, error) {
+	kind := resource.Properties.ProviderKind()
+	p, ok := providers[kind]
+	if !ok {
+		return nil, fmt.Errorf("'%s' is the invalid secret store provider", kind)
+	}
+	return p, nil
+}
+
+// GetSecretValues returns the values of the given secret store keyed by the secret store data keys. The values are read
+// from the backend of the secret store and each value is encoded using the encoding of the corresponding data entry.
+func GetSecretValues(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) (map[string]string, error) {
+	p, err := getProvider(resource)
+	if err != nil {
+		return nil, err
+	}
+	return p.ListSecrets(ctx, resource, kubeClient)
+}
+
+// UpsertSecret creates or updates the secret in the backend of the secret store based on the incoming request.
+func UpsertSecret(ctx context.Context, newResource, old *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+	p, err := getProvider(newResource)
+	if err != nil {
+		return nil, err
+	}
+	return p.Upsert(ctx, newResource, old, options)
+}
+
+// DeleteRadiusSecret deletes the secret associated with the given secret store from its backend if it is a
+// Radius managed resource.
+func DeleteRadiusSecret(ctx context.Context, oldResource *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+	p, err := getProvider(oldResource)
+	if err != nil {
+		return nil, err
+	}
+	return nil, p.Delete(ctx, oldResource, options.KubeClient)
+}
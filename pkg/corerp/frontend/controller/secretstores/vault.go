@@ -0,0 +1,258 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/vault"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// vaultDefaultPathPrefix is the prefix of the path of the secrets created by Radius in Vault.
+const vaultDefaultPathPrefix = "radius"
+
+var _ secretProvider = (*vaultProvider)(nil)
+
+// vaultProvider is the secretProvider which stores secret values in the KV version 2 secrets engine of HashiCorp Vault.
+//
+// When $.properties.resource is set, it references the path of an existing secret in the secrets engine. Otherwise,
+// Radius creates the secret under the radius/<resource group>/<name> path and deletes it with the secret store.
+type vaultProvider struct{}
+
+// Upsert creates or updates the Vault secret based on the incoming request and stores its path in $.properties.resource.
+func (p *vaultProvider) Upsert(ctx context.Context, newResource, old *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+	ref := strings.Trim(newResource.Properties.Resource, "/")
+	if ref == "" && old != nil {
+		ref = old.Properties.Resource
+	}
+
+	path := ref
+	if path == "" {
+		path = defaultVaultPath(newResource)
+	}
+	newResource.Properties.Resource = path
+
+	if old != nil && old.Properties.Resource != newResource.Properties.Resource {
+		return rest.NewBadRequestResponse(fmt.Sprintf("'%s' of $.properties.resource must be same as '%s'.", newResource.Properties.Resource, old.Properties.Resource)), nil
+	}
+
+	if resp, err := validateVaultTokenSecret(ctx, newResource, options); resp != nil || err != nil {
+		return resp, err
+	}
+
+	client, err := newVaultClient(ctx, newResource.Properties.Provider.Vault, options.KubeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := client.Read(ctx, path)
+	created := false
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		// If resource in incoming request references resource, then the resource must exist for a application/environment scoped resource.
+		if ref != "" && !rpv1.IsGlobalScopedResource(&newResource.Properties.BasicResourceProperties) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("'%s' referenced resource does not exist.", ref)), nil
+		}
+		values = map[string]string{}
+		created = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	updateRequired := false
+	for k, secret := range newResource.Properties.Data {
+		key := secret.SourceKey(k)
+		if val := to.String(secret.Value); val != "" {
+			values[key] = val
+			updateRequired = true
+			// Remove secret from metadata before storing it to data store.
+			secret.Value = nil
+		} else if _, ok := values[key]; !ok {
+			return rest.NewBadRequestResponse(fmt.Sprintf("'%s' resource does not have key, '%s'.", newResource.Properties.Resource, key)), nil
+		}
+	}
+
+	if created || updateRequired {
+		if err := client.Write(ctx, path, values); err != nil {
+			return nil, err
+		}
+	}
+
+	if created {
+		// Mark the secret as managed by Radius so that it is deleted with the secret store.
+		if err := client.WriteMetadata(ctx, path, map[string]string{kubernetes.LabelRadiusResourceType: ResourceTypeName}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Vault secrets are not tracked as output resources. The path in $.properties.resource is used to locate the secret.
+	newResource.Properties.Status.OutputResources = nil
+
+	return nil, nil
+}
+
+// Delete deletes all versions of the Vault secret if it was created by Radius.
+func (p *vaultProvider) Delete(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) error {
+	if resource.Properties.Resource == "" {
+		return nil
+	}
+
+	client, err := newVaultClient(ctx, resource.Properties.Provider.Vault, kubeClient)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := client.ReadMetadata(ctx, resource.Properties.Resource)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// Delete only Radius managed secret.
+	if _, ok := metadata[kubernetes.LabelRadiusResourceType]; !ok {
+		return nil
+	}
+
+	return client.Delete(ctx, resource.Properties.Resource)
+}
+
+// ListSecrets retrieves the values of the Vault secret.
+func (p *vaultProvider) ListSecrets(ctx context.Context, resource *datamodel.SecretStore, kubeClient runtimeclient.Client) (map[string]string, error) {
+	client, err := newVaultClient(ctx, resource.Properties.Provider.Vault, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := client.Read(ctx, resource.Properties.Resource)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, errors.New("referenced secret is not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for k, d := range resource.Properties.Data {
+		key := d.SourceKey(k)
+		val, ok := secret[key]
+		if !ok {
+			return nil, fmt.Errorf("cannot find %s key from secret data", key)
+		}
+		values[k] = val
+	}
+
+	return values, nil
+}
+
+// defaultVaultPath returns the path of the Vault secret created by Radius for the given secret store.
+func defaultVaultPath(resource *datamodel.SecretStore) string {
+	id, err := resources.ParseResource(resource.ID)
+	if err != nil {
+		return vaultDefaultPathPrefix + "/" + resource.Name
+	}
+
+	group := id.FindScope(resources_radius.ScopeResourceGroups)
+	if group == "" {
+		return vaultDefaultPathPrefix + "/" + resource.Name
+	}
+	return vaultDefaultPathPrefix + "/" + strings.ToLower(group) + "/" + resource.Name
+}
+
+// validateVaultTokenSecret ensures that the Vault token secret of the secret store is in the namespace of its application
+// or environment, or in one of the namespaces allowed by the operator. Radius can read secrets in every namespace, so
+// this prevents a secret store from sending the tokens stored in other namespaces to its Vault address.
+func validateVaultTokenSecret(ctx context.Context, resource *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+	config := resource.Properties.Provider.Vault
+	if config == nil {
+		return rest.NewBadRequestResponse("$.properties.provider.vault must be specified for vault provider."), nil
+	}
+
+	ns, _, _ := strings.Cut(config.TokenSecret, "/")
+	if slices.Contains(vaultOptions(ctx).TokenSecretNamespaces, ns) {
+		return nil, nil
+	}
+
+	allowed, err := resourceNamespaces(ctx, resource, options)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(allowed, ns) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.provider.vault.tokenSecret '%s' must be in the namespace of the application or environment of the secret store.", config.TokenSecret)), nil
+	}
+
+	return nil, nil
+}
+
+// resourceNamespaces returns the Kubernetes namespaces of the application and the environment of the secret store.
+func resourceNamespaces(ctx context.Context, resource *datamodel.SecretStore, options *controller.Options) ([]string, error) {
+	namespaces := []string{}
+	envID := resource.Properties.Environment
+	if resource.Properties.Application != "" {
+		app, err := database.GetResource[datamodel.Application](ctx, options.DatabaseClient, resource.Properties.Application)
+		if err != nil {
+			return nil, err
+		}
+		if compute := app.Properties.Status.Compute; compute != nil && compute.KubernetesCompute.Namespace != "" {
+			namespaces = append(namespaces, compute.KubernetesCompute.Namespace)
+		}
+		if envID == "" {
+			envID = app.Properties.Environment
+		}
+	}
+
+	if envID != "" {
+		env, err := database.GetResource[datamodel.Environment](ctx, options.DatabaseClient, envID)
+		if err != nil {
+			return nil, err
+		}
+		if ns := env.Properties.Compute.KubernetesCompute.Namespace; ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+
+	return namespaces, nil
+}
+
+// vaultOptions returns the Vault options of the hosting configuration.
+func vaultOptions(ctx context.Context) vault.Options {
+	if cfg := hostoptions.FromContext(ctx); cfg != nil {
+		return cfg.Vault
+	}
+	return vault.Options{}
+}
+
+// newVaultClient creates a Vault client using the token stored in the Kubernetes secret referenced by the provider config.
+func newVaultClient(ctx context.Context, config *datamodel.VaultSecretStoreProvider, kubeClient runtimeclient.Client) (*vault.Client, error) {
+	if config == nil {
+		return nil, errors.New("vault provider configuration is not specified")
+	}
+	return vault.NewClientFromSecret(ctx, kubeClient, config.Address, config.MountPath, config.TokenSecret, vaultOptions(ctx))
+}
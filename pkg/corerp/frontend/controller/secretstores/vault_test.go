@@ -0,0 +1,379 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/vault"
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testVaultToken = "s.testtoken"
+
+// fakeVault is an in-memory fake of the KV version 2 secrets engine mounted at "secret".
+type fakeVault struct {
+	mu       sync.Mutex
+	data     map[string]map[string]any
+	metadata map[string]map[string]string
+	writes   int
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *httptest.Server) {
+	v := &fakeVault{
+		data:     map[string]map[string]any{},
+		metadata: map[string]map[string]string{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(v.handle))
+	t.Cleanup(server.Close)
+	return v, server
+}
+
+func (v *fakeVault) handle(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != testVaultToken {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	var endpoint, path string
+	if p, ok := strings.CutPrefix(r.URL.Path, "/v1/secret/data/"); ok {
+		endpoint, path = "data", p
+	} else if p, ok := strings.CutPrefix(r.URL.Path, "/v1/secret/metadata/"); ok {
+		endpoint, path = "metadata", p
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case endpoint == "data" && r.Method == http.MethodGet:
+		data, ok := v.data[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+	case endpoint == "data" && r.Method == http.MethodPost:
+		body := struct {
+			Data map[string]any `json:"data"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		v.data[path] = body.Data
+		v.writes++
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"version": v.writes}})
+	case endpoint == "metadata" && r.Method == http.MethodGet:
+		if _, ok := v.data[path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"custom_metadata": v.metadata[path]}})
+	case endpoint == "metadata" && r.Method == http.MethodPost:
+		body := struct {
+			CustomMetadata map[string]string `json:"custom_metadata"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		v.metadata[path] = body.CustomMetadata
+		w.WriteHeader(http.StatusNoContent)
+	case endpoint == "metadata" && r.Method == http.MethodDelete:
+		delete(v.data, path)
+		delete(v.metadata, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// testVaultContext returns the context with the hosting configuration which allows the http address of the fake Vault
+// server and the token secret in the radius-system namespace.
+func testVaultContext() context.Context {
+	return hostoptions.WithContext(context.Background(), &hostoptions.ProviderConfig{
+		Vault: vault.Options{
+			AllowInsecureHTTP:     true,
+			TokenSecretNamespaces: []string{"radius-system"},
+		},
+	})
+}
+
+func newVaultTestResource(address string) *datamodel.SecretStore {
+	resource := testutil.MustGetTestData[datamodel.SecretStore](testFileVault)
+	resource.Properties.Provider.Vault.Address = address
+	return resource
+}
+
+func newVaultTestOptions(token string) *controller.Options {
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-token",
+			Namespace: "radius-system",
+		},
+		Data: map[string][]byte{
+			vault.TokenKey: []byte(token),
+		},
+	}
+	return &controller.Options{
+		KubeClient: k8sutil.NewFakeKubeClient(nil, tokenSecret),
+	}
+}
+
+func TestVaultUpsertSecret(t *testing.T) {
+	t.Run("create new secret", func(t *testing.T) {
+		vault, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+
+		resp, err := UpsertSecret(testVaultContext(), newResource, nil, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		require.Equal(t, "radius/testgroup/secret0", newResource.Properties.Resource)
+		require.Equal(t, map[string]any{"username": "admin", "password": "p@ssw0rd"}, vault.data["radius/testgroup/secret0"])
+		require.Equal(t, ResourceTypeName, vault.metadata["radius/testgroup/secret0"][kubernetes.LabelRadiusResourceType])
+		require.Nil(t, newResource.Properties.Data["username"].Value)
+		require.Nil(t, newResource.Properties.Data["password"].Value)
+	})
+
+	t.Run("reference existing secret", func(t *testing.T) {
+		vault, server := newFakeVault(t)
+		vault.data["apps/db"] = map[string]any{"username": "admin", "db-password": "secret"}
+
+		newResource := newVaultTestResource(server.URL)
+		newResource.Properties.Resource = "apps/db"
+		newResource.Properties.Data = map[string]*datamodel.SecretStoreDataValue{
+			"username": {Encoding: datamodel.SecretValueEncodingRaw},
+			"password": {Encoding: datamodel.SecretValueEncodingRaw, ValueFrom: &datamodel.SecretStoreDataValueFrom{Name: "db-password"}},
+		}
+
+		resp, err := UpsertSecret(testVaultContext(), newResource, nil, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		require.Equal(t, "apps/db", newResource.Properties.Resource)
+		require.Equal(t, 0, vault.writes)
+		require.Empty(t, vault.metadata["apps/db"])
+	})
+
+	t.Run("referenced secret does not exist", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+		newResource.Properties.Resource = "apps/missing"
+
+		resp, err := UpsertSecret(testVaultContext(), newResource, nil, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		r := resp.(*rest.BadRequestResponse)
+		require.Equal(t, "'apps/missing' referenced resource does not exist.", r.Body.Error.Message)
+	})
+
+	t.Run("referenced secret does not have key", func(t *testing.T) {
+		vault, server := newFakeVault(t)
+		vault.data["apps/db"] = map[string]any{"username": "admin"}
+
+		newResource := newVaultTestResource(server.URL)
+		newResource.Properties.Resource = "apps/db"
+		newResource.Properties.Data = map[string]*datamodel.SecretStoreDataValue{
+			"password": {Encoding: datamodel.SecretValueEncodingRaw},
+		}
+
+		resp, err := UpsertSecret(testVaultContext(), newResource, nil, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		r := resp.(*rest.BadRequestResponse)
+		require.Equal(t, "'apps/db' resource does not have key, 'password'.", r.Body.Error.Message)
+	})
+
+	t.Run("update the path of existing resource", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		oldResource := newVaultTestResource(server.URL)
+		oldResource.Properties.Resource = "apps/db"
+		newResource := newVaultTestResource(server.URL)
+		newResource.Properties.Resource = "apps/other"
+
+		resp, err := UpsertSecret(testVaultContext(), newResource, oldResource, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		r := resp.(*rest.BadRequestResponse)
+		require.Equal(t, "'apps/other' of $.properties.resource must be same as 'apps/db'.", r.Body.Error.Message)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+
+		_, err := UpsertSecret(testVaultContext(), newResource, nil, newVaultTestOptions("invalid"))
+		require.ErrorContains(t, err, "vault returned status code 403")
+		require.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("token secret not found", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+
+		_, err := UpsertSecret(testVaultContext(), newResource, nil, &controller.Options{KubeClient: k8sutil.NewFakeKubeClient(nil)})
+		require.ErrorContains(t, err, "failed to get vault token secret 'radius-system/vault-token'")
+	})
+}
+
+func TestVaultUpsertSecret_TokenSecretNamespace(t *testing.T) {
+	appData := testutil.MustGetTestData[any]("app_datamodel.json")
+	envData := testutil.MustGetTestData[any]("env_datamodel.json")
+	ctx := hostoptions.WithContext(context.Background(), &hostoptions.ProviderConfig{
+		Vault: vault.Options{AllowInsecureHTTP: true},
+	})
+
+	newOptions := func(t *testing.T, namespace string) *controller.Options {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+		databaseClient.EXPECT().Get(gomock.Any(), testAppID, gomock.Any()).Return(&database.Object{Data: *appData}, nil)
+		databaseClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&database.Object{Data: *envData}, nil)
+
+		tokenSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: namespace},
+			Data:       map[string][]byte{vault.TokenKey: []byte(testVaultToken)},
+		}
+		return &controller.Options{
+			DatabaseClient: databaseClient,
+			KubeClient:     k8sutil.NewFakeKubeClient(nil, tokenSecret),
+		}
+	}
+
+	for _, namespace := range []string{"app0-ns", "default"} {
+		t.Run("token secret in "+namespace, func(t *testing.T) {
+			v, server := newFakeVault(t)
+			newResource := newVaultTestResource(server.URL)
+			newResource.Properties.Provider.Vault.TokenSecret = namespace + "/vault-token"
+
+			resp, err := UpsertSecret(ctx, newResource, nil, newOptions(t, namespace))
+			require.NoError(t, err)
+			require.Nil(t, resp)
+			require.Contains(t, v.data, "radius/testgroup/secret0")
+		})
+	}
+
+	t.Run("token secret in other namespace", func(t *testing.T) {
+		v, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+
+		resp, err := UpsertSecret(ctx, newResource, nil, newOptions(t, "radius-system"))
+		require.NoError(t, err)
+		r := resp.(*rest.BadRequestResponse)
+		require.Equal(t, "$.properties.provider.vault.tokenSecret 'radius-system/vault-token' must be in the namespace of the application or environment of the secret store.", r.Body.Error.Message)
+		require.Empty(t, v.data)
+	})
+
+	t.Run("insecure http is not allowed", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		newResource := newVaultTestResource(server.URL)
+		ctx := hostoptions.WithContext(context.Background(), &hostoptions.ProviderConfig{
+			Vault: vault.Options{TokenSecretNamespaces: []string{"radius-system"}},
+		})
+
+		_, err := UpsertSecret(ctx, newResource, nil, newVaultTestOptions(testVaultToken))
+		require.ErrorContains(t, err, "is the insecure vault address")
+	})
+}
+
+func TestVaultGetSecretValues(t *testing.T) {
+	vault, server := newFakeVault(t)
+	vault.data["apps/db"] = map[string]any{"username": "admin", "db-password": "secret", "port": 5432}
+
+	resource := newVaultTestResource(server.URL)
+	resource.Properties.Resource = "apps/db"
+	resource.Properties.Data = map[string]*datamodel.SecretStoreDataValue{
+		"username": {Encoding: datamodel.SecretValueEncodingRaw},
+		"password": {Encoding: datamodel.SecretValueEncodingRaw, ValueFrom: &datamodel.SecretStoreDataValueFrom{Name: "db-password"}},
+		"port":     {Encoding: datamodel.SecretValueEncodingRaw},
+	}
+	kubeClient := newVaultTestOptions(testVaultToken).KubeClient
+
+	t.Run("success", func(t *testing.T) {
+		values, err := GetSecretValues(testVaultContext(), resource, kubeClient)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"username": "admin", "password": "secret", "port": "5432"}, values)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		missing := newVaultTestResource(server.URL)
+		missing.Properties.Resource = "apps/db"
+		missing.Properties.Data = map[string]*datamodel.SecretStoreDataValue{"token": {}}
+
+		_, err := GetSecretValues(testVaultContext(), missing, kubeClient)
+		require.EqualError(t, err, "cannot find token key from secret data")
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		missing := newVaultTestResource(server.URL)
+		missing.Properties.Resource = "apps/missing"
+
+		_, err := GetSecretValues(testVaultContext(), missing, kubeClient)
+		require.EqualError(t, err, "referenced secret is not found")
+	})
+}
+
+func TestVaultDeleteSecret(t *testing.T) {
+	t.Run("delete radius managed secret", func(t *testing.T) {
+		vault, server := newFakeVault(t)
+		opt := newVaultTestOptions(testVaultToken)
+		resource := newVaultTestResource(server.URL)
+
+		_, err := UpsertSecret(testVaultContext(), resource, nil, opt)
+		require.NoError(t, err)
+		require.Contains(t, vault.data, "radius/testgroup/secret0")
+
+		_, err = DeleteRadiusSecret(testVaultContext(), resource, opt)
+		require.NoError(t, err)
+		require.NotContains(t, vault.data, "radius/testgroup/secret0")
+	})
+
+	t.Run("do not delete referenced secret", func(t *testing.T) {
+		vault, server := newFakeVault(t)
+		vault.data["apps/db"] = map[string]any{"username": "admin"}
+
+		resource := newVaultTestResource(server.URL)
+		resource.Properties.Resource = "apps/db"
+
+		_, err := DeleteRadiusSecret(testVaultContext(), resource, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+		require.Contains(t, vault.data, "apps/db")
+	})
+
+	t.Run("secret already deleted", func(t *testing.T) {
+		_, server := newFakeVault(t)
+		resource := newVaultTestResource(server.URL)
+		resource.Properties.Resource = "apps/missing"
+
+		_, err := DeleteRadiusSecret(testVaultContext(), resource, newVaultTestOptions(testVaultToken))
+		require.NoError(t, err)
+	})
+}
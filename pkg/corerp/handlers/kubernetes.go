@@ -42,6 +42,7 @@ import (
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -49,7 +50,7 @@ const (
 	DefaultCacheResyncInterval = time.Second * time.Duration(30)
 )
 
-// Create an interface for deployment waiter and http proxy waiter
+// Create an interface for deployment waiter, http proxy waiter and Gateway API waiter
 type ResourceWaiter interface {
 	addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
 	addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
@@ -62,6 +63,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
+		gatewayAPIWaiter:   NewGatewayAPIWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
 	}
 }
@@ -71,11 +73,12 @@ type kubernetesHandler struct {
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
+	gatewayAPIWaiter   ResourceWaiter
 	deploymentWaiter   ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment, an HTTPProxy or a Gateway API Gateway or HTTPRoute, it also waits until the resource is ready.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -137,6 +140,18 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "gateway", "httproute":
+		// Only the Kubernetes Gateway API resources are monitored, other APIs may use the same kinds.
+		if groupVersion.Group != gatewayv1.GroupName {
+			return properties, nil
+		}
+
+		err = handler.gatewayAPIWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	MaxGatewayAPIDeploymentTimeout = time.Minute * time.Duration(10)
+)
+
+var (
+	// GatewayAPIGatewayGVR is the GroupVersionResource of a Kubernetes Gateway API Gateway.
+	GatewayAPIGatewayGVR = gatewayv1.SchemeGroupVersion.WithResource("gateways")
+
+	// GatewayAPIHTTPRouteGVR is the GroupVersionResource of a Kubernetes Gateway API HTTPRoute.
+	GatewayAPIHTTPRouteGVR = gatewayv1.SchemeGroupVersion.WithResource("httproutes")
+)
+
+type gatewayAPIWaiter struct {
+	dynamicClientSet            dynamic.Interface
+	gatewayAPIDeploymentTimeout time.Duration
+	cacheResyncInterval         time.Duration
+}
+
+// NewGatewayAPIWaiter returns a new instance of GatewayAPIWaiter, which waits until Kubernetes Gateway API
+// Gateway and HTTPRoute resources are ready.
+func NewGatewayAPIWaiter(dynamicClientSet dynamic.Interface) *gatewayAPIWaiter {
+	return &gatewayAPIWaiter{
+		dynamicClientSet:            dynamicClientSet,
+		gatewayAPIDeploymentTimeout: MaxGatewayAPIDeploymentTimeout,
+		cacheResyncInterval:         DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayAPIWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkGatewayAPIStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkGatewayAPIStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for GatewayAPIWaiter
+func (handler *gatewayAPIWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayAPIWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := gatewayAPIResource(obj)
+	if err != nil {
+		return err
+	}
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.gatewayAPIDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	// Create dynamic informer for the Gateway API resource
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, 0, obj.GetNamespace(), nil)
+	gatewayAPIInformer := dynamicInformerFactory.ForResource(gvr)
+	// Add event handlers to the Gateway API informer
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, gatewayAPIInformer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		item, err := gatewayAPIInformer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		conditions, err := gatewayAPIConditions(item.(*unstructured.Unstructured))
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		status := metav1.Condition{}
+		if len(conditions) > 0 {
+			status = conditions[len(conditions)-1]
+		}
+		return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, status: %s, reason: %s", kind, obj.GetName(), obj.GetNamespace(), status.Message, status.Reason)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking %s deployment %s in namespace %s as complete", kind, obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkGatewayAPIStatus checks the status of a Gateway or HTTPRoute. A Gateway is ready when it is programmed
+// by the Gateway API implementation, and an HTTPRoute is ready when all of its parent Gateways accepted it and
+// its backend references are resolved.
+func (handler *gatewayAPIWaiter) checkGatewayAPIStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := gatewayAPIResource(obj)
+	if err != nil {
+		doneCh <- err
+		return false
+	}
+
+	item, err := dynamicInformerFactory.ForResource(gvr).Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to get %s: %s", gvr.Resource, err.Error()))
+		return false
+	}
+
+	u := item.(*unstructured.Unstructured)
+	switch gvr {
+	case GatewayAPIGatewayGVR:
+		gateway := gatewayv1.Gateway{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &gateway)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to convert gateway: %s", err.Error()))
+			return false
+		}
+
+		accepted := findCurrentCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted), gateway.Generation)
+		if accepted != nil && accepted.Status == metav1.ConditionFalse {
+			doneCh <- fmt.Errorf("Failed to deploy Gateway. Reason: %s, Message: %s", accepted.Reason, accepted.Message)
+			return false
+		}
+
+		programmed := findCurrentCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionProgrammed), gateway.Generation)
+		if programmed != nil && programmed.Status == metav1.ConditionTrue {
+			// The Gateway is ready
+			doneCh <- nil
+			return true
+		}
+	case GatewayAPIHTTPRouteGVR:
+		route := gatewayv1.HTTPRoute{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &route)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to convert http route: %s", err.Error()))
+			return false
+		}
+
+		// The parents are only reported once the route is processed by the Gateway API implementation.
+		if len(route.Status.Parents) == 0 {
+			return false
+		}
+
+		ready := true
+		for _, parent := range route.Status.Parents {
+			for _, conditionType := range []gatewayv1.RouteConditionType{gatewayv1.RouteConditionAccepted, gatewayv1.RouteConditionResolvedRefs} {
+				condition := findCurrentCondition(parent.Conditions, string(conditionType), route.Generation)
+				if condition == nil {
+					ready = false
+					continue
+				}
+
+				if condition.Status == metav1.ConditionFalse {
+					doneCh <- fmt.Errorf("Failed to deploy HTTPRoute. Parent: %s, Type: %s, Reason: %s, Message: %s", parent.ParentRef.Name, condition.Type, condition.Reason, condition.Message)
+					return false
+				}
+
+				if condition.Status != metav1.ConditionTrue {
+					ready = false
+				}
+			}
+		}
+
+		if ready {
+			// The HTTPRoute is ready
+			doneCh <- nil
+			return true
+		}
+	}
+
+	return false
+}
+
+// gatewayAPIResource returns the GroupVersionResource of the given Gateway API object.
+func gatewayAPIResource(obj client.Object) (schema.GroupVersionResource, error) {
+	switch obj.GetObjectKind().GroupVersionKind().Kind {
+	case "Gateway":
+		return GatewayAPIGatewayGVR, nil
+	case "HTTPRoute":
+		return GatewayAPIHTTPRouteGVR, nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported Gateway API kind %q", obj.GetObjectKind().GroupVersionKind().Kind)
+	}
+}
+
+// gatewayAPIConditions returns the status conditions of a Gateway, or the conditions reported by the parents of an HTTPRoute.
+func gatewayAPIConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	switch obj.GetKind() {
+	case "Gateway":
+		gateway := gatewayv1.Gateway{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &gateway); err != nil {
+			return nil, err
+		}
+		return gateway.Status.Conditions, nil
+	default:
+		route := gatewayv1.HTTPRoute{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &route); err != nil {
+			return nil, err
+		}
+
+		conditions := []metav1.Condition{}
+		for _, parent := range route.Status.Parents {
+			conditions = append(conditions, parent.Conditions...)
+		}
+		return conditions, nil
+	}
+}
+
+// findCurrentCondition returns the condition of the given type if it was observed for the given generation of the object.
+func findCurrentCondition(conditions []metav1.Condition, conditionType string, generation int64) *metav1.Condition {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil || condition.ObservedGeneration != generation {
+		return nil
+	}
+	return condition
+}
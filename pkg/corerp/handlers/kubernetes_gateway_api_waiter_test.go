@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestCheckGatewayAPIStatus_GatewayProgrammed(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway([]metav1.Condition{
+		{Type: string(gatewayv1.GatewayConditionAccepted), Status: metav1.ConditionTrue},
+		{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionTrue},
+	})
+
+	err := runCheckGatewayAPIStatus(t, gateway)
+	require.NoError(t, err)
+}
+
+func TestCheckGatewayAPIStatus_GatewayNotAccepted(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway([]metav1.Condition{
+		{Type: string(gatewayv1.GatewayConditionAccepted), Status: metav1.ConditionFalse, Reason: "InvalidParameters", Message: "invalid gateway class"},
+	})
+
+	err := runCheckGatewayAPIStatus(t, gateway)
+	require.EqualError(t, err, "Failed to deploy Gateway. Reason: InvalidParameters, Message: invalid gateway class")
+}
+
+func TestCheckGatewayAPIStatus_HTTPRouteAccepted(t *testing.T) {
+	route := makeTestGatewayAPIHTTPRoute([]metav1.Condition{
+		{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue},
+		{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue},
+	})
+
+	err := runCheckGatewayAPIStatus(t, route)
+	require.NoError(t, err)
+}
+
+func TestCheckGatewayAPIStatus_HTTPRouteUnresolvedRefs(t *testing.T) {
+	route := makeTestGatewayAPIHTTPRoute([]metav1.Condition{
+		{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue},
+		{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, Reason: "BackendNotFound", Message: "service not found"},
+	})
+
+	err := runCheckGatewayAPIStatus(t, route)
+	require.EqualError(t, err, "Failed to deploy HTTPRoute. Parent: example, Type: ResolvedRefs, Reason: BackendNotFound, Message: service not found")
+}
+
+func TestCheckGatewayAPIStatus_StaleConditions(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway([]metav1.Condition{
+		{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+	})
+	gateway.Generation = 2
+
+	fakeClient := newFakeGatewayAPIClient(t, gateway)
+
+	dynamicInformerFactory := startGatewayAPIInformers(t, fakeClient)
+
+	waiter := &gatewayAPIWaiter{
+		dynamicClientSet: fakeClient,
+	}
+
+	// The Programmed condition was reported for a previous generation of the Gateway.
+	ready := waiter.checkGatewayAPIStatus(context.Background(), dynamicInformerFactory, gateway, make(chan error, 1))
+	require.False(t, ready)
+}
+
+func TestGatewayAPIWaiter_WaitUntilReady(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway([]metav1.Condition{
+		{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionTrue},
+	})
+
+	fakeClient := newFakeGatewayAPIClient(t, gateway)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayAPIDeploymentTimeout = time.Second * 10
+
+	err := waiter.waitUntilReady(context.Background(), gateway)
+	require.NoError(t, err)
+}
+
+func TestGatewayAPIWaiter_WaitUntilReady_Timeout(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway([]metav1.Condition{
+		{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionFalse, Reason: "AddressNotAssigned", Message: "no addresses assigned"},
+	})
+
+	fakeClient := newFakeGatewayAPIClient(t, gateway)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayAPIDeploymentTimeout = time.Second
+
+	err := waiter.waitUntilReady(context.Background(), gateway)
+	require.EqualError(t, err, "Gateway deployment timed out, name: example, namespace default, status: no addresses assigned, reason: AddressNotAssigned")
+}
+
+func runCheckGatewayAPIStatus(t *testing.T, obj client.Object) error {
+	fakeClient := newFakeGatewayAPIClient(t, obj)
+
+	dynamicInformerFactory := startGatewayAPIInformers(t, fakeClient)
+
+	// create a channel for the done signal
+	doneCh := make(chan error)
+
+	waiter := &gatewayAPIWaiter{
+		dynamicClientSet: fakeClient,
+	}
+
+	go waiter.checkGatewayAPIStatus(context.Background(), dynamicInformerFactory, obj, doneCh)
+	return <-doneCh
+}
+
+// newFakeGatewayAPIClient creates a fake dynamic clientset containing the given Gateway API objects. The objects are
+// created as unstructured objects through the client since the plural of Gateway cannot be guessed from its kind.
+func newFakeGatewayAPIClient(t *testing.T, objs ...client.Object) *fakedynamic.FakeDynamicClient {
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		GatewayAPIGatewayGVR:   "GatewayList",
+		GatewayAPIHTTPRouteGVR: "HTTPRouteList",
+	})
+
+	for _, obj := range objs {
+		gvr, err := gatewayAPIResource(obj)
+		require.NoError(t, err)
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		require.NoError(t, err)
+
+		_, err = fakeClient.Resource(gvr).Namespace(obj.GetNamespace()).Create(context.Background(), &unstructured.Unstructured{Object: content}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	return fakeClient
+}
+
+func startGatewayAPIInformers(t *testing.T, fakeClient *fakedynamic.FakeDynamicClient) dynamicinformer.DynamicSharedInformerFactory {
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(fakeClient, 0, "default", nil)
+	dynamicInformerFactory.ForResource(GatewayAPIGatewayGVR)
+	dynamicInformerFactory.ForResource(GatewayAPIHTTPRouteGVR)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	dynamicInformerFactory.Start(ctx.Done())
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	return dynamicInformerFactory
+}
+
+func makeTestGatewayAPIGateway(conditions []metav1.Condition) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "example",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "example-class",
+		},
+		Status: gatewayv1.GatewayStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func makeTestGatewayAPIHTTPRoute(conditions []metav1.Condition) *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HTTPRoute",
+			APIVersion: gatewayv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "example-route",
+		},
+		Status: gatewayv1.HTTPRouteStatus{
+			RouteStatus: gatewayv1.RouteStatus{
+				Parents: []gatewayv1.RouteParentStatus{
+					{
+						ParentRef:      gatewayv1.ParentReference{Name: "example"},
+						ControllerName: "example.com/gateway-controller",
+						Conditions:     conditions,
+					},
+				},
+			},
+		},
+	}
+}
@@ -24,8 +24,10 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/corerp/renderers/aci"
+	aci_autoscale "github.com/radius-project/radius/pkg/corerp/renderers/aci/autoscale"
 	aci_gateway "github.com/radius-project/radius/pkg/corerp/renderers/aci/gateway"
 	aci_manualscale "github.com/radius-project/radius/pkg/corerp/renderers/aci/manualscale"
+	"github.com/radius-project/radius/pkg/corerp/renderers/autoscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	azcontainer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
@@ -95,15 +97,19 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				Inners: map[rpv1.EnvironmentComputeKind]renderers.Renderer{
 					rpv1.KubernetesComputeKind: &kubernetesmetadata.Renderer{
 						Inner: &manualscale.Renderer{
-							Inner: &daprextension.Renderer{
-								Inner: &container.Renderer{
-									RoleAssignmentMap: roleAssignmentMap,
+							Inner: &autoscale.Renderer{
+								Inner: &daprextension.Renderer{
+									Inner: &container.Renderer{
+										RoleAssignmentMap: roleAssignmentMap,
+									},
 								},
 							},
 						},
 					},
 					rpv1.ACIComputeKind: &aci_manualscale.Renderer{
-						Inner: &aci.Renderer{},
+						Inner: &aci_autoscale.Renderer{
+							Inner: &aci.Renderer{},
+						},
 					},
 				},
 			},
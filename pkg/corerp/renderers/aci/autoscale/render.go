@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	ngroupsclient "github.com/radius-project/radius/pkg/sdk/v20241101preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Renderer is the renderers.Renderer implementation for the autoscaling extension on Azure Container Instances.
+//
+// NGroups do not support metric-based scaling, so the container falls back to running the minimum replica count.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an Autoscaling
+// extension and sets the desired count of the NGroup to the minimum replica count.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.Autoscaling)
+	if ext == nil || ext.Autoscaling == nil {
+		return output, nil
+	}
+
+	desiredCount := ext.Autoscaling.MinReplicas
+	if desiredCount == nil {
+		desiredCount = to.Ptr[int32](1)
+	}
+
+	for _, ores := range output.Resources {
+		resourceType := ores.GetResourceType()
+		if resourceType.Provider != resourcemodel.ProviderAzure || resourceType.Type != "Microsoft.ContainerInstance/nGroups" {
+			// Not an NGroup resource
+			continue
+		}
+		o, ok := ores.CreateResource.Data.(*ngroupsclient.NGroup)
+		if !ok {
+			return renderers.RendererOutput{}, errors.New("found NGroup resource with non-NGroup payload")
+		}
+
+		o.Properties.ElasticProfile.DesiredCount = desiredCount
+	}
+
+	return output, nil
+}
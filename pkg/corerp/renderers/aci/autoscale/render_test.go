@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ngroupsclient "github.com/radius-project/radius/pkg/sdk/v20241101preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+	payload any
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return an NGroup so the autoscale extension can modify it
+	payload := r.payload
+	if payload == nil {
+		payload = &ngroupsclient.NGroup{
+			Properties: &ngroupsclient.NGroupProperties{
+				ElasticProfile: &ngroupsclient.ElasticProfile{
+					DesiredCount: to.Ptr[int32](1),
+				},
+			},
+		}
+	}
+
+	return renderers.RendererOutput{
+		Resources: []rpv1.OutputResource{
+			{
+				LocalID: "NGroup",
+				CreateResource: &rpv1.Resource{
+					ResourceType: resourcemodel.ResourceType{
+						Type:     "Microsoft.ContainerInstance/nGroups",
+						Provider: resourcemodel.ProviderAzure,
+					},
+					Data: payload,
+				},
+			},
+		},
+	}, nil
+}
+
+func Test_Render_MinReplicas(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(&datamodel.AutoscalingExtension{MinReplicas: to.Ptr[int32](3), MaxReplicas: 10})
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	nGroup := output.Resources[0].CreateResource.Data.(*ngroupsclient.NGroup)
+	require.Equal(t, int32(3), *nGroup.Properties.ElasticProfile.DesiredCount)
+}
+
+func Test_Render_DefaultMinReplicas(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(&datamodel.AutoscalingExtension{MaxReplicas: 10})
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	nGroup := output.Resources[0].CreateResource.Data.(*ngroupsclient.NGroup)
+	require.Equal(t, int32(1), *nGroup.Properties.ElasticProfile.DesiredCount)
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(nil)
+	resource.Properties.Extensions = nil
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	nGroup := output.Resources[0].CreateResource.Data.(*ngroupsclient.NGroup)
+	require.Equal(t, int32(1), *nGroup.Properties.ElasticProfile.DesiredCount)
+}
+
+func Test_Render_InvalidNGroupPayload(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{payload: map[string]any{}}}
+
+	resource := makeResource(&datamodel.AutoscalingExtension{MinReplicas: to.Ptr[int32](3), MaxReplicas: 10})
+	_, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.EqualError(t, err, "found NGroup resource with non-NGroup payload")
+}
+
+func Test_Render_InvalidResourceType(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	_, err := renderer.Render(context.Background(), &datamodel.Gateway{}, renderers.RenderOptions{})
+	require.Equal(t, v1.ErrInvalidModelConversion, err)
+}
+
+func makeResource(ext *datamodel.AutoscalingExtension) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+			Extensions: []datamodel.Extension{{
+				Kind:        datamodel.Autoscaling,
+				Autoscaling: ext,
+			}},
+		},
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Renderer is the renderers.Renderer implementation for the autoscaling extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an Autoscaling
+// extension and adds a HorizontalPodAutoscaler targeting the rendered Deployment.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.Autoscaling)
+	if ext == nil || ext.Autoscaling == nil {
+		return output, nil
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		// Nothing to scale.
+		return output, nil
+	}
+
+	hpa, err := makeHorizontalPodAutoscaler(deployment, ext.Autoscaling)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	// The HorizontalPodAutoscaler owns the replica count, so the Deployment must not reset it on every update.
+	deployment.Spec.Replicas = nil
+
+	outputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	output.Resources = append(output.Resources, outputResource)
+
+	return output, nil
+}
+
+// makeHorizontalPodAutoscaler creates an autoscaling/v2 HorizontalPodAutoscaler which scales the given deployment.
+func makeHorizontalPodAutoscaler(deployment *appsv1.Deployment, ext *datamodel.AutoscalingExtension) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+	if ext.TargetCPUUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *ext.TargetCPUUtilization))
+	}
+	if ext.TargetMemoryUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *ext.TargetMemoryUtilization))
+	}
+
+	for _, m := range ext.Metrics {
+		target, err := resource.ParseQuantity(m.TargetAverageValue)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid targetAverageValue %q for autoscaling metric %s: %s", m.TargetAverageValue, m.Name, err.Error()))
+		}
+
+		metricTarget := autoscalingv2.MetricTarget{
+			Type:         autoscalingv2.AverageValueMetricType,
+			AverageValue: &target,
+		}
+
+		switch m.Kind {
+		case datamodel.AutoscalingMetricKindExternal:
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: m.Name},
+					Target: metricTarget,
+				},
+			})
+		default:
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: m.Name},
+					Target: metricTarget,
+				},
+			})
+		}
+	}
+
+	minReplicas := ext.MinReplicas
+	if minReplicas == nil {
+		minReplicas = to.Ptr[int32](1)
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    deployment.Labels,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				APIVersion: "apps/v1",
+			},
+			MinReplicas: minReplicas,
+			MaxReplicas: ext.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}, nil
+}
+
+// resourceMetric creates a metric spec that targets the average utilization of a container resource.
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: to.Ptr(utilization),
+			},
+		},
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return a deployment so the autoscale extension can target it
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-deployment",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "test"},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: to.Ptr[int32](1),
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_Success(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	container := makeResource(&datamodel.AutoscalingExtension{
+		MinReplicas:             to.Ptr[int32](2),
+		MaxReplicas:             10,
+		TargetCPUUtilization:    to.Ptr[int32](70),
+		TargetMemoryUtilization: to.Ptr[int32](80),
+		Metrics: []datamodel.AutoscalingMetric{
+			{Name: "http_requests_per_second", Kind: datamodel.AutoscalingMetricKindPods, TargetAverageValue: "100"},
+			{Name: "queue_messages_ready", Kind: datamodel.AutoscalingMetricKindExternal, TargetAverageValue: "500m"},
+		},
+	})
+
+	output, err := renderer.Render(context.Background(), container, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	outputResource := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDHorizontalPodAutoscaler, outputResource.LocalID)
+	require.Equal(t, resources_kubernetes.ResourceTypeHorizontalPodAutoscaler, outputResource.GetResourceType().Type)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, outputResource.CreateResource.Dependencies)
+
+	hpa, ok := outputResource.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.True(t, ok)
+	require.Equal(t, "test-deployment", hpa.Name)
+	require.Equal(t, "test-namespace", hpa.Namespace)
+	require.Equal(t, map[string]string{"app": "test"}, hpa.Labels)
+	require.Equal(t, autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "test-deployment", APIVersion: "apps/v1"}, hpa.Spec.ScaleTargetRef)
+	require.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	require.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+
+	expected := []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](70)},
+			},
+		},
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   corev1.ResourceMemory,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](80)},
+			},
+		},
+		{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "http_requests_per_second"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: to.Ptr(resource.MustParse("100"))},
+			},
+		},
+		{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "queue_messages_ready"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: to.Ptr(resource.MustParse("500m"))},
+			},
+		},
+	}
+	require.Equal(t, expected, hpa.Spec.Metrics)
+}
+
+func Test_Render_DefaultMinReplicas(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(&datamodel.AutoscalingExtension{
+		MaxReplicas:          3,
+		TargetCPUUtilization: to.Ptr[int32](50),
+	})
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	hpa := output.Resources[1].CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.Equal(t, int32(1), *hpa.Spec.MinReplicas)
+	require.Equal(t, int32(3), hpa.Spec.MaxReplicas)
+}
+
+func Test_Render_InvalidMetricValue(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(&datamodel.AutoscalingExtension{
+		MaxReplicas: 3,
+		Metrics:     []datamodel.AutoscalingMetric{{Name: "queue", TargetAverageValue: "lots"}},
+	})
+
+	_, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(nil)
+	resource.Properties.Extensions = nil
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Equal(t, int32(1), *deployment.Spec.Replicas)
+}
+
+func makeResource(ext *datamodel.AutoscalingExtension) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+			Extensions: []datamodel.Extension{{
+				Kind:        datamodel.Autoscaling,
+				Autoscaling: ext,
+			}},
+		},
+	}
+}
@@ -380,7 +380,7 @@ func (r Renderer) makeDeployment(
 	}
 
 	for k, v := range properties.Container.Env {
-		env[k], err = convertEnvVar(k, v, options)
+		env[k], err = convertEnvVar(k, v, resource.Name, options, secretData)
 		if err != nil {
 			return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to convert environment variable: %w", err)
 		}
@@ -637,8 +637,9 @@ func (r Renderer) makeDeployment(
 	return outputResources, secretData, nil
 }
 
-// convertEnvVar function to convert from map[string]EnvironmentVariable to map[string]corev1.EnvVar
-func convertEnvVar(key string, env datamodel.EnvironmentVariable, options renderers.RenderOptions) (corev1.EnvVar, error) {
+// convertEnvVar function to convert from map[string]EnvironmentVariable to map[string]corev1.EnvVar. Values resolved at
+// deployment time are added to secretData, which backs the secret named after the container resource.
+func convertEnvVar(key string, env datamodel.EnvironmentVariable, resourceName string, options renderers.RenderOptions, secretData map[string][]byte) (corev1.EnvVar, error) {
 	if env.Value != nil {
 		return corev1.EnvVar{Name: key, Value: *env.Value}, nil
 	} else if env.ValueFrom != nil {
@@ -653,6 +654,28 @@ func convertEnvVar(key string, env datamodel.EnvironmentVariable, options render
 				return corev1.EnvVar{}, fmt.Errorf("failed to find source in dependencies: %s", env.ValueFrom.SecretRef.Source)
 			}
 
+			// Vault backed secret values do not exist in Kubernetes. They are resolved by the deployment processor
+			// and stored in the secret of the container.
+			if secretStore.Properties.ProviderKind() == datamodel.SecretStoreProviderVault {
+				value, ok := options.Dependencies[env.ValueFrom.SecretRef.Source].ComputedValues[env.ValueFrom.SecretRef.Key].(string)
+				if !ok {
+					return corev1.EnvVar{}, fmt.Errorf("failed to find key %s in secret store: %s", env.ValueFrom.SecretRef.Key, env.ValueFrom.SecretRef.Source)
+				}
+				secretData[key] = []byte(value)
+
+				return corev1.EnvVar{
+					Name: key,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: kubernetes.NormalizeResourceName(resourceName),
+							},
+							Key: key,
+						},
+					},
+				}, nil
+			}
+
 			// The format may be <namespace>/<name> or <name>, as an example "default/my-secret" or "my-secret". We split the string on '/'
 			// and take the second part if the secret is namespace qualified.
 			var name string
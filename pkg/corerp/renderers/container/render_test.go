@@ -463,6 +463,96 @@ func Test_Render_Basic(t *testing.T) {
 	require.Len(t, output.Resources, 4)
 }
 
+func Test_Render_EnvironmentVariablesFromVaultSecretStore(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName3: {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{
+							Source: envVarSource3,
+							Key:    "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	secretStore := &datamodel.SecretStore{
+		BaseResource: apiv1.BaseResource{
+			TrackedResource: apiv1.TrackedResource{
+				ID: envVarSource3,
+			},
+		},
+		Properties: &datamodel.SecretStoreProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: applicationResourceID,
+			},
+			Resource: "radius/test-group/test-secret",
+			Provider: &datamodel.SecretStoreProvider{
+				Kind: datamodel.SecretStoreProviderVault,
+				Vault: &datamodel.VaultSecretStoreProvider{
+					Address:     "http://127.0.0.1:8200",
+					TokenSecret: "radius-system/vault-token",
+				},
+			},
+		},
+	}
+
+	resource := makeResource(properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+
+	t.Run("success", func(t *testing.T) {
+		dependencies := map[string]renderers.RendererDependency{
+			envVarSource3: {
+				ResourceID:     resources.MustParse(envVarSource3),
+				Resource:       secretStore,
+				ComputedValues: map[string]any{"password": "p@ssw0rd"},
+			},
+		}
+
+		output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies})
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+		expectedEnv := []corev1.EnvVar{
+			{Name: envVarName3, ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: kubernetes.NormalizeResourceName(resourceName),
+					},
+					Key: envVarName3,
+				},
+			}},
+		}
+		require.Equal(t, expectedEnv, deployment.Spec.Template.Spec.Containers[0].Env)
+
+		secret, _ := kubernetes.FindSecret(output.Resources)
+		require.NotNil(t, secret)
+		require.Equal(t, map[string][]byte{envVarName3: []byte("p@ssw0rd")}, secret.Data)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		dependencies := map[string]renderers.RendererDependency{
+			envVarSource3: {
+				ResourceID:     resources.MustParse(envVarSource3),
+				Resource:       secretStore,
+				ComputedValues: map[string]any{},
+			},
+		}
+
+		_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies})
+		require.ErrorContains(t, err, "failed to find key password in secret store: "+envVarSource3)
+	})
+}
+
 func Test_Render_WithInvalidEnvironmentVariables(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -0,0 +1,311 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// gatewayAPIHTTPListenerName is the name of the listener used for plain HTTP traffic.
+	gatewayAPIHTTPListenerName = "http"
+
+	// gatewayAPIHTTPSListenerName is the name of the listener used for TLS terminated traffic.
+	gatewayAPIHTTPSListenerName = "https"
+)
+
+// MakeGatewayAPIGateway validates the Gateway resource and its dependencies, and creates a Kubernetes Gateway API
+// Gateway resource to act as the Gateway. The listener is restricted to the given hostname unless it is empty.
+//
+// When the TLS certificate is stored in another namespace, a ReferenceGrant allowing the Gateway to use the certificate
+// secret is also created in the namespace of the secret.
+func MakeGatewayAPIGateway(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	if len(gateway.Properties.Routes) < 1 {
+		return nil, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	if options.Environment.Gateway.GatewayClassName == "" {
+		return nil, v1.NewClientErrInvalidRequest("the environment must specify a gatewayClassName to deploy Gateways with the Kubernetes Gateway API")
+	}
+
+	gatewayName := kubernetes.NormalizeResourceName(resourceName)
+	outputResources := []rpv1.OutputResource{}
+
+	listener := gatewayv1.Listener{
+		Name:     gatewayAPIHTTPListenerName,
+		Port:     gatewayv1.PortNumber(80),
+		Protocol: gatewayv1.HTTPProtocolType,
+	}
+
+	if hostname != "" {
+		listener.Hostname = to.Ptr(gatewayv1.Hostname(hostname))
+	}
+
+	// configure TLS termination if it is enabled
+	if gateway.Properties.TLS != nil {
+		if gateway.Properties.TLS.SSLPassthrough {
+			return nil, v1.NewClientErrInvalidRequest("sslPassthrough is not supported when deploying Gateways with the Kubernetes Gateway API")
+		}
+
+		if gateway.Properties.TLS.CertificateFrom != "" {
+			secretName, secretNamespace, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return nil, err
+			}
+
+			certificateRef := gatewayv1.SecretObjectReference{
+				Name: gatewayv1.ObjectName(secretName),
+			}
+
+			// Referencing a secret in another namespace requires a ReferenceGrant in the namespace of the secret.
+			if secretNamespace != options.Environment.Namespace {
+				certificateRef.Namespace = to.Ptr(gatewayv1.Namespace(secretNamespace))
+				outputResources = append(outputResources, makeGatewayAPIReferenceGrant(options, gateway, gatewayName, applicationName, resourceName, secretName, secretNamespace))
+			}
+
+			// The minimum TLS protocol version is left to the Gateway API implementation since
+			// the Gateway API does not define a portable setting for it.
+			listener.Name = gatewayAPIHTTPSListenerName
+			listener.Port = gatewayv1.PortNumber(443)
+			listener.Protocol = gatewayv1.HTTPSProtocolType
+			listener.TLS = &gatewayv1.ListenerTLSConfig{
+				Mode:            to.Ptr(gatewayv1.TLSModeTerminate),
+				CertificateRefs: []gatewayv1.SecretObjectReference{certificateRef},
+			}
+		}
+	}
+
+	gatewayObject := &gatewayv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gatewayName,
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(options.Environment.Gateway.GatewayClassName),
+			Listeners:        []gatewayv1.Listener{listener},
+		},
+	}
+
+	gatewayResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayObject.ObjectMeta)
+	if len(outputResources) > 0 {
+		// The listener only becomes ready once the Gateway is allowed to use the certificate.
+		gatewayResource.CreateResource.Dependencies = []string{rpv1.LocalIDReferenceGrant}
+	}
+
+	return append(outputResources, gatewayResource), nil
+}
+
+// makeGatewayAPIReferenceGrant creates a ReferenceGrant in the namespace of the certificate secret that allows the
+// Gateway to reference that secret only. The name includes the namespace of the Gateway, since Gateways of different
+// applications can share the namespace of the secret.
+func makeGatewayAPIReferenceGrant(options renderers.RenderOptions, gateway *datamodel.Gateway, gatewayName string, applicationName string, resourceName string, secretName string, secretNamespace string) rpv1.OutputResource {
+	referenceGrant := &gatewayv1beta1.ReferenceGrant{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReferenceGrant",
+			APIVersion: gatewayv1beta1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(options.Environment.Namespace + "-" + gatewayName),
+			Namespace:   secretNamespace,
+			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{
+				{
+					Group:     gatewayv1.GroupName,
+					Kind:      "Gateway",
+					Namespace: gatewayv1.Namespace(options.Environment.Namespace),
+				},
+			},
+			To: []gatewayv1beta1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Secret",
+					Name:  to.Ptr(gatewayv1.ObjectName(secretName)),
+				},
+			},
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDReferenceGrant, referenceGrant, referenceGrant.ObjectMeta)
+}
+
+// MakeGatewayAPIHTTPRoutes creates a Kubernetes Gateway API HTTPRoute for each route destination in the gateway and returns
+// them as OutputResources. Routes with the same destination are merged into the rules of a single HTTPRoute.
+func MakeGatewayAPIHTTPRoutes(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, applicationName string) ([]rpv1.OutputResource, error) {
+	objects := make(map[string]*gatewayv1.HTTPRoute)
+	localIDs := []string{}
+
+	for _, route := range gateway.Routes {
+		port, err := getRoutePort(options, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHTTPRoute, routeName)
+		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+
+		prefix := route.Path
+		if prefix == "" {
+			prefix = "/"
+		}
+
+		rule := gatewayv1.HTTPRouteRule{
+			Matches: []gatewayv1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1.PathMatchPathPrefix),
+						Value: to.Ptr(prefix),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: gatewayv1.ObjectName(routeResourceName),
+							Port: to.Ptr(gatewayv1.PortNumber(port)),
+						},
+					},
+				},
+			},
+		}
+
+		if route.ReplacePrefix != "" {
+			rule.Filters = []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+						Path: &gatewayv1.HTTPPathModifier{
+							Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: to.Ptr(route.ReplacePrefix),
+						},
+					},
+				},
+			}
+		}
+
+		if route.TimeoutPolicy != nil {
+			requestDuration, backendRequestDuration, err := parseTimeoutPolicy(route.TimeoutPolicy)
+			if err != nil {
+				return []rpv1.OutputResource{}, err
+			}
+
+			rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{
+				Request:        to.Ptr(toGatewayAPIDuration(requestDuration)),
+				BackendRequest: to.Ptr(toGatewayAPIDuration(backendRequestDuration)),
+			}
+		}
+
+		// If this route already exists, append the rule to it
+		if object, exists := objects[localID]; exists {
+			object.Spec.Rules = append(object.Spec.Rules, rule)
+			continue
+		}
+
+		httpRouteObject := &gatewayv1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "HTTPRoute",
+				APIVersion: gatewayv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        routeResourceName,
+				Namespace:   options.Environment.Namespace,
+				Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
+				Annotations: renderers.GetAnnotations(options),
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Name: gatewayv1.ObjectName(gatewayName),
+						},
+					},
+				},
+				Rules: []gatewayv1.HTTPRouteRule{rule},
+			},
+		}
+
+		objects[localID] = httpRouteObject
+		localIDs = append(localIDs, localID)
+	}
+
+	var outputResources []rpv1.OutputResource
+	for _, localID := range localIDs {
+		object := objects[localID]
+		outputResource := rpv1.NewKubernetesOutputResource(localID, object, object.ObjectMeta)
+
+		// The HTTPRoute is only accepted once its parent Gateway exists, so the Gateway is created first.
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
+// toGatewayAPIDuration formats the duration using the subset of the Go duration format accepted by
+// the Kubernetes Gateway API, which only supports the h, m, s and ms units.
+func toGatewayAPIDuration(d time.Duration) gatewayv1.Duration {
+	d = d.Truncate(time.Millisecond)
+	if d <= 0 {
+		return gatewayv1.Duration("0s")
+	}
+
+	result := ""
+	for _, unit := range []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+	} {
+		if value := d / unit.size; value > 0 {
+			result += fmt.Sprintf("%d%s", value, unit.suffix)
+			d -= value * unit.size
+		}
+	}
+
+	return gatewayv1.Duration(result)
+}
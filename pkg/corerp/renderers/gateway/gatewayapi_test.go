@@ -0,0 +1,418 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const testGatewayClassName = "test-gateway-class"
+
+func Test_Render_GatewayAPI_SingleRoute(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+	require.Empty(t, output.SecretValues)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	require.Equal(t, resourceName, gateway.Name)
+	require.Equal(t, applicationName, gateway.Namespace)
+	require.Equal(t, gatewayv1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1.Listener{
+			{
+				Name:     gatewayAPIHTTPListenerName,
+				Hostname: to.Ptr(gatewayv1.Hostname(expectedHostname)),
+				Port:     80,
+				Protocol: gatewayv1.HTTPProtocolType,
+			},
+		},
+	}, gateway.Spec)
+
+	httpRoute, outputResource := findGatewayAPIHTTPRoute(t, output.Resources, "A")
+	require.Equal(t, []string{rpv1.LocalIDGateway}, outputResource.CreateResource.Dependencies)
+	require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute, outputResource.GetResourceType().Type)
+	require.Equal(t, gatewayv1.HTTPRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: []gatewayv1.ParentReference{{Name: resourceName}},
+		},
+		Rules: []gatewayv1.HTTPRouteRule{
+			makeExpectedHTTPRouteRule("/", "a", 80),
+		},
+	}, httpRoute.Spec)
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Equal(t, "unknown", output.ComputedValues["url"].Value)
+
+	// The listener accepts every hostname when there is no public endpoint.
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	require.Len(t, gateway.Spec.Listeners, 1)
+	require.Nil(t, gateway.Spec.Listeners[0].Hostname)
+}
+
+func Test_Render_GatewayAPI_MultipleRoutes_WithPrefixRewriteAndTimeouts(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://A",
+				Path:        "/api",
+				TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{
+					Request:        "1m30s",
+					BackendRequest: "1.5s",
+				},
+			},
+			{
+				Destination:   "http://A:3000",
+				Path:          "/backend",
+				ReplacePrefix: "/",
+			},
+			{
+				Destination: "http://B",
+			},
+		},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions(testHostname, "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	routeA, _ := findGatewayAPIHTTPRoute(t, output.Resources, "A")
+	expectedTimeoutRule := makeExpectedHTTPRouteRule("/api", "a", 80)
+	expectedTimeoutRule.Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request:        to.Ptr(gatewayv1.Duration("1m30s")),
+		BackendRequest: to.Ptr(gatewayv1.Duration("1s500ms")),
+	}
+	expectedRewriteRule := makeExpectedHTTPRouteRule("/backend", "a", 3000)
+	expectedRewriteRule.Filters = []gatewayv1.HTTPRouteFilter{
+		{
+			Type: gatewayv1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: to.Ptr("/"),
+				},
+			},
+		},
+	}
+	require.Equal(t, []gatewayv1.HTTPRouteRule{expectedTimeoutRule, expectedRewriteRule}, routeA.Spec.Rules)
+
+	routeB, _ := findGatewayAPIHTTPRoute(t, output.Resources, "B")
+	require.Equal(t, []gatewayv1.HTTPRouteRule{makeExpectedHTTPRouteRule("/", "b", 80)}, routeB.Spec.Rules)
+}
+
+func Test_Render_GatewayAPI_WithTLSTermination(t *testing.T) {
+	tests := []struct {
+		desc            string
+		secretNamespace string
+		referenceGrant  bool
+	}{
+		{
+			desc:            "certificate in the gateway namespace",
+			secretNamespace: applicationName,
+		},
+		{
+			desc:            "certificate in another namespace",
+			secretNamespace: "secrets",
+			referenceGrant:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := &Renderer{}
+
+			secretName := "myapp-tls-secret"
+			secretStoreResourceId := makeSecretStoreResourceID(secretName)
+			properties, _ := makeTestGateway(datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				TLS: &datamodel.GatewayPropertiesTLS{
+					MinimumProtocolVersion: "1.2",
+					CertificateFrom:        secretStoreResourceId,
+				},
+			})
+			resource := makeResource(properties)
+			environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+			dependencies := map[string]renderers.RendererDependency{
+				secretStoreResourceId: {
+					ResourceID: resources.MustParse(secretStoreResourceId),
+					Resource: &datamodel.SecretStore{
+						Properties: &datamodel.SecretStoreProperties{
+							Type: "certificate",
+							Data: map[string]*datamodel.SecretStoreDataValue{
+								"tls.crt": {
+									Value: to.Ptr("test-crt"),
+								},
+								"tls.key": {
+									Value: to.Ptr("test-crt"),
+								},
+							},
+						},
+					},
+					OutputResources: map[string]resources.ID{
+						"Secret": resources_kubernetes.IDFromParts(
+							resources_kubernetes.PlaneNameTODO,
+							"",
+							"Secret",
+							tc.secretNamespace,
+							secretName),
+					},
+				},
+			}
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+			require.NoError(t, err)
+
+			expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+			require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+			certificateRef := gatewayv1.SecretObjectReference{
+				Name: gatewayv1.ObjectName(secretName),
+			}
+			if tc.referenceGrant {
+				certificateRef.Namespace = to.Ptr(gatewayv1.Namespace(tc.secretNamespace))
+			}
+
+			gateway := findGatewayAPIGateway(t, output.Resources)
+			require.Equal(t, []gatewayv1.Listener{
+				{
+					Name:     gatewayAPIHTTPSListenerName,
+					Hostname: to.Ptr(gatewayv1.Hostname(expectedHostname)),
+					Port:     443,
+					Protocol: gatewayv1.HTTPSProtocolType,
+					TLS: &gatewayv1.ListenerTLSConfig{
+						Mode:            to.Ptr(gatewayv1.TLSModeTerminate),
+						CertificateRefs: []gatewayv1.SecretObjectReference{certificateRef},
+					},
+				},
+			}, gateway.Spec.Listeners)
+
+			if !tc.referenceGrant {
+				require.Len(t, output.Resources, 2)
+				return
+			}
+
+			require.Len(t, output.Resources, 3)
+			referenceGrant, referenceGrantResource := findGatewayAPIReferenceGrant(t, output.Resources)
+			require.Equal(t, tc.secretNamespace, referenceGrant.Namespace)
+			require.Equal(t, applicationName+"-"+resourceName, referenceGrant.Name)
+			require.Equal(t, gatewayv1beta1.ReferenceGrantSpec{
+				From: []gatewayv1beta1.ReferenceGrantFrom{
+					{
+						Group:     gatewayv1.GroupName,
+						Kind:      "Gateway",
+						Namespace: gatewayv1.Namespace(applicationName),
+					},
+				},
+				To: []gatewayv1beta1.ReferenceGrantTo{
+					{
+						Group: "",
+						Kind:  "Secret",
+						Name:  to.Ptr(gatewayv1.ObjectName(secretName)),
+					},
+				},
+			}, referenceGrant.Spec)
+			require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIReferenceGrant, referenceGrantResource.GetResourceType().Type)
+
+			for _, r := range output.Resources {
+				if r.LocalID == rpv1.LocalIDGateway {
+					require.Equal(t, []string{rpv1.LocalIDReferenceGrant}, r.CreateResource.Dependencies)
+				}
+			}
+		})
+	}
+}
+
+func Test_Render_GatewayAPI_Fails_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+	})
+	properties.Routes[0].Path = ""
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, err.(*v1.ErrClientRP).Code, v1.CodeInvalid)
+	require.Equal(t, err.(*v1.ErrClientRP).Message, "sslPassthrough is not supported when deploying Gateways with the Kubernetes Gateway API")
+	require.Empty(t, output.Resources)
+}
+
+func Test_Render_GatewayAPI_Fails_WithoutGatewayClassName(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+	environmentOptions.Gateway.GatewayClassName = ""
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, err.(*v1.ErrClientRP).Code, v1.CodeInvalid)
+	require.Equal(t, err.(*v1.ErrClientRP).Message, "the environment must specify a gatewayClassName to deploy Gateways with the Kubernetes Gateway API")
+	require.Empty(t, output.Resources)
+}
+
+func Test_ToGatewayAPIDuration(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected gatewayv1.Duration
+	}{
+		{input: 0, expected: "0s"},
+		{input: 500 * time.Microsecond, expected: "0s"},
+		{input: 250 * time.Millisecond, expected: "250ms"},
+		{input: 30 * time.Second, expected: "30s"},
+		{input: 90 * time.Second, expected: "1m30s"},
+		{input: 2*time.Hour + 1500*time.Millisecond, expected: "2h1s500ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			require.Equal(t, tt.expected, toGatewayAPIDuration(tt.input))
+		})
+	}
+}
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP string) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, "", false, false)
+	environmentOptions.Gateway.Kind = datamodel.GatewayKindGatewayAPI
+	environmentOptions.Gateway.GatewayClassName = testGatewayClassName
+	return environmentOptions
+}
+
+func makeExpectedHTTPRouteRule(prefix string, serviceName string, port int32) gatewayv1.HTTPRouteRule {
+	return gatewayv1.HTTPRouteRule{
+		Matches: []gatewayv1.HTTPRouteMatch{
+			{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  to.Ptr(gatewayv1.PathMatchPathPrefix),
+					Value: to.Ptr(prefix),
+				},
+			},
+		},
+		BackendRefs: []gatewayv1.HTTPBackendRef{
+			{
+				BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: gatewayv1.BackendObjectReference{
+						Name: gatewayv1.ObjectName(serviceName),
+						Port: to.Ptr(gatewayv1.PortNumber(port)),
+					},
+				},
+			},
+		},
+	}
+}
+
+func findGatewayAPIGateway(t *testing.T, outputResources []rpv1.OutputResource) *gatewayv1.Gateway {
+	for _, r := range outputResources {
+		if r.LocalID == rpv1.LocalIDGateway {
+			require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIGateway, r.GetResourceType().Type)
+			gateway, ok := r.CreateResource.Data.(*gatewayv1.Gateway)
+			require.True(t, ok)
+			return gateway
+		}
+	}
+
+	require.Fail(t, "gateway output resource not found")
+	return nil
+}
+
+func findGatewayAPIReferenceGrant(t *testing.T, outputResources []rpv1.OutputResource) (*gatewayv1beta1.ReferenceGrant, rpv1.OutputResource) {
+	for _, r := range outputResources {
+		if r.LocalID == rpv1.LocalIDReferenceGrant {
+			referenceGrant, ok := r.CreateResource.Data.(*gatewayv1beta1.ReferenceGrant)
+			require.True(t, ok)
+			return referenceGrant, r
+		}
+	}
+
+	require.Fail(t, "reference grant output resource not found")
+	return nil, rpv1.OutputResource{}
+}
+
+func findGatewayAPIHTTPRoute(t *testing.T, outputResources []rpv1.OutputResource, routeName string) (*gatewayv1.HTTPRoute, rpv1.OutputResource) {
+	for _, r := range outputResources {
+		if r.LocalID == rpv1.LocalIDHTTPRoute+"-"+routeName {
+			httpRoute, ok := r.CreateResource.Data.(*gatewayv1.HTTPRoute)
+			require.True(t, ok)
+			return httpRoute, r
+		}
+	}
+
+	require.Fail(t, "http route output resource not found", routeName)
+	return nil, rpv1.OutputResource{}
+}
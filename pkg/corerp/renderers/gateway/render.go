@@ -67,7 +67,8 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 }
 
 // Render creates a gateway object and http route objects based on the given parameters, and returns them along
-// with a computed value for the gateway's public endpoint.
+// with a computed value for the gateway's public endpoint. Contour HTTPProxy resources are created by default,
+// and Kubernetes Gateway API resources are created when the environment selects the gatewayApi gateway kind.
 func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
 	outputResources := []rpv1.OutputResource{}
 	gateway, ok := dm.(*datamodel.Gateway)
@@ -83,6 +84,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
 
 	var publicEndpoint string
+	hasPublicEndpoint := false
 	if errors.Is(err, &ErrNoPublicEndpoint{}) {
 		publicEndpoint = "unknown"
 	} else if err != nil {
@@ -90,6 +92,38 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	} else {
 		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
+		hasPublicEndpoint = true
+	}
+
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	if options.Environment.Gateway.Kind == datamodel.GatewayKindGatewayAPI {
+		// Without a public endpoint the Gateway listens on all hostnames.
+		listenerHostname := ""
+		if hasPublicEndpoint {
+			listenerHostname = hostname
+		}
+
+		gatewayObjects, err := MakeGatewayAPIGateway(ctx, options, gateway, gateway.Name, applicationName, listenerHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		outputResources = append(outputResources, gatewayObjects...)
+
+		httpRouteObjects, err := MakeGatewayAPIHTTPRoutes(ctx, options, *gateway, &gateway.Properties, gatewayName, applicationName)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		outputResources = append(outputResources, httpRouteObjects...)
+
+		return renderers.RendererOutput{
+			Resources:      outputResources,
+			ComputedValues: computedValues,
+		}, nil
 	}
 
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
@@ -99,12 +133,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	outputResources = append(outputResources, gatewayObject)
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
-	}
-
 	httpProxyObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -121,7 +149,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
-	dependencies := options.Dependencies
 
 	if len(gateway.Properties.Routes) < 1 {
 		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
@@ -135,49 +162,9 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+			secretName, secretNamespace, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
@@ -288,24 +275,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
 // an error if it fails to get the route name.
 func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
-	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
 
 	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
+		port, err := getRoutePort(options, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
 		routeName, err := getRouteName(&route)
@@ -331,24 +306,8 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 
 		var timeoutPolicy *contourv1.TimeoutPolicy
 		if route.TimeoutPolicy != nil {
-			// Parse the timeout policy request durations and compare them
-			requestDuration, err := time.ParseDuration(route.TimeoutPolicy.Request)
-			if err != nil {
-				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("invalid request timeout duration")
-			}
-			var backendRequestDuration time.Duration
-			if route.TimeoutPolicy.BackendRequest != "" {
-				backendRequestDuration, err = time.ParseDuration(route.TimeoutPolicy.BackendRequest)
-				if err != nil {
-					return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("invalid backend request timeout duration")
-				}
-			} else {
-				// If the backend request timeout is not specified, default to the request timeout
-				backendRequestDuration = requestDuration
-			}
-			// Compare the 2 request durations and ensure that the request timeout is greater than the backend request timeout
-			if requestDuration < backendRequestDuration {
-				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("request timeout must be greater than or equal to backend request timeout")
+			if _, _, err := parseTimeoutPolicy(route.TimeoutPolicy); err != nil {
+				return []rpv1.OutputResource{}, err
 			}
 			timeoutPolicy = &contourv1.TimeoutPolicy{
 				Response: route.TimeoutPolicy.Request,
@@ -417,6 +376,108 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// getCertificateSecret validates the secretStore referenced by the certificateFrom property of the Gateway,
+// and returns the name and namespace of the Kubernetes secret holding the TLS certificate.
+func getCertificateSecret(options renderers.RenderOptions, gateway *datamodel.Gateway) (name string, namespace string, err error) {
+	secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
+	secretStoreResource, ok := options.Dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	referencedResource := options.Dependencies[secretStoreResourceId].Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
+	}
+
+	// The certificate is referenced from the Kubernetes secret of the secretStore, so Vault backed secretStores are not supported.
+	if secretStore.Properties.ProviderKind() != datamodel.SecretStoreProviderKubernetes {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with kubernetes provider")
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	return secretName, secretNamespace, nil
+}
+
+// getRoutePort returns the port of the route destination. The port is read from the destination URL, or from the
+// computed values of the destination resource, and defaults to renderers.DefaultPort.
+func getRoutePort(options renderers.RenderOptions, route *datamodel.GatewayRoute) (int32, error) {
+	if isURL(route.Destination) {
+		_, _, port, err := parseURL(route.Destination)
+		if err != nil {
+			return 0, err
+		}
+		return port, nil
+	}
+
+	routeProperties := options.Dependencies[route.Destination]
+	routePort, ok := routeProperties.ComputedValues["port"].(float64)
+	if ok {
+		return int32(routePort), nil
+	}
+
+	return renderers.DefaultPort, nil
+}
+
+// parseTimeoutPolicy parses the request and backend request durations of the timeout policy of a route
+// and ensures that the request timeout is greater than or equal to the backend request timeout.
+func parseTimeoutPolicy(policy *datamodel.GatewayRouteTimeoutPolicy) (requestDuration time.Duration, backendRequestDuration time.Duration, err error) {
+	requestDuration, err = time.ParseDuration(policy.Request)
+	if err != nil {
+		return 0, 0, v1.NewClientErrInvalidRequest("invalid request timeout duration")
+	}
+
+	if policy.BackendRequest != "" {
+		backendRequestDuration, err = time.ParseDuration(policy.BackendRequest)
+		if err != nil {
+			return 0, 0, v1.NewClientErrInvalidRequest("invalid backend request timeout duration")
+		}
+	} else {
+		// If the backend request timeout is not specified, default to the request timeout
+		backendRequestDuration = requestDuration
+	}
+
+	// Compare the 2 request durations and ensure that the request timeout is greater than the backend request timeout
+	if requestDuration < backendRequestDuration {
+		return 0, 0, v1.NewClientErrInvalidRequest("request timeout must be greater than or equal to backend request timeout")
+	}
+
+	return requestDuration, backendRequestDuration, nil
+}
+
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	u, err := url.Parse(route.Destination)
 	if err != nil {
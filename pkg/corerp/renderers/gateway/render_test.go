@@ -1326,6 +1326,54 @@ func Test_Render_WithDependencies(t *testing.T) {
 	validateContourHTTPRoute(t, output.Resources, "A", expectedHTTPRouteSpec, "")
 }
 
+func Test_Render_WithVaultSecretStore(t *testing.T) {
+	r := &Renderer{}
+
+	secret := makeSecretStoreResource(datamodel.SecretStoreProperties{
+		Type: datamodel.SecretTypeCert,
+		Data: map[string]*datamodel.SecretStoreDataValue{
+			"tls.crt": {},
+			"tls.key": {},
+		},
+		Provider: &datamodel.SecretStoreProvider{
+			Kind: datamodel.SecretStoreProviderVault,
+			Vault: &datamodel.VaultSecretStoreProvider{
+				Address:     "http://127.0.0.1:8200",
+				TokenSecret: "radius-system/vault-token",
+			},
+		},
+	})
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			CertificateFrom: secret.ID,
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://A:81",
+				Path:        "/routea",
+			},
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{
+		secret.ID: {
+			ResourceID:     resources.MustParse(secret.ID),
+			Resource:       secret,
+			ComputedValues: map[string]any{},
+		},
+	}
+
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "certificateFrom must reference a secretStore resource with kubernetes provider", err.(*v1.ErrClientRP).Message)
+}
+
 func Test_Render_WithEnvironment_KubernetesMetadata(t *testing.T) {
 	r := &Renderer{}
 
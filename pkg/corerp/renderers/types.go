@@ -87,6 +87,11 @@ type ApplicationOptions struct {
 }
 
 type GatewayOptions struct {
+	// Kind is the kind of Kubernetes resources used to implement Gateways, for example "contour" or "gatewayApi".
+	Kind string
+	// GatewayClassName is the name of the GatewayClass referenced by Gateway API Gateway resources.
+	GatewayClassName string
+
 	PublicEndpointOverride bool
 	Hostname               string
 	Port                   string
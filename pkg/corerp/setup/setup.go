@@ -17,12 +17,16 @@ limitations under the License.
 package setup
 
 import (
+	"fmt"
+	"sync"
 	"time"
 
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
 	backend_ctrl "github.com/radius-project/radius/pkg/corerp/backend/controller"
+	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	app_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/applications"
@@ -34,10 +38,14 @@ import (
 	rp_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/recipepacks"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
+	"github.com/radius-project/radius/pkg/corerp/model"
 	ext_processor "github.com/radius-project/radius/pkg/corerp/processors/extenders"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
+	"k8s.io/client-go/kubernetes"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -48,6 +56,7 @@ const (
 // SetupNamespace builds the namespace for core resource provider.
 func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerConfig) *builder.Namespace {
 	ns := builder.NewNamespace("Applications.Core")
+	deploymentProcessor := newDeploymentProcessorFactory(recipeControllerConfig)
 
 	_ = ns.AddResource("environments", &builder.ResourceOption[*datamodel.Environment, datamodel.Environment]{
 		RequestConverter:  converter.EnvironmentDataModelFromVersioned,
@@ -59,6 +68,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Environment]{
 			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
 		},
+		WhatIf: builder.WhatIfOperation[datamodel.Environment]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.Environment]{
+				env_ctrl.ValidateRequest,
+			},
+		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
 				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
@@ -120,6 +134,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncOperationTimeout:    time.Minute * time.Duration(20),
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		WhatIf: builder.WhatIfOperation[datamodel.ContainerResource]{
+			OutputResourcesPreview: rp_frontend.NewOutputResourcesPreview[*datamodel.ContainerResource](deploymentProcessor),
+		},
 	})
 
 	_ = ns.AddResource("gateways", &builder.ResourceOption[*datamodel.Gateway, datamodel.Gateway]{
@@ -149,6 +166,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncOperationTimeout:    time.Minute * time.Duration(20),
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		WhatIf: builder.WhatIfOperation[datamodel.Gateway]{
+			OutputResourcesPreview: rp_frontend.NewOutputResourcesPreview[*datamodel.Gateway](deploymentProcessor),
+		},
 	})
 
 	_ = ns.AddResource("volumes", &builder.ResourceOption[*datamodel.VolumeResource, datamodel.VolumeResource]{
@@ -175,6 +195,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncJobController:       backend_ctrl.NewDeleteResource,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		WhatIf: builder.WhatIfOperation[datamodel.VolumeResource]{
+			OutputResourcesPreview: rp_frontend.NewOutputResourcesPreview[*datamodel.VolumeResource](deploymentProcessor),
+		},
 	})
 
 	_ = ns.AddResource("secretStores", &builder.ResourceOption[*datamodel.SecretStore, datamodel.SecretStore]{
@@ -220,6 +243,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncCreateOrUpdateExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
@@ -230,6 +254,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncCreateOrUpdateExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.Extender]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -237,11 +262,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncDeleteExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Custom: map[string]builder.Operation[datamodel.Extender]{
 			"listsecrets": {
 				APIController: ext_ctrl.NewListSecretsExtender,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.Extender](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.Extender, datamodel.Extender](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -277,6 +311,11 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 		Patch: builder.Operation[datamodel.Environment_v20250801preview]{
 			APIController: env_v20250801_ctrl.NewCreateOrUpdateEnvironmentv20250801preview,
 		},
+		WhatIf: builder.WhatIfOperation[datamodel.Environment_v20250801preview]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.Environment_v20250801preview]{
+				env_v20250801_ctrl.ValidateRequest,
+			},
+		},
 	})
 
 	_ = ns.AddResource("applications", &builder.ResourceOption[*datamodel.Application_v20250801preview, datamodel.Application_v20250801preview]{
@@ -297,3 +336,43 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 
 	return ns
 }
+
+// newDeploymentProcessorFactory returns a function which creates the deployment processor used by the what-if action
+// to render the output resources of core resources. The Kubernetes clients and the application model are created on
+// first use, so that they are not required until a resource is previewed.
+func newDeploymentProcessorFactory(recipeControllerConfig *controllerconfig.RecipeControllerConfig) func(options *apictrl.Options) (deployment.DeploymentProcessor, error) {
+	var once sync.Once
+	var appModel model.ApplicationModel
+	var runtimeClient runtimeclient.Client
+	var clientSet kubernetes.Interface
+	var err error
+
+	initialize := func() {
+		if runtimeClient, err = recipeControllerConfig.Kubernetes.RuntimeClient(); err != nil {
+			return
+		}
+		if clientSet, err = recipeControllerConfig.Kubernetes.ClientGoClient(); err != nil {
+			return
+		}
+		discoveryClient, discoveryErr := recipeControllerConfig.Kubernetes.DiscoveryClient()
+		if discoveryErr != nil {
+			err = discoveryErr
+			return
+		}
+		dynamicClient, dynamicErr := recipeControllerConfig.Kubernetes.DynamicClient()
+		if dynamicErr != nil {
+			err = dynamicErr
+			return
+		}
+		appModel, err = model.NewApplicationModel(recipeControllerConfig.Arm, runtimeClient, clientSet, discoveryClient, dynamicClient)
+	}
+
+	return func(options *apictrl.Options) (deployment.DeploymentProcessor, error) {
+		once.Do(initialize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize the deployment processor: %w", err)
+		}
+
+		return deployment.NewDeploymentProcessor(appModel, options.DatabaseClient, runtimeClient, clientSet, recipeControllerConfig.Vault), nil
+	}
+}
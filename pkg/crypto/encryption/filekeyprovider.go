@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+const (
+	// DefaultEncryptionKeyFilePath is the default path of the file containing the versioned key store JSON. This is
+	// where the Kubernetes Secret containing the encryption key is mounted in the Radius containers.
+	DefaultEncryptionKeyFilePath = "/var/secrets/encryption/" + DefaultEncryptionKeySecretKey
+)
+
+var _ KeyProvider = (*FileKeyProvider)(nil)
+
+// FileKeyProvider implements KeyProvider by loading the versioned key store from a local file.
+//
+// The file is read on every call so that keys rotated by another process (eg: an update of a mounted Kubernetes Secret)
+// are picked up without a restart.
+type FileKeyProvider struct {
+	path string
+}
+
+// FileKeyProviderOptions contains options for creating a FileKeyProvider.
+type FileKeyProviderOptions struct {
+	// Path is the path of the file containing the versioned key store JSON.
+	// Defaults to DefaultEncryptionKeyFilePath if not specified.
+	Path string `yaml:"path,omitempty"`
+}
+
+// NewFileKeyProvider creates a new FileKeyProvider with the given options.
+func NewFileKeyProvider(opts *FileKeyProviderOptions) *FileKeyProvider {
+	path := DefaultEncryptionKeyFilePath
+	if opts != nil && opts.Path != "" {
+		path = opts.Path
+	}
+
+	return &FileKeyProvider{path: path}
+}
+
+// GetCurrentKey retrieves the current encryption key from the key store file.
+// Returns the key bytes, version number, and any error.
+func (p *FileKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int, error) {
+	keyStore, err := p.loadKeyStore()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	key, err := keyStore.getKey(keyStore.CurrentVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return key, keyStore.CurrentVersion, nil
+}
+
+// GetKeyByVersion retrieves a specific key version from the key store file.
+func (p *FileKeyProvider) GetKeyByVersion(ctx context.Context, version int) ([]byte, error) {
+	keyStore, err := p.loadKeyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyStore.getKey(version)
+}
+
+// loadKeyStore loads and parses the key store from the file.
+func (p *FileKeyProvider) loadKeyStore() (*KeyStore, error) {
+	keysJSON, err := os.ReadFile(p.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: file %s not found", ErrKeyNotFound, p.path)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	var keyStore KeyStore
+	if err := json.Unmarshal(keysJSON, &keyStore); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse key store JSON: %v", ErrKeyLoadFailed, err)
+	}
+
+	return &keyStore, nil
+}
@@ -90,6 +90,32 @@ type KeyProvider interface {
 	GetKeyByVersion(ctx context.Context, version int) ([]byte, error)
 }
 
+// KeyProviderType is the type of the source of the encryption keys.
+type KeyProviderType string
+
+const (
+	// KeyProviderTypeSecret loads the keys from the secret store of the service. See SecretKeyProvider.
+	KeyProviderTypeSecret KeyProviderType = "secret"
+
+	// KeyProviderTypeKubernetes loads the keys from a Kubernetes Secret. See KubernetesKeyProvider.
+	KeyProviderTypeKubernetes KeyProviderType = "kubernetes"
+
+	// KeyProviderTypeFile loads the keys from a local file. See FileKeyProvider.
+	KeyProviderTypeFile KeyProviderType = "file"
+)
+
+// KeyProviderOptions configures the source of the encryption keys.
+type KeyProviderOptions struct {
+	// Provider is the type of the key provider. Defaults to KeyProviderTypeSecret if not specified.
+	Provider KeyProviderType `yaml:"provider,omitempty"`
+
+	// Kubernetes configures the key provider when Provider is KeyProviderTypeKubernetes.
+	Kubernetes *KubernetesKeyProviderOptions `yaml:"kubernetes,omitempty"`
+
+	// File configures the key provider when Provider is KeyProviderTypeFile.
+	File *FileKeyProviderOptions `yaml:"file,omitempty"`
+}
+
 // KubernetesKeyProvider implements KeyProvider by loading the encryption key from a Kubernetes Secret.
 type KubernetesKeyProvider struct {
 	client     controller_runtime.Client
@@ -102,15 +128,15 @@ type KubernetesKeyProvider struct {
 type KubernetesKeyProviderOptions struct {
 	// SecretName is the name of the Kubernetes Secret containing the encryption key.
 	// Defaults to DefaultEncryptionKeySecretName if not specified.
-	SecretName string
+	SecretName string `yaml:"secretName,omitempty"`
 
 	// SecretKey is the key within the Secret that contains the encryption key.
 	// Defaults to DefaultEncryptionKeySecretKey if not specified.
-	SecretKey string
+	SecretKey string `yaml:"secretKey,omitempty"`
 
 	// Namespace is the namespace where the Secret is located.
 	// Defaults to RadiusNamespace if not specified.
-	Namespace string
+	Namespace string `yaml:"namespace,omitempty"`
 }
 
 // NewKubernetesKeyProvider creates a new KubernetesKeyProvider with the given Kubernetes client and options.
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -581,3 +583,55 @@ func TestKeyProviderIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte("old secret"), oldDecrypted)
 }
+
+func TestFileKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	provider := NewFileKeyProvider(&FileKeyProviderOptions{Path: path})
+
+	t.Run("file not found", func(t *testing.T) {
+		_, _, err := provider.GetCurrentKey(ctx)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+		_, _, err := provider.GetCurrentKey(ctx)
+		require.ErrorIs(t, err, ErrKeyLoadFailed)
+	})
+
+	t.Run("versioned keys", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, createTestKeyStore(t, map[int][]byte{1: key1}, 1), 0600))
+
+		key, version, err := provider.GetCurrentKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, version)
+		require.Equal(t, key1, key)
+
+		// Rotation is picked up without creating a new provider
+		require.NoError(t, os.WriteFile(path, createTestKeyStore(t, map[int][]byte{1: key1, 2: key2}, 2), 0600))
+
+		key, version, err = provider.GetCurrentKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, version)
+		require.Equal(t, key2, key)
+
+		key, err = provider.GetKeyByVersion(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, key1, key)
+
+		_, err = provider.GetKeyByVersion(ctx, 3)
+		require.ErrorIs(t, err, ErrKeyVersionNotFound)
+	})
+}
+
+func TestNewFileKeyProvider_DefaultOptions(t *testing.T) {
+	provider := NewFileKeyProvider(nil)
+	require.Equal(t, DefaultEncryptionKeyFilePath, provider.path)
+}
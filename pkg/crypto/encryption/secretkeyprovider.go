@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/secret"
+)
+
+const (
+	// DefaultKeyStoreSecretName is the default name of the secret in the secret store containing the versioned key store.
+	DefaultKeyStoreSecretName = "radius-encryption-keystore" //nolint:gosec // This is a secret name, not credentials
+
+	// DefaultKeyLifetime is the default lifetime of a key created by SecretKeyProvider.
+	DefaultKeyLifetime = 90 * 24 * time.Hour
+)
+
+var _ KeyProvider = (*SecretKeyProvider)(nil)
+
+// SecretKeyProvider implements KeyProvider by loading the versioned key store from a secret.Client.
+//
+// The key store is read on every call so that keys rotated by another process are picked up without a restart.
+type SecretKeyProvider struct {
+	client secret.Client
+	name   string
+}
+
+// NewSecretKeyProvider creates a new SecretKeyProvider which stores the key store in the secret with the given name.
+// If name is empty, DefaultKeyStoreSecretName is used.
+func NewSecretKeyProvider(client secret.Client, name string) *SecretKeyProvider {
+	if name == "" {
+		name = DefaultKeyStoreSecretName
+	}
+
+	return &SecretKeyProvider{
+		client: client,
+		name:   name,
+	}
+}
+
+// GetCurrentKey retrieves the current encryption key from the key store.
+// Returns the key bytes, version number, and any error.
+func (p *SecretKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int, error) {
+	keyStore, err := p.loadKeyStore(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	key, err := keyStore.getKey(keyStore.CurrentVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return key, keyStore.CurrentVersion, nil
+}
+
+// GetKeyByVersion retrieves a specific key version from the key store.
+func (p *SecretKeyProvider) GetKeyByVersion(ctx context.Context, version int) ([]byte, error) {
+	keyStore, err := p.loadKeyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyStore.getKey(version)
+}
+
+// Initialize creates the key store with a single key if it does not exist yet. It is safe to call Initialize
+// on every startup.
+func (p *SecretKeyProvider) Initialize(ctx context.Context) error {
+	_, err := p.loadKeyStore(ctx)
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, ErrKeyNotFound) {
+		return err
+	}
+
+	keyStore := &KeyStore{Keys: map[string]KeyData{}}
+	if err := keyStore.addKey(time.Now()); err != nil {
+		return err
+	}
+
+	return p.saveKeyStore(ctx, keyStore)
+}
+
+// Rotate adds a new key to the key store and makes it the current key. Previous keys are retained so that
+// data encrypted with them can still be decrypted. Returns the version of the new key.
+func (p *SecretKeyProvider) Rotate(ctx context.Context) (int, error) {
+	keyStore, err := p.loadKeyStore(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := keyStore.addKey(time.Now()); err != nil {
+		return 0, err
+	}
+
+	if err := p.saveKeyStore(ctx, keyStore); err != nil {
+		return 0, err
+	}
+
+	return keyStore.CurrentVersion, nil
+}
+
+// loadKeyStore loads and parses the key store from the secret store.
+func (p *SecretKeyProvider) loadKeyStore(ctx context.Context) (*KeyStore, error) {
+	keyStore, err := secret.GetSecret[KeyStore](ctx, p.client, p.name)
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return nil, fmt.Errorf("%w: secret %s not found", ErrKeyNotFound, p.name)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	if keyStore.Keys == nil {
+		keyStore.Keys = map[string]KeyData{}
+	}
+
+	return &keyStore, nil
+}
+
+// saveKeyStore saves the key store to the secret store.
+func (p *SecretKeyProvider) saveKeyStore(ctx context.Context, keyStore *KeyStore) error {
+	if err := secret.SaveSecret(ctx, p.client, p.name, keyStore); err != nil {
+		return fmt.Errorf("failed to save key store: %w", err)
+	}
+	return nil
+}
+
+// getKey returns the decoded key of the given version.
+func (s *KeyStore) getKey(version int) ([]byte, error) {
+	keyData, ok := s.Keys[strconv.Itoa(version)]
+	if !ok {
+		return nil, fmt.Errorf("%w: version %d not found in key store", ErrKeyVersionNotFound, version)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(keyData.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode key version %d: %v", ErrKeyLoadFailed, version, err)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: key version %d has invalid size (expected %d bytes, got %d)", ErrKeyLoadFailed, version, KeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// addKey generates a new key with the next version number and makes it the current key.
+func (s *KeyStore) addKey(now time.Time) error {
+	key, err := GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	version := 0
+	for _, keyData := range s.Keys {
+		version = max(version, keyData.Version)
+	}
+	version++
+
+	s.Keys[strconv.Itoa(version)] = KeyData{
+		Key:       base64.StdEncoding.EncodeToString(key),
+		Version:   version,
+		CreatedAt: now.UTC().Format(time.RFC3339),
+		ExpiresAt: now.Add(DefaultKeyLifetime).UTC().Format(time.RFC3339),
+	}
+	s.CurrentVersion = version
+
+	return nil
+}
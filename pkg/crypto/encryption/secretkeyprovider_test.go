@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/secret"
+	"github.com/radius-project/radius/pkg/components/secret/inmemory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSecretKeyProvider_Initialize(t *testing.T) {
+	ctx := context.Background()
+	client := &inmemory.Client{}
+	provider := NewSecretKeyProvider(client, "")
+
+	_, _, err := provider.GetCurrentKey(ctx)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, provider.Initialize(ctx))
+
+	key, version, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Len(t, key, KeySize)
+
+	// Initialize must not replace an existing key store.
+	require.NoError(t, provider.Initialize(ctx))
+	key2, version2, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, version2)
+	require.Equal(t, key, key2)
+
+	stored, err := secret.GetSecret[KeyStore](ctx, client, DefaultKeyStoreSecretName)
+	require.NoError(t, err)
+	require.Equal(t, 1, stored.CurrentVersion)
+	require.Len(t, stored.Keys, 1)
+}
+
+func TestSecretKeyProvider_Rotate(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSecretKeyProvider(&inmemory.Client{}, "test-keystore")
+
+	_, err := provider.Rotate(ctx)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, provider.Initialize(ctx))
+	oldKey, _, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+
+	version, err := provider.Rotate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+
+	newKey, currentVersion, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, currentVersion)
+	require.NotEqual(t, oldKey, newKey)
+
+	// The previous key is still available for decryption.
+	key, err := provider.GetKeyByVersion(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, oldKey, key)
+
+	_, err = provider.GetKeyByVersion(ctx, 3)
+	require.ErrorIs(t, err, ErrKeyVersionNotFound)
+}
+
+func TestSecretKeyProvider_DecryptAfterRotation(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSecretKeyProvider(&inmemory.Client{}, "")
+	require.NoError(t, provider.Initialize(ctx))
+
+	handler, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	data := map[string]any{"password": "s3cr3t"}
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"password"}, "test-resource"))
+
+	_, err = provider.Rotate(ctx)
+	require.NoError(t, err)
+
+	handler, err = NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+	require.NoError(t, handler.DecryptSensitiveFields(ctx, data, []string{"password"}, "test-resource"))
+	require.Equal(t, "s3cr3t", data["password"])
+}
+
+func TestSecretKeyProvider_LoadError(t *testing.T) {
+	ctx := context.Background()
+	mctrl := gomock.NewController(t)
+	client := secret.NewMockClient(mctrl)
+	client.EXPECT().Get(gomock.Any(), DefaultKeyStoreSecretName).Return(nil, errors.New("connection refused")).AnyTimes()
+
+	provider := NewSecretKeyProvider(client, "")
+
+	_, _, err := provider.GetCurrentKey(ctx)
+	require.ErrorIs(t, err, ErrKeyLoadFailed)
+
+	err = provider.Initialize(ctx)
+	require.ErrorIs(t, err, ErrKeyLoadFailed)
+}
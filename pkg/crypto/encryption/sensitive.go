@@ -160,6 +160,33 @@ func (h *SensitiveDataHandler) DecryptSensitiveFieldsWithSchema(ctx context.Cont
 	return nil
 }
 
+// RewrapSensitiveFields re-encrypts the sensitive fields in the data which were encrypted with a key version other
+// than the current version, so that retired keys can be removed without making the data unreadable. The values are
+// decrypted and encrypted again with the current key and the same associated data, without being interpreted.
+// The data is modified in place. Field paths support dot notation and [*] for arrays/maps.
+//
+// The resourceID must match what was provided during encryption. Returns true if any field was re-encrypted.
+// In case of error, partial re-encryption may have occurred.
+func (h *SensitiveDataHandler) RewrapSensitiveFields(ctx context.Context, data map[string]any, sensitiveFieldPaths []string, resourceID string) (bool, error) {
+	rewrapped := false
+	for _, path := range sensitiveFieldPaths {
+		ad := buildAssociatedData(resourceID, path)
+		processor := func(value any) (any, error) {
+			result, changed, err := h.rewrapValue(ctx, value, ad)
+			rewrapped = rewrapped || changed
+			return result, err
+		}
+
+		if err := h.processFieldAtPath(data, path, processor); err != nil {
+			if errors.Is(err, ErrFieldNotFound) {
+				continue
+			}
+			return rewrapped, fmt.Errorf("%w: path %q: %v", ErrFieldEncryptionFailed, path, err)
+		}
+	}
+	return rewrapped, nil
+}
+
 // getEncryptorForDecryption returns the appropriate encryptor for decrypting data.
 // If a keyProvider is available and the data contains a version, it fetches the versioned key.
 // Otherwise, it falls back to the default encryptor.
@@ -352,6 +379,11 @@ func (h *SensitiveDataHandler) encryptValue(value any, associatedData []byte) (a
 		return nil, nil
 	}
 
+	// Values which are already encrypted are not encrypted again, so that encryption is idempotent.
+	if IsEncryptedValue(value) {
+		return value, nil
+	}
+
 	var dataToEncrypt []byte
 	var err error
 
@@ -396,19 +428,11 @@ func (h *SensitiveDataHandler) decryptValue(ctx context.Context, value any, fiel
 	}
 
 	// Check if this looks like encrypted data
-	encMap, ok := value.(map[string]any)
-	if !ok {
-		// Not encrypted data, return as-is
-		return value, nil
-	}
-
-	_, hasEncrypted := encMap["encrypted"].(string)
-	_, hasNonce := encMap["nonce"].(string)
-
-	if !hasEncrypted || !hasNonce {
+	if !IsEncryptedValue(value) {
 		// Not our encrypted format, return as-is
 		return value, nil
 	}
+	encMap := value.(map[string]any)
 
 	// Convert back to JSON for decryption
 	encryptedJSON, err := json.Marshal(encMap)
@@ -452,6 +476,95 @@ func (h *SensitiveDataHandler) decryptValue(ctx context.Context, value any, fiel
 	return result, nil
 }
 
+// rewrapValue re-encrypts a single encrypted value with the current key if it was encrypted with another key version.
+// Returns the value unchanged if it is not encrypted or is already encrypted with the current key.
+func (h *SensitiveDataHandler) rewrapValue(ctx context.Context, value any, associatedData []byte) (any, bool, error) {
+	if !IsEncryptedValue(value) {
+		return value, false, nil
+	}
+
+	encryptedJSON, err := json.Marshal(value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	version, err := GetEncryptedDataVersion(encryptedJSON)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if version == h.encryptor.keyVersion {
+		return value, false, nil
+	}
+
+	encryptor, err := h.getEncryptorForDecryption(ctx, encryptedJSON)
+	if err != nil {
+		return nil, false, err
+	}
+
+	decrypted, err := encryptor.Decrypt(encryptedJSON, associatedData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encrypted, err := h.encryptor.Encrypt(decrypted, associatedData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(encrypted, &result); err != nil {
+		return nil, false, err
+	}
+
+	return result, true, nil
+}
+
+// IsEncryptedValue checks if the given value is a field value encrypted by SensitiveDataHandler.
+func IsEncryptedValue(value any) bool {
+	encMap, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	_, hasEncrypted := encMap["encrypted"].(string)
+	_, hasNonce := encMap["nonce"].(string)
+	return hasEncrypted && hasNonce
+}
+
+// RedactEncryptedValues returns a copy of the data in which every encrypted field value is replaced with nil.
+// The data itself is not modified. It does not require the sensitive field paths, so it can be applied to any
+// data read from storage before it is returned to the caller.
+func RedactEncryptedValues(data map[string]any) map[string]any {
+	if data == nil {
+		return nil
+	}
+	return redactValue(data).(map[string]any)
+}
+
+func redactValue(value any) any {
+	if IsEncryptedValue(value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = redactValue(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = redactValue(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
 // buildAssociatedData constructs the associated data for AEAD encryption from the resource ID and field path.
 // This binds the ciphertext to its context, preventing encrypted values from being moved between
 // different resources or fields.
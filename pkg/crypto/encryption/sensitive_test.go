@@ -18,6 +18,7 @@ package encryption
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -1003,6 +1004,134 @@ func TestSensitiveDataHandler_DecryptWithMissingKeyVersion(t *testing.T) {
 	require.Contains(t, err.Error(), "key version not found")
 }
 
+func TestSensitiveDataHandler_RewrapSensitiveFields(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	provider, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+
+	handler1, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	paths := []string{"password", "config", "tokens[*]"}
+	data := map[string]any{
+		"password": "old-secret",
+		"config":   map[string]any{"port": float64(5432)},
+		"tokens":   []any{"a", "b"},
+		"username": "admin",
+	}
+	err = handler1.EncryptSensitiveFields(data, paths, testResourceID)
+	require.NoError(t, err)
+
+	// Rotate to version 2
+	err = provider.SetCurrentVersion(2)
+	require.NoError(t, err)
+	handler2, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	rewrapped, err := handler2.RewrapSensitiveFields(ctx, data, paths, testResourceID)
+	require.NoError(t, err)
+	require.True(t, rewrapped)
+	require.Equal(t, "admin", data["username"])
+
+	for _, value := range []any{data["password"], data["config"], data["tokens"].([]any)[0], data["tokens"].([]any)[1]} {
+		encryptedJSON, err := json.Marshal(value)
+		require.NoError(t, err)
+		version, err := GetEncryptedDataVersion(encryptedJSON)
+		require.NoError(t, err)
+		require.Equal(t, 2, version)
+	}
+
+	// Rewrapping again is a no-op
+	rewrapped, err = handler2.RewrapSensitiveFields(ctx, data, paths, testResourceID)
+	require.NoError(t, err)
+	require.False(t, rewrapped)
+
+	// Version 1 can now be removed
+	provider2, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{2: key2}, 2)
+	require.NoError(t, err)
+	handler3, err := NewSensitiveDataHandlerFromProvider(ctx, provider2)
+	require.NoError(t, err)
+
+	err = handler3.DecryptSensitiveFields(ctx, data, paths, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, "old-secret", data["password"])
+	require.Equal(t, map[string]any{"port": float64(5432)}, data["config"])
+	require.Equal(t, []any{"a", "b"}, data["tokens"])
+}
+
+func TestSensitiveDataHandler_RewrapSensitiveFields_WrongResourceID(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	provider, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+	handler1, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	data := map[string]any{"password": "old-secret"}
+	err = handler1.EncryptSensitiveFields(data, []string{"password"}, testResourceID)
+	require.NoError(t, err)
+
+	err = provider.SetCurrentVersion(2)
+	require.NoError(t, err)
+	handler2, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	_, err = handler2.RewrapSensitiveFields(ctx, data, []string{"password"}, "/planes/radius/local/resourceGroups/other/providers/Foo.Bar/myResources/other")
+	require.ErrorIs(t, err, ErrFieldEncryptionFailed)
+}
+
+func TestRedactEncryptedValues(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	handler, err := NewSensitiveDataHandlerFromKey(key)
+	require.NoError(t, err)
+
+	data := map[string]any{
+		"host": "db.example.com",
+		"credentials": map[string]any{
+			"username": "admin",
+			"password": "s3cr3t",
+		},
+		"tokens": []any{"a", "b"},
+	}
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"credentials.password", "tokens[*]"}, testResourceID))
+	require.True(t, IsEncryptedValue(data["credentials"].(map[string]any)["password"]))
+
+	redacted := RedactEncryptedValues(data)
+	require.Equal(t, map[string]any{
+		"host": "db.example.com",
+		"credentials": map[string]any{
+			"username": "admin",
+			"password": nil,
+		},
+		"tokens": []any{nil, nil},
+	}, redacted)
+
+	// The original data is not modified.
+	require.True(t, IsEncryptedValue(data["credentials"].(map[string]any)["password"]))
+	require.True(t, IsEncryptedValue(data["tokens"].([]any)[0]))
+
+	// Encrypting the data again does not change the encrypted values.
+	encrypted := deepCopyMap(data)
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"credentials.password", "tokens[*]"}, testResourceID))
+	require.Equal(t, encrypted, data)
+
+	require.Nil(t, RedactEncryptedValues(nil))
+	require.False(t, IsEncryptedValue("plain"))
+	require.False(t, IsEncryptedValue(map[string]any{"encrypted": "abc"}))
+}
+
 // Helper function to deep copy a map for testing
 func deepCopyMap(original map[string]any) map[string]any {
 	result := make(map[string]any)
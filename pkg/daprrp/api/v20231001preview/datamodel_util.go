@@ -141,14 +141,19 @@ func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
 	}
+	recipe.Force = to.Bool(r.Force)
 	return recipe
 }
 
 func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
-	return &Recipe{
+	recipe := &Recipe{
 		Name:       to.Ptr(r.Name),
 		Parameters: r.Parameters,
 	}
+	if r.Force {
+		recipe.Force = to.Ptr(r.Force)
+	}
+	return recipe
 }
 
 func toMetadataDataModel(metadata map[string]*MetadataValue) map[string]*rpv1.DaprComponentMetadataValue {
@@ -287,6 +287,16 @@ func TestToRecipeDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			&Recipe{
+				Name:  to.Ptr("test"),
+				Force: to.Ptr(true),
+			},
+			portableresources.ResourceRecipe{
+				Name:  "test",
+				Force: true,
+			},
+		},
 	}
 	for _, testCase := range testset {
 		sc := toRecipeDataModel(testCase.versioned)
@@ -284,6 +284,75 @@ func (client *ConfigurationStoresClient) listByScopeHandleResponse(resp *http.Re
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified DaprConfigurationStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - configurationStoreName - The name of the DaprConfigurationStore portable resource
+//   - options - ConfigurationStoresClientBeginPlanOptions contains the optional parameters for the
+//     ConfigurationStoresClient.BeginPlan method.
+func (client *ConfigurationStoresClient) BeginPlan(ctx context.Context, configurationStoreName string, options *ConfigurationStoresClientBeginPlanOptions) (*runtime.Poller[ConfigurationStoresClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, configurationStoreName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ConfigurationStoresClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[ConfigurationStoresClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified DaprConfigurationStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ConfigurationStoresClient) plan(ctx context.Context, configurationStoreName string, options *ConfigurationStoresClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "ConfigurationStoresClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, configurationStoreName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *ConfigurationStoresClient) planCreateRequest(ctx context.Context, configurationStoreName string, _ *ConfigurationStoresClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/configurationStores/{configurationStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if configurationStoreName == "" {
+		return nil, errors.New("parameter configurationStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{configurationStoreName}", url.PathEscape(configurationStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprConfigurationStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -18,6 +18,13 @@ type ConfigurationStoresClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// ConfigurationStoresClientBeginPlanOptions contains the optional parameters for the ConfigurationStoresClient.BeginPlan
+// method.
+type ConfigurationStoresClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // ConfigurationStoresClientBeginUpdateOptions contains the optional parameters for the ConfigurationStoresClient.BeginUpdate
 // method.
 type ConfigurationStoresClientBeginUpdateOptions struct {
@@ -54,6 +61,12 @@ type PubSubBrokersClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// PubSubBrokersClientBeginPlanOptions contains the optional parameters for the PubSubBrokersClient.BeginPlan method.
+type PubSubBrokersClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // PubSubBrokersClientBeginUpdateOptions contains the optional parameters for the PubSubBrokersClient.BeginUpdate method.
 type PubSubBrokersClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -84,6 +97,12 @@ type SecretStoresClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// SecretStoresClientBeginPlanOptions contains the optional parameters for the SecretStoresClient.BeginPlan method.
+type SecretStoresClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // SecretStoresClientBeginUpdateOptions contains the optional parameters for the SecretStoresClient.BeginUpdate method.
 type SecretStoresClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -113,6 +132,12 @@ type StateStoresClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// StateStoresClientBeginPlanOptions contains the optional parameters for the StateStoresClient.BeginPlan method.
+type StateStoresClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // StateStoresClientBeginUpdateOptions contains the optional parameters for the StateStoresClient.BeginUpdate method.
 type StateStoresClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -284,6 +284,75 @@ func (client *PubSubBrokersClient) listByScopeHandleResponse(resp *http.Response
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified DaprPubSubBroker resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - pubSubBrokerName - The name of the DaprPubSubBroker portable resource
+//   - options - PubSubBrokersClientBeginPlanOptions contains the optional parameters for the
+//     PubSubBrokersClient.BeginPlan method.
+func (client *PubSubBrokersClient) BeginPlan(ctx context.Context, pubSubBrokerName string, options *PubSubBrokersClientBeginPlanOptions) (*runtime.Poller[PubSubBrokersClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, pubSubBrokerName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[PubSubBrokersClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[PubSubBrokersClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified DaprPubSubBroker resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *PubSubBrokersClient) plan(ctx context.Context, pubSubBrokerName string, options *PubSubBrokersClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "PubSubBrokersClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, pubSubBrokerName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *PubSubBrokersClient) planCreateRequest(ctx context.Context, pubSubBrokerName string, _ *PubSubBrokersClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/pubSubBrokers/{pubSubBrokerName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if pubSubBrokerName == "" {
+		return nil, errors.New("parameter pubSubBrokerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{pubSubBrokerName}", url.PathEscape(pubSubBrokerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprPubSubBrokerResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -27,6 +27,12 @@ type ConfigurationStoresClientListByScopeResponse struct {
 	DaprConfigurationStoreResourceListResult
 }
 
+// ConfigurationStoresClientPlanResponse contains the response from method ConfigurationStoresClient.BeginPlan.
+type ConfigurationStoresClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // ConfigurationStoresClientUpdateResponse contains the response from method ConfigurationStoresClient.BeginUpdate.
 type ConfigurationStoresClientUpdateResponse struct {
 	// Dapr configuration store portable resource
@@ -62,6 +68,12 @@ type PubSubBrokersClientListByScopeResponse struct {
 	DaprPubSubBrokerResourceListResult
 }
 
+// PubSubBrokersClientPlanResponse contains the response from method PubSubBrokersClient.BeginPlan.
+type PubSubBrokersClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // PubSubBrokersClientUpdateResponse contains the response from method PubSubBrokersClient.BeginUpdate.
 type PubSubBrokersClientUpdateResponse struct {
 	// Dapr PubSubBroker portable resource
@@ -91,6 +103,12 @@ type SecretStoresClientListByScopeResponse struct {
 	DaprSecretStoreResourceListResult
 }
 
+// SecretStoresClientPlanResponse contains the response from method SecretStoresClient.BeginPlan.
+type SecretStoresClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // SecretStoresClientUpdateResponse contains the response from method SecretStoresClient.BeginUpdate.
 type SecretStoresClientUpdateResponse struct {
 	// Dapr SecretStore portable resource
@@ -120,6 +138,12 @@ type StateStoresClientListByScopeResponse struct {
 	DaprStateStoreResourceListResult
 }
 
+// StateStoresClientPlanResponse contains the response from method StateStoresClient.BeginPlan.
+type StateStoresClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // StateStoresClientUpdateResponse contains the response from method StateStoresClient.BeginUpdate.
 type StateStoresClientUpdateResponse struct {
 	// Dapr StateStore portable resource
@@ -284,6 +284,75 @@ func (client *SecretStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified DaprSecretStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - The name of the DaprSecretStore portable resource
+//   - options - SecretStoresClientBeginPlanOptions contains the optional parameters for the
+//     SecretStoresClient.BeginPlan method.
+func (client *SecretStoresClient) BeginPlan(ctx context.Context, secretStoreName string, options *SecretStoresClientBeginPlanOptions) (*runtime.Poller[SecretStoresClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, secretStoreName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[SecretStoresClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[SecretStoresClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified DaprSecretStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *SecretStoresClient) plan(ctx context.Context, secretStoreName string, options *SecretStoresClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "SecretStoresClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, secretStoreName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *SecretStoresClient) planCreateRequest(ctx context.Context, secretStoreName string, _ *SecretStoresClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/secretStores/{secretStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprSecretStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
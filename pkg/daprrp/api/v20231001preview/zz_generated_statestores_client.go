@@ -283,6 +283,75 @@ func (client *StateStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified DaprStateStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - stateStoreName - The name of the DaprStateStore portable resource
+//   - options - StateStoresClientBeginPlanOptions contains the optional parameters for the StateStoresClient.BeginPlan
+//     method.
+func (client *StateStoresClient) BeginPlan(ctx context.Context, stateStoreName string, options *StateStoresClientBeginPlanOptions) (*runtime.Poller[StateStoresClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, stateStoreName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[StateStoresClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[StateStoresClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified DaprStateStore resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *StateStoresClient) plan(ctx context.Context, stateStoreName string, options *StateStoresClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "StateStoresClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, stateStoreName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *StateStoresClient) planCreateRequest(ctx context.Context, stateStoreName string, _ *StateStoresClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/stateStores/{stateStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if stateStoreName == "" {
+		return nil, errors.New("parameter stateStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{stateStoreName}", url.PathEscape(stateStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprStateStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -22,6 +22,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -32,6 +33,7 @@ import (
 	secretstore_proc "github.com/radius-project/radius/pkg/daprrp/processors/secretstores"
 	statestore_proc "github.com/radius-project/radius/pkg/daprrp/processors/statestores"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -57,6 +59,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.DaprPubSubBroker]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
@@ -67,6 +70,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.DaprPubSubBroker]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -74,6 +78,17 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
+		},
+		Custom: map[string]builder.Operation[datamodel.DaprPubSubBroker]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.DaprPubSubBroker](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -90,6 +105,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.DaprStateStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
@@ -100,6 +116,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.DaprStateStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -107,6 +124,17 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
+		},
+		Custom: map[string]builder.Operation[datamodel.DaprStateStore]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.DaprStateStore](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -123,6 +151,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.DaprSecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
@@ -133,6 +162,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.DaprSecretStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -140,6 +170,17 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
+		},
+		Custom: map[string]builder.Operation[datamodel.DaprSecretStore]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.DaprSecretStore](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -156,6 +197,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.DaprConfigurationStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprConfigurationStore]{
@@ -166,6 +208,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.DaprConfigurationStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -173,6 +216,17 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
+		},
+		Custom: map[string]builder.Operation[datamodel.DaprConfigurationStore]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.DaprConfigurationStore](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
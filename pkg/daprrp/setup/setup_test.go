@@ -60,6 +60,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/pubsubbroker",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/pubsubbroker/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.dapr/statestores",
@@ -84,6 +88,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/statestore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/statestore/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.dapr/secretstores",
@@ -108,6 +116,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/secretstore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/secretstore/plan",
+		Method:        http.MethodPost,
 	},
 	{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprConfigurationStoresResourceType, Method: v1.OperationPlaneScopeList},
@@ -133,6 +145,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprConfigurationStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/configurationstores/configstore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprConfigurationStoresResourceType, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/configurationstores/configstore/plan",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -313,10 +313,46 @@ type Recipe struct {
 	// REQUIRED; The name of the recipe within the environment to use
 	Name *string
 
+	// Execute the recipe even if the recipe, its parameters and the resource have not changed since the previous deployment
+	Force *bool
+
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
 }
 
+// RecipePlanResult - The changes that deploying the recipe of a resource would make.
+type RecipePlanResult struct {
+	// REQUIRED; The changes that would be made to the resources deployed by the recipe if the recipe was deployed.
+	Changes []*RecipeResourceChange
+
+	// REQUIRED; The changes made to the resources deployed by the recipe outside of the recipe since it was last deployed.
+	Drift []*RecipeResourceChange
+}
+
+// RecipeResourceChange - A change to a resource deployed by a recipe.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that will be taken on the resource, for example create, update, replace, delete, read or no-op.
+	Action *string
+
+	// REQUIRED; The address of the resource within the recipe.
+	Address *string
+
+	// The properties of the resource after the change. Sensitive values are redacted.
+	After map[string]any
+
+	// The properties of the resource before the change. Sensitive values are redacted.
+	Before map[string]any
+
+	// The top-level properties whose values differ between before and after.
+	ChangedProperties []*string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	Type *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
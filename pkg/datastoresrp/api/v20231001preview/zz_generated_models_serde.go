@@ -716,6 +716,7 @@ func (o *OutputResource) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type Recipe.
 func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "force", r.Force)
 	populate(objectMap, "name", r.Name)
 	populate(objectMap, "parameters", r.Parameters)
 	return json.Marshal(objectMap)
@@ -730,6 +731,9 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "force":
+			err = unpopulate(val, "Force", &r.Force)
+			delete(rawMsg, key)
 		case "name":
 			err = unpopulate(val, "Name", &r.Name)
 			delete(rawMsg, key)
@@ -744,6 +748,88 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResult.
+func (r RecipePlanResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", r.Changes)
+	populate(objectMap, "drift", r.Drift)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResult.
+func (r *RecipePlanResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+			err = unpopulate(val, "Changes", &r.Changes)
+			delete(rawMsg, key)
+		case "drift":
+			err = unpopulate(val, "Drift", &r.Drift)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "action", r.Action)
+	populate(objectMap, "address", r.Address)
+	populate(objectMap, "after", r.After)
+	populate(objectMap, "before", r.Before)
+	populate(objectMap, "changedProperties", r.ChangedProperties)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "action":
+			err = unpopulate(val, "Action", &r.Action)
+			delete(rawMsg, key)
+		case "address":
+			err = unpopulate(val, "Address", &r.Address)
+			delete(rawMsg, key)
+		case "after":
+			err = unpopulate(val, "After", &r.After)
+			delete(rawMsg, key)
+		case "before":
+			err = unpopulate(val, "Before", &r.Before)
+			delete(rawMsg, key)
+		case "changedProperties":
+			err = unpopulate(val, "ChangedProperties", &r.ChangedProperties)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "type":
+			err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
 func (r RecipeStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -343,6 +343,75 @@ func (client *MongoDatabasesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified MongoDatabase resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - mongoDatabaseName - The name of the MongoDatabase portable resource
+//   - options - MongoDatabasesClientBeginPlanOptions contains the optional parameters for the
+//     MongoDatabasesClient.BeginPlan method.
+func (client *MongoDatabasesClient) BeginPlan(ctx context.Context, mongoDatabaseName string, options *MongoDatabasesClientBeginPlanOptions) (*runtime.Poller[MongoDatabasesClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, mongoDatabaseName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[MongoDatabasesClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[MongoDatabasesClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified MongoDatabase resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *MongoDatabasesClient) plan(ctx context.Context, mongoDatabaseName string, options *MongoDatabasesClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "MongoDatabasesClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, mongoDatabaseName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *MongoDatabasesClient) planCreateRequest(ctx context.Context, mongoDatabaseName string, _ *MongoDatabasesClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/mongoDatabases/{mongoDatabaseName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if mongoDatabaseName == "" {
+		return nil, errors.New("parameter mongoDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{mongoDatabaseName}", url.PathEscape(mongoDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a MongoDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
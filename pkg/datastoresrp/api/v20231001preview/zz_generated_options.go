@@ -17,6 +17,12 @@ type MongoDatabasesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// MongoDatabasesClientBeginPlanOptions contains the optional parameters for the MongoDatabasesClient.BeginPlan method.
+type MongoDatabasesClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // MongoDatabasesClientBeginUpdateOptions contains the optional parameters for the MongoDatabasesClient.BeginUpdate method.
 type MongoDatabasesClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -57,6 +63,12 @@ type RedisCachesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// RedisCachesClientBeginPlanOptions contains the optional parameters for the RedisCachesClient.BeginPlan method.
+type RedisCachesClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // RedisCachesClientBeginUpdateOptions contains the optional parameters for the RedisCachesClient.BeginUpdate method.
 type RedisCachesClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -91,6 +103,12 @@ type SQLDatabasesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// SQLDatabasesClientBeginPlanOptions contains the optional parameters for the SQLDatabasesClient.BeginPlan method.
+type SQLDatabasesClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // SQLDatabasesClientBeginUpdateOptions contains the optional parameters for the SQLDatabasesClient.BeginUpdate method.
 type SQLDatabasesClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
@@ -341,6 +341,75 @@ func (client *RedisCachesClient) listSecretsHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified RedisCache resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - redisCacheName - The name of the RedisCache portable resource
+//   - options - RedisCachesClientBeginPlanOptions contains the optional parameters for the RedisCachesClient.BeginPlan
+//     method.
+func (client *RedisCachesClient) BeginPlan(ctx context.Context, redisCacheName string, options *RedisCachesClientBeginPlanOptions) (*runtime.Poller[RedisCachesClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, redisCacheName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[RedisCachesClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[RedisCachesClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified RedisCache resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *RedisCachesClient) plan(ctx context.Context, redisCacheName string, options *RedisCachesClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "RedisCachesClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, redisCacheName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *RedisCachesClient) planCreateRequest(ctx context.Context, redisCacheName string, _ *RedisCachesClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/redisCaches/{redisCacheName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if redisCacheName == "" {
+		return nil, errors.New("parameter redisCacheName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{redisCacheName}", url.PathEscape(redisCacheName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a RedisCacheResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -33,6 +33,12 @@ type MongoDatabasesClientListSecretsResponse struct {
 	MongoDatabaseListSecretsResult
 }
 
+// MongoDatabasesClientPlanResponse contains the response from method MongoDatabasesClient.BeginPlan.
+type MongoDatabasesClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // MongoDatabasesClientUpdateResponse contains the response from method MongoDatabasesClient.BeginUpdate.
 type MongoDatabasesClientUpdateResponse struct {
 	// MongoDatabase portable resource
@@ -74,6 +80,12 @@ type RedisCachesClientListSecretsResponse struct {
 	RedisCacheListSecretsResult
 }
 
+// RedisCachesClientPlanResponse contains the response from method RedisCachesClient.BeginPlan.
+type RedisCachesClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // RedisCachesClientUpdateResponse contains the response from method RedisCachesClient.BeginUpdate.
 type RedisCachesClientUpdateResponse struct {
 	// RedisCache portable resource
@@ -109,6 +121,12 @@ type SQLDatabasesClientListSecretsResponse struct {
 	SQLDatabaseListSecretsResult
 }
 
+// SQLDatabasesClientPlanResponse contains the response from method SQLDatabasesClient.BeginPlan.
+type SQLDatabasesClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // SQLDatabasesClientUpdateResponse contains the response from method SQLDatabasesClient.BeginUpdate.
 type SQLDatabasesClientUpdateResponse struct {
 	// SqlDatabase portable resource
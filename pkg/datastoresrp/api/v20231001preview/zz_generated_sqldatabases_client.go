@@ -343,6 +343,75 @@ func (client *SQLDatabasesClient) listSecretsHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified SqlDatabase resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - sqlDatabaseName - The name of the SqlDatabase portable resource
+//   - options - SQLDatabasesClientBeginPlanOptions contains the optional parameters for the
+//     SQLDatabasesClient.BeginPlan method.
+func (client *SQLDatabasesClient) BeginPlan(ctx context.Context, sqlDatabaseName string, options *SQLDatabasesClientBeginPlanOptions) (*runtime.Poller[SQLDatabasesClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, sqlDatabaseName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[SQLDatabasesClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[SQLDatabasesClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified SqlDatabase resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *SQLDatabasesClient) plan(ctx context.Context, sqlDatabaseName string, options *SQLDatabasesClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "SQLDatabasesClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, sqlDatabaseName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *SQLDatabasesClient) planCreateRequest(ctx context.Context, sqlDatabaseName string, _ *SQLDatabasesClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/sqlDatabases/{sqlDatabaseName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if sqlDatabaseName == "" {
+		return nil, errors.New("parameter sqlDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{sqlDatabaseName}", url.PathEscape(sqlDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a SqlDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
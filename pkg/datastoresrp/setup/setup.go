@@ -22,6 +22,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -34,6 +35,7 @@ import (
 	rds_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
 	sql_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -59,6 +61,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
@@ -69,6 +72,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.RedisCache]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -76,11 +80,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Custom: map[string]builder.Operation[datamodel.RedisCache]{
 			"listsecrets": {
 				APIController: rds_ctrl.NewListSecretsRedisCache,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.RedisCache](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.RedisCache, datamodel.RedisCache](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -97,6 +110,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
@@ -107,6 +121,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.MongoDatabase]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -114,11 +129,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Custom: map[string]builder.Operation[datamodel.MongoDatabase]{
 			"listsecrets": {
 				APIController: mongo_ctrl.NewListSecretsMongoDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.MongoDatabase](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -135,6 +159,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
@@ -145,6 +170,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.SqlDatabase]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -152,11 +178,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Custom: map[string]builder.Operation[datamodel.SqlDatabase]{
 			"listsecrets": {
 				APIController: sql_ctrl.NewListSecretsSqlDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.SqlDatabase](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -84,6 +84,13 @@ func (d *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
 		return fmt.Errorf("failed to unmarshal properties: %w", err)
 	}
 
+	// The cached recipe output is only used internally to skip unchanged recipe executions.
+	if status, ok := properties["status"].(map[string]any); ok {
+		if recipeStatus, ok := status["recipe"].(map[string]any); ok {
+			delete(recipeStatus, "cachedOutput")
+		}
+	}
+
 	d.ID = &dm.ID
 	d.Name = &dm.Name
 	d.Type = &dm.Type
@@ -100,6 +100,29 @@ func Test_DynamicResource_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "dynamicresource-recipe-datamodel.json",
+			expected: &DynamicResource{
+				ID:       to.Ptr("/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/testResource"),
+				Name:     to.Ptr("testResource"),
+				Type:     to.Ptr("Applications.Test/testResources"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: map[string]any{
+					"provisioningState": fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					"message":           "Hello, world!",
+					"status": map[string]any{
+						"recipe": map[string]any{
+							"templateKind": "bicep",
+							"templatePath": "ghcr.io/radius-project/recipes/test:latest",
+							"fingerprint":  "abc123",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -25,6 +25,9 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	pr_backend_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/schema"
@@ -107,6 +110,9 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipePutController(options, c.engine, c.configurationLoader)
 
+	case pr_frontend_ctrl.PlanOperationMethod:
+		return pr_backend_ctrl.NewPlanResource[*dynamicdatamodel.DynamicResource](options, c.engine)
+
 	default:
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
@@ -28,6 +28,9 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	pr_backend_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
@@ -104,6 +107,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipeDeleteController{}, selected)
 	})
 
+	t.Run("recipe plan", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: pr_frontend_ctrl.PlanOperationMethod}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &pr_backend_ctrl.PlanResource[*dynamicdatamodel.DynamicResource, dynamicdatamodel.DynamicResource]{}, selected)
+	})
+
 	t.Run("unknown operation", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
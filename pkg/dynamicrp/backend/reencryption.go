@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultReencryptionInterval is the default interval between runs of the re-encryption job.
+	DefaultReencryptionInterval = time.Hour
+)
+
+// ReencryptionService periodically re-encrypts the sensitive fields of dynamic resources which were encrypted with
+// a retired key, so that the key can be removed from the key store after it is rotated.
+type ReencryptionService struct {
+	options *dynamicrp.Options
+}
+
+// NewReencryptionService creates a new service to run the re-encryption job of the dynamic-rp.
+func NewReencryptionService(options *dynamicrp.Options) *ReencryptionService {
+	return &ReencryptionService{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (s *ReencryptionService) Name() string {
+	return "dynamic-rp re-encryption job"
+}
+
+// Run runs the re-encryption job at the configured interval until the context is cancelled.
+func (s *ReencryptionService) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval := DefaultReencryptionInterval
+	if s.options.Config.Encryption.ReencryptionIntervalSeconds > 0 {
+		interval = time.Duration(s.options.Config.Encryption.ReencryptionIntervalSeconds) * time.Second
+	}
+
+	databaseClient, err := s.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	reencrypter := sensitive.NewReencrypter(databaseClient, ucp, s.options.KeyProvider)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			updated, err := reencrypter.Run(ctx)
+			if err != nil {
+				logger.Error(err, "Failed to re-encrypt sensitive fields", "updated", updated)
+			} else if updated > 0 {
+				logger.Info("Re-encrypted sensitive fields", "updated", updated)
+			}
+		}
+	}
+}
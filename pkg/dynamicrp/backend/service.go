@@ -24,6 +24,7 @@ import (
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -60,6 +61,9 @@ func (w *Service) Run(ctx context.Context) error {
 	if w.options.Config.Worker.MaxOperationRetryCount != nil {
 		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
 	}
+	if w.options.Config.Worker.MaxScheduledMessages != nil {
+		w.Service.Options.MaxScheduledMessages = *w.options.Config.Worker.MaxScheduledMessages
+	}
 
 	e, err := w.options.RecipeEngine()
 	if err != nil {
@@ -91,15 +95,17 @@ func (w *Service) Run(ctx context.Context) error {
 }
 
 func (w *Service) registerControllers() error {
-	options := ctrl.Options{
-		DatabaseClient: w.Service.DatabaseClient,
-	}
-
 	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(w.options.UCP))
 	if err != nil {
 		return err
 	}
 
+	// The controllers operate on the plaintext values of sensitive fields. They are decrypted when resources are
+	// read and encrypted again when resources are saved.
+	options := ctrl.Options{
+		DatabaseClient: sensitive.NewDatabaseClient(w.Service.DatabaseClient, ucp, w.options.KeyProvider),
+	}
+
 	return w.Service.Controllers().RegisterDefault(func(opts ctrl.Options) (ctrl.Controller, error) {
 		return controller.NewDynamicResourceController(opts, ucp, w.recipes, w.options.Recipes.ConfigurationLoader)
 	}, options)
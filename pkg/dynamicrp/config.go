@@ -20,6 +20,7 @@ import (
 	"bytes"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
@@ -27,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"gopkg.in/yaml.v3"
@@ -36,12 +38,18 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Audit is the configuration for the audit log of mutating requests.
+	Audit audit.Options `yaml:"audit,omitempty"`
+
 	// Bicep configures properties for the Bicep recipe driver.
 	Bicep hostoptions.BicepOptions `yaml:"bicep"`
 
 	// Database is the configuration for the database.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
+	// Encryption is the configuration for the encryption of sensitive fields of dynamic resources.
+	Encryption EncryptionOptions `yaml:"encryption,omitempty"`
+
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
@@ -60,6 +68,9 @@ type Config struct {
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
+	// RecipeDrivers configures out-of-process recipe drivers in addition to the built-in Bicep and Terraform drivers.
+	RecipeDrivers []hostoptions.RecipeDriverOptions `yaml:"recipeDrivers,omitempty"`
+
 	// Secrets is the configuration for the secret storage system.
 	Secrets secretprovider.SecretProviderOptions `yaml:"secretProvider"`
 
@@ -79,6 +90,16 @@ type Config struct {
 	Worker hostoptions.WorkerServerOptions `yaml:"workerServer"`
 }
 
+// EncryptionOptions defines the configuration for the encryption of sensitive fields of dynamic resources.
+type EncryptionOptions struct {
+	// KeyProvider configures the source of the encryption keys.
+	KeyProvider encryption.KeyProviderOptions `yaml:"keyProvider,omitempty"`
+
+	// ReencryptionIntervalSeconds is the interval between runs of the job that re-encrypts the sensitive fields encrypted
+	// with a retired key. Defaults to 3600 seconds. The job is disabled if the value is negative.
+	ReencryptionIntervalSeconds int `yaml:"reencryptionIntervalSeconds,omitempty"`
+}
+
 // LoadConfig loads a Config from bytes.
 func LoadConfig(bs []byte) (*Config, error) {
 	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
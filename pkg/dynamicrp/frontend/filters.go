@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// preserveRecipeStatus copies the recipe status of the existing resource to the updated resource. The status is not
+// part of the request body, and the recipe status is needed to skip the recipe execution when its inputs have not changed.
+func preserveRecipeStatus(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+	if oldResource == nil {
+		return nil, nil
+	}
+
+	if recipeStatus, ok := oldResource.Status()["recipe"]; ok {
+		newResource.Status()["recipe"] = recipeStatus
+	}
+
+	return nil, nil
+}
+
+// encryptSensitiveFields returns a filter that encrypts the fields marked as sensitive in the schema of the resource type,
+// so that their values are never persisted in plaintext.
+func encryptSensitiveFields(ucp *v20231001preview.ClientFactory, keyProvider encryption.KeyProvider) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		fields, err := sensitive.GetFields(ctx, ucp, newResource.ID, newResource.InternalMetadata.UpdatedAPIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, sensitive.Encrypt(ctx, keyProvider, fields, newResource)
+	}
+}
+
+// validateResourceSchema returns a filter that validates the properties of the resource against the schema of its
+// resource type. Resource types without a schema are not validated.
+func validateResourceSchema(ucp *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		schemaData, err := processor.GetSchemaForResourceType(ctx, ucp, newResource.ID, newResource.InternalMetadata.UpdatedAPIVersion)
+		if errors.Is(err, processor.ErrNoSchemaFound) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		resourceData := map[string]any{
+			"id":         newResource.ID,
+			"properties": newResource.Properties,
+		}
+		if err := schema.ValidateResourceAgainstSchema(ctx, resourceData, schemaData); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Schema validation failed: %v", err)), nil
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_preserveRecipeStatus(t *testing.T) {
+	recipeStatus := map[string]any{
+		"templateKind": "bicep",
+		"templatePath": "ghcr.io/radius-project/recipes/test:latest",
+		"fingerprint":  "abc123",
+	}
+
+	tests := []struct {
+		desc        string
+		oldResource *datamodel.DynamicResource
+		expected    map[string]any
+	}{
+		{
+			desc:        "new resource",
+			oldResource: nil,
+			expected: map[string]any{
+				"message": "hello",
+			},
+		},
+		{
+			desc: "existing resource without recipe status",
+			oldResource: &datamodel.DynamicResource{
+				Properties: map[string]any{
+					"status": map[string]any{
+						"outputResources": []any{},
+					},
+				},
+			},
+			expected: map[string]any{
+				"message": "hello",
+			},
+		},
+		{
+			desc: "existing resource with recipe status",
+			oldResource: &datamodel.DynamicResource{
+				Properties: map[string]any{
+					"status": map[string]any{
+						"outputResources": []any{},
+						"recipe":          recipeStatus,
+					},
+				},
+			},
+			expected: map[string]any{
+				"message": "hello",
+				"status": map[string]any{
+					"recipe": recipeStatus,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			newResource := &datamodel.DynamicResource{
+				Properties: map[string]any{
+					"message": "hello",
+				},
+			}
+
+			resp, err := preserveRecipeStatus(testcontext.New(t), newResource, tc.oldResource, nil)
+			require.NoError(t, err)
+			require.Nil(t, resp)
+			require.Equal(t, tc.expected, newResource.Properties)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// ListSecretsActionName is the name of the custom action that returns the decrypted sensitive fields of a dynamic resource.
+	ListSecretsActionName = "listSecrets"
+)
+
+// ListSecrets is the controller implementation of the listSecrets action for dynamic resources. The sensitive fields
+// of dynamic resources are encrypted at rest and redacted from read operations, so this action is the only way to
+// retrieve their values.
+//
+// The database client of the controller must decrypt the sensitive fields. See sensitive.NewDatabaseClient.
+type ListSecrets struct {
+	controller.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucp *v20231001preview.ClientFactory
+}
+
+// NewListSecrets creates a new instance of the ListSecrets controller.
+func NewListSecrets(opts controller.Options, ucp *v20231001preview.ClientFactory) (controller.Controller, error) {
+	return &ListSecrets{
+		Operation: controller.NewOperation[*datamodel.DynamicResource](opts, controller.ResourceOptions[datamodel.DynamicResource]{}),
+		ucp:       ucp,
+	}, nil
+}
+
+// Run returns the top-level properties of the resource that contain sensitive fields, with the sensitive values decrypted.
+// It returns an empty object if the resource type does not define any sensitive fields.
+func (c *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for listSecrets has name of the operation as suffix which should be removed to get the resource id.
+	parsedResourceID := serviceCtx.ResourceID.Truncate()
+	resource, _, err := c.GetResource(ctx, parsedResourceID)
+	if err != nil {
+		if errors.Is(&database.ErrNotFound{ID: parsedResourceID.String()}, err) {
+			return rest.NewNotFoundResponse(parsedResourceID), nil
+		}
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(parsedResourceID), nil
+	}
+
+	fields, err := sensitive.GetFields(ctx, c.ucp, resource.ID, resource.InternalMetadata.UpdatedAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]any{}
+	if fields != nil {
+		for _, path := range fields.Paths {
+			name, _, _ := strings.Cut(path, ".")
+			name, _, _ = strings.Cut(name, "[")
+			if value, ok := resource.Properties[name]; ok {
+				secrets[name] = value
+			}
+		}
+	}
+
+	return rest.NewOKResponse(secrets), nil
+}
@@ -47,7 +47,7 @@ import (
 // This code ensures that the controller will be provided with the correct resource type.
 func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := resources.Parse(r.URL.Path)
+		id, err := resources.ParseByMethod(r.URL.Path, r.Method)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
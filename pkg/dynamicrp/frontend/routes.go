@@ -24,8 +24,14 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
@@ -47,6 +53,43 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 		pathBase = pathBase + "/"
 	}
 
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	// Sensitive fields are encrypted before they are saved. The actions that need their plaintext values read the
+	// resource through a database client that decrypts them.
+	makePutResourceController := func(opts controller.Options) (controller.Controller, error) {
+		copy := dynamicResourceOptions
+		copy.UpdateFilters = []controller.UpdateFilter[datamodel.DynamicResource]{
+			preserveRecipeStatus,
+			encryptSensitiveFields(ucp, s.options.KeyProvider),
+		}
+		return defaultoperation.NewDefaultAsyncPut(opts, copy)
+	}
+
+	// The what-if action runs the same filters as the PUT operation, and additionally validates the resource against
+	// the schema of its resource type. For PUT this validation happens in the backend.
+	makeWhatIfResourceController := func(opts controller.Options) (controller.Controller, error) {
+		copy := dynamicResourceOptions
+		copy.UpdateFilters = []controller.UpdateFilter[datamodel.DynamicResource]{
+			preserveRecipeStatus,
+			validateResourceSchema(ucp),
+			encryptSensitiveFields(ucp, s.options.KeyProvider),
+		}
+		return defaultoperation.NewWhatIfResource(opts, copy)
+	}
+
+	makeListSecretsController := func(opts controller.Options) (controller.Controller, error) {
+		opts.DatabaseClient = sensitive.NewDatabaseClient(opts.DatabaseClient, ucp, s.options.KeyProvider)
+		return NewListSecrets(opts, ucp)
+	}
+
+	makePlanResourceController := func(opts controller.Options) (controller.Controller, error) {
+		return pr_frontend_ctrl.NewPlanResource[*datamodel.DynamicResource](opts)
+	}
+
 	r.Route(pathBase+"planes/radius/{planeName}", func(r chi.Router) {
 
 		// Plane-scoped
@@ -58,7 +101,9 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 			// Async operation status/results
 			r.Route("/locations/{locationName}", func(r chi.Router) {
 				r.Get("/{or:operation[Rr]esults}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationResultController))
+				r.Get("/{os:operation[Ss]tatuses}", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListOperationStatusesController))
 				r.Get("/{os:operation[Ss]tatuses}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationStatusController))
+				r.Post("/{os:operation[Ss]tatuses}/{operationID}/"+defaultoperation.CancelOperationActionName, dynamicOperationHandler(v1.OperationPost, controllerOptions, makeCancelOperationController))
 			})
 		})
 
@@ -68,6 +113,9 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetResourceController))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, makePutResourceController))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions, makeDeleteResourceController))
+			r.Post("/{resourceName}/"+pr_frontend_ctrl.PlanActionName, dynamicOperationHandler(pr_frontend_ctrl.PlanOperationMethod, controllerOptions, makePlanResourceController))
+			r.Post("/{resourceName}/"+v1.WhatIfActionName, dynamicOperationHandler(v1.OperationPost, controllerOptions, makeWhatIfResourceController))
+			r.Post("/{resourceName}/"+ListSecretsActionName, dynamicOperationHandler(v1.OperationPost, controllerOptions, makeListSecretsController))
 		})
 	})
 
@@ -76,9 +124,18 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 
 var dynamicResourceOptions = controller.ResourceOptions[datamodel.DynamicResource]{
 	RequestConverter:         converter.DynamicResourceDataModelFromVersioned,
-	ResponseConverter:        converter.DynamicResourceDataModelToVersioned,
+	ResponseConverter:        toVersionedRedacted,
 	AsyncOperationRetryAfter: time.Second * 5,
 	AsyncOperationTimeout:    time.Hour * 24,
+
+	// Operations on dynamic resources may execute a recipe, so they should not delay other operations.
+	AsyncOperationPriority: queue.PriorityLow,
+}
+
+// toVersionedRedacted converts the resource to its versioned model with the encrypted values of sensitive fields
+// redacted. The values of sensitive fields are only returned by the listSecrets action.
+func toVersionedRedacted(model *datamodel.DynamicResource, version string) (v1.VersionedModelInterface, error) {
+	return converter.DynamicResourceDataModelToVersioned(sensitive.Redact(model), version)
 }
 
 func makeListResourceAtPlaneScopeController(opts controller.Options) (controller.Controller, error) {
@@ -96,10 +153,6 @@ func makeGetResourceController(opts controller.Options) (controller.Controller,
 	return defaultoperation.NewGetResource(opts, dynamicResourceOptions)
 }
 
-func makePutResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewDefaultAsyncPut(opts, dynamicResourceOptions)
-}
-
 func makeDeleteResourceController(opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewDefaultAsyncDelete(opts, dynamicResourceOptions)
 }
@@ -111,3 +164,11 @@ func makeGetOperationResultController(opts controller.Options) (controller.Contr
 func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
+
+func makeListOperationStatusesController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewListOperationStatuses(opts)
+}
+
+func makeCancelOperationController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewCancelOperation(opts)
+}
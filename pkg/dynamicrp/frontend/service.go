@@ -76,6 +76,12 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+	if s.options.AuditSink != nil {
+		app = middleware.Audit(s.options.AuditSink, middleware.AuditOptions{
+			Service:        "dynamic-rp",
+			IncludeChanges: s.options.Config.Audit.IncludeChanges,
+		})(app)
+	}
 
 	// Autodetect pathbase
 	app = servicecontext.ARMRequestCtx("", s.options.Config.Environment.RoleLocation)(app)
@@ -23,12 +23,16 @@ import (
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -195,6 +199,175 @@ func Test_Dynamic_Resource_Inert_Schema_Validation_Failure(t *testing.T) {
 	require.Contains(t, errorMap["message"].(string), "Schema validation failed", "Expected schema validation error message")
 }
 
+// Test_Dynamic_Resource_Inert_WhatIf tests that the whatIf action validates the resource against the schema of its
+// resource type and reports the changes of a PUT operation without persisting the resource.
+func Test_Dynamic_Resource_Inert_WhatIf(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+	createAPIVersion(ucp, inertResourceTypeName, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"requiredField": map[string]any{
+				"type": "string",
+			},
+		},
+		"required": []string{"requiredField"},
+	})
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	whatIfURL := testInertResourceID + "/" + v1.WhatIfActionName + "?api-version=" + apiVersion
+	resource := func(value string) map[string]any {
+		return map[string]any{
+			"properties": map[string]any{
+				"requiredField": value,
+			},
+		}
+	}
+
+	// A resource that violates the schema is rejected.
+	response := ucp.MakeTypedRequest(http.MethodPost, whatIfURL, map[string]any{"properties": map[string]any{"foo": "bar"}})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+
+	// A new resource is reported as created, and is not persisted.
+	response = ucp.MakeTypedRequest(http.MethodPost, whatIfURL, resource("a"))
+	response.EqualsStatusCode(http.StatusOK)
+	change := v1.WhatIfResourceChange{}
+	response.ReadAs(&change)
+	require.Equal(t, v1.WhatIfChangeTypeCreate, change.ChangeType)
+	require.Equal(t, "a", change.After["properties"].(map[string]any)["requiredField"])
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource("a"))
+	response.WaitForOperationComplete(nil)
+
+	// The same resource is reported as unchanged.
+	response = ucp.MakeTypedRequest(http.MethodPost, whatIfURL, resource("a"))
+	response.EqualsStatusCode(http.StatusOK)
+	change = v1.WhatIfResourceChange{}
+	response.ReadAs(&change)
+	require.Equal(t, v1.WhatIfChangeTypeNoChange, change.ChangeType)
+
+	// A modified resource is reported with its property changes.
+	response = ucp.MakeTypedRequest(http.MethodPost, whatIfURL, resource("b"))
+	response.EqualsStatusCode(http.StatusOK)
+	change = v1.WhatIfResourceChange{}
+	response.ReadAs(&change)
+	require.Equal(t, v1.WhatIfChangeTypeModify, change.ChangeType)
+	require.Equal(t, []v1.WhatIfPropertyChange{
+		{Path: "properties.requiredField", ChangeType: v1.WhatIfChangeTypeModify, Before: "a", After: "b"},
+	}, change.Delta)
+
+	// The existing resource is not modified.
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsStatusCode(http.StatusOK)
+	existing := map[string]any{}
+	response.ReadAs(&existing)
+	require.Equal(t, "a", existing["properties"].(map[string]any)["requiredField"])
+}
+
+// Test_Dynamic_Resource_Inert_Sensitive_Fields tests that the fields marked as sensitive in the schema are encrypted
+// in the database, redacted from read operations, returned in plaintext by the listSecrets action, and re-encrypted
+// after the key is rotated.
+func Test_Dynamic_Resource_Inert_Sensitive_Fields(t *testing.T) {
+	host, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"username": map[string]any{
+				"type": "string",
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-sensitive": true,
+			},
+		},
+		"required": []string{"username", "password"},
+	}
+
+	createAPIVersion(ucp, inertResourceTypeName, schema)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"username": "admin",
+			"password": "v3ryS3cr3t",
+		},
+	}
+
+	// Schema validation in the backend operates on the decrypted values, so the operation succeeds.
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	// The password is never stored in plaintext.
+	databaseClient, err := host.Options().DatabaseProvider.GetClient(context.Background())
+	require.NoError(t, err)
+	obj, err := databaseClient.Get(context.Background(), testInertResourceID)
+	require.NoError(t, err)
+	stored := obj.Data.(map[string]any)["properties"].(map[string]any)
+	require.Equal(t, "admin", stored["username"])
+	require.True(t, encryption.IsEncryptedValue(stored["password"]))
+
+	// GET redacts the password.
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"username":          "admin",
+			"password":          nil,
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(200, expectedResource)
+
+	// listSecrets returns the plaintext password.
+	response = ucp.MakeRequest(http.MethodPost, testInertResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsValue(200, map[string]any{"password": "v3ryS3cr3t"})
+
+	// Rotate the key and re-encrypt the password with the new key.
+	keyProvider := host.Options().KeyProvider.(*encryption.SecretKeyProvider)
+	version, err := keyProvider.Rotate(context.Background())
+	require.NoError(t, err)
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(host.Options().UCP))
+	require.NoError(t, err)
+	updated, err := sensitive.NewReencrypter(databaseClient, ucpClient, keyProvider).Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, updated)
+
+	obj, err = databaseClient.Get(context.Background(), testInertResourceID)
+	require.NoError(t, err)
+	encryptedJSON, err := json.Marshal(obj.Data.(map[string]any)["properties"].(map[string]any)["password"])
+	require.NoError(t, err)
+	encryptedVersion, err := encryption.GetEncryptedDataVersion(encryptedJSON)
+	require.NoError(t, err)
+	require.Equal(t, version, encryptedVersion)
+
+	response = ucp.MakeRequest(http.MethodPost, testInertResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsValue(200, map[string]any{"password": "v3ryS3cr3t"})
+
+	response = ucp.MakeRequest(http.MethodDelete, testInertResourceURL, nil)
+	response.WaitForOperationComplete(nil)
+
+	response = ucp.MakeRequest(http.MethodPost, testInertResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsErrorCode(404, v1.CodeNotFound)
+}
+
 func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockDriver := driver.NewMockDriver(ctrl)
@@ -266,7 +439,7 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 				TemplateVersion: "test-version",
 			},
 		}, nil).
-		Times(1)
+		Times(2)
 
 	mockDriver.EXPECT().
 		Delete(gomock.Any(), gomock.Any()).
@@ -289,6 +462,18 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	response := ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, resource)
 	response.WaitForOperationComplete(nil)
 
+	// The fingerprint of the recipe inputs is stored with the recipe status.
+	response = ucp.MakeRequest(http.MethodGet, testRecipeResourceURL, nil)
+	created := map[string]any{}
+	response.ReadAs(&created)
+	fingerprint := created["properties"].(map[string]any)["status"].(map[string]any)["recipe"].(map[string]any)["fingerprint"]
+	require.NotEmpty(t, fingerprint)
+
+	// The recipe produces secrets, so its output is not cached. Updating the resource without changes executes the
+	// recipe again to read the secrets, and keeps the fingerprint.
+	response = ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
 	// Now lets verify the resource was created successfully.
 	expectedResource := map[string]any{
 		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleRecipeResources/my-recipe-example",
@@ -322,6 +507,7 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 					"templateKind":    "test",
 					"templatePath":    "test-path",
 					"templateVersion": "test-version",
+					"fingerprint":     fingerprint,
 				},
 			},
 		},
@@ -25,15 +25,19 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/external"
+	"github.com/radius-project/radius/pkg/recipes/driver/helm"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
@@ -47,12 +51,18 @@ import (
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// AuditSink records mutating requests. It is nil when audit logging is disabled.
+	AuditSink audit.Sink
+
 	// Config is the configuration for the server.
 	Config *Config
 
 	// DatabaseProvider provides access to the database.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// KeyProvider provides the encryption keys used to encrypt sensitive fields of dynamic resources at rest.
+	KeyProvider encryption.KeyProvider
+
 	// KubernetesProvider provides access to the Kubernetes clients.
 	KubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
 
@@ -77,7 +87,7 @@ type RecipeOptions struct {
 	// ConfigurationLoader is the loader for recipe configurations.
 	ConfigurationLoader configloader.ConfigurationLoader
 
-	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers are used (Bicep, Terraform) will
+	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers are used (Bicep, Terraform, Helm) will
 	// be used.
 	Drivers map[string]func(options *Options) (driver.Driver, error)
 
@@ -108,11 +118,21 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 
 	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
 
+	options.AuditSink, err = audit.NewSink(ctx, config.Audit, databaseClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit sink: %w", err)
+	}
+
 	options.KubernetesProvider, err = kubernetesclientprovider.FromOptions(config.Kubernetes)
 	if err != nil {
 		return nil, err
 	}
 
+	options.KeyProvider, err = newKeyProvider(ctx, config.Encryption.KeyProvider, &options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize encryption keys: %w", err)
+	}
+
 	options.UCP, err = ucpconfig.NewConnectionFromUCPConfig(&config.UCP, options.KubernetesProvider.Config())
 	if err != nil {
 		return nil, err
@@ -129,6 +149,40 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 	return &options, nil
 }
 
+// newKeyProvider creates the provider of the encryption keys configured by the options.
+func newKeyProvider(ctx context.Context, config encryption.KeyProviderOptions, options *Options) (encryption.KeyProvider, error) {
+	switch config.Provider {
+	case "", encryption.KeyProviderTypeSecret:
+		secretClient, err := options.SecretProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The key store is created on first startup. The keys can be rotated afterwards without a restart.
+		keyProvider := encryption.NewSecretKeyProvider(secretClient, "")
+		err = keyProvider.Initialize(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return keyProvider, nil
+
+	case encryption.KeyProviderTypeKubernetes:
+		client, err := options.KubernetesProvider.RuntimeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewKubernetesKeyProvider(client, config.Kubernetes), nil
+
+	case encryption.KeyProviderTypeFile:
+		return encryption.NewFileKeyProvider(config.File), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported encryption key provider %q", config.Provider)
+	}
+}
+
 // RecipeEngine creates a new recipe engine from the options.
 func (o *Options) RecipeEngine() (engine.Engine, error) {
 	var errs error
@@ -139,6 +193,7 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		o.Recipes.Drivers = map[string]func(options *Options) (driver.Driver, error){
 			recipes.TemplateKindBicep:     bicepDriver,
 			recipes.TemplateKindTerraform: terraformDriver,
+			recipes.TemplateKindHelm:      helmDriver,
 		}
 	}
 
@@ -152,6 +207,12 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		drivers[name] = driver
 	}
 
+	// Out-of-process drivers are registered alongside the configured drivers and cannot replace them.
+	err := external.RegisterDrivers(drivers, o.Config.RecipeDrivers)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if errs != nil {
 		return nil, fmt.Errorf("failed to create recipe drivers: %w", errs)
 	}
@@ -209,7 +270,12 @@ func terraformDriver(options *Options) (driver.Driver, error) {
 		options.UCP,
 		options.SecretProvider,
 		terraform.TerraformOptions{
-			Path:     options.Config.Terraform.Path,
-			LogLevel: options.Config.Terraform.LogLevel,
+			Path:           options.Config.Terraform.Path,
+			LogLevel:       options.Config.Terraform.LogLevel,
+			LocalStatePath: options.Config.Terraform.LocalStatePath,
 		}, *options.KubernetesProvider), nil
 }
+
+func helmDriver(options *Options) (driver.Driver, error) {
+	return helm.NewHelmDriver(options.KubernetesProvider), nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicrp
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Options_RecipeEngine(t *testing.T) {
+	newOptions := func(t *testing.T, configs ...hostoptions.RecipeDriverOptions) *Options {
+		mctrl := gomock.NewController(t)
+		return &Options{
+			Config: &Config{RecipeDrivers: configs},
+			Recipes: RecipeOptions{
+				Drivers: map[string]func(options *Options) (driver.Driver, error){
+					recipes.TemplateKindBicep: func(options *Options) (driver.Driver, error) {
+						return driver.NewMockDriver(mctrl), nil
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("external driver", func(t *testing.T) {
+		options := newOptions(t, hostoptions.RecipeDriverOptions{Kind: "dynamicrp-test", Socket: "/var/run/radius/dynamicrp-test.sock"})
+
+		engine, err := options.RecipeEngine()
+		require.NoError(t, err)
+		require.NotNil(t, engine)
+		require.True(t, recipes.IsSupportedTemplateKind("dynamicrp-test"))
+	})
+
+	t.Run("external driver replaces configured driver", func(t *testing.T) {
+		options := newOptions(t, hostoptions.RecipeDriverOptions{Kind: recipes.TemplateKindBicep, Socket: "/var/run/radius/bicep.sock"})
+
+		_, err := options.RecipeEngine()
+		require.ErrorContains(t, err, `a recipe driver is already registered for kind "bicep"`)
+	})
+
+	t.Run("invalid external driver", func(t *testing.T) {
+		options := newOptions(t, hostoptions.RecipeDriverOptions{Kind: "dynamicrp-invalid"})
+
+		_, err := options.RecipeEngine()
+		require.ErrorContains(t, err, `recipe driver "dynamicrp-invalid" must specify a socket`)
+		require.False(t, recipes.IsSupportedTemplateKind("dynamicrp-invalid"))
+	})
+}
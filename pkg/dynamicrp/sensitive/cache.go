@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// DefaultFieldsCacheTTL is the default time the sensitive fields of a resource type are cached.
+const DefaultFieldsCacheTTL = time.Minute
+
+// fieldsCache caches the sensitive fields of resource types per resource type and API version, so that the schema
+// is not fetched from UCP each time a resource is read or written.
+//
+// Changes to the schema of a resource type are only observed after the entry expires. Failed lookups are not cached.
+//
+// fieldsCache is safe for concurrent use.
+type fieldsCache struct {
+	ttl time.Duration
+
+	// fetch returns the sensitive fields of the resource type of the resource at the given API version.
+	fetch func(ctx context.Context, resourceID string, apiVersion string) (*Fields, error)
+
+	// now is the clock used for expiration. Can be overridden for testing.
+	now func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]fieldsCacheEntry
+}
+
+type fieldsCacheEntry struct {
+	fields  *Fields
+	expires time.Time
+}
+
+// newFieldsCache creates a fieldsCache which keeps the fields returned by fetch for the given TTL.
+func newFieldsCache(ttl time.Duration, fetch func(context.Context, string, string) (*Fields, error)) *fieldsCache {
+	return &fieldsCache{
+		ttl:     ttl,
+		fetch:   fetch,
+		now:     time.Now,
+		entries: map[string]fieldsCacheEntry{},
+	}
+}
+
+// Get returns the sensitive fields of the resource type of the given resource at the given API version.
+func (c *fieldsCache) Get(ctx context.Context, resourceID string, apiVersion string) (*Fields, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil || !id.IsUCPQualified() {
+		return c.fetch(ctx, resourceID, apiVersion)
+	}
+
+	// Resource types are registered per plane, so the plane is part of the key.
+	key := strings.ToLower(id.PlaneNamespace() + "|" + id.Type() + "|" + apiVersion)
+
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	c.mutex.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		return entry.fields, nil
+	}
+
+	fields, err := c.fetch(ctx, resourceID, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[key] = fieldsCacheEntry{fields: fields, expires: c.now().Add(c.ttl)}
+
+	return fields, nil
+}
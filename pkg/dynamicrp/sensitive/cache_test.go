@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FieldsCache(t *testing.T) {
+	fields := &Fields{Paths: []string{"credentials.password"}}
+	calls := map[string]int{}
+	var fetchErr error
+	fetch := func(ctx context.Context, resourceID string, apiVersion string) (*Fields, error) {
+		calls[apiVersion]++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return fields, nil
+	}
+
+	now := time.Now()
+	cache := newFieldsCache(time.Minute, fetch)
+	cache.now = func() time.Time { return now }
+	ctx := testcontext.New(t)
+
+	t.Run("fetches and caches per API version", func(t *testing.T) {
+		for range 2 {
+			actual, err := cache.Get(ctx, testResourceID, "2025-01-01-preview")
+			require.NoError(t, err)
+			require.Same(t, fields, actual)
+		}
+
+		// Another resource of the same type is served from the cache.
+		_, err := cache.Get(ctx, "/planes/radius/local/resourceGroups/other/providers/Applications.Test/testResources/other", "2025-01-01-preview")
+		require.NoError(t, err)
+		require.Equal(t, 1, calls["2025-01-01-preview"])
+
+		_, err = cache.Get(ctx, testResourceID, "2025-02-01-preview")
+		require.NoError(t, err)
+		require.Equal(t, 1, calls["2025-02-01-preview"])
+	})
+
+	t.Run("fetches again after the entry expires", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		_, err := cache.Get(ctx, testResourceID, "2025-01-01-preview")
+		require.NoError(t, err)
+		require.Equal(t, 2, calls["2025-01-01-preview"])
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		fetchErr = errors.New("unavailable")
+
+		_, err := cache.Get(ctx, testResourceID, "2025-03-01-preview")
+		require.ErrorIs(t, err, fetchErr)
+
+		fetchErr = nil
+		_, err = cache.Get(ctx, testResourceID, "2025-03-01-preview")
+		require.NoError(t, err)
+		require.Equal(t, 2, calls["2025-03-01-preview"])
+	})
+}
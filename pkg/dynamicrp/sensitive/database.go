@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+var _ database.Client = (*databaseClient)(nil)
+
+// databaseClient is a database.Client that decrypts the sensitive fields of dynamic resources when they are read
+// and encrypts them when they are written. Objects that are not dynamic resources, such as operation statuses,
+// are passed through unchanged.
+type databaseClient struct {
+	database.Client
+	keyProvider encryption.KeyProvider
+
+	// fields caches the sensitive fields of the resource types fetched from UCP.
+	fields *fieldsCache
+}
+
+// NewDatabaseClient creates a database.Client that wraps the given client and transparently decrypts and encrypts
+// the sensitive fields of dynamic resources. It is used by the components that need to operate on the plaintext
+// values, such as the backend controllers.
+func NewDatabaseClient(inner database.Client, ucp *v20231001preview.ClientFactory, keyProvider encryption.KeyProvider) database.Client {
+	fetch := func(ctx context.Context, resourceID string, apiVersion string) (*Fields, error) {
+		return GetFields(ctx, ucp, resourceID, apiVersion)
+	}
+	return &databaseClient{Client: inner, keyProvider: keyProvider, fields: newFieldsCache(DefaultFieldsCacheTTL, fetch)}
+}
+
+// Get retrieves the object and decrypts its sensitive fields.
+func (c *databaseClient) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	obj, err := c.Client.Get(ctx, id, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := obj.Data.(map[string]any)
+	if !ok {
+		return obj, nil
+	}
+
+	err = c.transform(ctx, data, Decrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Save encrypts the sensitive fields of a copy of the object and persists it. The object passed by the caller is not
+// modified except for its ETag.
+func (c *databaseClient) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	encrypted, err := c.encrypt(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	err = c.Client.Save(ctx, encrypted, options...)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = encrypted.ETag
+	return nil
+}
+
+// Transact encrypts the sensitive fields of copies of the saved objects and applies the operations.
+func (c *databaseClient) Transact(ctx context.Context, operations ...database.Operation) error {
+	encrypted := make([]database.Operation, len(operations))
+	for i, operation := range operations {
+		encrypted[i] = operation
+		if operation.Type != database.OperationTypeSave || operation.Object == nil {
+			continue
+		}
+
+		obj, err := c.encrypt(ctx, operation.Object)
+		if err != nil {
+			return err
+		}
+		encrypted[i].Object = obj
+	}
+
+	err := c.Client.Transact(ctx, encrypted...)
+	if err != nil {
+		return err
+	}
+
+	for i, operation := range operations {
+		if operation.Object != nil && encrypted[i].Object != operation.Object {
+			operation.Object.ETag = encrypted[i].Object.ETag
+		}
+	}
+
+	return nil
+}
+
+func (c *databaseClient) encrypt(ctx context.Context, obj *database.Object) (*database.Object, error) {
+	copied, err := obj.DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := copied.Data.(map[string]any)
+	if !ok {
+		return obj, nil
+	}
+
+	err = c.transform(ctx, data, Encrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
+// transform applies fn to the properties of the dynamic resource stored in data. Data which does not represent
+// a dynamic resource is left unchanged.
+func (c *databaseClient) transform(ctx context.Context, data map[string]any, fn func(context.Context, encryption.KeyProvider, *Fields, *datamodel.DynamicResource) error) error {
+	id, _ := data["id"].(string)
+	apiVersion, _ := data["updatedApiVersion"].(string)
+	properties, _ := data["properties"].(map[string]any)
+	if id == "" || apiVersion == "" || properties == nil {
+		return nil
+	}
+
+	fields, err := c.fields.Get(ctx, id, apiVersion)
+	if err != nil {
+		return err
+	}
+
+	resource := &datamodel.DynamicResource{Properties: properties}
+	resource.ID = id
+	err = fn(ctx, c.keyProvider, fields, resource)
+	if err != nil {
+		return err
+	}
+
+	data["properties"] = resource.Properties
+	return nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Reencrypter re-encrypts the sensitive fields of dynamic resources which were encrypted with a key version other
+// than the current version. Keys are removed from the key store some time after they are rotated, so the values
+// encrypted with them must be re-encrypted before that happens to remain readable.
+type Reencrypter struct {
+	databaseClient database.Client
+	ucp            *v20231001preview.ClientFactory
+	keyProvider    encryption.KeyProvider
+}
+
+// NewReencrypter creates a new Reencrypter. The database client must not decrypt sensitive fields.
+func NewReencrypter(databaseClient database.Client, ucp *v20231001preview.ClientFactory, keyProvider encryption.KeyProvider) *Reencrypter {
+	return &Reencrypter{
+		databaseClient: databaseClient,
+		ucp:            ucp,
+		keyProvider:    keyProvider,
+	}
+}
+
+// Run walks the dynamic resources of every resource type with sensitive fields in every Radius plane, and re-encrypts
+// the values encrypted with a retired key using the current key. Returns the number of resources that were updated.
+//
+// Failures to process a single resource do not stop the walk. They are returned together after all resources have
+// been processed.
+func (r *Reencrypter) Run(ctx context.Context) (int, error) {
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, r.keyProvider)
+	if err != nil {
+		return 0, err
+	}
+
+	planes := r.ucp.NewRadiusPlanesClient().NewListPager(nil)
+	updated := 0
+	var errs error
+	for planes.More() {
+		page, err := planes.NextPage(ctx)
+		if err != nil {
+			return updated, fmt.Errorf("failed to list planes: %w", err)
+		}
+
+		for _, plane := range page.Value {
+			count, err := r.reencryptPlane(ctx, handler, to.String(plane.Name))
+			updated += count
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return updated, errs
+}
+
+func (r *Reencrypter) reencryptPlane(ctx context.Context, handler *encryption.SensitiveDataHandler, planeName string) (int, error) {
+	providers := r.ucp.NewResourceProvidersClient().NewListProviderSummariesPager(planeName, nil)
+	updated := 0
+	var errs error
+	for providers.More() {
+		page, err := providers.NextPage(ctx)
+		if err != nil {
+			return updated, fmt.Errorf("failed to list resource providers of plane %q: %w", planeName, err)
+		}
+
+		for _, provider := range page.Value {
+			for resourceTypeName, resourceType := range provider.ResourceTypes {
+				// The sensitive fields of a resource depend on the api-version that was used to update it.
+				fields := map[string]*Fields{}
+				for apiVersion, summary := range resourceType.APIVersions {
+					if summary == nil {
+						continue
+					}
+
+					if f := fieldsFromSchema(summary.Schema); f != nil {
+						fields[apiVersion] = f
+					}
+				}
+
+				if len(fields) == 0 {
+					continue
+				}
+
+				query := database.Query{
+					RootScope:      "/planes/radius/" + planeName,
+					ScopeRecursive: true,
+					ResourceType:   to.String(provider.Name) + resources.SegmentSeparator + resourceTypeName,
+				}
+				count, err := r.reencryptResources(ctx, handler, query, fields)
+				updated += count
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+
+	return updated, errs
+}
+
+func (r *Reencrypter) reencryptResources(ctx context.Context, handler *encryption.SensitiveDataHandler, query database.Query, fields map[string]*Fields) (int, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	updated := 0
+	var errs error
+	paginationToken := ""
+	for {
+		result, err := r.databaseClient.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return updated, fmt.Errorf("failed to query resources of type %q: %w", query.ResourceType, err)
+		}
+
+		for _, obj := range result.Items {
+			rewrapped, err := r.reencryptObject(ctx, handler, &obj, fields)
+			if errors.Is(err, &database.ErrConcurrency{}) {
+				// The resource was updated concurrently. Updates encrypt the sensitive fields with the current key.
+				logger.V(ucplog.LevelDebug).Info("Skipping re-encryption of a resource updated concurrently", "resourceID", obj.ID)
+				continue
+			} else if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to re-encrypt %q: %w", obj.ID, err))
+				continue
+			}
+
+			if rewrapped {
+				updated++
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return updated, errs
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+func (r *Reencrypter) reencryptObject(ctx context.Context, handler *encryption.SensitiveDataHandler, obj *database.Object, fields map[string]*Fields) (bool, error) {
+	data, ok := obj.Data.(map[string]any)
+	if !ok {
+		return false, nil
+	}
+
+	// The sensitive fields are bound to the resource ID stored with the resource when they are encrypted.
+	id, _ := data["id"].(string)
+	apiVersion, _ := data["updatedApiVersion"].(string)
+	properties, _ := data["properties"].(map[string]any)
+	f := fields[apiVersion]
+	if id == "" || f == nil || properties == nil {
+		return false, nil
+	}
+
+	rewrapped, err := handler.RewrapSensitiveFields(ctx, properties, f.Paths, id)
+	if err != nil || !rewrapped {
+		return false, err
+	}
+
+	err = r.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sensitive encrypts and decrypts the fields of dynamic resources marked with the x-radius-sensitive
+// annotation in the schema of their resource type.
+//
+// Sensitive fields are encrypted before the resource is persisted in the database. The encrypted values are
+// redacted from the responses of read operations, and decrypted only for the listSecrets action and for the
+// processing of the resource in the backend.
+package sensitive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Fields describes the sensitive fields of a resource type.
+type Fields struct {
+	// Paths are the paths of the sensitive fields relative to the resource properties.
+	Paths []string
+
+	// Schema is the schema of the resource type. It is used to restore the types of decrypted values.
+	Schema map[string]any
+}
+
+// GetFields fetches the schema of the resource type of the given resource from UCP and returns its sensitive
+// fields. Returns nil if the resource type has no schema or no sensitive fields.
+func GetFields(ctx context.Context, ucp *v20231001preview.ClientFactory, resourceID string, apiVersion string) (*Fields, error) {
+	if ucp == nil {
+		return nil, nil
+	}
+
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	planeName := id.ScopeSegments()[0].Name
+	resourceTypeName := strings.TrimPrefix(id.Type(), id.ProviderNamespace()+resources.SegmentSeparator)
+
+	response, err := ucp.NewAPIVersionsClient().Get(ctx, planeName, id.ProviderNamespace(), resourceTypeName, apiVersion, nil)
+	if clientv2.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch the schema of %q: %w", id.Type(), err)
+	}
+
+	if response.Properties == nil {
+		return nil, nil
+	}
+
+	return fieldsFromSchema(response.Properties.Schema), nil
+}
+
+// fieldsFromSchema returns the sensitive fields defined by the schema, or nil if there are none.
+func fieldsFromSchema(s map[string]any) *Fields {
+	if s == nil {
+		return nil
+	}
+
+	paths := schema.ExtractSensitiveFieldPaths(s, "")
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return &Fields{Paths: paths, Schema: s}
+}
+
+// Encrypt encrypts the sensitive fields of the resource in place using the current key of the key provider.
+// The ciphertext is bound to the resource ID so that it cannot be copied to another resource.
+func Encrypt(ctx context.Context, keyProvider encryption.KeyProvider, fields *Fields, resource *datamodel.DynamicResource) error {
+	if fields == nil || resource.Properties == nil {
+		return nil
+	}
+
+	if keyProvider == nil {
+		return errors.New("an encryption key provider is required to process sensitive fields")
+	}
+
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, keyProvider)
+	if err != nil {
+		return err
+	}
+
+	return handler.EncryptSensitiveFields(resource.Properties, fields.Paths, resource.ID)
+}
+
+// Decrypt decrypts the sensitive fields of the resource in place. Values which are not encrypted are left as-is.
+func Decrypt(ctx context.Context, keyProvider encryption.KeyProvider, fields *Fields, resource *datamodel.DynamicResource) error {
+	if fields == nil || resource.Properties == nil {
+		return nil
+	}
+
+	if keyProvider == nil {
+		return errors.New("an encryption key provider is required to process sensitive fields")
+	}
+
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, keyProvider)
+	if err != nil {
+		return err
+	}
+
+	return handler.DecryptSensitiveFieldsWithSchema(ctx, resource.Properties, fields.Paths, resource.ID, fields.Schema)
+}
+
+// Redact returns a copy of the resource in which the values of encrypted fields are replaced with nil.
+func Redact(resource *datamodel.DynamicResource) *datamodel.DynamicResource {
+	redacted := *resource
+	redacted.Properties = encryption.RedactEncryptedValues(resource.Properties)
+	return &redacted
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/test"
+
+func newTestResource() *datamodel.DynamicResource {
+	return &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: testResourceID},
+		},
+		Properties: map[string]any{
+			"username": "admin",
+			"credentials": map[string]any{
+				"password": "v3ryS3cr3t",
+			},
+		},
+	}
+}
+
+func Test_EncryptDecrypt(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+	keyProvider, err := encryption.NewInMemoryKeyProvider(key)
+	require.NoError(t, err)
+
+	fields := &Fields{
+		Paths: []string{"credentials.password"},
+		Schema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"credentials": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+					},
+				},
+			},
+		},
+	}
+
+	resource := newTestResource()
+	err = Encrypt(ctx, keyProvider, fields, resource)
+	require.NoError(t, err)
+	require.Equal(t, "admin", resource.Properties["username"])
+	require.True(t, encryption.IsEncryptedValue(resource.Properties["credentials"].(map[string]any)["password"]))
+
+	redacted := Redact(resource)
+	require.Equal(t, map[string]any{"username": "admin", "credentials": map[string]any{"password": nil}}, redacted.Properties)
+	require.True(t, encryption.IsEncryptedValue(resource.Properties["credentials"].(map[string]any)["password"]), "Redact must not modify the resource")
+
+	err = Decrypt(ctx, keyProvider, fields, resource)
+	require.NoError(t, err)
+	require.Equal(t, newTestResource().Properties, resource.Properties)
+}
+
+func Test_Encrypt_NoFields(t *testing.T) {
+	resource := newTestResource()
+	err := Encrypt(testcontext.New(t), nil, nil, resource)
+	require.NoError(t, err)
+	require.Equal(t, newTestResource().Properties, resource.Properties)
+}
+
+func Test_Encrypt_NoKeyProvider(t *testing.T) {
+	err := Encrypt(testcontext.New(t), nil, &Fields{Paths: []string{"credentials.password"}}, newTestResource())
+	require.Error(t, err)
+}
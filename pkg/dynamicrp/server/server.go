@@ -48,6 +48,11 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Sensitive fields encrypted with a retired key are re-encrypted periodically unless the job is disabled.
+	if options.Config.Encryption.ReencryptionIntervalSeconds >= 0 {
+		services = append(services, backend.NewReencryptionService(options))
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
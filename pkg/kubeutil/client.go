@@ -27,6 +27,8 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	csidriver "sigs.k8s.io/secrets-store-csi-driver/apis/v1alpha1"
 
 	// Import kubernetes auth plugins
@@ -90,6 +92,8 @@ func NewRuntimeClient(config *rest.Config) (runtimeclient.Client, error) {
 	utilruntime.Must(csidriver.AddToScheme(scheme))
 	utilruntime.Must(apiextv1.AddToScheme(scheme))
 	utilruntime.Must(contourv1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1beta1.Install(scheme))
 
 	return runtimeclient.New(config, runtimeclient.Options{Scheme: scheme})
 }
@@ -22,6 +22,12 @@ type RabbitMQQueuesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// RabbitMQQueuesClientBeginPlanOptions contains the optional parameters for the RabbitMQQueuesClient.BeginPlan method.
+type RabbitMQQueuesClientBeginPlanOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // RabbitMQQueuesClientBeginUpdateOptions contains the optional parameters for the RabbitMQQueuesClient.BeginUpdate method.
 type RabbitMQQueuesClientBeginUpdateOptions struct {
 	// Resumes the long-running operation from the provided token.
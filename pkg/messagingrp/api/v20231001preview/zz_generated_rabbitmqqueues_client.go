@@ -343,6 +343,75 @@ func (client *RabbitMQQueuesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+// BeginPlan - Computes the changes that deploying the recipe of the specified RabbitMQQueue resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - rabbitMQQueueName - The name of the RabbitMQQueue portable resource
+//   - options - RabbitMQQueuesClientBeginPlanOptions contains the optional parameters for the
+//     RabbitMQQueuesClient.BeginPlan method.
+func (client *RabbitMQQueuesClient) BeginPlan(ctx context.Context, rabbitMQQueueName string, options *RabbitMQQueuesClientBeginPlanOptions) (*runtime.Poller[RabbitMQQueuesClientPlanResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.plan(ctx, rabbitMQQueueName, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[RabbitMQQueuesClientPlanResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[RabbitMQQueuesClientPlanResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Plan - Computes the changes that deploying the recipe of the specified RabbitMQQueue resource would make
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *RabbitMQQueuesClient) plan(ctx context.Context, rabbitMQQueueName string, options *RabbitMQQueuesClientBeginPlanOptions) (*http.Response, error) {
+	var err error
+	const operationName = "RabbitMQQueuesClient.BeginPlan"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planCreateRequest(ctx, rabbitMQQueueName, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// planCreateRequest creates the Plan request.
+func (client *RabbitMQQueuesClient) planCreateRequest(ctx context.Context, rabbitMQQueueName string, _ *RabbitMQQueuesClientBeginPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Messaging/rabbitMQQueues/{rabbitMQQueueName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if rabbitMQQueueName == "" {
+		return nil, errors.New("parameter rabbitMQQueueName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rabbitMQQueueName}", url.PathEscape(rabbitMQQueueName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // BeginUpdate - Update a RabbitMQQueueResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
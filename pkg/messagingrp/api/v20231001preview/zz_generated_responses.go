@@ -39,6 +39,12 @@ type RabbitMQQueuesClientListSecretsResponse struct {
 	RabbitMQListSecretsResult
 }
 
+// RabbitMQQueuesClientPlanResponse contains the response from method RabbitMQQueuesClient.BeginPlan.
+type RabbitMQQueuesClientPlanResponse struct {
+	// The changes that deploying the recipe of a resource would make.
+	RecipePlanResult
+}
+
 // RabbitMQQueuesClientUpdateResponse contains the response from method RabbitMQQueuesClient.BeginUpdate.
 type RabbitMQQueuesClientUpdateResponse struct {
 	// RabbitMQQueue portable resource
@@ -19,6 +19,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -27,6 +28,7 @@ import (
 	rmq_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller/rabbitmqqueues"
 	rmq_proc "github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -52,6 +54,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Patch: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
@@ -62,6 +65,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Delete: builder.Operation[datamodel.RabbitMQQueue]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -69,11 +73,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncDeleteRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncOperationPriority:   queue.PriorityLow,
 		},
 		Custom: map[string]builder.Operation[datamodel.RabbitMQQueue]{
 			"listsecrets": {
 				APIController: rmq_ctrl.NewListSecretsRabbitMQQueue,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.RabbitMQQueue](opt)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
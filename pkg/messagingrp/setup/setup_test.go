@@ -62,6 +62,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: msg_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/plan",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// maxAuditBodySize is the maximum size of a request or response body captured to compute the changes of a request.
+// Changes are not recorded for requests with a larger body.
+const maxAuditBodySize = 4 * 1024 * 1024
+
+// AuditOptions configures the Audit middleware.
+type AuditOptions struct {
+	// Service is the name of the service recorded in each audit record, for example 'ucp'.
+	Service string
+
+	// IncludeChanges records the redacted difference between the resource before and after each request.
+	IncludeChanges bool
+}
+
+// Audit returns a middleware that writes an audit record to sink for every PUT, PATCH, DELETE and POST request.
+// ARMRequestCtx middleware and the authentication middleware must run before this middleware. To record requests
+// rejected by authorization, run the authorization middleware after this middleware.
+func Audit(sink audit.Sink, options AuditOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isMutatingMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			rpcContext := v1.ARMRequestContextFromContext(ctx)
+			record := &audit.Record{
+				ID:              newAuditRecordID(),
+				Timestamp:       time.Now().UTC(),
+				Service:         options.Service,
+				Method:          r.Method,
+				Operation:       authorization.Action(r.Method, r.URL.Path, rpcContext.ResourceID),
+				ResourceID:      rpcContext.ResourceID.String(),
+				CorrelationID:   rpcContext.CorrelationID,
+				ClientRequestID: rpcContext.ClientRequestID,
+			}
+			if record.ResourceID == "" {
+				record.ResourceID = r.URL.Path
+			}
+			if rpcContext.Principal != nil {
+				record.Principal = rpcContext.Principal.Name
+			}
+
+			includeChanges := options.IncludeChanges && r.Method != http.MethodPost
+			var before, requestBody []byte
+			if includeChanges {
+				before, includeChanges = readCurrentState(next, r)
+			}
+			if includeChanges && r.Method != http.MethodDelete && r.Body != nil {
+				// Read one byte more than the limit to detect larger bodies. The handler always receives the
+				// complete body.
+				requestBody, _ = io.ReadAll(io.LimitReader(r.Body, maxAuditBodySize+1))
+				r.Body = &replayBody{Reader: io.MultiReader(bytes.NewReader(requestBody), r.Body), Closer: r.Body}
+				if len(requestBody) > maxAuditBodySize {
+					includeChanges = false
+					requestBody = nil
+				}
+			}
+
+			aw := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK, capture: includeChanges}
+			next.ServeHTTP(aw, r)
+
+			record.StatusCode = aw.statusCode
+			record.Outcome = audit.OutcomeFromStatusCode(aw.statusCode)
+
+			if includeChanges && !aw.truncated && record.Outcome == audit.OutcomeSucceeded {
+				var after []byte
+				if r.Method != http.MethodDelete {
+					// The response contains the resource after the change. Fall back to the request when the
+					// response does not have a body, for example for async operations.
+					after = aw.body.Bytes()
+					if aw.body.Len() == 0 {
+						after = requestBody
+					}
+				}
+				record.Changes = audit.Changes(before, after)
+			}
+
+			if err := sink.Write(ctx, record); err != nil {
+				ucplog.FromContextOrDiscard(ctx).Error(err, "failed to write audit record", "resourceId", record.ResourceID)
+			}
+		})
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+func newAuditRecordID() string {
+	// Version 7 UUIDs are ordered by time.
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New().String()
+	}
+	return id.String()
+}
+
+// readCurrentState returns the current state of the resource targeted by the request by sending a GET request
+// for the same URL to next. It returns nil if the resource does not exist or cannot be read, and false if the
+// resource is larger than maxAuditBodySize.
+func readCurrentState(next http.Handler, r *http.Request) ([]byte, bool) {
+	ctx := r.Context()
+	if chi.RouteContext(ctx) != nil {
+		// The routing context is mutated while routing, so the request for the current state needs its own.
+		ctx = context.WithValue(ctx, chi.RouteCtxKey, chi.NewRouteContext())
+	}
+
+	get := r.Clone(ctx)
+	get.Method = http.MethodGet
+	get.Body = http.NoBody
+	get.ContentLength = 0
+	get.Header.Del("Content-Type")
+	get.Header.Del("If-Match")
+	get.Header.Del("If-None-Match")
+
+	w := &bufferResponseWriter{header: http.Header{}, statusCode: http.StatusOK}
+	next.ServeHTTP(w, get)
+	if w.statusCode != http.StatusOK {
+		return nil, true
+	}
+	if w.truncated {
+		return nil, false
+	}
+
+	return w.body.Bytes(), true
+}
+
+// replayBody is a request body that replays the bytes read by the Audit middleware before the rest of the
+// original body.
+type replayBody struct {
+	io.Reader
+	io.Closer
+}
+
+var _ http.Flusher = (*auditResponseWriter)(nil)
+
+// auditResponseWriter records the status code and optionally the body of a response.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	capture     bool
+	truncated   bool
+	body        bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	if w.capture && !w.truncated {
+		if w.body.Len()+len(b) <= maxAuditBodySize {
+			w.body.Write(b)
+		} else {
+			w.truncated = true
+			w.body.Reset()
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher so that streaming responses, for example from the UCP proxy, keep working.
+func (w *auditResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *auditResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// bufferResponseWriter is a ResponseWriter that buffers the response in memory.
+type bufferResponseWriter struct {
+	header     http.Header
+	statusCode int
+	truncated  bool
+	body       bytes.Buffer
+}
+
+func (w *bufferResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+func (w *bufferResponseWriter) Write(b []byte) (int, error) {
+	if w.truncated || w.body.Len()+len(b) > maxAuditBodySize {
+		w.truncated = true
+		w.body.Reset()
+		return len(b), nil
+	}
+	return w.body.Write(b)
+}
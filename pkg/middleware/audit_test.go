@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type testAuditSink struct {
+	records []*audit.Record
+}
+
+func (s *testAuditSink) Write(ctx context.Context, record *audit.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testAuditSink) Query(ctx context.Context, query audit.Query) ([]*audit.Record, error) {
+	return s.records, nil
+}
+
+func newAuditRequest(method string, path string, body string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	id, _ := resources.ParseByMethod(path, method)
+	return req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{
+		ResourceID:    id,
+		CorrelationID: "correlation-id",
+		Principal:     v1.NewPrincipal("alice", nil),
+	}))
+}
+
+func TestAudit(t *testing.T) {
+	const path = "/planes/radius/local/resourcegroups/rg/providers/Applications.Core/containers/c"
+
+	// stored is the state of the resource served by the test handler.
+	stored := `{"name":"c","properties":{"image":"nginx:1","env":{"DB":{"value":"secret"}}}}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(stored))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusForbidden)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	t.Run("read requests are not audited", func(t *testing.T) {
+		sink := &testAuditSink{}
+		w := httptest.NewRecorder()
+		Audit(sink, AuditOptions{Service: "ucp"})(handler).ServeHTTP(w, newAuditRequest(http.MethodGet, path, ""))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, sink.records)
+	})
+
+	t.Run("put with changes", func(t *testing.T) {
+		sink := &testAuditSink{}
+		w := httptest.NewRecorder()
+		body := `{"name":"c","properties":{"image":"nginx:2","env":{"DB":{"value":"other"}}}}`
+		Audit(sink, AuditOptions{Service: "ucp", IncludeChanges: true})(handler).ServeHTTP(w, newAuditRequest(http.MethodPut, path, body))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, body, w.Body.String())
+		require.Len(t, sink.records, 1)
+
+		record := sink.records[0]
+		require.NotEmpty(t, record.ID)
+		require.Equal(t, "ucp", record.Service)
+		require.Equal(t, "alice", record.Principal)
+		require.Equal(t, http.MethodPut, record.Method)
+		require.Equal(t, "Applications.Core/containers/write", record.Operation)
+		require.Equal(t, path, record.ResourceID)
+		require.Equal(t, "correlation-id", record.CorrelationID)
+		require.Equal(t, http.StatusOK, record.StatusCode)
+		require.Equal(t, audit.OutcomeSucceeded, record.Outcome)
+		require.Equal(t, []audit.Change{
+			{Path: "properties.env.DB.value", Old: audit.RedactedValue, New: audit.RedactedValue},
+			{Path: "properties.image", Old: "nginx:1", New: "nginx:2"},
+		}, record.Changes)
+	})
+
+	t.Run("denied delete", func(t *testing.T) {
+		sink := &testAuditSink{}
+		w := httptest.NewRecorder()
+		Audit(sink, AuditOptions{Service: "ucp", IncludeChanges: true})(handler).ServeHTTP(w, newAuditRequest(http.MethodDelete, path, ""))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Len(t, sink.records, 1)
+		require.Equal(t, audit.OutcomeDenied, sink.records[0].Outcome)
+		require.Equal(t, "Applications.Core/containers/delete", sink.records[0].Operation)
+		require.Empty(t, sink.records[0].Changes)
+	})
+
+	t.Run("failed action", func(t *testing.T) {
+		sink := &testAuditSink{}
+		w := httptest.NewRecorder()
+		Audit(sink, AuditOptions{Service: "applications-rp"})(handler).ServeHTTP(w, newAuditRequest(http.MethodPost, path+"/restart", ""))
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Len(t, sink.records, 1)
+		require.Equal(t, audit.OutcomeFailed, sink.records[0].Outcome)
+		require.Equal(t, "Applications.Core/containers/restart/action", sink.records[0].Operation)
+		require.Equal(t, path, sink.records[0].ResourceID)
+	})
+
+	t.Run("chi middleware", func(t *testing.T) {
+		sink := &testAuditSink{}
+		r := chi.NewRouter()
+		r.Use(Audit(sink, AuditOptions{Service: "ucp", IncludeChanges: true}))
+		r.Get(path, handler)
+		r.Put(path, handler)
+
+		w := httptest.NewRecorder()
+		body := `{"name":"c","properties":{"image":"nginx:2"}}`
+		r.ServeHTTP(w, newAuditRequest(http.MethodPut, path, body))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, body, w.Body.String())
+		require.Len(t, sink.records, 1)
+		require.Contains(t, sink.records[0].Changes, audit.Change{Path: "properties.image", Old: "nginx:1", New: "nginx:2"})
+	})
+
+	t.Run("put larger than the capture limit", func(t *testing.T) {
+		sink := &testAuditSink{}
+		w := httptest.NewRecorder()
+		body := `{"name":"c","properties":{"image":"nginx:2","data":"` + strings.Repeat("a", maxAuditBodySize) + `"}}`
+		Audit(sink, AuditOptions{Service: "ucp", IncludeChanges: true})(handler).ServeHTTP(w, newAuditRequest(http.MethodPut, path, body))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, body, w.Body.String())
+		require.Len(t, sink.records, 1)
+		require.Equal(t, audit.OutcomeSucceeded, sink.records[0].Outcome)
+		require.Empty(t, sink.records[0].Changes)
+	})
+}
@@ -17,15 +17,29 @@ limitations under the License.
 package middleware
 
 import (
+	"context"
 	"net/http"
 )
 
+type remoteAddrKey struct{}
+
 // RemoveRemoteAddr is the middleware to remove remoteaddr to avoid high cardinality in metrics.
 // This is a temporary workaround until opentelemetry-go fixes the issue - https://github.com/open-telemetry/opentelemetry-go-contrib/issues/3765
+//
+// The address is kept in the request context and can be read with RemoteAddrFromContext.
 func RemoveRemoteAddr(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
+		if r.RemoteAddr != "" {
+			r = r.WithContext(context.WithValue(r.Context(), remoteAddrKey{}, r.RemoteAddr))
+		}
 		r.RemoteAddr = ""
 		next.ServeHTTP(w, r)
 	}
 	return http.HandlerFunc(fn)
 }
+
+// RemoteAddrFromContext returns the network address of the client removed by RemoveRemoteAddr, or an empty string.
+func RemoteAddrFromContext(ctx context.Context) string {
+	addr, _ := ctx.Value(remoteAddrKey{}).(string)
+	return addr
+}
@@ -30,7 +30,6 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/recipes/util"
-	"github.com/radius-project/radius/pkg/resourceutil"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
@@ -139,6 +138,10 @@ func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, er
 
 		// Set the deployment status to the recipe error code
 		recipeDataModel.GetRecipe().DeploymentStatus = util.RecipeDeploymentStatus(recipeErr.DeploymentStatus)
+
+		// The recipe resources may have been partially updated, so the output of the previous execution can't be reused.
+		clearCachedRecipeOutput(recipeDataModel.(rpv1.RadiusResourceModel))
+
 		update := &database.Object{
 			Metadata: database.Metadata{ID: resourceID},
 			Data:     recipeDataModel.(rpv1.RadiusResourceModel),
@@ -168,55 +171,19 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 	// Caller ensures recipeDataModel supports recipes and has a non-nil recipe
 	recipe := recipeDataModel.GetRecipe()
 
-	resourceProperties, err := resourceutil.GetPropertiesFromResource(resource)
+	metadata, err := processors.GetRecipeResourceMetadata(ctx, c.DatabaseClient(), resource, recipe)
 	if err != nil {
 		return nil, err
 	}
 
-	connectionsAndSourceIDs, err := resourceutil.GetConnectionNameandSourceIDs(resource)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get connected resource IDs: %w", err)
-	}
-	connectedResourcesMetadata := make(map[string]recipes.ConnectedResource)
-
-	// If there are connected resources, we need to fetch their properties and add them to the recipe context.
-	for connName, connectedResourceID := range connectionsAndSourceIDs {
-		connectedResource, err := c.DatabaseClient().Get(ctx, connectedResourceID)
-		if errors.Is(&database.ErrNotFound{ID: connectedResourceID}, err) {
-			return nil, fmt.Errorf("connected resource %s not found: %w", connectedResourceID, err)
-		} else if err != nil {
-			return nil, fmt.Errorf("failed to get connected resource %s: %w", connectedResourceID, err)
-		}
-
-		connectedResourceMetadata, err := resourceutil.GetAllPropertiesFromResource(connectedResource.Data)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
-		}
-
-		connectedResourcesMetadata[connName] = recipes.ConnectedResource{
-			ID:         connectedResourceMetadata.ID,
-			Name:       connectedResourceMetadata.Name,
-			Type:       connectedResourceMetadata.Type,
-			Properties: connectedResourceMetadata.Properties,
-		}
-	}
-
-	metadata := recipes.ResourceMetadata{
-		Name:                         recipe.Name,
-		Parameters:                   recipe.Parameters,
-		EnvironmentID:                resource.ResourceMetadata().EnvironmentID(),
-		ApplicationID:                resource.ResourceMetadata().ApplicationID(),
-		ResourceID:                   resource.GetBaseResource().ID,
-		Properties:                   resourceProperties,
-		ConnectedResourcesProperties: connectedResourcesMetadata,
-	}
-
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
 		BaseOptions: engine.BaseOptions{
 			Recipe: metadata,
 		},
-		PreviousState: prevState,
-		Simulated:     simulated,
+		PreviousState:        prevState,
+		Simulated:            simulated,
+		PreviousRecipeStatus: resource.ResourceMetadata().GetResourceStatus().Recipe,
+		Force:                recipe.Force,
 	})
 }
 
@@ -229,3 +196,17 @@ func setRecipeStatus[P rpv1.RadiusResourceModel](data P, recipeStatus rpv1.Recip
 	status.Recipe = &recipeStatus
 	rm.SetResourceStatus(status)
 }
+
+// clearCachedRecipeOutput removes the fingerprint and output of the previous recipe execution from the resource status,
+// so that the next deployment of the resource executes the recipe.
+func clearCachedRecipeOutput[P rpv1.RadiusResourceModel](data P) {
+	rm := data.ResourceMetadata()
+	status := rm.GetResourceStatus().DeepCopyRecipeStatus()
+	if status.Recipe == nil {
+		return
+	}
+
+	status.Recipe.Fingerprint = ""
+	status.Recipe.CachedOutput = nil
+	rm.SetResourceStatus(status)
+}
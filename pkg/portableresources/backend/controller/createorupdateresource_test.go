@@ -298,12 +298,18 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 								"id": oldOutputResourceResourceID,
 							},
 						},
+						"recipe": map[string]any{
+							"templateKind": "bicep",
+							"templatePath": "test-recipe:latest",
+							"fingerprint":  "abc123",
+						},
 					},
 					"recipe": map[string]any{
 						"name": "test-recipe",
 						"parameters": map[string]any{
 							"p1": "v1",
 						},
+						"force": true,
 					},
 				},
 			}
@@ -334,6 +340,12 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 				data["type"] = 3 // This won't convert to our data model.
 			}
 
+			previousRecipeStatus := &rpv1.RecipeStatus{
+				TemplateKind: "bicep",
+				TemplatePath: "test-recipe:latest",
+				Fingerprint:  "abc123",
+			}
+
 			testResource := &TestResource{
 				Properties: TestResourceProperties{
 					BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -345,6 +357,7 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 									ID: resources.MustParse(oldOutputResourceResourceID),
 								},
 							},
+							Recipe: previousRecipeStatus,
 						},
 					},
 					IsProcessed: false,
@@ -353,6 +366,7 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						Parameters: map[string]any{
 							"p1": "v1",
 						},
+						Force: true,
 					},
 				},
 			}
@@ -411,7 +425,9 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						BaseOptions: engine.BaseOptions{
 							Recipe: recipeMetadata,
 						},
-						PreviousState: prevState,
+						PreviousState:        prevState,
+						PreviousRecipeStatus: previousRecipeStatus,
+						Force:                true,
 					}).
 					Return(&recipes.RecipeOutput{}, tt.recipeErr).
 					Times(1)
@@ -421,7 +437,9 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						BaseOptions: engine.BaseOptions{
 							Recipe: recipeMetadata,
 						},
-						PreviousState: prevState,
+						PreviousState:        prevState,
+						PreviousRecipeStatus: previousRecipeStatus,
+						Force:                true,
 					}).
 					Return(&recipes.RecipeOutput{}, nil).
 					Times(1)
@@ -470,3 +488,32 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestClearCachedRecipeOutput(t *testing.T) {
+	resource := &TestResource{
+		Properties: TestResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Status: rpv1.ResourceStatus{
+					Recipe: &rpv1.RecipeStatus{
+						TemplateKind: "bicep",
+						TemplatePath: "test-recipe:latest",
+						Fingerprint:  "abc123",
+						CachedOutput: &rpv1.RecipeCachedOutput{
+							Values: map[string]any{"host": "test"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	clearCachedRecipeOutput(resource)
+	require.Equal(t, &rpv1.RecipeStatus{
+		TemplateKind: "bicep",
+		TemplatePath: "test-recipe:latest",
+	}, resource.Properties.Status.Recipe)
+
+	resource = &TestResource{}
+	clearCachedRecipeOutput(resource)
+	require.Nil(t, resource.Properties.Status.Recipe)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// PlanResource is the async operation controller of the plan action for recipe-backed resources. It computes the changes
+// that re-deploying the recipe of the resource would make, and the changes made to the recipe resources outside of Radius
+// since the recipe was last deployed, without deploying the recipe. The plan is the result of the operation.
+type PlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.BaseController
+	engine engine.Engine
+}
+
+// NewPlanResource creates a new PlanResource controller which is used to compute recipe plans asynchronously.
+func NewPlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, eng engine.Engine) (ctrl.Controller, error) {
+	return &PlanResource[P, T]{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         eng,
+	}, nil
+}
+
+// Run computes the recipe plan of the resource and returns it as the result of the operation. The operation fails if the
+// resource is not provisioned by a recipe, or if the recipe driver does not support plans.
+func (c *PlanResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := P(new(T))
+	if err = obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	recipeDataModel, supportsRecipes := any(resource).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.GetRecipe() == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("resource %q is not provisioned by a recipe", request.ResourceID),
+			Target:  request.ResourceID,
+		}), nil
+	}
+
+	metadata, err := processors.GetRecipeResourceMetadata(ctx, c.DatabaseClient(), resource, recipeDataModel.GetRecipe())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	previousState := []string{}
+	for _, outputResource := range resource.OutputResources() {
+		previousState = append(previousState, outputResource.ID.String())
+	}
+
+	plan, err := c.engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: previousState,
+	})
+	if err != nil {
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Properties: plan}, nil
+}
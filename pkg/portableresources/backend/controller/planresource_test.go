@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func TestPlanResource_Run(t *testing.T) {
+	const outputResourceID = "/planes/aws/aws/accounts/123456789012/regions/us-east-1/providers/AWS.S3/Bucket/test-bucket"
+
+	expectedPlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{Address: "aws_s3_bucket.test", Action: recipes.ResourceChangeActionUpdate, ChangedProperties: []string{"tags"}},
+		},
+		Drift: []recipes.ResourceChange{},
+	}
+
+	tests := []struct {
+		name          string
+		planErr       error
+		expectedState v1.ProvisioningState
+		expectedCode  string
+		expectedErr   bool
+	}{
+		{
+			name:          "success",
+			expectedState: v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:          "plan not supported",
+			planErr:       recipes.NewRecipeError(recipes.RecipePlanNotSupported, "plan is not supported for bicep recipes", recipes_util.RecipeSetupError),
+			expectedState: v1.ProvisioningStateFailed,
+			expectedCode:  recipes.RecipePlanNotSupported,
+		},
+		{
+			name:          "plan failed",
+			planErr:       recipes.NewRecipeError(recipes.RecipePlanFailed, "terraform plan failed", recipes_util.ExecutionError),
+			expectedState: v1.ProvisioningStateFailed,
+			expectedCode:  recipes.RecipePlanFailed,
+		},
+		{
+			name:        "unexpected engine error",
+			planErr:     errors.New("unexpected error"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+
+			resource := &TestResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{ID: TestResourceID, Name: "tr", Type: TestResourceType},
+				},
+				Properties: TestResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{
+						Application: TestApplicationID,
+						Environment: TestEnvironmentID,
+						Status: rpv1.ResourceStatus{
+							OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(outputResourceID)}},
+						},
+					},
+					Recipe: portableresources.ResourceRecipe{Name: "test-recipe"},
+				},
+			}
+
+			databaseClient.EXPECT().
+				Get(gomock.Any(), TestResourceID).
+				Return(&database.Object{Metadata: database.Metadata{ID: TestResourceID}, Data: resource}, nil)
+
+			eng.EXPECT().
+				Plan(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts engine.PlanOptions) (*recipes.RecipePlan, error) {
+					require.Equal(t, "test-recipe", opts.Recipe.Name)
+					require.Equal(t, TestResourceID, opts.Recipe.ResourceID)
+					require.Equal(t, TestEnvironmentID, opts.Recipe.EnvironmentID)
+					require.Equal(t, []string{outputResourceID}, opts.PreviousState)
+					if tt.planErr != nil {
+						return nil, tt.planErr
+					}
+					return expectedPlan, nil
+				})
+
+			ctl, err := NewPlanResource[*TestResource, TestResource](ctrl.Options{DatabaseClient: databaseClient}, eng)
+			require.NoError(t, err)
+
+			result, err := ctl.Run(context.Background(), &ctrl.Request{
+				OperationID:   uuid.New(),
+				OperationType: "APPLICATIONS.TEST/TESTRESOURCES|ACTIONPLAN",
+				ResourceID:    TestResourceID,
+				ReadOnly:      true,
+			})
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedState, result.ProvisioningState())
+
+			if tt.expectedCode == "" {
+				require.Equal(t, expectedPlan, result.Properties)
+			} else {
+				require.Equal(t, tt.expectedCode, result.Error.Code)
+				require.Nil(t, result.Properties)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// PlanActionName is the name of the custom action that computes the changes deploying the recipe of a resource would make.
+	PlanActionName = "plan"
+
+	// PlanOperationMethod is the operation method of the async operation of the plan action.
+	PlanOperationMethod v1.OperationMethod = "ACTIONPLAN"
+
+	// AsyncPlanResourceTimeout is the timeout of the async operation of the plan action.
+	AsyncPlanResourceTimeout = time.Duration(30) * time.Minute
+)
+
+// PlanResource is the controller implementation of the plan action for recipe-backed resources. The plan action reports
+// the changes that re-deploying the recipe of the resource would make, and the changes made to the recipe resources outside
+// of Radius since the recipe was last deployed, without deploying the recipe.
+//
+// Computing a plan runs the recipe driver, so the plan is computed by an async operation. The plan is the result of the
+// operation and is returned by the operation result once the operation succeeds.
+type PlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewPlanResource creates a new instance of the PlanResource controller.
+func NewPlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options) (ctrl.Controller, error) {
+	return &PlanResource[P, T]{
+		Operation: ctrl.NewOperation[P](opts, ctrl.ResourceOptions[T]{AsyncOperationTimeout: AsyncPlanResourceTimeout}),
+	}, nil
+}
+
+// Run queues the async operation that computes the recipe plan for the resource and returns an Accepted response. It
+// returns a Bad Request response if the resource is not provisioned by a recipe.
+func (c *PlanResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for plan has name of the operation as suffix which should be removed to get the resource id.
+	// route id format: /planes/radius/local/resourceGroups/<resource_group>/providers/Applications.Datastores/redisCaches/<resource_name>/plan
+	parsedResourceID := serviceCtx.ResourceID.Truncate()
+	stored, _, err := c.GetResource(ctx, parsedResourceID)
+	if err != nil {
+		if errors.Is(&database.ErrNotFound{ID: parsedResourceID.String()}, err) {
+			return rest.NewNotFoundResponse(parsedResourceID), nil
+		}
+		return nil, err
+	}
+
+	if stored == nil {
+		return rest.NewNotFoundResponse(parsedResourceID), nil
+	}
+
+	recipeDataModel, supportsRecipes := any(P(stored)).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.GetRecipe() == nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("resource %q is not provisioned by a recipe", parsedResourceID.String())), nil
+	}
+
+	// The operation is linked to the resource, not to the action.
+	operationCtx := *serviceCtx
+	operationCtx.ResourceID = parsedResourceID
+	err = c.StatusManager().QueueAsyncOperation(ctx, &operationCtx, sm.QueueOperationOptions{
+		OperationTimeout: c.AsyncOperationTimeout(),
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+		ReadOnly:         true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, parsedResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", ""), nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	testResourceType  = "Applications.Test/testResources"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/test-env"
+	testApplicationID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/applications/test-app"
+	testResourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/tr"
+	testOutputID      = "/planes/aws/aws/accounts/123456789012/regions/us-east-1/providers/AWS.S3/Bucket/test-bucket"
+)
+
+type testResource struct {
+	v1.BaseResource
+
+	// PortableResourceMetadata represents internal DataModel properties common to all portable resource types.
+	datamodel.PortableResourceMetadata
+
+	// Properties is the properties of the resource.
+	Properties testResourceProperties `json:"properties"`
+}
+
+type testResourceProperties struct {
+	rpv1.BasicResourceProperties
+	Recipe portableresources.ResourceRecipe `json:"recipe,omitempty"`
+}
+
+func (r *testResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	return nil
+}
+
+func (r *testResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+func (r *testResource) ResourceMetadata() rpv1.BasicResourcePropertiesAdapter {
+	return &r.Properties.BasicResourceProperties
+}
+
+// GetRecipe returns nil when no recipe name is set so that tests can model manually provisioned resources.
+func (r *testResource) GetRecipe() *portableresources.ResourceRecipe {
+	if r.Properties.Recipe.Name == "" {
+		return nil
+	}
+	return &r.Properties.Recipe
+}
+
+func (r *testResource) SetRecipe(recipe *portableresources.ResourceRecipe) {
+	r.Properties.Recipe = *recipe
+}
+
+func newTestResource(recipeName string) *testResource {
+	return &testResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testResourceID,
+				Name: "tr",
+				Type: testResourceType,
+			},
+		},
+		Properties: testResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: testApplicationID,
+				Environment: testEnvironmentID,
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{
+						{ID: resources.MustParse(testOutputID)},
+					},
+				},
+			},
+			Recipe: portableresources.ResourceRecipe{
+				Name: recipeName,
+			},
+		},
+	}
+}
+
+func newPlanRequest(t *testing.T) (context.Context, *http.Request) {
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPost, "http://localhost:8080"+testResourceID+"/plan?api-version=2023-10-01-preview", nil)
+	require.NoError(t, err)
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func TestPlanResource_Run(t *testing.T) {
+	tests := []struct {
+		name           string
+		resource       *testResource
+		getErr         error
+		queueErr       error
+		expectQueue    bool
+		expectedStatus int
+		expectedErr    bool
+	}{
+		{
+			name:           "success",
+			resource:       newTestResource("test-recipe"),
+			expectQueue:    true,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "resource not found",
+			getErr:         &database.ErrNotFound{ID: testResourceID},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "database error",
+			getErr:      errors.New("failed to get the resource"),
+			expectedErr: true,
+		},
+		{
+			name:           "resource not provisioned by a recipe",
+			resource:       newTestResource(""),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "queue error",
+			resource:    newTestResource("test-recipe"),
+			expectQueue: true,
+			queueErr:    errors.New("failed to queue the operation"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(mctrl)
+			statusManager := statusmanager.NewMockStatusManager(mctrl)
+
+			databaseClient.EXPECT().
+				Get(gomock.Any(), testResourceID).
+				DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &database.Object{Metadata: database.Metadata{ID: id}, Data: tt.resource}, nil
+				})
+
+			if tt.expectQueue {
+				statusManager.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						// The operation is linked to the resource, and does not change it.
+						require.Equal(t, testResourceID, sCtx.ResourceID.String())
+						require.True(t, options.ReadOnly)
+						require.Equal(t, AsyncPlanResourceTimeout, options.OperationTimeout)
+						return tt.queueErr
+					})
+			}
+
+			ctl, err := NewPlanResource[*testResource](ctrl.Options{DatabaseClient: databaseClient, StatusManager: statusManager})
+			require.NoError(t, err)
+
+			ctx, req := newPlanRequest(t)
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+
+			if tt.expectedStatus == http.StatusAccepted {
+				require.NotEmpty(t, w.Header().Get("Location"))
+				require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+			}
+		})
+	}
+}
@@ -17,10 +17,14 @@ limitations under the License.
 package processors
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/resourceutil"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -67,3 +71,50 @@ func GetOutputResourcesFromRecipe(output *recipes.RecipeOutput) ([]rpv1.OutputRe
 
 	return results, nil
 }
+
+// GetRecipeResourceMetadata builds the recipe metadata passed to the recipe engine for the given resource and recipe.
+// The metadata includes the properties of the resource and of each of its connected resources, which are read from the database.
+func GetRecipeResourceMetadata[P rpv1.RadiusResourceModel](ctx context.Context, databaseClient database.Client, resource P, recipe *portableresources.ResourceRecipe) (recipes.ResourceMetadata, error) {
+	resourceProperties, err := resourceutil.GetPropertiesFromResource(resource)
+	if err != nil {
+		return recipes.ResourceMetadata{}, err
+	}
+
+	connectionsAndSourceIDs, err := resourceutil.GetConnectionNameandSourceIDs(resource)
+	if err != nil {
+		return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource IDs: %w", err)
+	}
+	connectedResourcesMetadata := make(map[string]recipes.ConnectedResource)
+
+	// If there are connected resources, we need to fetch their properties and add them to the recipe context.
+	for connName, connectedResourceID := range connectionsAndSourceIDs {
+		connectedResource, err := databaseClient.Get(ctx, connectedResourceID)
+		if errors.Is(&database.ErrNotFound{ID: connectedResourceID}, err) {
+			return recipes.ResourceMetadata{}, fmt.Errorf("connected resource %s not found: %w", connectedResourceID, err)
+		} else if err != nil {
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource %s: %w", connectedResourceID, err)
+		}
+
+		connectedResourceMetadata, err := resourceutil.GetAllPropertiesFromResource(connectedResource.Data)
+		if err != nil {
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
+		}
+
+		connectedResourcesMetadata[connName] = recipes.ConnectedResource{
+			ID:         connectedResourceMetadata.ID,
+			Name:       connectedResourceMetadata.Name,
+			Type:       connectedResourceMetadata.Type,
+			Properties: connectedResourceMetadata.Properties,
+		}
+	}
+
+	return recipes.ResourceMetadata{
+		Name:                         recipe.Name,
+		Parameters:                   recipe.Parameters,
+		EnvironmentID:                resource.ResourceMetadata().EnvironmentID(),
+		ApplicationID:                resource.ResourceMetadata().ApplicationID(),
+		ResourceID:                   resource.GetBaseResource().ID,
+		Properties:                   resourceProperties,
+		ConnectedResourcesProperties: connectedResourcesMetadata,
+	}, nil
+}
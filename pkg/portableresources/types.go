@@ -58,6 +58,8 @@ type ResourceRecipe struct {
 	Parameters map[string]any `json:"parameters,omitempty"`
 	// DeploymentStatus is the deployment status of the recipe
 	DeploymentStatus util.RecipeDeploymentStatus `json:"recipeStatus,omitempty"`
+	// Force specifies that the recipe must be executed even if its inputs have not changed since the previous deployment
+	Force bool `json:"force,omitempty"`
 }
 
 // ResourceReference represents a reference to a resource that was deployed by the user
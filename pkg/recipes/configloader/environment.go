@@ -255,6 +255,13 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
 		}
+	case *v20231001preview.HelmRecipeProperties:
+		if c.TemplateVersion != nil {
+			definition.TemplateVersion = *c.TemplateVersion
+		}
+		if c.PlainHTTP != nil {
+			definition.PlainHTTP = *c.PlainHTTP
+		}
 	}
 
 	return definition, nil
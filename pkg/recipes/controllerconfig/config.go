@@ -29,10 +29,13 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/external"
+	"github.com/radius-project/radius/pkg/recipes/driver/helm"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/vault"
 )
 
 // RecipeControllerConfig is the configuration for the controllers which uses recipe.
@@ -53,6 +56,9 @@ type RecipeControllerConfig struct {
 
 	// UCPConnection is the connection to UCP
 	UCPConnection *sdk.Connection
+
+	// Vault configures the connections to the Vault servers of Vault backed secret stores.
+	Vault vault.Options
 }
 
 // New creates a new RecipeControllerConfig instance with the given host options.
@@ -64,6 +70,8 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 
 	cfg.UCPConnection = &options.UCPConnection
 
+	cfg.Vault = options.Config.Vault
+
 	// This is a temporary fix to avoid ARM initialization in the test environment.
 	cfg.Arm = options.Arm
 
@@ -96,26 +104,35 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	drivers := map[string]driver.Driver{
+		recipes.TemplateKindBicep: bicep.NewBicepDriver(
+			clientOptions,
+			cfg.DeploymentEngineClient,
+			processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes),
+			bicep.BicepOptions{
+				DeleteRetryCount:        bicepDeleteRetryCount,
+				DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
+			},
+		),
+		recipes.TemplateKindTerraform: terraform.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
+			terraform.TerraformOptions{
+				Path:           options.Config.Terraform.Path,
+				LogLevel:       options.Config.Terraform.LogLevel,
+				LocalStatePath: options.Config.Terraform.LocalStatePath,
+			}, *cfg.Kubernetes),
+		recipes.TemplateKindHelm: helm.NewHelmDriver(cfg.Kubernetes),
+	}
+
+	// Out-of-process drivers are registered alongside the built-in drivers and cannot replace them.
+	if err := external.RegisterDrivers(drivers, options.Config.RecipeDrivers); err != nil {
+		return nil, err
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
 		SecretsLoader:       configloader.NewSecretStoreLoader(clientOptions),
-		Drivers: map[string]driver.Driver{
-			recipes.TemplateKindBicep: bicep.NewBicepDriver(
-				clientOptions,
-				cfg.DeploymentEngineClient,
-				processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes),
-				bicep.BicepOptions{
-					DeleteRetryCount:        bicepDeleteRetryCount,
-					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
-				},
-			),
-			recipes.TemplateKindTerraform: terraform.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
-				terraform.TerraformOptions{
-					Path:     options.Config.Terraform.Path,
-					LogLevel: options.Config.Terraform.LogLevel,
-				}, *cfg.Kubernetes),
-		},
+		Drivers:             drivers,
 	})
 
 	return cfg, nil
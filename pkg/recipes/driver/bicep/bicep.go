@@ -256,6 +256,12 @@ func (d *bicepDriver) Delete(ctx context.Context, opts driver.DeleteOptions) err
 	return nil
 }
 
+// Plan is not supported for Bicep recipes because the deployment engine does not support what-if deployments.
+func (d *bicepDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	err := fmt.Errorf("plan is not supported for %s recipes", recipes.TemplateKindBicep)
+	return nil, recipes.NewRecipeError(recipes.RecipePlanNotSupported, err.Error(), recipes_util.RecipeSetupError)
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -515,6 +515,20 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	require.Equal(t, actualErr, &expErr)
 }
 
+func Test_Bicep_Plan_NotSupported(t *testing.T) {
+	driverBicep := &bicepDriver{}
+
+	_, actualErr := driverBicep.Plan(testcontext.New(t), driver.ExecuteOptions{})
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanNotSupported,
+			Message: "plan is not supported for bicep recipes",
+		},
+		DeploymentStatus: "setupError",
+	}
+	require.Equal(t, &expErr, actualErr)
+}
+
 func Test_GetGCOutputResources(t *testing.T) {
 	d := &bicepDriver{}
 	before := []string{
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Options represents the options for connecting to an external recipe driver.
+type Options struct {
+	// Kind is the recipe kind served by the driver.
+	Kind string
+
+	// Socket is the path of the unix domain socket the driver listens on.
+	Socket string
+
+	// Timeout is the maximum duration of a single request to the driver. Zero means no timeout.
+	Timeout time.Duration
+}
+
+var _ driver.DriverWithSecrets = (*externalDriver)(nil)
+
+type externalDriver struct {
+	kind   string
+	client *http.Client
+}
+
+// NewDriver creates a recipe driver that forwards every call to the external driver listening on options.Socket.
+func NewDriver(options Options) driver.DriverWithSecrets {
+	socket := options.Socket
+	return &externalDriver{
+		kind: options.Kind,
+		client: &http.Client{
+			Timeout: options.Timeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socket)
+				},
+			},
+		},
+	}
+}
+
+// Execute sends the execute request to the external driver and returns the recipe output.
+func (d *externalDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	response := ExecuteResponse{}
+	err := d.call(ctx, ExecutePath, &ExecuteRequest{Options: opts}, &response, recipes.RecipeDeploymentFailed, util.RecipeSetupError)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Output == nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("recipe driver %q returned no output", d.kind), util.ExecutionError)
+	}
+
+	return response.Output, nil
+}
+
+// Delete sends the delete request to the external driver.
+func (d *externalDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	return d.call(ctx, DeletePath, &DeleteRequest{Options: opts}, nil, recipes.RecipeDeletionFailed, "")
+}
+
+// GetRecipeMetadata sends the metadata request to the external driver and returns the recipe metadata.
+func (d *externalDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	response := GetRecipeMetadataResponse{}
+	err := d.call(ctx, GetRecipeMetadataPath, &GetRecipeMetadataRequest{Options: opts}, &response, recipes.RecipeGetMetadataFailed, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Metadata, nil
+}
+
+// Plan sends the plan request to the external driver and returns the changes deploying the recipe would make.
+func (d *externalDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	response := PlanResponse{}
+	err := d.call(ctx, PlanPath, &PlanRequest{Options: opts}, &response, recipes.RecipePlanFailed, util.RecipeSetupError)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Plan == nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("recipe driver %q returned no plan", d.kind), util.ExecutionError)
+	}
+
+	return response.Plan, nil
+}
+
+// FindSecretIDs sends the secretids request to the external driver and returns the secret IDs required by the recipe.
+func (d *externalDriver) FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (map[string][]string, error) {
+	response := FindSecretIDsResponse{}
+	err := d.call(ctx, FindSecretIDsPath, &FindSecretIDsRequest{Configuration: config, Definition: definition}, &response, recipes.LoadSecretsFailed, util.RecipeSetupError)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.SecretIDs, nil
+}
+
+// call sends request to the given path of the external driver and decodes the response into response.
+// Failures to reach the driver are reported as a RecipeError with the given code and deployment status.
+func (d *externalDriver) call(ctx context.Context, path string, request any, response any, code string, status util.RecipeDeploymentStatus) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	// The host is ignored because the transport always dials the unix socket.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost"+path, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	logger.V(ucplog.LevelDebug).Info(fmt.Sprintf("Calling recipe driver %q: %s", d.kind, path))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return recipes.NewRecipeError(code, fmt.Sprintf("failed to call recipe driver %q: %s", d.kind, err.Error()), status)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return recipes.NewRecipeError(code, fmt.Sprintf("failed to read response from recipe driver %q: %s", d.kind, err.Error()), status)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errorResponse := ErrorResponse{}
+		if err := json.Unmarshal(body, &errorResponse); err != nil || errorResponse.Error.Code == "" {
+			// The plan method is optional for drivers, and drivers that don't serve it respond with not found.
+			if path == PlanPath && resp.StatusCode == http.StatusNotFound {
+				return recipes.NewRecipeError(recipes.RecipePlanNotSupported, fmt.Sprintf("recipe driver %q does not support plan", d.kind), util.RecipeSetupError)
+			}
+
+			return recipes.NewRecipeError(code, fmt.Sprintf("recipe driver %q responded with status %d", d.kind, resp.StatusCode), status)
+		}
+
+		return recipes.NewRecipeError(errorResponse.Error.Code, errorResponse.Error.Message, errorResponse.DeploymentStatus, errorResponse.Error.Details...)
+	}
+
+	if response == nil || len(body) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, response); err != nil {
+		return recipes.NewRecipeError(code, fmt.Sprintf("failed to decode response from recipe driver %q: %s", d.kind, err.Error()), status)
+	}
+
+	return nil
+}
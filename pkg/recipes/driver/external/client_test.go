@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// startDriver serves d on a temporary unix socket and returns a client connected to it.
+func startDriver(t *testing.T, d driver.Driver) driver.DriverWithSecrets {
+	// Unix socket paths are limited to around 100 characters, so avoid the long paths from t.TempDir.
+	dir, err := os.MkdirTemp("", "recipedriver")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "driver.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, socket, d)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return NewDriver(Options{Kind: "test", Socket: socket, Timeout: 10 * time.Second})
+}
+
+func Test_Execute(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mock := driver.NewMockDriver(mctrl)
+	client := startDriver(t, mock)
+
+	opts := driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Recipe:     recipes.ResourceMetadata{Name: "redis", Parameters: map[string]any{"port": float64(6379)}},
+			Definition: recipes.EnvironmentDefinition{Name: "redis", Driver: "test", TemplatePath: "test://redis"},
+		},
+		PrevState: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		expected := &recipes.RecipeOutput{
+			Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+			Secrets:   map[string]any{"password": "secret"},
+			Values:    map[string]any{"host": "redis", "port": float64(6379)},
+		}
+		mock.EXPECT().Execute(gomock.Any(), opts).Return(expected, nil)
+
+		output, err := client.Execute(context.Background(), opts)
+		require.NoError(t, err)
+		require.Equal(t, expected, output)
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		expected := recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "failed to deploy", util.ExecutionError)
+		mock.EXPECT().Execute(gomock.Any(), opts).Return(nil, expected)
+
+		output, err := client.Execute(context.Background(), opts)
+		require.Nil(t, output)
+		require.Equal(t, expected, err)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		mock.EXPECT().Execute(gomock.Any(), opts).Return(nil, errors.New("boom"))
+
+		output, err := client.Execute(context.Background(), opts)
+		require.Nil(t, output)
+		require.Equal(t, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "boom", util.ExecutionError), err)
+	})
+
+	t.Run("no output", func(t *testing.T) {
+		mock.EXPECT().Execute(gomock.Any(), opts).Return(nil, nil)
+
+		output, err := client.Execute(context.Background(), opts)
+		require.Nil(t, output)
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+	})
+}
+
+func Test_Delete(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mock := driver.NewMockDriver(mctrl)
+	client := startDriver(t, mock)
+
+	opts := driver.DeleteOptions{
+		BaseOptions: driver.BaseOptions{
+			Definition: recipes.EnvironmentDefinition{Name: "redis", Driver: "test", TemplatePath: "test://redis"},
+		},
+	}
+
+	mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, client.Delete(context.Background(), opts))
+
+	mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+	err := client.Delete(context.Background(), opts)
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipeDeletionFailed, "boom", ""), err)
+}
+
+func Test_GetRecipeMetadata(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mock := driver.NewMockDriver(mctrl)
+	client := startDriver(t, mock)
+
+	opts := driver.BaseOptions{
+		Definition: recipes.EnvironmentDefinition{Name: "redis", Driver: "test", TemplatePath: "test://redis"},
+	}
+	expected := map[string]any{"parameters": map[string]any{"port": map[string]any{"type": "int"}}}
+
+	mock.EXPECT().GetRecipeMetadata(gomock.Any(), opts).Return(expected, nil)
+	metadata, err := client.GetRecipeMetadata(context.Background(), opts)
+	require.NoError(t, err)
+	require.Equal(t, expected, metadata)
+}
+
+func Test_Plan(t *testing.T) {
+	opts := driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Definition: recipes.EnvironmentDefinition{Name: "redis", Driver: "test", TemplatePath: "test://redis"},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mock := driver.NewMockDriver(mctrl)
+		client := startDriver(t, mock)
+
+		expected := &recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{
+					Address:           "redis",
+					Action:            recipes.ResourceChangeActionUpdate,
+					Before:            map[string]any{"port": float64(6379)},
+					After:             map[string]any{"port": float64(6380)},
+					ChangedProperties: []string{"port"},
+				},
+			},
+			Drift: []recipes.ResourceChange{},
+		}
+		mock.EXPECT().Plan(gomock.Any(), opts).Return(expected, nil)
+
+		plan, err := client.Plan(context.Background(), opts)
+		require.NoError(t, err)
+		require.Equal(t, expected, plan)
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mock := driver.NewMockDriver(mctrl)
+		client := startDriver(t, mock)
+
+		expected := recipes.NewRecipeError(recipes.RecipePlanNotSupported, "not supported", util.RecipeSetupError)
+		mock.EXPECT().Plan(gomock.Any(), opts).Return(nil, expected)
+
+		plan, err := client.Plan(context.Background(), opts)
+		require.Nil(t, plan)
+		require.Equal(t, expected, err)
+	})
+
+	t.Run("driver without plan", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "recipedriver")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+		// Serve the protocol without the plan method, like a driver built before plan was added.
+		socket := filepath.Join(dir, "driver.sock")
+		listener, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+		server := &http.Server{Handler: http.NotFoundHandler()}
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(func() { _ = server.Close() })
+
+		client := NewDriver(Options{Kind: "test", Socket: socket, Timeout: 10 * time.Second})
+		plan, err := client.Plan(context.Background(), opts)
+		require.Nil(t, plan)
+		require.Equal(t, recipes.NewRecipeError(recipes.RecipePlanNotSupported, `recipe driver "test" does not support plan`, util.RecipeSetupError), err)
+	})
+}
+
+func Test_FindSecretIDs(t *testing.T) {
+	definition := recipes.EnvironmentDefinition{Name: "redis", Driver: "test", TemplatePath: "test://redis"}
+
+	t.Run("driver with secrets", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mock := driver.NewMockDriverWithSecrets(mctrl)
+		client := startDriver(t, mock)
+
+		expected := map[string][]string{
+			"/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/creds": {"token"},
+		}
+		mock.EXPECT().FindSecretIDs(gomock.Any(), gomock.Any(), definition).Return(expected, nil)
+
+		secretIDs, err := client.FindSecretIDs(context.Background(), recipes.Configuration{}, definition)
+		require.NoError(t, err)
+		require.Equal(t, expected, secretIDs)
+	})
+
+	t.Run("driver without secrets", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		client := startDriver(t, driver.NewMockDriver(mctrl))
+
+		secretIDs, err := client.FindSecretIDs(context.Background(), recipes.Configuration{}, definition)
+		require.NoError(t, err)
+		require.Empty(t, secretIDs)
+	})
+}
+
+func Test_DriverUnavailable(t *testing.T) {
+	client := NewDriver(Options{Kind: "test", Socket: filepath.Join(t.TempDir(), "missing.sock")})
+
+	output, err := client.Execute(context.Background(), driver.ExecuteOptions{})
+	require.Nil(t, output)
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Equal(t, util.RecipeSetupError, recipeError.DeploymentStatus)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance contains a test suite that checks a recipe driver follows the contract expected by the
+// recipe engine. It is intended to be run against external recipe drivers through the client returned by
+// external.NewDriver, so that the wire encoding of the protocol is covered as well.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+// Fixture describes the recipes used to exercise the driver under test.
+type Fixture struct {
+	// Configuration is the environment configuration passed to the driver.
+	Configuration recipes.Configuration
+
+	// Recipe is the metadata of the resource the recipe is deployed for.
+	Recipe recipes.ResourceMetadata
+
+	// Definition is a recipe that the driver can deploy, describe, and delete.
+	Definition recipes.EnvironmentDefinition
+
+	// InvalidDefinition is a recipe that the driver must reject.
+	InvalidDefinition recipes.EnvironmentDefinition
+}
+
+// Run runs the conformance suite against d.
+func Run(t *testing.T, d driver.Driver, fixture Fixture) {
+	ctx := context.Background()
+	base := driver.BaseOptions{
+		Configuration: fixture.Configuration,
+		Recipe:        fixture.Recipe,
+		Definition:    fixture.Definition,
+	}
+
+	t.Run("Execute", func(t *testing.T) {
+		output, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: base})
+		require.NoError(t, err)
+		require.NotNil(t, output)
+
+		for _, id := range output.Resources {
+			_, err := resources.Parse(id)
+			require.NoErrorf(t, err, "output resource %q is not a valid resource ID", id)
+		}
+	})
+
+	t.Run("Execute invalid recipe", func(t *testing.T) {
+		invalid := base
+		invalid.Definition = fixture.InvalidDefinition
+
+		output, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: invalid})
+		require.Nil(t, output)
+		requireRecipeError(t, err)
+	})
+
+	t.Run("Execute is repeatable", func(t *testing.T) {
+		first, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: base})
+		require.NoError(t, err)
+
+		second, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: base, PrevState: first.Resources})
+		require.NoError(t, err)
+		require.ElementsMatch(t, first.Resources, second.Resources)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		output, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: base})
+		require.NoError(t, err)
+
+		outputResources := []rpv1.OutputResource{}
+		for _, id := range output.Resources {
+			outputResources = append(outputResources, rpv1.OutputResource{ID: resources.MustParse(id)})
+		}
+
+		err = d.Delete(ctx, driver.DeleteOptions{BaseOptions: base, OutputResources: outputResources})
+		require.NoError(t, err)
+	})
+
+	t.Run("GetRecipeMetadata", func(t *testing.T) {
+		metadata, err := d.GetRecipeMetadata(ctx, base)
+		require.NoError(t, err)
+		require.Contains(t, metadata, "parameters")
+		require.IsType(t, map[string]any{}, metadata["parameters"])
+	})
+
+	t.Run("GetRecipeMetadata invalid recipe", func(t *testing.T) {
+		invalid := base
+		invalid.Definition = fixture.InvalidDefinition
+
+		metadata, err := d.GetRecipeMetadata(ctx, invalid)
+		require.Nil(t, metadata)
+		requireRecipeError(t, err)
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		plan, err := d.Plan(ctx, driver.ExecuteOptions{BaseOptions: base})
+		if isRecipeError(err, recipes.RecipePlanNotSupported) {
+			t.Skip("driver does not support plan")
+		}
+		require.NoError(t, err)
+		require.NotNil(t, plan)
+
+		for _, change := range append(plan.Changes, plan.Drift...) {
+			require.NotEmpty(t, change.Address)
+			require.NotEmpty(t, change.Action)
+		}
+	})
+
+	t.Run("Plan invalid recipe", func(t *testing.T) {
+		invalid := base
+		invalid.Definition = fixture.InvalidDefinition
+
+		plan, err := d.Plan(ctx, driver.ExecuteOptions{BaseOptions: invalid})
+		require.Nil(t, plan)
+		requireRecipeError(t, err)
+	})
+
+	t.Run("FindSecretIDs", func(t *testing.T) {
+		ds, ok := d.(driver.DriverWithSecrets)
+		if !ok {
+			t.Skip("driver does not implement DriverWithSecrets")
+		}
+
+		secretIDs, err := ds.FindSecretIDs(ctx, fixture.Configuration, fixture.Definition)
+		require.NoError(t, err)
+		for id := range secretIDs {
+			_, err := resources.Parse(id)
+			require.NoErrorf(t, err, "secret store %q is not a valid resource ID", id)
+		}
+	})
+}
+
+func isRecipeError(err error, code string) bool {
+	recipeError := &recipes.RecipeError{}
+	return errors.As(err, &recipeError) && recipeError.ErrorDetails.Code == code
+}
+
+func requireRecipeError(t *testing.T, err error) {
+	recipeError := &recipes.RecipeError{}
+	require.Truef(t, errors.As(err, &recipeError), "expected a RecipeError, got %v", err)
+	require.NotEmpty(t, recipeError.ErrorDetails.Code)
+	require.NotEmpty(t, recipeError.ErrorDetails.Message)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external implements support for recipe drivers that run out of process.
+//
+// An external driver is a separate process that listens on a unix domain socket and serves the
+// recipe driver protocol: JSON requests sent over HTTP to a fixed set of paths, one per method of
+// the driver.DriverWithSecrets interface. Radius routes a recipe to an external driver when the
+// recipe kind matches the kind the driver was registered with.
+//
+// Drivers written in Go can use NewHandler or Serve to implement the server side of the protocol
+// on top of an existing driver.Driver implementation.
+package external
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+const (
+	// ExecutePath is the path of the protocol method that maps to driver.Driver.Execute.
+	ExecutePath = "/v1/execute"
+
+	// DeletePath is the path of the protocol method that maps to driver.Driver.Delete.
+	DeletePath = "/v1/delete"
+
+	// GetRecipeMetadataPath is the path of the protocol method that maps to driver.Driver.GetRecipeMetadata.
+	GetRecipeMetadataPath = "/v1/metadata"
+
+	// FindSecretIDsPath is the path of the protocol method that maps to driver.DriverWithSecrets.FindSecretIDs.
+	FindSecretIDsPath = "/v1/secretids"
+
+	// PlanPath is the path of the protocol method that maps to driver.Driver.Plan. Drivers that do not
+	// implement this method are treated as not supporting plans.
+	PlanPath = "/v1/plan"
+)
+
+// ExecuteRequest is the request body of the execute method.
+type ExecuteRequest struct {
+	// Options are the options passed to driver.Driver.Execute.
+	Options driver.ExecuteOptions `json:"options"`
+}
+
+// ExecuteResponse is the response body of the execute method.
+type ExecuteResponse struct {
+	// Output is the output of the recipe deployment.
+	Output *recipes.RecipeOutput `json:"output"`
+}
+
+// DeleteRequest is the request body of the delete method. The delete method responds with an empty body.
+type DeleteRequest struct {
+	// Options are the options passed to driver.Driver.Delete.
+	Options driver.DeleteOptions `json:"options"`
+}
+
+// GetRecipeMetadataRequest is the request body of the metadata method.
+type GetRecipeMetadataRequest struct {
+	// Options are the options passed to driver.Driver.GetRecipeMetadata.
+	Options driver.BaseOptions `json:"options"`
+}
+
+// GetRecipeMetadataResponse is the response body of the metadata method.
+type GetRecipeMetadataResponse struct {
+	// Metadata is the recipe metadata, including its parameters.
+	Metadata map[string]any `json:"metadata"`
+}
+
+// PlanRequest is the request body of the plan method.
+type PlanRequest struct {
+	// Options are the options passed to driver.Driver.Plan.
+	Options driver.ExecuteOptions `json:"options"`
+}
+
+// PlanResponse is the response body of the plan method.
+type PlanResponse struct {
+	// Plan describes the changes deploying the recipe would make.
+	Plan *recipes.RecipePlan `json:"plan"`
+}
+
+// FindSecretIDsRequest is the request body of the secretids method.
+type FindSecretIDsRequest struct {
+	// Configuration is the configuration for the recipe.
+	Configuration recipes.Configuration `json:"configuration"`
+
+	// Definition is the environment definition for the recipe.
+	Definition recipes.EnvironmentDefinition `json:"definition"`
+}
+
+// FindSecretIDsResponse is the response body of the secretids method.
+type FindSecretIDsResponse struct {
+	// SecretIDs maps secret store resource IDs to the secret keys required by the recipe.
+	SecretIDs map[string][]string `json:"secretIDs"`
+}
+
+// ErrorResponse is the body of every non-successful response of the protocol.
+type ErrorResponse struct {
+	// Error describes the error.
+	Error v1.ErrorDetails `json:"error"`
+
+	// DeploymentStatus is the recipe deployment status reported with the error.
+	DeploymentStatus util.RecipeDeploymentStatus `json:"deploymentStatus,omitempty"`
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+)
+
+// NewDriverFromConfig validates the host configuration of an external recipe driver and creates the driver.
+func NewDriverFromConfig(config hostoptions.RecipeDriverOptions) (driver.DriverWithSecrets, error) {
+	if strings.TrimSpace(config.Kind) == "" {
+		return nil, errors.New("recipe driver kind must not be empty")
+	}
+
+	if strings.TrimSpace(config.Socket) == "" {
+		return nil, fmt.Errorf("recipe driver %q must specify a socket", config.Kind)
+	}
+
+	var timeout time.Duration
+	if config.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(config.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("recipe driver %q has an invalid timeout: %w", config.Kind, err)
+		}
+	}
+
+	return NewDriver(Options{Kind: config.Kind, Socket: config.Socket, Timeout: timeout}), nil
+}
+
+// RegisterDrivers creates the external recipe drivers described by configs and adds them to drivers, keyed by
+// recipe kind. Registering a driver for a kind that already has a driver, including the built-in kinds, is an error.
+// The kinds of the registered drivers are also registered as template kinds so that environments can use them.
+func RegisterDrivers(drivers map[string]driver.Driver, configs []hostoptions.RecipeDriverOptions) error {
+	var errs error
+	for _, config := range configs {
+		d, err := NewDriverFromConfig(config)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		if _, ok := drivers[config.Kind]; ok {
+			errs = errors.Join(errs, fmt.Errorf("a recipe driver is already registered for kind %q", config.Kind))
+			continue
+		}
+
+		drivers[config.Kind] = d
+		recipes.RegisterTemplateKind(config.Kind)
+	}
+
+	return errs
+}
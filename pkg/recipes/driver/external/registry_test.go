@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_RegisterDrivers(t *testing.T) {
+	builtIn := func(t *testing.T) map[string]driver.Driver {
+		mctrl := gomock.NewController(t)
+		return map[string]driver.Driver{
+			recipes.TemplateKindBicep:     driver.NewMockDriver(mctrl),
+			recipes.TemplateKindTerraform: driver.NewMockDriver(mctrl),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		drivers := builtIn(t)
+		err := RegisterDrivers(drivers, []hostoptions.RecipeDriverOptions{
+			{Kind: "pulumi", Socket: "/var/run/radius/pulumi.sock", Timeout: "30m"},
+			{Kind: "crossplane", Socket: "/var/run/radius/crossplane.sock"},
+		})
+		require.NoError(t, err)
+		require.Len(t, drivers, 4)
+		require.Contains(t, drivers, "pulumi")
+		require.Contains(t, drivers, "crossplane")
+		require.True(t, recipes.IsSupportedTemplateKind("pulumi"))
+		require.True(t, recipes.IsSupportedTemplateKind("crossplane"))
+	})
+
+	t.Run("built-in kind", func(t *testing.T) {
+		drivers := builtIn(t)
+		err := RegisterDrivers(drivers, []hostoptions.RecipeDriverOptions{
+			{Kind: recipes.TemplateKindBicep, Socket: "/var/run/radius/bicep.sock"},
+		})
+		require.EqualError(t, err, `a recipe driver is already registered for kind "bicep"`)
+		require.Len(t, drivers, 2)
+	})
+
+	t.Run("duplicate kind", func(t *testing.T) {
+		drivers := builtIn(t)
+		err := RegisterDrivers(drivers, []hostoptions.RecipeDriverOptions{
+			{Kind: "pulumi", Socket: "/var/run/radius/pulumi.sock"},
+			{Kind: "pulumi", Socket: "/var/run/radius/pulumi2.sock"},
+		})
+		require.EqualError(t, err, `a recipe driver is already registered for kind "pulumi"`)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		drivers := builtIn(t)
+		err := RegisterDrivers(drivers, []hostoptions.RecipeDriverOptions{
+			{Socket: "/var/run/radius/pulumi.sock"},
+			{Kind: "pulumi"},
+			{Kind: "crossplane", Socket: "/var/run/radius/crossplane.sock", Timeout: "soon"},
+		})
+		require.ErrorContains(t, err, "recipe driver kind must not be empty")
+		require.ErrorContains(t, err, `recipe driver "pulumi" must specify a socket`)
+		require.ErrorContains(t, err, `recipe driver "crossplane" has an invalid timeout`)
+		require.Len(t, drivers, 2)
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sample implements a minimal recipe driver that is used to demonstrate and test the external recipe
+// driver protocol. The driver does not deploy anything: executing a recipe returns the recipe parameters as
+// output values.
+package sample
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+const (
+	// Kind is the recipe kind served by the sample driver.
+	Kind = "sample"
+
+	// TemplatePrefix is the prefix required on the template path of recipes run by the sample driver.
+	TemplatePrefix = "sample://"
+)
+
+var _ driver.DriverWithSecrets = (*sampleDriver)(nil)
+
+type sampleDriver struct{}
+
+// NewDriver creates a new instance of the sample recipe driver.
+func NewDriver() driver.DriverWithSecrets {
+	return &sampleDriver{}
+}
+
+// Execute returns the merged environment and resource parameters of the recipe as output values.
+func (d *sampleDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	if err := validate(opts.Definition); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), util.RecipeSetupError)
+	}
+
+	values := map[string]any{}
+	maps.Copy(values, opts.Definition.Parameters)
+	maps.Copy(values, opts.Recipe.Parameters)
+
+	return &recipes.RecipeOutput{
+		Resources: []string{},
+		Secrets:   map[string]any{},
+		Values:    values,
+	}, nil
+}
+
+// Delete is a no-op because the sample driver does not deploy any resources.
+func (d *sampleDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	return nil
+}
+
+// GetRecipeMetadata describes each environment parameter of the recipe as a parameter of the recipe template.
+func (d *sampleDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	if err := validate(opts.Definition); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), "")
+	}
+
+	parameters := map[string]any{}
+	for name, value := range opts.Definition.Parameters {
+		parameters[name] = map[string]any{"defaultValue": value}
+	}
+
+	return map[string]any{"parameters": parameters}, nil
+}
+
+// Plan returns an empty plan because the sample driver does not deploy any resources.
+func (d *sampleDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	if err := validate(opts.Definition); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), util.RecipeSetupError)
+	}
+
+	return &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{},
+		Drift:   []recipes.ResourceChange{},
+	}, nil
+}
+
+// FindSecretIDs returns no secret IDs because the sample driver does not require secrets.
+func (d *sampleDriver) FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (map[string][]string, error) {
+	return map[string][]string{}, nil
+}
+
+func validate(definition recipes.EnvironmentDefinition) error {
+	if !strings.HasPrefix(definition.TemplatePath, TemplatePrefix) {
+		return fmt.Errorf("template path %q of recipe %q must start with %q", definition.TemplatePath, definition.Name, TemplatePrefix)
+	}
+
+	return nil
+}
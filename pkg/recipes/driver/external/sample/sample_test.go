@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sample
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/driver/external"
+	"github.com/radius-project/radius/pkg/recipes/driver/external/conformance"
+	"github.com/stretchr/testify/require"
+)
+
+var fixture = conformance.Fixture{
+	Recipe: recipes.ResourceMetadata{
+		Name:       "default",
+		ResourceID: "/planes/radius/local/resourceGroups/test/providers/Applications.Datastores/redisCaches/redis",
+		Parameters: map[string]any{"port": float64(6380)},
+	},
+	Definition: recipes.EnvironmentDefinition{
+		Name:         "default",
+		Driver:       Kind,
+		ResourceType: "Applications.Datastores/redisCaches",
+		TemplatePath: TemplatePrefix + "redis",
+		Parameters:   map[string]any{"host": "redis", "port": float64(6379)},
+	},
+	InvalidDefinition: recipes.EnvironmentDefinition{
+		Name:         "default",
+		Driver:       Kind,
+		ResourceType: "Applications.Datastores/redisCaches",
+		TemplatePath: "https://example.com/redis",
+	},
+}
+
+func Test_Conformance(t *testing.T) {
+	t.Run("in process", func(t *testing.T) {
+		conformance.Run(t, NewDriver(), fixture)
+	})
+
+	t.Run("out of process", func(t *testing.T) {
+		conformance.Run(t, serve(t), fixture)
+	})
+}
+
+func Test_Execute(t *testing.T) {
+	output, err := serve(t).Execute(context.Background(), driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{Recipe: fixture.Recipe, Definition: fixture.Definition},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"host": "redis", "port": float64(6380)}, output.Values)
+}
+
+// serve runs the sample driver on a temporary unix socket and returns a client connected to it.
+func serve(t *testing.T) driver.DriverWithSecrets {
+	dir, err := os.MkdirTemp("", "sampledriver")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "driver.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- external.Serve(ctx, socket, NewDriver())
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return external.NewDriver(external.Options{Kind: Kind, Socket: socket})
+}
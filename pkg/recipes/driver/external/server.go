@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// NewHandler creates an http.Handler that serves the recipe driver protocol by calling d. FindSecretIDs
+// reports that no secrets are required unless d also implements driver.DriverWithSecrets.
+func NewHandler(d driver.Driver) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST "+ExecutePath, func(w http.ResponseWriter, r *http.Request) {
+		request := ExecuteRequest{}
+		if !decodeRequest(w, r, &request) {
+			return
+		}
+
+		output, err := d.Execute(r.Context(), request.Options)
+		if err != nil {
+			writeError(w, err, recipes.RecipeDeploymentFailed, util.ExecutionError)
+			return
+		}
+
+		writeResponse(w, http.StatusOK, &ExecuteResponse{Output: output})
+	})
+
+	mux.HandleFunc("POST "+DeletePath, func(w http.ResponseWriter, r *http.Request) {
+		request := DeleteRequest{}
+		if !decodeRequest(w, r, &request) {
+			return
+		}
+
+		if err := d.Delete(r.Context(), request.Options); err != nil {
+			writeError(w, err, recipes.RecipeDeletionFailed, "")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST "+GetRecipeMetadataPath, func(w http.ResponseWriter, r *http.Request) {
+		request := GetRecipeMetadataRequest{}
+		if !decodeRequest(w, r, &request) {
+			return
+		}
+
+		metadata, err := d.GetRecipeMetadata(r.Context(), request.Options)
+		if err != nil {
+			writeError(w, err, recipes.RecipeGetMetadataFailed, "")
+			return
+		}
+
+		writeResponse(w, http.StatusOK, &GetRecipeMetadataResponse{Metadata: metadata})
+	})
+
+	mux.HandleFunc("POST "+PlanPath, func(w http.ResponseWriter, r *http.Request) {
+		request := PlanRequest{}
+		if !decodeRequest(w, r, &request) {
+			return
+		}
+
+		plan, err := d.Plan(r.Context(), request.Options)
+		if err != nil {
+			writeError(w, err, recipes.RecipePlanFailed, util.ExecutionError)
+			return
+		}
+
+		writeResponse(w, http.StatusOK, &PlanResponse{Plan: plan})
+	})
+
+	mux.HandleFunc("POST "+FindSecretIDsPath, func(w http.ResponseWriter, r *http.Request) {
+		request := FindSecretIDsRequest{}
+		if !decodeRequest(w, r, &request) {
+			return
+		}
+
+		secretIDs := map[string][]string{}
+		if ds, ok := d.(driver.DriverWithSecrets); ok {
+			var err error
+			secretIDs, err = ds.FindSecretIDs(r.Context(), request.Configuration, request.Definition)
+			if err != nil {
+				writeError(w, err, recipes.LoadSecretsFailed, util.RecipeSetupError)
+				return
+			}
+		}
+
+		writeResponse(w, http.StatusOK, &FindSecretIDsResponse{SecretIDs: secretIDs})
+	})
+
+	return mux
+}
+
+// Serve listens on the unix domain socket at socket and serves the recipe driver protocol for d until ctx is
+// canceled. A stale socket file left behind by a previous run is removed before listening.
+func Serve(ctx context.Context, socket string, d driver.Driver) error {
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove existing socket %q: %w", socket, err)
+	}
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on socket %q: %w", socket, err)
+	}
+
+	server := &http.Server{
+		Handler:     NewHandler(d),
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	err = server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request, request any) bool {
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeResponse(w, http.StatusBadRequest, &ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("failed to decode request: %s", err.Error()),
+			},
+		})
+		return false
+	}
+
+	return true
+}
+
+// writeError writes err as an ErrorResponse. Errors that are not a RecipeError are reported with the given code
+// and deployment status.
+func writeError(w http.ResponseWriter, err error, code string, status util.RecipeDeploymentStatus) {
+	recipeError := &recipes.RecipeError{}
+	if !errors.As(err, &recipeError) {
+		recipeError = recipes.NewRecipeError(code, err.Error(), status, recipes.GetErrorDetails(err))
+	}
+
+	writeResponse(w, http.StatusInternalServerError, &ErrorResponse{
+		Error:            recipeError.ErrorDetails,
+		DeploymentStatus: recipeError.DeploymentStatus,
+	})
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmStorageDriver stores release information in Kubernetes secrets, matching the Helm CLI default.
+	helmStorageDriver = "secret"
+
+	// operationTimeout is the maximum time to wait for a release to be installed, upgraded or uninstalled.
+	operationTimeout = 10 * time.Minute
+)
+
+// chartReference identifies a chart in an OCI registry or a chart repository.
+type chartReference struct {
+	// Chart is the chart reference. For OCI registries this is the full oci:// reference without a tag,
+	// for chart repositories this is the name of the chart in the repository.
+	Chart string
+
+	// RepoURL is the URL of the chart repository. Empty for OCI registries.
+	RepoURL string
+
+	// Version is the chart version. Empty to use the latest version.
+	Version string
+
+	// PlainHTTP allows insecure connections to the OCI registry.
+	PlainHTTP bool
+}
+
+// helmClient performs the Helm operations used by the driver.
+type helmClient interface {
+	// LoadChart downloads the chart identified by ref and loads it.
+	LoadChart(ctx context.Context, ref chartReference) (*chart.Chart, error)
+
+	// Apply installs the chart as a new release in the namespace, or upgrades the release if it is already installed.
+	Apply(ctx context.Context, namespace string, releaseName string, helmChart *chart.Chart, values map[string]any) (*release.Release, error)
+
+	// Uninstall removes the release from the namespace. Uninstalling a release that does not exist is not an error.
+	Uninstall(ctx context.Context, namespace string, releaseName string) error
+}
+
+var _ helmClient = (*sdkClient)(nil)
+
+// sdkClient implements helmClient using the Helm Go SDK.
+type sdkClient struct {
+	config *rest.Config
+
+	// actionConfig creates the Helm action configuration for a namespace. When nil, the configuration uses config to
+	// connect to Kubernetes and stores releases in Kubernetes secrets.
+	actionConfig func(ctx context.Context, namespace string) (*action.Configuration, error)
+}
+
+// LoadChart pulls the chart into a temporary directory and loads it. The repository index and configuration are
+// also kept in the temporary directory, so every chart is pulled from its source.
+func (c *sdkClient) LoadChart(ctx context.Context, ref chartReference) (*chart.Chart, error) {
+	registryOptions := []registry.ClientOption{}
+	if ref.PlainHTTP {
+		registryOptions = append(registryOptions, registry.ClientOptPlainHTTP())
+	}
+
+	registryClient, err := registry.NewClient(registryOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "helm-recipe")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory to download the chart: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	chartDir := filepath.Join(dir, "chart")
+	if err := os.Mkdir(chartDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create directory to download the chart: %w", err)
+	}
+
+	pull := action.NewPullWithOpts(action.WithConfig(&action.Configuration{RegistryClient: registryClient}))
+	pull.Settings = &cli.EnvSettings{
+		RepositoryConfig: filepath.Join(dir, "repositories.yaml"),
+		RepositoryCache:  filepath.Join(dir, "cache"),
+	}
+	pull.SetRegistryClient(registryClient)
+	pull.DestDir = chartDir
+	pull.RepoURL = ref.RepoURL
+	pull.Version = ref.Version
+	pull.PlainHTTP = ref.PlainHTTP
+
+	if _, err := pull.Run(ref.Chart); err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(chartDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) != 1 {
+		return nil, fmt.Errorf("expected a single chart archive for %q, found %d files", ref.Chart, len(files))
+	}
+
+	return loader.Load(filepath.Join(chartDir, files[0].Name()))
+}
+
+// Apply installs the release if it does not exist, and upgrades it otherwise. Both operations are atomic: a failed
+// install is purged and a failed upgrade is rolled back, so that the next attempt starts from a known state.
+func (c *sdkClient) Apply(ctx context.Context, namespace string, releaseName string, helmChart *chart.Chart, values map[string]any) (*release.Release, error) {
+	cfg, err := c.configuration(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err = history.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = releaseName
+		install.Namespace = namespace
+		install.CreateNamespace = true
+		install.Atomic = true
+		install.Timeout = operationTimeout
+
+		return install.RunWithContext(ctx, helmChart, values)
+	} else if err != nil {
+		return nil, err
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = namespace
+	upgrade.Atomic = true
+	upgrade.Timeout = operationTimeout
+
+	return upgrade.RunWithContext(ctx, releaseName, helmChart, values)
+}
+
+// Uninstall uninstalls the release and waits for its resources to be deleted.
+func (c *sdkClient) Uninstall(ctx context.Context, namespace string, releaseName string) error {
+	cfg, err := c.configuration(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.IgnoreNotFound = true
+	uninstall.Wait = true
+	uninstall.Timeout = operationTimeout
+
+	_, err = uninstall.Run(releaseName)
+	return err
+}
+
+// configuration creates the Helm action configuration for the namespace.
+func (c *sdkClient) configuration(ctx context.Context, namespace string) (*action.Configuration, error) {
+	if c.actionConfig != nil {
+		return c.actionConfig(ctx, namespace)
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(&restClientGetter{config: c.config, namespace: namespace}, namespace, helmStorageDriver, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize helm configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// restClientGetter adapts a rest.Config to the RESTClientGetter interface used by Helm, so that the driver
+// uses the same Kubernetes connection as the rest of the service instead of a kubeconfig file.
+type restClientGetter struct {
+	config    *rest.Config
+	namespace string
+}
+
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(g.config), nil
+}
+
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(g.config)
+	if err != nil {
+		return nil, err
+	}
+
+	return memory.NewMemCacheClient(client), nil
+}
+
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	client, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(client)
+	return restmapper.NewShortcutExpander(mapper, client, nil), nil
+}
+
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{},
+		&clientcmd.ConfigOverrides{Context: clientcmdapi.Context{Namespace: g.namespace}})
+}
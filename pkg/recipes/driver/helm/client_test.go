@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const testChartTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-result
+data:
+  replicas: "{{ .Values.replicas }}"
+`
+
+// newChartRepository packages the given versions of a test chart and serves them from a local chart repository.
+func newChartRepository(t *testing.T, versions ...string) string {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	t.Cleanup(server.Close)
+
+	for _, version := range versions {
+		_, err := chartutil.Save(newTestChart(version), dir)
+		require.NoError(t, err)
+	}
+
+	index, err := repo.IndexDirectory(dir, server.URL)
+	require.NoError(t, err)
+	require.NoError(t, index.WriteFile(filepath.Join(dir, "index.yaml"), 0o644))
+
+	return server.URL
+}
+
+func newTestChart(version string) *chart.Chart {
+	return &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "test-chart",
+			Version:    version,
+		},
+		Values: map[string]any{"replicas": float64(1)},
+		Raw: []*chart.File{
+			{Name: chartutil.ValuesfileName, Data: []byte("replicas: 1\n")},
+		},
+		Templates: []*chart.File{
+			{Name: "templates/configmap.yaml", Data: []byte(testChartTemplate)},
+		},
+	}
+}
+
+// newTestClient creates a client that stores releases in memory and doesn't connect to Kubernetes.
+func newTestClient() (*sdkClient, *action.Configuration) {
+	cfg := &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(format string, v ...any) {},
+	}
+
+	return &sdkClient{
+		actionConfig: func(ctx context.Context, namespace string) (*action.Configuration, error) {
+			return cfg, nil
+		},
+	}, cfg
+}
+
+func Test_SDKClient_LoadChart(t *testing.T) {
+	repoURL := newChartRepository(t, "0.1.0", "0.2.0")
+	client := &sdkClient{}
+
+	t.Run("version", func(t *testing.T) {
+		loaded, err := client.LoadChart(testcontext.New(t), chartReference{Chart: "test-chart", RepoURL: repoURL, Version: "0.1.0"})
+		require.NoError(t, err)
+		require.Equal(t, "test-chart", loaded.Metadata.Name)
+		require.Equal(t, "0.1.0", loaded.Metadata.Version)
+		require.Equal(t, map[string]any{"replicas": float64(1)}, loaded.Values)
+		require.Len(t, loaded.Templates, 1)
+	})
+
+	t.Run("latest version", func(t *testing.T) {
+		loaded, err := client.LoadChart(testcontext.New(t), chartReference{Chart: "test-chart", RepoURL: repoURL})
+		require.NoError(t, err)
+		require.Equal(t, "0.2.0", loaded.Metadata.Version)
+	})
+
+	t.Run("version not found", func(t *testing.T) {
+		_, err := client.LoadChart(testcontext.New(t), chartReference{Chart: "test-chart", RepoURL: repoURL, Version: "1.0.0"})
+		require.ErrorContains(t, err, "test-chart")
+	})
+
+	t.Run("chart not found", func(t *testing.T) {
+		_, err := client.LoadChart(testcontext.New(t), chartReference{Chart: "other-chart", RepoURL: repoURL})
+		require.ErrorContains(t, err, "other-chart")
+	})
+}
+
+func Test_SDKClient_Apply_Uninstall(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, cfg := newTestClient()
+	testChart := newTestChart("0.1.0")
+
+	// The first apply installs the release.
+	rel, err := client.Apply(ctx, "test-namespace", "test-release", testChart, map[string]any{"replicas": 2})
+	require.NoError(t, err)
+	require.Equal(t, 1, rel.Version)
+	require.Equal(t, release.StatusDeployed, rel.Info.Status)
+	require.Equal(t, "test-namespace", rel.Namespace)
+	require.Contains(t, rel.Manifest, "name: test-release-result")
+	require.Contains(t, rel.Manifest, `replicas: "2"`)
+
+	// The second apply upgrades the release.
+	rel, err = client.Apply(ctx, "test-namespace", "test-release", testChart, map[string]any{"replicas": 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, rel.Version)
+	require.Equal(t, release.StatusDeployed, rel.Info.Status)
+	require.Contains(t, rel.Manifest, `replicas: "3"`)
+
+	err = client.Uninstall(ctx, "test-namespace", "test-release")
+	require.NoError(t, err)
+
+	_, err = cfg.Releases.History("test-release")
+	require.ErrorIs(t, err, driver.ErrReleaseNotFound)
+
+	// Uninstalling a release that doesn't exist is not an error.
+	err = client.Uninstall(ctx, "test-namespace", "test-release")
+	require.NoError(t, err)
+}
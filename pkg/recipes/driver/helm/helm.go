@@ -0,0 +1,407 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// OutputsNameSuffix is appended to the release name to form the name of the ConfigMap and Secret the chart
+	// can create to return recipe outputs, for example "{{ .Release.Name }}-result". Each key of the ConfigMap
+	// becomes a recipe output value and each key of the Secret becomes a recipe output secret.
+	OutputsNameSuffix = "-" + recipes.ResultPropertyName
+
+	// maxReleaseNameLength is the maximum length of a Helm release name.
+	maxReleaseNameLength = 53
+
+	// releaseNameHashLength is the number of hex characters of the resource ID hash appended to release names.
+	releaseNameHashLength = 8
+)
+
+var _ driver.Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm chart recipe.
+//
+// Charts are installed with the service account of the Radius service running the driver, so a chart can only create
+// the kinds of objects allowed by the ClusterRole of that service. Installing a chart that renders other kinds fails,
+// and the operator must extend the ClusterRole to use such charts as recipes.
+func NewHelmDriver(kubernetesClients *kubernetesclientprovider.KubernetesClientProvider) driver.Driver {
+	return &helmDriver{
+		helmClient:        &sdkClient{config: kubernetesClients.Config()},
+		kubernetesClients: kubernetesClients,
+	}
+}
+
+// helmDriver represents a driver to interact with Helm chart recipes - install or upgrade the release, uninstall it, etc.
+type helmDriver struct {
+	// helmClient is used to pull charts and manage releases.
+	helmClient helmClient
+
+	// kubernetesClients is used to read the recipe outputs from the cluster.
+	kubernetesClients *kubernetesclientprovider.KubernetesClientProvider
+}
+
+// Execute installs the chart referenced by the recipe template path into the recipe's Kubernetes namespace, or upgrades
+// the release if it is already installed. The recipe context and parameters are passed as values, the objects rendered by
+// the chart are returned as output resources, and the outputs are read from the ConfigMap and Secret named after the release.
+func (d *helmDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace, err := recipeNamespace(opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	releaseName, err := releaseNameFor(opts.Recipe.ResourceID)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	ref, err := parseChartReference(opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.helmClient.LoadChart(ctx, ref)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	recipeContext.Resource.Connections = opts.Recipe.ConnectedResourcesProperties
+
+	values, err := createValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	logger.Info(fmt.Sprintf("Deploying helm recipe: %q, chart: %q, release: %q, namespace: %q", opts.Recipe.Name, opts.Definition.TemplatePath, releaseName, namespace))
+	rel, err := d.helmClient.Apply(ctx, namespace, releaseName, helmChart, values)
+	if apierrors.IsForbidden(err) {
+		err = fmt.Errorf("%w. The chart creates objects that Radius is not allowed to manage; the ClusterRole of the Radius service must allow them", err)
+	}
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s: %s", opts.Recipe.Name, opts.Definition.ResourceType, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(ctx, opts.Definition, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeResponse, nil
+}
+
+// Delete uninstalls the release created for the recipe. The output resources are owned by the release, so they are
+// deleted by Helm rather than individually.
+func (d *helmDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace, err := recipeNamespace(opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	releaseName, err := releaseNameFor(opts.Recipe.ResourceID)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	logger.Info(fmt.Sprintf("Uninstalling helm release: %q, namespace: %q", releaseName, namespace))
+	if err := d.helmClient.Uninstall(ctx, namespace, releaseName); err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// Plan is not supported for Helm recipes.
+func (d *helmDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	err := fmt.Errorf("plan is not supported for %s recipes", recipes.TemplateKindHelm)
+	return nil, recipes.NewRecipeError(recipes.RecipePlanNotSupported, err.Error(), recipes_util.RecipeSetupError)
+}
+
+// GetRecipeMetadata returns the top-level values of the chart and their defaults as the recipe parameters.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	ref, err := parseChartReference(opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.helmClient.LoadChart(ctx, ref)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return chartParameters(helmChart), nil
+}
+
+// prepareRecipeResponse populates the recipe response from the objects rendered by the release and the outputs
+// stored in the ConfigMap and Secret named after the release.
+func (d *helmDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, rel *release.Release) (*recipes.RecipeOutput, error) {
+	deployedResources, err := outputResources(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: deployedResources,
+		Secrets:   map[string]any{},
+		Values:    map[string]any{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    definition.TemplatePath,
+			TemplateVersion: definition.TemplateVersion,
+		},
+	}
+
+	client, err := d.kubernetesClients.ClientGoClient()
+	if err != nil {
+		return nil, err
+	}
+
+	outputsName := rel.Name + OutputsNameSuffix
+	configMap, err := client.CoreV1().ConfigMaps(rel.Namespace).Get(ctx, outputsName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil {
+		for k, v := range configMap.Data {
+			recipeResponse.Values[k] = outputValue(v)
+		}
+	}
+
+	secret, err := client.CoreV1().Secrets(rel.Namespace).Get(ctx, outputsName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil {
+		for k, v := range secret.Data {
+			recipeResponse.Secrets[k] = string(v)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// outputResources returns the UCP resource IDs of the objects rendered by the release, sorted for a stable result.
+// Objects without a namespace are assumed to be in the release namespace, which is where Helm creates them.
+func outputResources(rel *release.Release) ([]string, error) {
+	ids := []string{}
+	for _, manifest := range releaseutil.SplitManifests(rel.Manifest) {
+		obj := metav1.PartialObjectMetadata{}
+		if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse manifest of release %q: %w", rel.Name, err)
+		}
+
+		if obj.Kind == "" || obj.Name == "" {
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse apiVersion of %s %q: %w", obj.Kind, obj.Name, err)
+		}
+
+		namespace := obj.Namespace
+		if namespace == "" {
+			namespace = rel.Namespace
+		}
+
+		ids = append(ids, resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, gv.Group, obj.Kind, namespace, obj.Name).String())
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// outputValue decodes a ConfigMap value as JSON so that charts can return numbers, booleans and objects.
+// Values that are not valid JSON are returned as strings.
+func outputValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+// createValues creates the values passed to the chart after handling conflicts in parameters set by operator and developer.
+// In case of conflict the developer parameter takes precedence. The recipe context is passed as the "context" value.
+func createValues(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range operatorParams {
+		values[k] = v
+	}
+	for k, v := range devParams {
+		values[k] = v
+	}
+
+	// Helm merges values with the chart defaults and renders templates using their JSON representation, so the
+	// recipe context needs to be converted to plain maps.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, nil
+}
+
+// chartParameters returns the recipe parameters in the format used by the other drivers:
+//
+//	{
+//		"parameters": {
+//			<value-name>: {
+//				"type": <value-type>,
+//				"defaultValue": <default-value>
+//			}
+//		}
+//	}
+func chartParameters(helmChart *chart.Chart) map[string]any {
+	parameters := map[string]any{}
+	for k, v := range helmChart.Values {
+		parameters[k] = map[string]any{
+			"type":         valueType(v),
+			"defaultValue": v,
+		}
+	}
+
+	return map[string]any{"parameters": parameters}
+}
+
+func valueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int64, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
+
+// parseChartReference parses the recipe template path. Two formats are supported:
+//
+//   - An OCI reference, for example "oci://ghcr.io/myregistry/charts/redis:1.2.0". The tag is the chart version.
+//   - A chart repository URL followed by the chart name, for example "https://charts.example.com/stable/redis".
+//
+// The template version takes precedence over the tag of an OCI reference when both are set.
+func parseChartReference(definition recipes.EnvironmentDefinition) (chartReference, error) {
+	templatePath := strings.TrimSpace(definition.TemplatePath)
+	ref := chartReference{
+		Version:   definition.TemplateVersion,
+		PlainHTTP: definition.PlainHTTP,
+	}
+
+	if registry.IsOCI(templatePath) {
+		ref.Chart = templatePath
+		lastSegment := templatePath[strings.LastIndex(templatePath, "/")+1:]
+		if name, tag, found := strings.Cut(lastSegment, ":"); found {
+			ref.Chart = strings.TrimSuffix(templatePath, ":"+tag)
+			if name == "" || tag == "" {
+				return chartReference{}, fmt.Errorf("invalid chart reference %q", templatePath)
+			}
+
+			if ref.Version == "" {
+				ref.Version = tag
+			}
+		}
+
+		return ref, nil
+	}
+
+	if strings.HasPrefix(templatePath, "https://") || strings.HasPrefix(templatePath, "http://") {
+		index := strings.LastIndex(templatePath, "/")
+		ref.RepoURL = templatePath[:index]
+		ref.Chart = templatePath[index+1:]
+		if ref.Chart == "" || strings.HasSuffix(ref.RepoURL, "/") {
+			return chartReference{}, fmt.Errorf("invalid chart reference %q, expected the chart repository URL followed by the chart name", templatePath)
+		}
+
+		return ref, nil
+	}
+
+	return chartReference{}, fmt.Errorf("invalid chart reference %q, expected an OCI reference (%s) or a chart repository URL followed by the chart name", templatePath, registry.OCIScheme+"://")
+}
+
+// recipeNamespace returns the Kubernetes namespace the recipe is deployed to.
+func recipeNamespace(configuration recipes.Configuration) (string, error) {
+	if configuration.Runtime.Kubernetes == nil || configuration.Runtime.Kubernetes.Namespace == "" {
+		return "", errors.New("helm recipes require a Kubernetes namespace in the environment compute configuration")
+	}
+
+	return configuration.Runtime.Kubernetes.Namespace, nil
+}
+
+// releaseNameFor returns the name of the release for the resource. The name combines the resource name with a hash
+// of the resource ID so that resources of different types with the same name do not share a release.
+func releaseNameFor(resourceID string) (string, error) {
+	parsed, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	suffix := "-" + hex.EncodeToString(hash[:])[:releaseNameHashLength]
+
+	name := strings.ToLower(parsed.Name())
+	if len(name) > maxReleaseNameLength-len(suffix) {
+		name = strings.TrimRight(name[:maxReleaseNameLength-len(suffix)], "-.")
+	}
+
+	return name + suffix, nil
+}
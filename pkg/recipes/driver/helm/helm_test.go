@@ -0,0 +1,344 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testNamespace  = "default-app"
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	testManifest   = `---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+  namespace: other
+`
+)
+
+type fakeHelmClient struct {
+	chart    *chart.Chart
+	err      error
+	applyErr error
+
+	loaded      chartReference
+	namespace   string
+	releaseName string
+	values      map[string]any
+	uninstalled bool
+}
+
+func (c *fakeHelmClient) LoadChart(ctx context.Context, ref chartReference) (*chart.Chart, error) {
+	c.loaded = ref
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.chart, nil
+}
+
+func (c *fakeHelmClient) Apply(ctx context.Context, namespace string, releaseName string, helmChart *chart.Chart, values map[string]any) (*release.Release, error) {
+	c.namespace = namespace
+	c.releaseName = releaseName
+	c.values = values
+	if c.applyErr != nil {
+		return nil, c.applyErr
+	}
+
+	return &release.Release{Name: releaseName, Namespace: namespace, Chart: helmChart, Manifest: testManifest}, nil
+}
+
+func (c *fakeHelmClient) Uninstall(ctx context.Context, namespace string, releaseName string) error {
+	c.namespace = namespace
+	c.releaseName = releaseName
+	c.uninstalled = true
+	return c.err
+}
+
+func setup(t *testing.T, objects ...*corev1.Secret) (*fakeHelmClient, *helmDriver) {
+	releaseName, err := releaseNameFor(testResourceID)
+	require.NoError(t, err)
+
+	clientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: releaseName + OutputsNameSuffix, Namespace: testNamespace},
+		Data:       map[string]string{"host": "redis.default-app.svc.cluster.local", "port": "6379"},
+	})
+	for _, obj := range objects {
+		_, err := clientset.CoreV1().Secrets(obj.Namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	kubernetesClients := kubernetesclientprovider.FromConfig(nil)
+	kubernetesClients.SetClientGoClient(clientset)
+
+	client := &fakeHelmClient{chart: &chart.Chart{Values: map[string]any{"replicas": float64(1)}}}
+	return client, &helmDriver{helmClient: client, kubernetesClients: kubernetesClients}
+}
+
+func buildTestOptions() driver.BaseOptions {
+	return driver.BaseOptions{
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{Namespace: testNamespace, EnvironmentNamespace: "default"},
+			},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			ResourceID:    testResourceID,
+			Parameters:    map[string]any{"replicas": 3},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:            "default",
+			Driver:          recipes.TemplateKindHelm,
+			ResourceType:    "Applications.Datastores/redisCaches",
+			TemplatePath:    "oci://ghcr.io/radius-project/charts/redis",
+			TemplateVersion: "1.0.0",
+			Parameters:      map[string]any{"replicas": 2, "tls": true},
+		},
+	}
+}
+
+func TestHelmDriver_Execute(t *testing.T) {
+	releaseName, err := releaseNameFor(testResourceID)
+	require.NoError(t, err)
+
+	client, d := setup(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: releaseName + OutputsNameSuffix, Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+
+	output, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: buildTestOptions()})
+	require.NoError(t, err)
+
+	require.Equal(t, chartReference{Chart: "oci://ghcr.io/radius-project/charts/redis", Version: "1.0.0"}, client.loaded)
+	require.Equal(t, testNamespace, client.namespace)
+	require.Equal(t, releaseName, client.releaseName)
+	require.Equal(t, 3, client.values["replicas"])
+	require.Equal(t, true, client.values["tls"])
+	recipeContext := client.values["context"].(map[string]any)
+	require.Equal(t, "redis", recipeContext["resource"].(map[string]any)["name"])
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/default-app/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/other/providers/apps/Deployment/redis",
+		},
+		Values:  map[string]any{"host": "redis.default-app.svc.cluster.local", "port": float64(6379)},
+		Secrets: map[string]any{"password": "secret"},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://ghcr.io/radius-project/charts/redis",
+			TemplateVersion: "1.0.0",
+		},
+	}
+	require.Equal(t, expected, output)
+}
+
+func TestHelmDriver_Execute_Errors(t *testing.T) {
+	t.Run("missing namespace", func(t *testing.T) {
+		_, d := setup(t)
+		opts := buildTestOptions()
+		opts.Configuration.Runtime.Kubernetes = nil
+
+		_, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: opts})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	})
+
+	t.Run("invalid template path", func(t *testing.T) {
+		_, d := setup(t)
+		opts := buildTestOptions()
+		opts.Definition.TemplatePath = "ghcr.io/radius-project/charts/redis"
+
+		_, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: opts})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+	})
+
+	t.Run("install failure", func(t *testing.T) {
+		client, d := setup(t)
+		client.applyErr = errors.New("timed out waiting for the condition")
+
+		_, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: buildTestOptions()})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Equal(t, recipes_util.ExecutionError, recipeError.DeploymentStatus)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		client, d := setup(t)
+		client.applyErr = fmt.Errorf("failed to create resource: %w", apierrors.NewForbidden(schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}, "redis", errors.New("access denied")))
+
+		_, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: buildTestOptions()})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Contains(t, recipeError.ErrorDetails.Message, "the ClusterRole of the Radius service must allow them")
+	})
+
+	t.Run("chart download failure", func(t *testing.T) {
+		client, d := setup(t)
+		client.err = errors.New("not found")
+
+		_, err := d.Execute(testcontext.New(t), driver.ExecuteOptions{BaseOptions: buildTestOptions()})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func TestHelmDriver_Delete(t *testing.T) {
+	client, d := setup(t)
+
+	err := d.Delete(testcontext.New(t), driver.DeleteOptions{BaseOptions: buildTestOptions()})
+	require.NoError(t, err)
+	require.True(t, client.uninstalled)
+	require.Equal(t, testNamespace, client.namespace)
+
+	client.err = errors.New("uninstall failed")
+	err = d.Delete(testcontext.New(t), driver.DeleteOptions{BaseOptions: buildTestOptions()})
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+}
+
+func TestHelmDriver_Plan(t *testing.T) {
+	_, d := setup(t)
+
+	_, err := d.Plan(testcontext.New(t), driver.ExecuteOptions{BaseOptions: buildTestOptions()})
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipePlanNotSupported, recipeError.ErrorDetails.Code)
+}
+
+func TestHelmDriver_GetRecipeMetadata(t *testing.T) {
+	_, d := setup(t)
+
+	metadata, err := d.GetRecipeMetadata(testcontext.New(t), buildTestOptions())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+		},
+	}, metadata)
+}
+
+func Test_ParseChartReference(t *testing.T) {
+	tests := []struct {
+		name            string
+		templatePath    string
+		templateVersion string
+		expected        chartReference
+		err             string
+	}{
+		{
+			name:         "oci without tag",
+			templatePath: "oci://ghcr.io/charts/redis",
+			expected:     chartReference{Chart: "oci://ghcr.io/charts/redis"},
+		},
+		{
+			name:         "oci with tag",
+			templatePath: "oci://localhost:5000/charts/redis:1.2.0",
+			expected:     chartReference{Chart: "oci://localhost:5000/charts/redis", Version: "1.2.0"},
+		},
+		{
+			name:            "template version takes precedence over tag",
+			templatePath:    "oci://ghcr.io/charts/redis:1.2.0",
+			templateVersion: "2.0.0",
+			expected:        chartReference{Chart: "oci://ghcr.io/charts/redis", Version: "2.0.0"},
+		},
+		{
+			name:            "chart repository",
+			templatePath:    "https://charts.example.com/stable/redis",
+			templateVersion: "1.0.0",
+			expected:        chartReference{Chart: "redis", RepoURL: "https://charts.example.com/stable", Version: "1.0.0"},
+		},
+		{
+			name:         "chart repository without chart name",
+			templatePath: "https://charts.example.com/stable/",
+			err:          "invalid chart reference \"https://charts.example.com/stable/\", expected the chart repository URL followed by the chart name",
+		},
+		{
+			name:         "unsupported scheme",
+			templatePath: "ghcr.io/charts/redis",
+			err:          "invalid chart reference \"ghcr.io/charts/redis\", expected an OCI reference (oci://) or a chart repository URL followed by the chart name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := parseChartReference(recipes.EnvironmentDefinition{TemplatePath: tc.templatePath, TemplateVersion: tc.templateVersion})
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ref)
+		})
+	}
+}
+
+func Test_ReleaseNameFor(t *testing.T) {
+	name, err := releaseNameFor(testResourceID)
+	require.NoError(t, err)
+	require.Regexp(t, "^redis-[0-9a-f]{8}$", name)
+
+	other, err := releaseNameFor("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/redis")
+	require.NoError(t, err)
+	require.NotEqual(t, name, other)
+
+	long, err := releaseNameFor("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + "a-very-long-resource-name-that-exceeds-the-helm-release-name-limit")
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(long), maxReleaseNameLength)
+
+	_, err = releaseNameFor("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// sensitiveValue replaces values marked as sensitive in the Terraform plan.
+	sensitiveValue = "(sensitive value)"
+
+	// unknownValue replaces values that Terraform will only know after the plan is applied.
+	unknownValue = "(known after apply)"
+)
+
+// newRecipePlan converts a Terraform JSON plan into the recipe plan returned by the driver.
+func newRecipePlan(plan *tfjson.Plan) *recipes.RecipePlan {
+	result := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{},
+		Drift:   []recipes.ResourceChange{},
+	}
+
+	if plan == nil {
+		return result
+	}
+
+	for _, rc := range plan.ResourceChanges {
+		if change, ok := newResourceChange(rc); ok {
+			result.Changes = append(result.Changes, change)
+		}
+	}
+
+	for _, rc := range plan.ResourceDrift {
+		if change, ok := newResourceChange(rc); ok {
+			result.Drift = append(result.Drift, change)
+		}
+	}
+
+	return result
+}
+
+// newResourceChange converts a Terraform resource change into a recipe resource change. Sensitive values are redacted
+// and values that are unknown until apply are replaced with a placeholder.
+func newResourceChange(rc *tfjson.ResourceChange) (recipes.ResourceChange, bool) {
+	if rc == nil || rc.Change == nil {
+		return recipes.ResourceChange{}, false
+	}
+
+	before, _ := redactValue(rc.Change.Before, rc.Change.BeforeSensitive).(map[string]any)
+	after, _ := redactValue(markUnknown(rc.Change.After, rc.Change.AfterUnknown), rc.Change.AfterSensitive).(map[string]any)
+
+	return recipes.ResourceChange{
+		Address:           rc.Address,
+		Type:              rc.Type,
+		Name:              rc.Name,
+		Action:            changeAction(rc.Change.Actions),
+		Before:            before,
+		After:             after,
+		ChangedProperties: changedProperties(before, after),
+	}, true
+}
+
+// changeAction maps the Terraform actions for a resource to a single recipe resource change action.
+func changeAction(actions tfjson.Actions) string {
+	switch {
+	case actions.NoOp():
+		return recipes.ResourceChangeActionNoOp
+	case actions.Create():
+		return recipes.ResourceChangeActionCreate
+	case actions.Read():
+		return recipes.ResourceChangeActionRead
+	case actions.Update():
+		return recipes.ResourceChangeActionUpdate
+	case actions.Delete():
+		return recipes.ResourceChangeActionDelete
+	case actions.Replace():
+		return recipes.ResourceChangeActionReplace
+	}
+
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, string(action))
+	}
+	return strings.Join(names, "-")
+}
+
+// redactValue replaces the parts of value that are marked as sensitive. Terraform describes sensitive values
+// using a structure that mirrors the value, where true marks a sensitive leaf.
+func redactValue(value any, sensitive any) any {
+	switch s := sensitive.(type) {
+	case bool:
+		if s {
+			return sensitiveValue
+		}
+	case map[string]any:
+		if v, ok := value.(map[string]any); ok {
+			result := make(map[string]any, len(v))
+			for key, item := range v {
+				result[key] = redactValue(item, s[key])
+			}
+			return result
+		}
+	case []any:
+		if v, ok := value.([]any); ok {
+			result := make([]any, len(v))
+			for i, item := range v {
+				var itemSensitive any
+				if i < len(s) {
+					itemSensitive = s[i]
+				}
+				result[i] = redactValue(item, itemSensitive)
+			}
+			return result
+		}
+	}
+
+	return value
+}
+
+// markUnknown replaces the parts of value that are unknown until apply with a placeholder. Terraform describes unknown
+// values using a structure that mirrors the value, where true marks an unknown leaf.
+func markUnknown(value any, unknown any) any {
+	switch u := unknown.(type) {
+	case bool:
+		if u {
+			return unknownValue
+		}
+	case map[string]any:
+		v, ok := value.(map[string]any)
+		if !ok && value != nil {
+			return value
+		}
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = item
+		}
+		for key, itemUnknown := range u {
+			if item := markUnknown(result[key], itemUnknown); item != nil {
+				result[key] = item
+			}
+		}
+		return result
+	case []any:
+		if v, ok := value.([]any); ok {
+			result := make([]any, len(v))
+			for i, item := range v {
+				if i < len(u) {
+					item = markUnknown(item, u[i])
+				}
+				result[i] = item
+			}
+			return result
+		}
+	}
+
+	return value
+}
+
+// changedProperties returns the sorted list of top-level properties whose values differ between before and after.
+func changedProperties(before, after map[string]any) []string {
+	changed := []string{}
+	for key, value := range before {
+		if !reflect.DeepEqual(value, after[key]) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewRecipePlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected *recipes.RecipePlan
+	}{
+		{
+			name:     "nil plan",
+			plan:     nil,
+			expected: &recipes.RecipePlan{Changes: []recipes.ResourceChange{}, Drift: []recipes.ResourceChange{}},
+		},
+		{
+			name: "create with sensitive and unknown values",
+			plan: &tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{
+					{
+						Address: "aws_db_instance.db",
+						Type:    "aws_db_instance",
+						Name:    "db",
+						Change: &tfjson.Change{
+							Actions:        tfjson.Actions{tfjson.ActionCreate},
+							Before:         nil,
+							After:          map[string]any{"name": "db", "password": "p@ssw0rd", "tags": map[string]any{"env": "dev"}},
+							AfterUnknown:   map[string]any{"id": true, "tags": map[string]any{"env": false}},
+							AfterSensitive: map[string]any{"password": true},
+						},
+					},
+				},
+			},
+			expected: &recipes.RecipePlan{
+				Changes: []recipes.ResourceChange{
+					{
+						Address: "aws_db_instance.db",
+						Type:    "aws_db_instance",
+						Name:    "db",
+						Action:  recipes.ResourceChangeActionCreate,
+						After: map[string]any{
+							"id":       unknownValue,
+							"name":     "db",
+							"password": sensitiveValue,
+							"tags":     map[string]any{"env": "dev"},
+						},
+						ChangedProperties: []string{"id", "name", "password", "tags"},
+					},
+				},
+				Drift: []recipes.ResourceChange{},
+			},
+		},
+		{
+			name: "replace and no-op changes with drift",
+			plan: &tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{
+					{
+						Address: "kubernetes_deployment.redis",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+							Before:  map[string]any{"replicas": float64(1), "image": "redis:6"},
+							After:   map[string]any{"replicas": float64(1), "image": "redis:7"},
+						},
+					},
+					{
+						Address: "kubernetes_service.redis",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionNoop},
+							Before:  map[string]any{"port": float64(6379)},
+							After:   map[string]any{"port": float64(6379)},
+						},
+					},
+					{
+						Address: "missing.change",
+					},
+				},
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "kubernetes_deployment.redis",
+						Change: &tfjson.Change{
+							Actions:         tfjson.Actions{tfjson.ActionUpdate},
+							Before:          map[string]any{"replicas": float64(1), "token": []any{"a", "b"}},
+							After:           map[string]any{"replicas": float64(3), "token": []any{"a", "c"}},
+							BeforeSensitive: map[string]any{"token": []any{false, true}},
+							AfterSensitive:  map[string]any{"token": []any{false, true}},
+						},
+					},
+				},
+			},
+			expected: &recipes.RecipePlan{
+				Changes: []recipes.ResourceChange{
+					{
+						Address:           "kubernetes_deployment.redis",
+						Action:            recipes.ResourceChangeActionReplace,
+						Before:            map[string]any{"replicas": float64(1), "image": "redis:6"},
+						After:             map[string]any{"replicas": float64(1), "image": "redis:7"},
+						ChangedProperties: []string{"image"},
+					},
+					{
+						Address:           "kubernetes_service.redis",
+						Action:            recipes.ResourceChangeActionNoOp,
+						Before:            map[string]any{"port": float64(6379)},
+						After:             map[string]any{"port": float64(6379)},
+						ChangedProperties: []string{},
+					},
+				},
+				Drift: []recipes.ResourceChange{
+					{
+						Address:           "kubernetes_deployment.redis",
+						Action:            recipes.ResourceChangeActionUpdate,
+						Before:            map[string]any{"replicas": float64(1), "token": []any{"a", sensitiveValue}},
+						After:             map[string]any{"replicas": float64(3), "token": []any{"a", sensitiveValue}},
+						ChangedProperties: []string{"replicas"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, newRecipePlan(tc.plan))
+		})
+	}
+}
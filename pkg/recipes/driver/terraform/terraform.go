@@ -63,6 +63,10 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution. Valid values: TRACE, DEBUG, INFO, WARN, ERROR, OFF. Default: ERROR.
 	LogLevel string
+
+	// LocalStatePath is the directory that stores the state of recipes using the "local" Terraform backend.
+	// The "local" backend is disabled when LocalStatePath is empty.
+	LocalStatePath string
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -109,6 +113,7 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		LocalStatePath:   d.options.LocalStatePath,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -163,6 +168,7 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		LocalStatePath:   d.options.LocalStatePath,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -177,6 +183,57 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 	return nil
 }
 
+// Plan creates a unique directory for each execution of terraform and runs terraform plan on the recipe using the
+// Terraform CLI through terraform-exec. It returns the changes that deploying the recipe would make and the changes
+// made to the recipe resources outside of Terraform, or an error if the plan fails.
+func (d *terraformDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Get the secret store ID associated with the git private terraform repository source.
+	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add credential information to .gitconfig for module source of type git if applicable.
+	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:          requestDirPath,
+		EnvConfig:        &opts.Configuration,
+		ResourceRecipe:   &opts.Recipe,
+		EnvRecipe:        &opts.Definition,
+		Secrets:          opts.Secrets,
+		StateLockTimeout: terraform.DefaultStateLockTimeout,
+		LogLevel:         d.options.LogLevel,
+		LocalStatePath:   d.options.LocalStatePath,
+	})
+
+	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return newRecipePlan(tfPlan), nil
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -462,6 +462,90 @@ func Test_Terraform_Delete_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "azurerm_redis_cache.redis",
+				Type:    "azurerm_redis_cache",
+				Name:    "redis",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionUpdate},
+					Before:  map[string]any{"capacity": float64(0)},
+					After:   map[string]any{"capacity": float64(1)},
+				},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				Address:           "azurerm_redis_cache.redis",
+				Type:              "azurerm_redis_cache",
+				Name:              "redis",
+				Action:            recipes.ResourceChangeActionUpdate,
+				Before:            map[string]any{"capacity": float64(0)},
+				After:             map[string]any{"capacity": float64(1)},
+				ChangedProperties: []string{"capacity"},
+			},
+		},
+		Drift: []recipes.ResourceChange{},
+	}, plan)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).
+		Return(nil, errors.New("Failed to plan terraform module"))
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "Failed to plan terraform module",
+		},
+		DeploymentStatus: "executionError",
+	}
+
+	_, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, &expErr, err)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 	d := &terraformDriver{}
 	tests := []struct {
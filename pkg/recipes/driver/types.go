@@ -35,6 +35,11 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan computes the changes that deploying the recipe would make, and the changes made to the recipe resources
+	// outside of the recipe since it was last deployed, without modifying any resources.
+	// Drivers that cannot compute a plan return a recipes.RecipeError with the RecipePlanNotSupported code.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // DriverWithSecrets is an optional interface and used when the driver needs to load secrets for recipe deployment.
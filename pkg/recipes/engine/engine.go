@@ -19,6 +19,8 @@ package engine
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/radius-project/radius/pkg/components/metrics"
@@ -50,12 +52,13 @@ type engine struct {
 
 // Execute loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
 // configuration associated with the recipe, and then executes the recipe using the driver. It returns a RecipeOutput and
-// an error if one occurs.
+// an error if one occurs. If the inputs of the recipe have not changed since the previous execution, the output of the
+// previous execution is returned without executing the recipe.
 func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState)
+	recipeOutput, definition, err := e.executeCore(ctx, opts)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
@@ -72,8 +75,9 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 
 // executeCore function is the core logic of the Execute function.
 // Any changes to the core logic of the Execute function should be made here.
-func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
+func (e *engine) executeCore(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
+	recipe := opts.Recipe
 
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
@@ -91,6 +95,18 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	fingerprint, err := recipes.Fingerprint(*definition, recipe, *configuration)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	if !opts.Force {
+		if cached := cachedRecipeOutput(opts.PreviousRecipeStatus, fingerprint); cached != nil {
+			logger.Info(fmt.Sprintf("inputs of recipe %q have not changed since the previous execution, skipping deployment", recipe.Name))
+			return cached, definition, nil
+		}
+	}
+
 	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
 	if err != nil {
 		return nil, nil, err
@@ -103,15 +119,60 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 			Definition:    *definition,
 			Secrets:       secrets,
 		},
-		PrevState: prevState,
+		PrevState: opts.PreviousState,
 	})
 	if err != nil {
 		return nil, definition, err
 	}
 
+	if res != nil {
+		cacheRecipeOutput(res, definition, fingerprint)
+	}
+
 	return res, definition, nil
 }
 
+// cachedRecipeOutput returns the output stored in the previous recipe status if it was produced by an execution
+// with the given fingerprint, or nil otherwise.
+func cachedRecipeOutput(previous *rpv1.RecipeStatus, fingerprint string) *recipes.RecipeOutput {
+	if previous == nil || previous.CachedOutput == nil || previous.Fingerprint != fingerprint {
+		return nil
+	}
+
+	status := rpv1.ResourceStatus{Recipe: previous}.DeepCopyRecipeStatus().Recipe
+	return &recipes.RecipeOutput{
+		Resources: status.CachedOutput.Resources,
+		Values:    status.CachedOutput.Values,
+		Status:    status,
+	}
+}
+
+// cacheRecipeOutput stores the fingerprint of the recipe inputs and the recipe output in the recipe status, so that
+// the next execution with the same inputs can be skipped.
+//
+// The recipe status is stored with the resource, so the output of a recipe that produces secrets is not cached.
+// Such recipes are executed again to read their secrets.
+func cacheRecipeOutput(output *recipes.RecipeOutput, definition *recipes.EnvironmentDefinition, fingerprint string) {
+	if output.Status == nil {
+		output.Status = &rpv1.RecipeStatus{
+			TemplateKind:    definition.Driver,
+			TemplatePath:    definition.TemplatePath,
+			TemplateVersion: definition.TemplateVersion,
+		}
+	}
+
+	output.Status.Fingerprint = fingerprint
+	if len(output.Secrets) > 0 {
+		output.Status.CachedOutput = nil
+		return
+	}
+
+	output.Status.CachedOutput = &rpv1.RecipeCachedOutput{
+		Resources: slices.Clone(output.Resources),
+		Values:    maps.Clone(output.Values),
+	}
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
@@ -171,6 +232,69 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 	return definition, nil
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then computes the changes deploying the recipe would make using the driver.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Nothing is ever deployed in a simulated environment, so there are no changes to report.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}, Drift: []recipes.ResourceChange{}}, nil, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
 // Gets the Recipe metadata and parameters from Recipe's template path.
 func (e *engine) GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
 	recipeData, err := e.getRecipeMetadataCore(ctx, opts)
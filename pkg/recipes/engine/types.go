@@ -28,6 +28,7 @@ import (
 type Engine interface {
 	// Execute gathers environment configuration, recipe definition and calls the driver to deploy the recipe.
 	// prevState is added to the driver execute options, which is used to get the obsolete resources for cleanup. It consists list of recipe output resource IDs that were created in the previous deployment.
+	// The execution is skipped and the output of the previous execution is returned when the inputs of the recipe have not changed, unless Force is set.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
 	// Delete handles deletion of output resources for the recipe deployment.
@@ -35,6 +36,10 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes that
+	// deploying the recipe would make, without deploying it.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -50,6 +55,11 @@ type ExecuteOptions struct {
 	PreviousState []string
 	// Simulated is the flag to indicate if the execution is a simulation.
 	Simulated bool
+	// PreviousRecipeStatus represents the recipe status stored with the resource by the previous execution.
+	// If the inputs of the recipe have not changed since then, the output stored in it is returned instead of executing the recipe.
+	PreviousRecipeStatus *rpv1.RecipeStatus
+	// Force is the flag to execute the recipe even if its inputs have not changed since the previous execution.
+	Force bool
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -60,6 +70,13 @@ type DeleteOptions struct {
 	OutputResources []rpv1.OutputResource
 }
 
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+	// PreviousState represents previously deployed state of output resource IDs.
+	PreviousState []string
+}
+
 type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
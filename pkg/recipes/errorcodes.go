@@ -49,4 +49,10 @@ const (
 
 	// Used for errors encountered while loading recipe secrets.
 	LoadSecretsFailed = "LoadSecretsFailed"
+
+	// Used for errors encountered while computing the changes a recipe deployment would make.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used when the recipe driver does not support computing the changes a recipe deployment would make.
+	RecipePlanNotSupported = "RecipePlanNotSupported"
 )
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+)
+
+var (
+	// fingerprintExcludedProperties are the resource properties that Radius updates as a result of deploying the resource.
+	// They are not inputs to the recipe, so they are excluded from the fingerprint.
+	fingerprintExcludedProperties = []string{"status", "provisioningState"}
+
+	// fingerprintExcludedRecipeProperties are the properties of the resource's recipe that do not affect the recipe output.
+	fingerprintExcludedRecipeProperties = []string{"recipeStatus", "force"}
+)
+
+// Fingerprint computes a hash of the inputs of a recipe execution: the recipe definition registered in the environment
+// (including the template version), the metadata of the resource the recipe is deploying and the environment configuration.
+// Two executions with the same fingerprint are expected to produce the same output.
+//
+// The values of the secrets referenced by the environment configuration are not part of the fingerprint. Changes to them
+// are only picked up when the recipe execution is forced.
+func Fingerprint(definition EnvironmentDefinition, metadata ResourceMetadata, configuration Configuration) (string, error) {
+	metadata.Properties = fingerprintProperties(metadata.Properties)
+
+	input := struct {
+		Definition    EnvironmentDefinition
+		Resource      ResourceMetadata
+		Configuration Configuration
+	}{
+		Definition:    definition,
+		Resource:      metadata,
+		Configuration: configuration,
+	}
+
+	// encoding/json sorts map keys, so the serialized input is stable for the same values.
+	bs, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute recipe fingerprint: %w", err)
+	}
+
+	hash := sha256.Sum256(bs)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// fingerprintProperties returns a copy of the resource properties without the properties excluded from the fingerprint.
+func fingerprintProperties(properties map[string]any) map[string]any {
+	if properties == nil {
+		return nil
+	}
+
+	result := maps.Clone(properties)
+	for _, key := range fingerprintExcludedProperties {
+		delete(result, key)
+	}
+
+	if recipe, ok := result["recipe"].(map[string]any); ok {
+		recipe = maps.Clone(recipe)
+		for _, key := range fingerprintExcludedRecipeProperties {
+			delete(recipe, key)
+		}
+		result["recipe"] = recipe
+	}
+
+	return result
+}
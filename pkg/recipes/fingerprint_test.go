@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	definition := EnvironmentDefinition{
+		Name:            "default",
+		Driver:          TemplateKindTerraform,
+		ResourceType:    "Applications.Datastores/redisCaches",
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}
+	metadata := ResourceMetadata{
+		Name:          "default",
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+		Properties: map[string]any{
+			"environment": "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0",
+			"recipe": map[string]any{
+				"name":         "default",
+				"recipeStatus": "success",
+			},
+			"provisioningState": "Accepted",
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"fingerprint": "abc123",
+				},
+			},
+		},
+		Parameters: map[string]any{
+			"size": "small",
+		},
+	}
+	configuration := Configuration{
+		Runtime: RuntimeConfiguration{
+			Kubernetes: &KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+
+	expected, err := Fingerprint(definition, metadata, configuration)
+	require.NoError(t, err)
+	require.NotEmpty(t, expected)
+
+	tests := []struct {
+		desc    string
+		modify  func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration)
+		changed bool
+	}{
+		{
+			desc:    "same inputs",
+			modify:  func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {},
+			changed: false,
+		},
+		{
+			desc: "status and provisioning state are ignored",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				metadata.Properties["provisioningState"] = "Succeeded"
+				metadata.Properties["status"] = map[string]any{}
+			},
+			changed: false,
+		},
+		{
+			desc: "recipe deployment status and force flag are ignored",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				metadata.Properties["recipe"] = map[string]any{
+					"name":         "default",
+					"recipeStatus": "failed",
+					"force":        true,
+				}
+			},
+			changed: false,
+		},
+		{
+			desc: "template version",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				definition.TemplateVersion = "1.1.0"
+			},
+			changed: true,
+		},
+		{
+			desc: "environment parameters",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				definition.Parameters = map[string]any{"location": "westus"}
+			},
+			changed: true,
+		},
+		{
+			desc: "resource parameters",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				metadata.Parameters = map[string]any{"size": "large"}
+			},
+			changed: true,
+		},
+		{
+			desc: "resource properties",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				metadata.Properties["application"] = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0"
+			},
+			changed: true,
+		},
+		{
+			desc: "connected resources",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				metadata.ConnectedResourcesProperties = map[string]ConnectedResource{
+					"db": {ID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/sqlDatabases/db"},
+				}
+			},
+			changed: true,
+		},
+		{
+			desc: "environment configuration",
+			modify: func(definition *EnvironmentDefinition, metadata *ResourceMetadata, configuration *Configuration) {
+				configuration.Runtime.Kubernetes = &KubernetesRuntime{Namespace: "other"}
+			},
+			changed: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			d, m, c := definition, metadata, configuration
+			m.Properties = map[string]any{}
+			for k, v := range metadata.Properties {
+				m.Properties[k] = v
+			}
+
+			tc.modify(&d, &m, &c)
+
+			actual, err := Fingerprint(d, m, c)
+			require.NoError(t, err)
+			if tc.changed {
+				require.NotEqual(t, expected, actual)
+			} else {
+				require.Equal(t, expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NewBackend creates the Terraform backend selected by the recipe configuration of the environment. The Kubernetes
+// backend is used when config is nil. Backend settings stored as secrets are resolved from secrets, which holds the
+// secret data loaded for the recipe. localStatePath is the directory configured by the host for the local backend,
+// the local backend is disabled when it is empty.
+func NewBackend(config *datamodel.TerraformBackendConfig, secrets map[string]recipes.SecretData, k8sClientSet kubernetes.Interface, localStatePath string) (Backend, error) {
+	if config == nil || config.Kind == "" || config.Kind == BackendKubernetes {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	settings, err := resolveSettings(config, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.Kind {
+	case BackendPostgres:
+		return NewPostgresBackend(settings)
+	case BackendS3:
+		return NewS3Backend(settings)
+	case BackendLocal:
+		return NewLocalBackend(localStatePath, settings)
+	default:
+		return nil, fmt.Errorf("unsupported Terraform backend kind %q", config.Kind)
+	}
+}
+
+// resolveSettings merges the backend settings with the values of the settings stored as secrets.
+// Settings stored as secrets override settings with the same name.
+func resolveSettings(config *datamodel.TerraformBackendConfig, secrets map[string]recipes.SecretData) (map[string]any, error) {
+	settings := map[string]any{}
+	maps.Copy(settings, config.AdditionalProperties)
+
+	for name, reference := range config.Secrets {
+		secretData, ok := secrets[reference.Source]
+		if !ok {
+			return nil, fmt.Errorf("missing secret store id: %s", reference.Source)
+		}
+
+		value, ok := secretData.Data[reference.Key]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key in secret store id: %s", reference.Source)
+		}
+
+		settings[name] = value
+	}
+
+	return settings, nil
+}
+
+// getString returns the string value of the setting with the given name, or an empty string if the setting is not set.
+func getString(settings map[string]any, name string) (string, error) {
+	value, ok := settings[name]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("backend setting %q must be a string", name)
+	}
+
+	return s, nil
+}
@@ -70,6 +70,16 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
+// StateName returns the name of the Kubernetes secret that Terraform creates to store the state file for the recipe.
+func (p *kubernetesBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
 // ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
 // name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
 // during recipe deployment.
@@ -89,6 +99,11 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// DeleteState deletes the Kubernetes secret that stores the Terraform state file.
+func (p *kubernetesBackend) DeleteState(ctx context.Context, name string) error {
+	return p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
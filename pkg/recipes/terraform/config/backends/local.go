@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendLocal = "local"
+
+	localPath = "path"
+)
+
+// ErrLocalBackendDisabled is returned when an environment selects the local backend but the host has not enabled it.
+var ErrLocalBackendDisabled = errors.New(`the "local" Terraform backend is not enabled on this server`)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	directory string
+}
+
+// NewLocalBackend creates a Terraform backend that stores state as files in directory on the local filesystem. The
+// directory is configured by the host, the local backend is disabled when it is empty. The backend does not accept
+// settings from the environment so that environments cannot read or write files outside of directory.
+func NewLocalBackend(directory string, settings map[string]any) (Backend, error) {
+	if directory == "" {
+		return nil, ErrLocalBackendDisabled
+	}
+
+	if len(settings) > 0 {
+		return nil, errors.New(`the "local" Terraform backend does not support settings, the state is stored in the directory configured by the server`)
+	}
+
+	return &localBackend{directory: directory}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for the local backend, using a state file dedicated to the recipe.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (p *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	path, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(p.directory, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for Terraform state: %w", err)
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			localPath: path,
+		},
+	}, nil
+}
+
+// StateName returns the path of the file that stores the Terraform state of the recipe.
+func (p *localBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.directory, suffix+".tfstate"), nil
+}
+
+// ValidateBackendExists checks if the state file with the given path exists.
+func (p *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.Info(fmt.Sprintf("Terraform state file %q does not exist", name))
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteState deletes the state file and the backup Terraform keeps next to it.
+func (p *localBackend) DeleteState(ctx context.Context, name string) error {
+	for _, path := range []string{name, name + ".backup"} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
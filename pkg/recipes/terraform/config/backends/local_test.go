@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	directory := filepath.Join(t.TempDir(), "tfstate")
+
+	backend, err := NewLocalBackend(directory, nil)
+	require.NoError(t, err)
+
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+	expectedPath := filepath.Join(directory, suffix+".tfstate")
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, expectedPath, name)
+
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"local": map[string]any{"path": expectedPath}}, config)
+	require.DirExists(t, directory)
+
+	ctx := context.Background()
+	exists, err := backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Simulate the state file written by terraform apply.
+	require.NoError(t, os.WriteFile(name, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(name+".backup", []byte("{}"), 0600))
+
+	exists, err = backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, backend.DeleteState(ctx, name))
+	require.NoFileExists(t, name)
+	require.NoFileExists(t, name+".backup")
+
+	// Deleting state that does not exist is not an error.
+	require.NoError(t, backend.DeleteState(ctx, name))
+}
+
+func Test_NewLocalBackend_Disabled(t *testing.T) {
+	_, err := NewLocalBackend("", nil)
+	require.ErrorIs(t, err, ErrLocalBackendDisabled)
+}
+
+func Test_NewLocalBackend_Settings(t *testing.T) {
+	_, err := NewLocalBackend(t.TempDir(), map[string]any{"path": "/var/run/secrets"})
+	require.Error(t, err)
+}
@@ -79,6 +79,83 @@ func (c *MockBackendBuildBackendCall) DoAndReturn(f func(*recipes.ResourceMetada
 	return c
 }
 
+// DeleteState mocks base method.
+func (m *MockBackend) DeleteState(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBackendMockRecorder) DeleteState(arg0, arg1 any) *MockBackendDeleteStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBackend)(nil).DeleteState), arg0, arg1)
+	return &MockBackendDeleteStateCall{Call: call}
+}
+
+// MockBackendDeleteStateCall wrap *gomock.Call
+type MockBackendDeleteStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendDeleteStateCall) Return(arg0 error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendDeleteStateCall) Do(f func(context.Context, string) error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendDeleteStateCall) DoAndReturn(f func(context.Context, string) error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StateName mocks base method.
+func (m *MockBackend) StateName(arg0 *recipes.ResourceMetadata) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateName", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateName indicates an expected call of StateName.
+func (mr *MockBackendMockRecorder) StateName(arg0 any) *MockBackendStateNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateName", reflect.TypeOf((*MockBackend)(nil).StateName), arg0)
+	return &MockBackendStateNameCall{Call: call}
+}
+
+// MockBackendStateNameCall wrap *gomock.Call
+type MockBackendStateNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendStateNameCall) Return(arg0 string, arg1 error) *MockBackendStateNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendStateNameCall) Do(f func(*recipes.ResourceMetadata) (string, error)) *MockBackendStateNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendStateNameCall) DoAndReturn(f func(*recipes.ResourceMetadata) (string, error)) *MockBackendStateNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ValidateBackendExists mocks base method.
 func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendPostgres = "pg"
+
+	// PostgresSchemaPrefix is the prefix of the schema that stores the Terraform state of a recipe. Terraform stores
+	// the state of each workspace as a row of the "states" table in the configured schema, so Radius uses one schema
+	// per recipe to keep the state of recipes apart.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+	PostgresSchemaPrefix = "tfstate_"
+
+	postgresConnectionString  = "conn_str"
+	postgresSchemaName        = "schema_name"
+	postgresStatesTable       = "states"
+	terraformDefaultWorkspace = "default"
+)
+
+var _ Backend = (*postgresBackend)(nil)
+
+type postgresBackend struct {
+	settings map[string]any
+	connStr  string
+}
+
+// NewPostgresBackend creates a Terraform backend that stores state in a PostgreSQL database. settings are passed to
+// the Terraform "pg" backend configuration and must include the connection string "conn_str".
+func NewPostgresBackend(settings map[string]any) (Backend, error) {
+	connStr, err := getString(settings, postgresConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	if connStr == "" {
+		return nil, fmt.Errorf("the %q Terraform backend requires the %q setting", BackendPostgres, postgresConnectionString)
+	}
+
+	return &postgresBackend{settings: settings, connStr: connStr}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for the PostgreSQL backend, using a schema dedicated to the recipe.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (p *postgresBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	schemaName, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{}
+	maps.Copy(config, p.settings)
+	config[postgresSchemaName] = schemaName
+
+	return map[string]any{BackendPostgres: config}, nil
+}
+
+// StateName returns the name of the schema that stores the Terraform state of the recipe.
+func (p *postgresBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return PostgresSchemaPrefix + suffix, nil
+}
+
+// ValidateBackendExists checks if the schema with the given name stores the state of the default Terraform workspace.
+func (p *postgresBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	conn, err := pgx.Connect(ctx, p.connStr)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(ctx)
+
+	var tableExists bool
+	err = conn.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = $1 AND table_name = $2)",
+		name, postgresStatesTable).Scan(&tableExists)
+	if err != nil {
+		return false, err
+	}
+
+	if !tableExists {
+		logger.Info(fmt.Sprintf("Terraform state table does not exist in schema %q", name))
+		return false, nil
+	}
+
+	var stateExists bool
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE name = $1)", pgx.Identifier{name, postgresStatesTable}.Sanitize())
+	err = conn.QueryRow(ctx, query, terraformDefaultWorkspace).Scan(&stateExists)
+	if err != nil {
+		return false, err
+	}
+
+	return stateExists, nil
+}
+
+// DeleteState drops the schema that stores the Terraform state of the recipe.
+func (p *postgresBackend) DeleteState(ctx context.Context, name string) error {
+	conn, err := pgx.Connect(ctx, p.connStr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pgx.Identifier{name}.Sanitize()))
+	if err != nil {
+		return fmt.Errorf("failed to drop schema %q: %w", name, err)
+	}
+
+	return nil
+}
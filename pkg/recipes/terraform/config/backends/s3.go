@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendS3 = "s3"
+
+	// S3KeyPrefix is the prefix of the object key that stores the Terraform state of a recipe.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+	S3KeyPrefix = "radius/tfstate/"
+
+	s3DefaultRegion = "us-east-1"
+
+	s3Bucket       = "bucket"
+	s3Key          = "key"
+	s3Region       = "region"
+	s3AccessKey    = "access_key"
+	s3SecretKey    = "secret_key"
+	s3Endpoints    = "endpoints"
+	s3UsePathStyle = "use_path_style"
+)
+
+var _ Backend = (*s3Backend)(nil)
+
+type s3Backend struct {
+	settings map[string]any
+	bucket   string
+
+	// client is created on first use.
+	client *s3.Client
+}
+
+// NewS3Backend creates a Terraform backend that stores state in an S3-compatible object storage bucket. settings are
+// passed to the Terraform "s3" backend configuration and must include the "bucket" setting. S3-compatible services
+// such as MinIO are selected with the "endpoints" and "use_path_style" settings.
+func NewS3Backend(settings map[string]any) (Backend, error) {
+	bucket, err := getString(settings, s3Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("the %q Terraform backend requires the %q setting", BackendS3, s3Bucket)
+	}
+
+	return &s3Backend{settings: settings, bucket: bucket}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for the S3 backend, using an object key dedicated to the recipe.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (p *s3Backend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{}
+	maps.Copy(config, p.settings)
+	config[s3Key] = key
+
+	return map[string]any{BackendS3: config}, nil
+}
+
+// StateName returns the key of the object that stores the Terraform state of the recipe.
+func (p *s3Backend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return S3KeyPrefix + suffix, nil
+}
+
+// ValidateBackendExists checks if the object with the given key exists in the bucket.
+func (p *s3Backend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String(p.bucket), Key: aws.String(name)})
+	if err != nil {
+		notFound := &types.NotFound{}
+		if errors.As(err, &notFound) {
+			logger.Info(fmt.Sprintf("Terraform state object %q does not exist in bucket %q", name, p.bucket))
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteState deletes the object that stores the Terraform state of the recipe.
+func (p *s3Backend) DeleteState(ctx context.Context, name string) error {
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(p.bucket), Key: aws.String(name)})
+	return err
+}
+
+// getClient creates an S3 client from the same settings Terraform uses to access the bucket.
+func (p *s3Backend) getClient(ctx context.Context) (*s3.Client, error) {
+	if p.client != nil {
+		return p.client, nil
+	}
+
+	region, err := getString(p.settings, s3Region)
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	accessKey, err := getString(p.settings, s3AccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secretKey, err := getString(p.settings, s3SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if accessKey != "" {
+		options = append(options, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKey, secretKey, "")))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ""
+	if endpoints, ok := p.settings[s3Endpoints].(map[string]any); ok {
+		endpoint, _ = endpoints[BackendS3].(string)
+	}
+	usePathStyle, _ := p.settings[s3UsePathStyle].(bool)
+
+	p.client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		o.UsePathStyle = usePathStyle
+	})
+
+	return p.client, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal path-style S3 endpoint that supports the object operations used by the S3 backend.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodHead:
+		if _, ok := f.objects[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	settings := map[string]any{"bucket": "tfstate", "region": "us-west-2"}
+
+	backend, err := NewS3Backend(settings)
+	require.NoError(t, err)
+
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, S3KeyPrefix+suffix, name)
+
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"s3": map[string]any{
+			"bucket": "tfstate",
+			"key":    S3KeyPrefix + suffix,
+			"region": "us-west-2",
+		},
+	}, config)
+}
+
+func Test_S3Backend(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	testS3Backend(t, map[string]any{
+		"bucket":         "tfstate",
+		"region":         "us-east-1",
+		"access_key":     "access",
+		"secret_key":     "secret",
+		"endpoints":      map[string]any{"s3": server.URL},
+		"use_path_style": true,
+	}, func(key string) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		fake.objects["tfstate/"+key] = []byte("{}")
+	})
+}
+
+// Test_S3Backend_MinIO runs against a real S3-compatible endpoint such as MinIO, for example:
+//
+//	docker run -p 9000:9000 minio/minio server /data
+//
+// The bucket must exist before the test runs.
+func Test_S3Backend_MinIO(t *testing.T) {
+	endpoint := os.Getenv("TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("TEST_S3_ENDPOINT is not set.")
+	}
+
+	settings := map[string]any{
+		"bucket":         os.Getenv("TEST_S3_BUCKET"),
+		"region":         "us-east-1",
+		"access_key":     os.Getenv("TEST_S3_ACCESS_KEY"),
+		"secret_key":     os.Getenv("TEST_S3_SECRET_KEY"),
+		"endpoints":      map[string]any{"s3": endpoint},
+		"use_path_style": true,
+	}
+
+	backend, err := NewS3Backend(settings)
+	require.NoError(t, err)
+	client, err := backend.(*s3Backend).getClient(context.Background())
+	require.NoError(t, err)
+
+	testS3Backend(t, settings, func(key string) {
+		_, err := client.PutObject(context.Background(), &s3.PutObjectInput{
+			Bucket: aws.String(settings["bucket"].(string)),
+			Key:    aws.String(key),
+			Body:   strings.NewReader("{}"),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func testS3Backend(t *testing.T, settings map[string]any, writeState func(key string)) {
+	ctx := context.Background()
+	_, resourceRecipe := getTestInputs()
+
+	backend, err := NewS3Backend(settings)
+	require.NoError(t, err)
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	exists, err := backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Simulate the state written by terraform apply.
+	writeState(name)
+
+	exists, err = backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, backend.DeleteState(ctx, name))
+
+	exists, err = backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -31,8 +31,16 @@ type Backend interface {
 	// Returns an error if the backend configuration cannot be generated.
 	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
+	// StateName returns the name that identifies the Terraform state of the recipe in the backend.
+	// For example, for Kubernetes backend, it is the name of the Kubernetes secret for Terraform state file.
+	// The name is passed to ValidateBackendExists and DeleteState.
+	StateName(resourceRecipe *recipes.ResourceMetadata) (string, error)
+
 	// ValidateBackendExists checks if the Terraform state file backend source exists.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// DeleteState deletes the Terraform state with the given name from the backend once the recipe resources are destroyed.
+	DeleteState(ctx context.Context, name string) error
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
@@ -37,7 +39,6 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -74,7 +75,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	backend, stateName, err := e.generateConfig(ctx, tf, options)
 	if err != nil {
 		return nil, err
 	}
@@ -94,18 +95,12 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
-	if err != nil {
-		return nil, fmt.Errorf("error getting kubernetes client: %w", err)
-	}
-
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Validate that the terraform state file backend source exists, which is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state %q: %w", stateName, err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, fmt.Errorf("expected terraform state %q is not found", stateName)
 	}
 
 	return state, nil
@@ -127,7 +122,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	backend, stateName, err := e.generateConfig(ctx, tf, options)
 	if err != nil {
 		return err
 	}
@@ -135,12 +130,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
-	if err != nil {
-		return fmt.Errorf("error getting kubernetes client: %w", err)
-	}
-
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -158,17 +148,44 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = kubernetesClient.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend.
+	err = backend.DeleteState(ctx, stateName)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return fmt.Errorf("error deleting terraform state %q: %w", stateName, err)
 	}
 
 	return nil
 }
 
+// Plan ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the Terraform plan or an error if any of these steps fail.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	_, _, err = e.generateConfig(ctx, tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.EnvConfig != nil {
+		// Set environment variables for the Terraform process.
+		err = e.setEnvironmentVariables(tf, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Run TF Init and Plan in the working directory
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
+	return initAndPlan(ctx, tf, stateLockTimeout)
+}
+
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	// Install Terraform
 	i := install.NewInstaller()
@@ -251,45 +268,52 @@ func splitEnvVar(envVars []string) map[string]string {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the backend that stores the Terraform state and the name of the state in the backend.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (backends.Backend, string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	loadedModule, err := downloadAndInspect(ctx, tf, options)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetUCPConfiguredTerraformProviders(e.ucpConn, e.secretProvider),
 		options.EnvConfig, options.Secrets); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
 	if err != nil {
-		return "", fmt.Errorf("error getting kubernetes client: %w", err)
+		return nil, "", fmt.Errorf("error getting kubernetes client: %w", err)
+	}
+
+	// The backend is selected by the Terraform recipe configuration of the environment and defaults to Kubernetes secrets.
+	var backendConfig *dm.TerraformBackendConfig
+	if options.EnvConfig != nil {
+		backendConfig = options.EnvConfig.RecipeConfig.Terraform.Backend
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(kubernetesClient))
+	backend, err := backends.NewBackend(backendConfig, options.Secrets, kubernetesClient, options.LocalStatePath)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+	if _, err = tfConfig.AddTerraformBackend(options.ResourceRecipe, backend); err != nil {
+		return nil, "", err
+	}
+
+	// The state name is used to verify the state creation during terraform apply and to delete the state after terraform destroy.
+	stateName, err := backend.StateName(options.ResourceRecipe)
+	if err != nil {
+		return nil, "", err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -300,7 +324,7 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return nil, "", err
 		}
 
 		//update the recipe context with connected resources properties
@@ -309,12 +333,12 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return nil, "", err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return nil, "", err
 		}
 	}
 
@@ -322,10 +346,10 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	return secretSuffix, nil
+	return backend, stateName, nil
 }
 
 // getTerraformConfig initializes the Terraform json config with provided module source and saves it
@@ -399,6 +423,38 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the resulting plan.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Save the plan to a file in the working directory so that it can be read back as JSON.
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+
+	logger.Info("Running Terraform plan with state lock timeout: " + stateLockTimeout)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform plan: %w", err)
+	}
+
+	return plan, nil
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
 func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
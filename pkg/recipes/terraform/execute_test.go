@@ -69,7 +69,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			_, _, err = e.generateConfig(ctx, tf, tc.opts)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -33,6 +33,7 @@ import (
 
 const (
 	executionSubDir                = "deploy"
+	planFileName                   = "tfplan"
 	workingDirFileMode fs.FileMode = 0700
 
 	// DefaultStateLockTimeout is the default timeout for acquiring Terraform state locks
@@ -50,6 +51,11 @@ type TerraformExecutor interface {
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec.
+	// The returned plan describes the changes that apply would make and the changes made outside of Terraform since the last apply.
+	// Plan does not modify the deployed resources or the Terraform state.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -76,6 +82,10 @@ type Options struct {
 
 	// LogLevel is the log level for Terraform execution (e.g., TRACE, DEBUG, INFO, WARN, ERROR).
 	LogLevel string
+
+	// LocalStatePath is the directory that stores the state of recipes using the "local" Terraform backend.
+	// The "local" backend is disabled when LocalStatePath is empty.
+	LocalStatePath string
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -106,8 +116,8 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 	return workingDir, nil
 }
 
-// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration and environment variables
-// and returns a map of secret store IDs and corresponding slice of keys.
+// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration, environment variables
+// and backend configuration and returns a map of secret store IDs and corresponding slice of keys.
 func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]string {
 	providerSecretIDs := make(map[string][]string)
 	var mu sync.Mutex
@@ -118,6 +128,11 @@ func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]strin
 	// Extract secrets from environment variables
 	extractEnvSecretIDs(envConfig.RecipeConfig.EnvSecrets, providerSecretIDs, &mu)
 
+	// Extract secrets from Terraform backend configuration
+	if backend := envConfig.RecipeConfig.Terraform.Backend; backend != nil {
+		extractEnvSecretIDs(backend.Secrets, providerSecretIDs, &mu)
+	}
+
 	return providerSecretIDs
 }
 
@@ -153,6 +153,24 @@ func TestGetProviderEnvSecretIDs(t *testing.T) {
 				"my-app-secret-source-id": {"secret-key1"},
 			},
 		},
+		{
+			name: "backend secret populated",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackendConfig{
+							Kind: "pg",
+							Secrets: map[string]datamodel.SecretReference{
+								"conn_str": {Source: "my-backend-secret-source-id", Key: "connection-string"},
+							},
+						},
+					},
+				},
+			},
+			want: map[string][]string{
+				"my-backend-secret-source-id": {"connection-string"},
+			},
+		},
 		{
 			name: "env secret populated",
 			envConfig: recipes.Configuration{
@@ -19,6 +19,8 @@ package recipes
 import (
 	"bytes"
 	"encoding/json"
+	"slices"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -108,15 +110,45 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm}
+
+	registeredTemplateKindsMutex sync.RWMutex
+	registeredTemplateKinds      = []string{}
 )
 
+// RegisterTemplateKind adds a template kind served by an out-of-process recipe driver to the set of
+// template kinds accepted by the API. Registering a built-in or already registered kind is a no-op.
+func RegisterTemplateKind(kind string) {
+	registeredTemplateKindsMutex.Lock()
+	defer registeredTemplateKindsMutex.Unlock()
+
+	if slices.Contains(SupportedTemplateKind, kind) || slices.Contains(registeredTemplateKinds, kind) {
+		return
+	}
+
+	registeredTemplateKinds = append(registeredTemplateKinds, kind)
+}
+
+// IsSupportedTemplateKind returns true if the template kind is a built-in kind or was registered with RegisterTemplateKind.
+func IsSupportedTemplateKind(kind string) bool {
+	return slices.Contains(SupportedTemplateKinds(), kind)
+}
+
+// SupportedTemplateKinds returns the built-in template kinds followed by the registered template kinds.
+func SupportedTemplateKinds() []string {
+	registeredTemplateKindsMutex.RLock()
+	defer registeredTemplateKindsMutex.RUnlock()
+
+	return append(slices.Clone(SupportedTemplateKind), registeredTemplateKinds...)
+}
+
 // RecipeOutput represents recipe deployment output.
 type RecipeOutput struct {
 	// Resources represents the list of output resources deployed recipe.
@@ -132,6 +164,75 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// RecipePlan represents the changes a recipe deployment would make to the resources deployed by the recipe.
+type RecipePlan struct {
+	// Changes represents the changes that would be made to the recipe resources if the recipe was deployed.
+	Changes []ResourceChange `json:"changes"`
+
+	// Drift represents the changes made to the recipe resources outside of the recipe since it was last deployed.
+	Drift []ResourceChange `json:"drift"`
+}
+
+// HasChanges returns true if the plan contains any change other than a no-op.
+func (p *RecipePlan) HasChanges() bool {
+	if p == nil {
+		return false
+	}
+
+	for _, change := range p.Changes {
+		if change.Action != ResourceChangeActionNoOp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasDrift returns true if any of the recipe resources changed outside of the recipe.
+func (p *RecipePlan) HasDrift() bool {
+	return p != nil && len(p.Drift) > 0
+}
+
+const (
+	// ResourceChangeActionCreate indicates that the resource will be created.
+	ResourceChangeActionCreate = "create"
+	// ResourceChangeActionUpdate indicates that the resource will be updated in-place.
+	ResourceChangeActionUpdate = "update"
+	// ResourceChangeActionReplace indicates that the resource will be deleted and re-created.
+	ResourceChangeActionReplace = "replace"
+	// ResourceChangeActionDelete indicates that the resource will be deleted.
+	ResourceChangeActionDelete = "delete"
+	// ResourceChangeActionRead indicates that the resource (usually a data source) will be read.
+	ResourceChangeActionRead = "read"
+	// ResourceChangeActionNoOp indicates that the resource will not change.
+	ResourceChangeActionNoOp = "no-op"
+)
+
+// ResourceChange represents the change to a single resource deployed by a recipe.
+type ResourceChange struct {
+	// Address is the address of the resource within the recipe, for example "aws_s3_bucket.bucket".
+	Address string `json:"address"`
+
+	// Type is the type of the resource, for example "aws_s3_bucket".
+	Type string `json:"type,omitempty"`
+
+	// Name is the name of the resource within the recipe.
+	Name string `json:"name,omitempty"`
+
+	// Action is the action that will be taken on the resource. See the ResourceChangeAction* constants.
+	Action string `json:"action"`
+
+	// Before represents the properties of the resource before the change. Sensitive values are redacted.
+	Before map[string]any `json:"before,omitempty"`
+
+	// After represents the properties of the resource after the change. Sensitive values are redacted
+	// and values that are only known after the change is applied are replaced with a placeholder.
+	After map[string]any `json:"after,omitempty"`
+
+	// ChangedProperties is the sorted list of top-level properties whose values differ between Before and After.
+	ChangedProperties []string `json:"changedProperties,omitempty"`
+}
+
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
 type SecretData struct {
 	Type string            `json:"type"`
@@ -80,3 +80,32 @@ func TestRecipeOutput_PrepareRecipeResponse(t *testing.T) {
 		})
 	}
 }
+
+func Test_RecipePlan_HasChanges(t *testing.T) {
+	var nilPlan *RecipePlan
+	require.False(t, nilPlan.HasChanges())
+	require.False(t, nilPlan.HasDrift())
+
+	plan := &RecipePlan{Changes: []ResourceChange{{Action: ResourceChangeActionNoOp}}}
+	require.False(t, plan.HasChanges())
+
+	plan.Changes = append(plan.Changes, ResourceChange{Action: ResourceChangeActionCreate})
+	require.True(t, plan.HasChanges())
+
+	plan.Drift = []ResourceChange{{Action: ResourceChangeActionUpdate}}
+	require.True(t, plan.HasDrift())
+}
+
+func Test_RegisterTemplateKind(t *testing.T) {
+	require.True(t, IsSupportedTemplateKind(TemplateKindBicep))
+	require.False(t, IsSupportedTemplateKind("test-registered-kind"))
+
+	RegisterTemplateKind("test-registered-kind")
+	RegisterTemplateKind("test-registered-kind")
+	RegisterTemplateKind(TemplateKindHelm)
+
+	require.True(t, IsSupportedTemplateKind("test-registered-kind"))
+	kinds := SupportedTemplateKinds()
+	require.Equal(t, SupportedTemplateKind, kinds[:len(SupportedTemplateKind)])
+	require.Equal(t, []string{"test-registered-kind"}, kinds[len(SupportedTemplateKind):])
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// NewOutputResourcesPreview returns an output resources preview for the what-if operation which renders the resource
+// with the renderers of the deployment processor and compares the rendered output resources with the output resources
+// of the existing resource. Output resources whose ID is only known after they are deployed are reported by their
+// local ID.
+func NewOutputResourcesPreview[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](getDeploymentProcessor func(options *controller.Options) (deployment.DeploymentProcessor, error)) controller.OutputResourcesPreview[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *controller.Options) ([]v1.WhatIfOutputResourceChange, error) {
+		dp, err := getDeploymentProcessor(options)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		output, err := dp.Preview(ctx, serviceCtx.ResourceID, P(newResource))
+		if err != nil {
+			return nil, err
+		}
+
+		before := []string{}
+		if oldResource != nil {
+			for _, outputResource := range P(oldResource).OutputResources() {
+				before = append(before, outputResourceID(outputResource))
+			}
+		}
+
+		after := []string{}
+		for _, outputResource := range output.Resources {
+			after = append(after, outputResourceID(outputResource))
+		}
+
+		return v1.DiffOutputResources(before, after), nil
+	}
+}
+
+func outputResourceID(outputResource rpv1.OutputResource) string {
+	if outputResource.ID.IsEmpty() {
+		return outputResource.LocalID
+	}
+	return outputResource.ID.String()
+}
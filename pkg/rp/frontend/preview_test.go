@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewOutputResourcesPreview(t *testing.T) {
+	resourceID := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test")
+	deploymentID := resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test")
+	serviceID := resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/core/Service/test")
+	ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: resourceID})
+
+	t.Run("new resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		dp := deployment.NewMockDeploymentProcessor(mctrl)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{}}
+		dp.EXPECT().Preview(gomock.Any(), resourceID, newResource).Return(renderers.RendererOutput{
+			Resources: []rpv1.OutputResource{
+				{LocalID: rpv1.LocalIDDeployment, ID: deploymentID},
+				{LocalID: rpv1.LocalIDAzureCosmosAccount},
+			},
+		}, nil)
+
+		preview := NewOutputResourcesPreview[*TestResourceDataModel](func(*controller.Options) (deployment.DeploymentProcessor, error) { return dp, nil })
+		changes, err := preview(ctx, newResource, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, []v1.WhatIfOutputResourceChange{
+			{ID: deploymentID.String(), ChangeType: v1.WhatIfChangeTypeCreate},
+			{ID: rpv1.LocalIDAzureCosmosAccount, ChangeType: v1.WhatIfChangeTypeCreate},
+		}, changes)
+	})
+
+	t.Run("existing resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		dp := deployment.NewMockDeploymentProcessor(mctrl)
+
+		oldResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{}}
+		oldResource.Properties.Status.OutputResources = []rpv1.OutputResource{{ID: deploymentID}, {ID: serviceID}}
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{}}
+		dp.EXPECT().Preview(gomock.Any(), resourceID, newResource).Return(renderers.RendererOutput{
+			Resources: []rpv1.OutputResource{{ID: deploymentID}},
+		}, nil)
+
+		preview := NewOutputResourcesPreview[*TestResourceDataModel](func(*controller.Options) (deployment.DeploymentProcessor, error) { return dp, nil })
+		changes, err := preview(ctx, newResource, oldResource, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, []v1.WhatIfOutputResourceChange{
+			{ID: deploymentID.String(), ChangeType: v1.WhatIfChangeTypeModify},
+			{ID: serviceID.String(), ChangeType: v1.WhatIfChangeTypeDelete},
+		}, changes)
+	})
+
+	t.Run("render failure", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		dp := deployment.NewMockDeploymentProcessor(mctrl)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{}}
+		dp.EXPECT().Preview(gomock.Any(), resourceID, newResource).Return(renderers.RendererOutput{}, errors.New("render failed"))
+
+		preview := NewOutputResourcesPreview[*TestResourceDataModel](func(*controller.Options) (deployment.DeploymentProcessor, error) { return dp, nil })
+		_, err := preview(ctx, newResource, nil, &controller.Options{})
+		require.EqualError(t, err, "render failed")
+	})
+}
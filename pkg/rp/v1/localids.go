@@ -53,8 +53,11 @@ const (
 	LocalIDDaprPubSubBrokerKafka          = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                     = "Deployment"
 	LocalIDGateway                        = "Gateway"
+	LocalIDHorizontalPodAutoscaler        = "HorizontalPodAutoscaler"
 	LocalIDHttpProxy                      = "HttpProxy"
+	LocalIDHTTPRoute                      = "HTTPRoute"
 	LocalIDKeyVault                       = "KeyVault"
+	LocalIDReferenceGrant                 = "ReferenceGrant"
 	LocalIDSecret                         = "Secret"
 	LocalIDConfigMap                      = "ConfigMap"
 	LocalIDSecretProviderClass            = "SecretProviderClass"
@@ -26,4 +26,21 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Fingerprint is the hash of the recipe inputs used for the last successful execution of the recipe.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// CachedOutput is the output of the last successful execution of the recipe. It is returned instead of
+	// executing the recipe again when the fingerprint of the recipe inputs has not changed. The output of recipes
+	// that produce secrets is not cached, so secret values are never stored in the recipe status.
+	CachedOutput *RecipeCachedOutput `json:"cachedOutput,omitempty"`
+}
+
+// RecipeCachedOutput defines the stored output of a recipe execution.
+type RecipeCachedOutput struct {
+	// Resources is the list of output resource IDs deployed by the recipe.
+	Resources []string `json:"resources,omitempty"`
+
+	// Values is the key/value pairs of properties of the deployed resource.
+	Values map[string]any `json:"values,omitempty"`
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"maps"
+	"slices"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -117,6 +119,14 @@ func (original ResourceStatus) DeepCopyRecipeStatus() ResourceStatus {
 			TemplateKind:    original.Recipe.TemplateKind,
 			TemplatePath:    original.Recipe.TemplatePath,
 			TemplateVersion: original.Recipe.TemplateVersion,
+			Fingerprint:     original.Recipe.Fingerprint,
+		}
+
+		if original.Recipe.CachedOutput != nil {
+			copy.Recipe.CachedOutput = &RecipeCachedOutput{
+				Resources: slices.Clone(original.Recipe.CachedOutput.Resources),
+				Values:    maps.Clone(original.Recipe.CachedOutput.Values),
+			}
 		}
 	}
 
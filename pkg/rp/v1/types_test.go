@@ -130,3 +130,29 @@ func Test_IsGlobalScopedResource(t *testing.T) {
 	}
 
 }
+
+func Test_DeepCopyRecipeStatus(t *testing.T) {
+	original := ResourceStatus{
+		Recipe: &RecipeStatus{
+			TemplateKind:    "terraform",
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0.0",
+			Fingerprint:     "abc123",
+			CachedOutput: &RecipeCachedOutput{
+				Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+				Values:    map[string]any{"host": "redis"},
+			},
+		},
+	}
+
+	copy := original.DeepCopyRecipeStatus()
+	require.Equal(t, original, copy)
+
+	copy.Recipe.Fingerprint = "def456"
+	copy.Recipe.CachedOutput.Resources[0] = "changed"
+	copy.Recipe.CachedOutput.Values["host"] = "changed"
+
+	require.Equal(t, "abc123", original.Recipe.Fingerprint)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis", original.Recipe.CachedOutput.Resources[0])
+	require.Equal(t, "redis", original.Recipe.CachedOutput.Values["host"])
+}
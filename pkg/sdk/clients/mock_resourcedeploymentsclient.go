@@ -24,6 +24,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
 
 // This file contains mocks for the ResourceDeploymentsClient interface.
@@ -42,6 +44,7 @@ var _ ResourceDeploymentsClient = (*MockResourceDeploymentsClient)(nil)
 type MockResourceDeploymentsClient struct {
 	resourceDeployments map[string]*ClientCreateOrUpdateResponse
 	operations          map[string]*OperationState
+	whatIfResponse      *ClientWhatIfResponse
 
 	lock *sync.Mutex
 }
@@ -146,6 +149,25 @@ func (rdc *MockResourceDeploymentsClient) ContinueDeleteOperation(ctx context.Co
 	}, nil
 }
 
+func (rdc *MockResourceDeploymentsClient) WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (ClientWhatIfResponse, error) {
+	rdc.lock.Lock()
+	defer rdc.lock.Unlock()
+
+	if rdc.whatIfResponse != nil {
+		return *rdc.whatIfResponse, nil
+	}
+
+	return ClientWhatIfResponse{WhatIfResult: v1.WhatIfResult{Changes: []v1.WhatIfResourceChange{}}}, nil
+}
+
+// SetWhatIfResponse sets the response returned by the WhatIf method.
+func (rdc *MockResourceDeploymentsClient) SetWhatIfResponse(response ClientWhatIfResponse) {
+	rdc.lock.Lock()
+	defer rdc.lock.Unlock()
+
+	rdc.whatIfResponse = &response
+}
+
 func (rdc *MockResourceDeploymentsClient) GetResource(resourceID string) (*ClientCreateOrUpdateResponse, bool) {
 	resource, ok := rdc.resourceDeployments[resourceID]
 
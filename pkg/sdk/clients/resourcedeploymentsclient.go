@@ -27,6 +27,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -99,6 +100,7 @@ type ResourceDeploymentsClient interface {
 	ContinueCreateOperation(ctx context.Context, resumeToken string) (Poller[ClientCreateOrUpdateResponse], error)
 	Delete(ctx context.Context, resourceID, apiVersion string) (Poller[ClientDeleteResponse], error)
 	ContinueDeleteOperation(ctx context.Context, resumeToken string) (Poller[ClientDeleteResponse], error)
+	WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (ClientWhatIfResponse, error)
 }
 
 type ResourceDeploymentsClientImpl struct {
@@ -144,6 +146,11 @@ type ClientDeleteResponse struct {
 	armresources.DeploymentExtended
 }
 
+// ClientWhatIfResponse contains the response from method Client.WhatIf.
+type ClientWhatIfResponse struct {
+	v1.WhatIfResult
+}
+
 // CreateOrUpdate creates a request to create or update a deployment and returns a poller to
 // track the progress of the operation.
 func (client *ResourceDeploymentsClientImpl) CreateOrUpdate(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (Poller[ClientCreateOrUpdateResponse], error) {
@@ -245,3 +252,53 @@ func (client *ResourceDeploymentsClientImpl) deleteCreateRequest(ctx context.Con
 func (client *ResourceDeploymentsClientImpl) ContinueDeleteOperation(ctx context.Context, resumeToken string) (Poller[ClientDeleteResponse], error) {
 	return runtime.NewPollerFromResumeToken[ClientDeleteResponse](resumeToken, *client.pipeline, nil)
 }
+
+// WhatIf previews the changes that a deployment would make without applying them.
+func (client *ResourceDeploymentsClientImpl) WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (ClientWhatIfResponse, error) {
+	if !strings.HasPrefix(resourceID, "/") {
+		return ClientWhatIfResponse{}, fmt.Errorf("error previewing a deployment: resourceID must start with a slash")
+	}
+
+	_, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return ClientWhatIfResponse{}, fmt.Errorf("invalid resourceID: %v", resourceID)
+	}
+
+	req, err := client.whatIfCreateRequest(ctx, resourceID, apiVersion, parameters)
+	if err != nil {
+		return ClientWhatIfResponse{}, err
+	}
+
+	resp, err := client.pipeline.Do(req)
+	if err != nil {
+		return ClientWhatIfResponse{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return ClientWhatIfResponse{}, runtime.NewResponseError(resp)
+	}
+
+	result := ClientWhatIfResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.WhatIfResult); err != nil {
+		return ClientWhatIfResponse{}, err
+	}
+
+	return result, nil
+}
+
+// whatIfCreateRequest creates the WhatIf request.
+func (client *ResourceDeploymentsClientImpl) whatIfCreateRequest(ctx context.Context, resourceID, apiVersion string, parameters Deployment) (*policy.Request, error) {
+	if resourceID == "" {
+		return nil, errors.New("resourceID cannot be empty")
+	}
+
+	urlPath := DeploymentEngineURL(client.baseURI, resourceID+"/"+v1.WhatIfActionName)
+	req, err := runtime.NewRequest(ctx, http.MethodPost, urlPath)
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, runtime.MarshalAsJSON(req, parameters)
+}
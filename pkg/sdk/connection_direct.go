@@ -17,6 +17,7 @@ limitations under the License.
 package sdk
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -26,10 +27,12 @@ import (
 
 var _ Connection = (*directConnection)(nil)
 
-// directConnection represents a connection to a Radius API endpoint with no authentication
-// or intermediate systems. This is mostly used for test scenarios.
+// directConnection represents a connection to a Radius API endpoint with no intermediate systems. Requests
+// are authenticated with a bearer token when a token source is set, otherwise they are not authenticated. The latter
+// is mostly used for test scenarios.
 type directConnection struct {
-	endpoint string
+	endpoint    string
+	tokenSource TokenSource
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
@@ -49,11 +52,43 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 	}, nil
 }
 
+// NewDirectConnectionWithBearerToken creates a direct connection that authenticates each request with the bearer
+// token provided by tokenSource. The endpoint must use the https scheme unless it is a loopback address.
+func NewDirectConnectionWithBearerToken(endpoint string, tokenSource TokenSource) (Connection, error) {
+	if tokenSource == nil {
+		return nil, errors.New("the token source is required")
+	}
+
+	connection, err := NewDirectConnection(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
+	}
+
+	err = validateBearerTokenEndpoint(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	direct := connection.(*directConnection)
+	direct.tokenSource = tokenSource
+	return direct, nil
+}
+
 // Client returns an http.Client for communicating with Radius. This satisfies both the
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	var transport http.RoundTripper = http.DefaultTransport
+	if c.tokenSource != nil {
+		transport = &bearerTokenTransport{tokenSource: c.tokenSource, next: transport}
+	}
+
+	return &http.Client{Transport: otelhttp.NewTransport(transport)}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
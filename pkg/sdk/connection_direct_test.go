@@ -18,6 +18,9 @@ package sdk
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -57,3 +60,59 @@ func Test_NewDirectConnection_InvalidWithoutScheme(t *testing.T) {
 	require.Contains(t, err.Error(), "the endpoint must use the http or https scheme")
 	require.Nil(t, connection)
 }
+
+func Test_NewDirectConnectionWithBearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("static token", func(t *testing.T) {
+		connection, err := NewDirectConnectionWithBearerToken(server.URL, StaticTokenSource("test-token"))
+		require.NoError(t, err)
+
+		resp, err := connection.Client().Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(file, []byte("test-token\n"), 0600))
+
+		connection, err := NewDirectConnectionWithBearerToken(server.URL, FileTokenSource(file))
+		require.NoError(t, err)
+
+		resp, err := connection.Client().Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("missing token file", func(t *testing.T) {
+		connection, err := NewDirectConnectionWithBearerToken(server.URL, FileTokenSource(filepath.Join(t.TempDir(), "missing")))
+		require.NoError(t, err)
+
+		_, err = connection.Client().Get(server.URL)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to read bearer token")
+	})
+
+	t.Run("plain http is rejected", func(t *testing.T) {
+		connection, err := NewDirectConnectionWithBearerToken("http://example.com", StaticTokenSource("test-token"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bearer tokens can only be sent to an https endpoint")
+		require.Nil(t, connection)
+	})
+
+	t.Run("https is allowed", func(t *testing.T) {
+		connection, err := NewDirectConnectionWithBearerToken("https://example.com", StaticTokenSource("test-token"))
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com", connection.Endpoint())
+	})
+}
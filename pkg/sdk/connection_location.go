@@ -57,7 +57,17 @@ type locationRewriteRoundTripper struct {
 }
 
 // RoundTrip is the implementation of http.RoundTripper. It rewrites response headers with a given scheme and authority.
+//
+// Requests that were built from a URL returned by the server (eg. the nextLink of a paginated list) have the
+// same problem, so the request URL is rewritten as well.
 func (t *locationRewriteRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.URL != nil && request.URL.Host != "" && request.URL.Host != t.Authority {
+		// A RoundTripper must not modify the request, so we work on a copy.
+		request = request.Clone(request.Context())
+		request.URL = t.rewrite(request.URL.String(), t.Scheme, t.Authority)
+		request.Host = ""
+	}
+
 	// Send the request and then rewrite response headers.
 	res, err := t.RoundTripper.RoundTrip(request)
 	if err != nil {
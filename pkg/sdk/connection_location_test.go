@@ -69,13 +69,31 @@ func Test_locationRewriteRoundTripper_RoundTrip(t *testing.T) {
 	require.Equal(t, "http://example.com/async-operation", response.Header.Get(azureAsyncOperationHeader))
 }
 
+func Test_locationRewriteRoundTripper_RoundTrip_RewritesRequest(t *testing.T) {
+	mockRoundTripper := &MockTransport{Response: &http.Response{Header: http.Header{}}}
+
+	request, err := http.NewRequest(http.MethodGet, "https://ucp.radius-system:443/apis/api.ucp.dev/v1alpha3/planes/radius/local?skipToken=abc", nil)
+	require.NoError(t, err)
+
+	roundTripper := newLocationRewriteRoundTripper("http://example.com", mockRoundTripper)
+	_, err = roundTripper.RoundTrip(request)
+	require.NoError(t, err)
+
+	require.Equal(t, "http://example.com/apis/api.ucp.dev/v1alpha3/planes/radius/local?skipToken=abc", mockRoundTripper.Request.URL.String())
+
+	// The original request must not be modified.
+	require.Equal(t, "ucp.radius-system:443", request.URL.Host)
+}
+
 var _ http.RoundTripper = (*MockTransport)(nil)
 
 type MockTransport struct {
+	Request  *http.Request
 	Response *http.Response
 }
 
 // RoundTrip implements http.RoundTripper
-func (mt *MockTransport) RoundTrip(*http.Request) (*http.Response, error) {
+func (mt *MockTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	mt.Request = request
 	return mt.Response, nil
 }
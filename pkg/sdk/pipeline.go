@@ -18,14 +18,22 @@ package sdk
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 )
 
+const (
+	// maxRetryAfter is the longest Retry-After delay requested by the server that is honored. Requests are not
+	// retried when the server asks to wait longer.
+	maxRetryAfter = 2 * time.Minute
+)
+
 // NewClientOptions creates a new ARM client options object with the given connection's endpoint, audience, transport and
-// removes the authorization header policy.
+// removes the authorization header policy. Throttled (429) and unavailable (503) responses are retried after the delay
+// requested by the Retry-After header of the response.
 func NewClientOptions(connection Connection) *arm.ClientOptions {
 	return &arm.ClientOptions{
 		ClientOptions: policy.ClientOptions{
@@ -45,6 +53,19 @@ func NewClientOptions(connection Connection) *arm.ClientOptions {
 				// We'll solve this problem permanently by writing our own client.
 				&removeAuthorizationHeaderPolicy{},
 			},
+			Retry: policy.RetryOptions{
+				// UCP rejects throttled requests with 429 and a Retry-After header. The retry policy waits for the
+				// requested delay before retrying, as long as the delay does not exceed MaxRetryDelay.
+				MaxRetryDelay: maxRetryAfter,
+				StatusCodes: []int{
+					http.StatusRequestTimeout,
+					http.StatusTooManyRequests,
+					http.StatusInternalServerError,
+					http.StatusBadGateway,
+					http.StatusServiceUnavailable,
+					http.StatusGatewayTimeout,
+				},
+			},
 			Transport: connection.Client(),
 			// When updating azcore to 1.11.1 from 1.7.0, we saw that HTTPS check for Authentication was added.
 			// Link to the check: https://github.com/Azure/azure-sdk-for-go/blob/main/sdk/azcore/runtime/policy_bearer_token.go#L118
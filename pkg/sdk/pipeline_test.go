@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewClientOptions_HonorsRetryAfter(t *testing.T) {
+	attempts := []time.Time{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts = append(attempts, time.Now())
+		if len(attempts) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	options := NewClientOptions(connection)
+	pipeline := runtime.NewPipeline("test", "v0.0.1", runtime.PipelineOptions{}, &options.ClientOptions)
+
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, server.URL)
+	require.NoError(t, err)
+
+	resp, err := pipeline.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, attempts, 2)
+	require.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), time.Second)
+}
+
+func Test_NewClientOptions_RetryAfterTooLong(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	options := NewClientOptions(connection)
+	pipeline := runtime.NewPipeline("test", "v0.0.1", runtime.PipelineOptions{}, &options.ClientOptions)
+
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, server.URL)
+	require.NoError(t, err)
+
+	resp, err := pipeline.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// The server asked to wait longer than the maximum delay, so the response is returned to the caller.
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, 1, attempts)
+}
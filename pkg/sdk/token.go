@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// TokenSource provides the bearer token used to authenticate with the Radius API.
+type TokenSource interface {
+	// Token returns the bearer token.
+	Token(ctx context.Context) (string, error)
+}
+
+var _ TokenSource = StaticTokenSource("")
+
+// StaticTokenSource is a TokenSource that always returns the same token.
+type StaticTokenSource string
+
+// Token returns the token.
+func (s StaticTokenSource) Token(ctx context.Context) (string, error) {
+	if s == "" {
+		return "", errors.New("the bearer token is empty")
+	}
+	return string(s), nil
+}
+
+var _ TokenSource = FileTokenSource("")
+
+// FileTokenSource is a TokenSource that reads the token from a file on every request. This supports tokens that
+// are rotated by another process, such as projected Kubernetes ServiceAccount tokens.
+type FileTokenSource string
+
+// Token reads the token from the file.
+func (s FileTokenSource) Token(ctx context.Context) (string, error) {
+	b, err := os.ReadFile(string(s))
+	if err != nil {
+		return "", fmt.Errorf("failed to read bearer token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("the bearer token file %q is empty", string(s))
+	}
+
+	return token, nil
+}
+
+var _ http.RoundTripper = (*bearerTokenTransport)(nil)
+
+// bearerTokenTransport is an http.RoundTripper that adds a bearer token to each request.
+type bearerTokenTransport struct {
+	tokenSource TokenSource
+	next        http.RoundTripper
+}
+
+// RoundTrip sets the Authorization header of the request and sends it using the next transport.
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokenSource.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.next.RoundTrip(req)
+}
+
+// validateBearerTokenEndpoint returns an error if bearer tokens would be sent to the endpoint in clear text. Plain
+// http is only allowed for loopback addresses, which is useful for local development and testing.
+func validateBearerTokenEndpoint(endpoint *url.URL) error {
+	if endpoint.Scheme == "https" {
+		return nil
+	}
+
+	host := endpoint.Hostname()
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+
+	return fmt.Errorf("bearer tokens can only be sent to an https endpoint (got %q)", endpoint.String())
+}
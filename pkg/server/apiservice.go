@@ -26,6 +26,7 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/audit"
 )
 
 // APIService is the restful API server for Radius Resource Provider.
@@ -62,11 +63,17 @@ func (s *APIService) Run(ctx context.Context) error {
 		return err
 	}
 
+	auditSink, err := audit.NewSink(ctx, s.Options.Config.Audit, databaseClient)
+	if err != nil {
+		return fmt.Errorf("failed to initialize audit sink: %w", err)
+	}
+
 	address := fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port)
 	return s.Start(ctx, server.Options{
-		Location: s.Options.Config.Env.RoleLocation,
-		Address:  address,
-		PathBase: s.Options.Config.Server.PathBase,
+		ServiceName: "applications-rp",
+		Location:    s.Options.Config.Env.RoleLocation,
+		Address:     address,
+		PathBase:    s.Options.Config.Server.PathBase,
 		Configure: func(r chi.Router) error {
 			for _, b := range s.handlerBuilder {
 				opts := apictrl.Options{
@@ -92,5 +99,8 @@ func (s *APIService) Run(ctx context.Context) error {
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+
+		AuditSink:           auditSink,
+		AuditIncludeChanges: s.Options.Config.Audit.IncludeChanges,
 	})
 }
@@ -65,6 +65,9 @@ func (w *AsyncWorker) init(ctx context.Context) error {
 		if w.options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOptions.MaxOperationRetryCount = *w.options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.options.Config.WorkerServer.MaxScheduledMessages != nil {
+			workerOptions.MaxScheduledMessages = *w.options.Config.WorkerServer.MaxScheduledMessages
+		}
 	}
 
 	queueProvider := queueprovider.New(w.options.Config.QueueProvider)
@@ -114,7 +117,7 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 			DatabaseClient: w.DatabaseClient,
 			KubeClient:     k8s.RuntimeClient,
 			GetDeploymentProcessor: func() deployment.DeploymentProcessor {
-				return deployment.NewDeploymentProcessor(appModel, w.DatabaseClient, k8s.RuntimeClient, k8s.ClientSet)
+				return deployment.NewDeploymentProcessor(appModel, w.DatabaseClient, k8s.RuntimeClient, k8s.ClientSet, w.options.Config.Vault)
 			},
 		}
 
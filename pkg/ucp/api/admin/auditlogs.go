@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"time"
+)
+
+// AuditRecord is the API representation of an entry of the audit log.
+type AuditRecord struct {
+	// ID is the unique id of the record.
+	ID string `json:"id"`
+	// Timestamp is the time when the request was received.
+	Timestamp time.Time `json:"timestamp"`
+	// Service is the name of the service that handled the request.
+	Service string `json:"service"`
+	// Principal is the name of the caller.
+	Principal string `json:"principal,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Operation is the action performed by the request.
+	Operation string `json:"operation"`
+	// ResourceID is the id of the resource or scope targeted by the request.
+	ResourceID string `json:"resourceId"`
+	// CorrelationID is the correlation id of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+	// ClientRequestID is the client request id of the request.
+	ClientRequestID string `json:"clientRequestId,omitempty"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Outcome is the outcome of the request: Succeeded, Denied or Failed.
+	Outcome string `json:"outcome"`
+	// Changes is the redacted difference between the resource before and after the request.
+	Changes []*AuditChange `json:"changes,omitempty"`
+}
+
+// AuditChange is the API representation of a change to a single field of a resource.
+type AuditChange struct {
+	// Path is the path of the field.
+	Path string `json:"path"`
+	// Old is the redacted value before the change.
+	Old any `json:"old,omitempty"`
+	// New is the redacted value after the change.
+	New any `json:"new,omitempty"`
+}
+
+// AuditRecordList is the API representation of a list of audit records.
+type AuditRecordList struct {
+	// Value is the list of audit records, most recent first.
+	Value []*AuditRecord `json:"value"`
+}
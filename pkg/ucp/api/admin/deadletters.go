@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin contains the types of the UCP admin API. The admin API is not an ARM API and is not versioned.
+package admin
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeadLetter is the API representation of a dead-lettered async operation.
+type DeadLetter struct {
+	// ID is the id of the dead-lettered message.
+	ID string `json:"id"`
+	// Queue is the name of the queue.
+	Queue string `json:"queue"`
+	// OperationID is the id of the async operation.
+	OperationID string `json:"operationId,omitempty"`
+	// OperationType is the type of the async operation.
+	OperationType string `json:"operationType,omitempty"`
+	// ResourceID is the id of the resource the async operation was processing.
+	ResourceID string `json:"resourceId,omitempty"`
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+	// ReplayCount is the number of times the message was replayed.
+	ReplayCount int `json:"replayCount"`
+	// EnqueuedAt is the time when the message was enqueued.
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+	// DeadLetteredAt is the time when the message was dead-lettered.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+	// Reason is the reason why the message was dead-lettered.
+	Reason string `json:"reason"`
+	// Data is the message payload. It is only returned when a single dead-lettered message is fetched.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// DeadLetterList is the API representation of a list of dead-lettered async operations.
+type DeadLetterList struct {
+	// Value is the list of dead-lettered async operations.
+	Value []*DeadLetter `json:"value"`
+}
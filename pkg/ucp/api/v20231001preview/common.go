@@ -40,3 +40,10 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 		LastModifiedAt:     v1.UnmarshalTimeString(s.LastModifiedAt),
 	}
 }
+
+func toStringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
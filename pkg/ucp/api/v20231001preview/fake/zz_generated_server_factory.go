@@ -39,6 +39,9 @@ type ServerFactory struct {
 	// RadiusPlanesServer contains the fakes for client RadiusPlanesClient
 	RadiusPlanesServer RadiusPlanesServer
 
+	// ResourceGroupRoleAssignmentsServer contains the fakes for client ResourceGroupRoleAssignmentsClient
+	ResourceGroupRoleAssignmentsServer ResourceGroupRoleAssignmentsServer
+
 	// ResourceGroupsServer contains the fakes for client ResourceGroupsClient
 	ResourceGroupsServer ResourceGroupsServer
 
@@ -50,6 +53,12 @@ type ServerFactory struct {
 
 	// ResourcesServer contains the fakes for client ResourcesClient
 	ResourcesServer ResourcesServer
+
+	// RoleAssignmentsServer contains the fakes for client RoleAssignmentsClient
+	RoleAssignmentsServer RoleAssignmentsServer
+
+	// RoleDefinitionsServer contains the fakes for client RoleDefinitionsClient
+	RoleDefinitionsServer RoleDefinitionsServer
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -64,20 +73,23 @@ func NewServerFactoryTransport(srv *ServerFactory) *ServerFactoryTransport {
 // ServerFactoryTransport connects instances of v20231001preview.ClientFactory to instances of ServerFactory.
 // Don't use this type directly, use NewServerFactoryTransport instead.
 type ServerFactoryTransport struct {
-	srv                       *ServerFactory
-	trMu                      sync.Mutex
-	trAPIVersionsServer       *APIVersionsServerTransport
-	trAwsCredentialsServer    *AwsCredentialsServerTransport
-	trAwsPlanesServer         *AwsPlanesServerTransport
-	trAzureCredentialsServer  *AzureCredentialsServerTransport
-	trAzurePlanesServer       *AzurePlanesServerTransport
-	trLocationsServer         *LocationsServerTransport
-	trPlanesServer            *PlanesServerTransport
-	trRadiusPlanesServer      *RadiusPlanesServerTransport
-	trResourceGroupsServer    *ResourceGroupsServerTransport
-	trResourceProvidersServer *ResourceProvidersServerTransport
-	trResourceTypesServer     *ResourceTypesServerTransport
-	trResourcesServer         *ResourcesServerTransport
+	srv                                  *ServerFactory
+	trMu                                 sync.Mutex
+	trAPIVersionsServer                  *APIVersionsServerTransport
+	trAwsCredentialsServer               *AwsCredentialsServerTransport
+	trAwsPlanesServer                    *AwsPlanesServerTransport
+	trAzureCredentialsServer             *AzureCredentialsServerTransport
+	trAzurePlanesServer                  *AzurePlanesServerTransport
+	trLocationsServer                    *LocationsServerTransport
+	trPlanesServer                       *PlanesServerTransport
+	trRadiusPlanesServer                 *RadiusPlanesServerTransport
+	trResourceGroupRoleAssignmentsServer *ResourceGroupRoleAssignmentsServerTransport
+	trResourceGroupsServer               *ResourceGroupsServerTransport
+	trResourceProvidersServer            *ResourceProvidersServerTransport
+	trResourceTypesServer                *ResourceTypesServerTransport
+	trResourcesServer                    *ResourcesServerTransport
+	trRoleAssignmentsServer              *RoleAssignmentsServerTransport
+	trRoleDefinitionsServer              *RoleDefinitionsServerTransport
 }
 
 // Do implements the policy.Transporter interface for ServerFactoryTransport.
@@ -121,6 +133,11 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "RadiusPlanesClient":
 		initServer(s, &s.trRadiusPlanesServer, func() *RadiusPlanesServerTransport { return NewRadiusPlanesServerTransport(&s.srv.RadiusPlanesServer) })
 		resp, err = s.trRadiusPlanesServer.Do(req)
+	case "ResourceGroupRoleAssignmentsClient":
+		initServer(s, &s.trResourceGroupRoleAssignmentsServer, func() *ResourceGroupRoleAssignmentsServerTransport {
+			return NewResourceGroupRoleAssignmentsServerTransport(&s.srv.ResourceGroupRoleAssignmentsServer)
+		})
+		resp, err = s.trResourceGroupRoleAssignmentsServer.Do(req)
 	case "ResourceGroupsClient":
 		initServer(s, &s.trResourceGroupsServer, func() *ResourceGroupsServerTransport {
 			return NewResourceGroupsServerTransport(&s.srv.ResourceGroupsServer)
@@ -139,6 +156,16 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "ResourcesClient":
 		initServer(s, &s.trResourcesServer, func() *ResourcesServerTransport { return NewResourcesServerTransport(&s.srv.ResourcesServer) })
 		resp, err = s.trResourcesServer.Do(req)
+	case "RoleAssignmentsClient":
+		initServer(s, &s.trRoleAssignmentsServer, func() *RoleAssignmentsServerTransport {
+			return NewRoleAssignmentsServerTransport(&s.srv.RoleAssignmentsServer)
+		})
+		resp, err = s.trRoleAssignmentsServer.Do(req)
+	case "RoleDefinitionsClient":
+		initServer(s, &s.trRoleDefinitionsServer, func() *RoleDefinitionsServerTransport {
+			return NewRoleDefinitionsServerTransport(&s.srv.RoleDefinitionsServer)
+		})
+		resp, err = s.trRoleDefinitionsServer.Do(req)
 	default:
 		err = fmt.Errorf("unhandled client %s", client)
 	}
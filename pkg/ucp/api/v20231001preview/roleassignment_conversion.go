@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleAssignmentResource resource to version-agnostic datamodel.
+func (src *RoleAssignmentResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleAssignmentResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location, systemData, or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties == nil {
+		return dst, nil
+	}
+
+	dst.Properties = datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: to.String(src.Properties.RoleDefinitionID),
+		PrincipalID:      to.String(src.Properties.PrincipalID),
+		Scope:            to.String(src.Properties.Scope),
+	}
+
+	if src.Properties.PrincipalType != nil {
+		dst.Properties.PrincipalType = v1.PrincipalType(*src.Properties.PrincipalType)
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleAssignmentResource resource.
+func (dst *RoleAssignmentResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleAssignment)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(datamodel.RoleAssignmentResourceType)
+
+	// NOTE: this is a proxy resource. It does not have a location, systemData, or tags.
+
+	dst.Properties = &RoleAssignmentProperties{
+		ProvisioningState: fromProvisioningStateDataModel(dm.InternalMetadata.AsyncProvisioningState),
+		RoleDefinitionID:  to.Ptr(dm.Properties.RoleDefinitionID),
+		PrincipalID:       to.Ptr(dm.Properties.PrincipalID),
+		PrincipalType:     to.Ptr(PrincipalType(dm.Properties.PrincipalType)),
+		Scope:             toStringPtr(dm.Properties.Scope),
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAssignment_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_resource.json")
+	versioned := &RoleAssignmentResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/System.Authorization/roleAssignments/team-a",
+				Name: "team-a",
+				Type: datamodel.RoleAssignmentResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			RoleDefinitionID: "Contributor",
+			PrincipalID:      "team-a",
+			PrincipalType:    v1.PrincipalTypeGroup,
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleAssignment_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_datamodel.json")
+	data := &datamodel.RoleAssignment{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &RoleAssignmentResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &RoleAssignmentResource{
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/System.Authorization/roleAssignments/team-a"),
+		Name: to.Ptr("team-a"),
+		Type: to.Ptr(datamodel.RoleAssignmentResourceType),
+		Properties: &RoleAssignmentProperties{
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+			RoleDefinitionID:  to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/app-reader"),
+			PrincipalID:       to.Ptr("system:serviceaccount:default:deployer"),
+			PrincipalType:     to.Ptr(PrincipalTypeServiceAccount),
+			Scope:             to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app"),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_RoleAssignment_InvalidDataModel(t *testing.T) {
+	versioned := &RoleAssignmentResource{}
+	err := versioned.ConvertFrom(&datamodel.RoleDefinition{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleDefinitionResource resource to version-agnostic datamodel.
+func (src *RoleDefinitionResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleDefinitionResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location, systemData, or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties == nil {
+		return dst, nil
+	}
+
+	dst.Properties = datamodel.RoleDefinitionProperties{
+		RoleName:    to.String(src.Properties.RoleName),
+		Description: to.String(src.Properties.Description),
+	}
+
+	for _, permission := range src.Properties.Permissions {
+		if permission == nil {
+			continue
+		}
+
+		dst.Properties.Permissions = append(dst.Properties.Permissions, datamodel.RolePermission{
+			Actions:    to.StringArray(permission.Actions),
+			NotActions: to.StringArray(permission.NotActions),
+		})
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleDefinitionResource resource.
+func (dst *RoleDefinitionResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleDefinition)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(datamodel.RoleDefinitionResourceType)
+
+	// NOTE: this is a proxy resource. It does not have a location, systemData, or tags.
+
+	dst.Properties = &RoleDefinitionProperties{
+		ProvisioningState: fromProvisioningStateDataModel(dm.InternalMetadata.AsyncProvisioningState),
+		RoleName:          toStringPtr(dm.Properties.RoleName),
+		Description:       toStringPtr(dm.Properties.Description),
+		Permissions:       []*RolePermission{},
+	}
+
+	for _, permission := range dm.Properties.Permissions {
+		dst.Properties.Permissions = append(dst.Properties.Permissions, &RolePermission{
+			Actions:    to.ArrayofStringPtrs(permission.Actions),
+			NotActions: to.ArrayofStringPtrs(permission.NotActions),
+		})
+	}
+
+	return nil
+}
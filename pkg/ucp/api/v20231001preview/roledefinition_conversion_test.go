@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleDefinition_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_resource.json")
+	versioned := &RoleDefinitionResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/providers/System.Authorization/roleDefinitions/app-reader",
+				Name: "app-reader",
+				Type: datamodel.RoleDefinitionResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			RoleName:    "Application Reader",
+			Description: "Read access to applications.",
+			Permissions: []datamodel.RolePermission{
+				{
+					Actions:    []string{"Applications.Core/*/read"},
+					NotActions: []string{"Applications.Core/secretStores/read"},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleDefinition_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_datamodel.json")
+	data := &datamodel.RoleDefinition{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &RoleDefinitionResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &RoleDefinitionResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/app-reader"),
+		Name: to.Ptr("app-reader"),
+		Type: to.Ptr(datamodel.RoleDefinitionResourceType),
+		Properties: &RoleDefinitionProperties{
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+			RoleName:          to.Ptr("Application Reader"),
+			Description:       to.Ptr("Read access to applications."),
+			Permissions: []*RolePermission{
+				{
+					Actions:    []*string{to.Ptr("Applications.Core/*/read")},
+					NotActions: []*string{to.Ptr("Applications.Core/secretStores/read")},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_RoleDefinition_InvalidDataModel(t *testing.T) {
+	versioned := &RoleDefinitionResource{}
+	err := versioned.ConvertFrom(&datamodel.RoleAssignment{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -85,6 +85,13 @@ func (c *ClientFactory) NewRadiusPlanesClient() *RadiusPlanesClient {
 	}
 }
 
+// NewResourceGroupRoleAssignmentsClient creates a new instance of ResourceGroupRoleAssignmentsClient.
+func (c *ClientFactory) NewResourceGroupRoleAssignmentsClient() *ResourceGroupRoleAssignmentsClient {
+	return &ResourceGroupRoleAssignmentsClient{
+		internal: c.internal,
+	}
+}
+
 // NewResourceGroupsClient creates a new instance of ResourceGroupsClient.
 func (c *ClientFactory) NewResourceGroupsClient() *ResourceGroupsClient {
 	return &ResourceGroupsClient{
@@ -112,3 +119,17 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 		internal: c.internal,
 	}
 }
+
+// NewRoleAssignmentsClient creates a new instance of RoleAssignmentsClient.
+func (c *ClientFactory) NewRoleAssignmentsClient() *RoleAssignmentsClient {
+	return &RoleAssignmentsClient{
+		internal: c.internal,
+	}
+}
+
+// NewRoleDefinitionsClient creates a new instance of RoleDefinitionsClient.
+func (c *ClientFactory) NewRoleDefinitionsClient() *RoleDefinitionsClient {
+	return &RoleDefinitionsClient{
+		internal: c.internal,
+	}
+}
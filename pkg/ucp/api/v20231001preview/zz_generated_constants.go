@@ -77,6 +77,27 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// PrincipalType - The type of a principal.
+type PrincipalType string
+
+const (
+	// PrincipalTypeGroup - A group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeServiceAccount - A Kubernetes service account.
+	PrincipalTypeServiceAccount PrincipalType = "ServiceAccount"
+	// PrincipalTypeUser - A user.
+	PrincipalTypeUser PrincipalType = "User"
+)
+
+// PossiblePrincipalTypeValues returns the possible values for the PrincipalType const type.
+func PossiblePrincipalTypeValues() []PrincipalType {
+	return []PrincipalType{
+		PrincipalTypeGroup,
+		PrincipalTypeServiceAccount,
+		PrincipalTypeUser,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
@@ -791,6 +791,107 @@ type ResourceTypeSummaryResultAPIVersion struct {
 	Schema map[string]any
 }
 
+// RoleAssignmentProperties - The properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// REQUIRED; The identifier of the principal. For users and groups this is the name reported by the authenticator. For
+	// Kubernetes service accounts this is 'system:serviceaccount:{namespace}:{name}'.
+	PrincipalID *string
+
+	// REQUIRED; The type of the principal.
+	PrincipalType *PrincipalType
+
+	// REQUIRED; The resource ID of the role definition, or the name of a built-in role: 'Reader', 'Contributor' or
+	// 'Owner'.
+	RoleDefinitionID *string
+
+	// The scope of the role assignment. Defaults to the plane or resource group containing the role assignment. Can be set
+	// to a resource in that plane or resource group.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleAssignmentResource - A role assignment grants a role to a principal at a scope. Role assignments are created in a
+// plane or a resource group and apply to that scope unless a narrower scope is specified.
+type RoleAssignmentResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleAssignmentProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleAssignmentResourceListResult - The response of a RoleAssignmentResource list operation.
+type RoleAssignmentResourceListResult struct {
+	// REQUIRED; The RoleAssignmentResource items on this page
+	Value []*RoleAssignmentResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// RoleDefinitionProperties - The properties of a role definition.
+type RoleDefinitionProperties struct {
+	// REQUIRED; The permissions granted by the role.
+	Permissions []*RolePermission
+
+	// Description of the role.
+	Description *string
+
+	// The display name of the role.
+	RoleName *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleDefinitionResource - A role definition is a named set of permissions that can be assigned to principals.
+type RoleDefinitionResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleDefinitionProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleDefinitionResourceListResult - The response of a RoleDefinitionResource list operation.
+type RoleDefinitionResourceListResult struct {
+	// REQUIRED; The RoleDefinitionResource items on this page
+	Value []*RoleDefinitionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// RolePermission - A set of actions allowed by a role. Actions have the form '{resourceType}/{operation}', for example
+// 'Applications.Core/containers/read'. The '*' wildcard matches any sequence of characters.
+type RolePermission struct {
+	// REQUIRED; The allowed actions.
+	Actions []*string
+
+	// The actions excluded from the allowed actions.
+	NotActions []*string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
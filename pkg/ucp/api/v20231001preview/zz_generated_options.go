@@ -194,6 +194,30 @@ type RadiusPlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupRoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupRoleAssignmentsClient.CreateOrUpdate
+// method.
+type ResourceGroupRoleAssignmentsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceGroupRoleAssignmentsClientDeleteOptions contains the optional parameters for the ResourceGroupRoleAssignmentsClient.Delete
+// method.
+type ResourceGroupRoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceGroupRoleAssignmentsClientGetOptions contains the optional parameters for the ResourceGroupRoleAssignmentsClient.Get
+// method.
+type ResourceGroupRoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceGroupRoleAssignmentsClientListOptions contains the optional parameters for the ResourceGroupRoleAssignmentsClient.NewListPager
+// method.
+type ResourceGroupRoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupsClient.CreateOrUpdate
 // method.
 type ResourceGroupsClientCreateOrUpdateOptions struct {
@@ -283,3 +307,45 @@ type ResourceTypesClientListOptions struct {
 type ResourcesClientListOptions struct {
 	// placeholder for future optional parameters
 }
+
+// RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate
+// method.
+type RoleAssignmentsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+type RoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+type RoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+type RoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientCreateOrUpdateOptions contains the optional parameters for the RoleDefinitionsClient.CreateOrUpdate
+// method.
+type RoleDefinitionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientDeleteOptions contains the optional parameters for the RoleDefinitionsClient.Delete method.
+type RoleDefinitionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientGetOptions contains the optional parameters for the RoleDefinitionsClient.Get method.
+type RoleDefinitionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientListOptions contains the optional parameters for the RoleDefinitionsClient.NewListPager method.
+type RoleDefinitionsClientListOptions struct {
+	// placeholder for future optional parameters
+}
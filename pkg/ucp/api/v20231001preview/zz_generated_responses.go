@@ -203,6 +203,31 @@ type RadiusPlanesClientUpdateResponse struct {
 	RadiusPlaneResource
 }
 
+// ResourceGroupRoleAssignmentsClientCreateOrUpdateResponse contains the response from method ResourceGroupRoleAssignmentsClient.CreateOrUpdate.
+type ResourceGroupRoleAssignmentsClientCreateOrUpdateResponse struct {
+	// A role assignment grants a role to a principal at a scope. Role assignments are created in a plane or a resource group
+	// and apply to that scope unless a narrower scope is specified.
+	RoleAssignmentResource
+}
+
+// ResourceGroupRoleAssignmentsClientDeleteResponse contains the response from method ResourceGroupRoleAssignmentsClient.Delete.
+type ResourceGroupRoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// ResourceGroupRoleAssignmentsClientGetResponse contains the response from method ResourceGroupRoleAssignmentsClient.Get.
+type ResourceGroupRoleAssignmentsClientGetResponse struct {
+	// A role assignment grants a role to a principal at a scope. Role assignments are created in a plane or a resource group
+	// and apply to that scope unless a narrower scope is specified.
+	RoleAssignmentResource
+}
+
+// ResourceGroupRoleAssignmentsClientListResponse contains the response from method ResourceGroupRoleAssignmentsClient.NewListPager.
+type ResourceGroupRoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
+
 // ResourceGroupsClientCreateOrUpdateResponse contains the response from method ResourceGroupsClient.CreateOrUpdate.
 type ResourceGroupsClientCreateOrUpdateResponse struct {
 	// The resource group resource
@@ -296,3 +321,51 @@ type ResourcesClientListResponse struct {
 	// The response of a GenericResource list operation.
 	GenericResourceListResult
 }
+
+// RoleAssignmentsClientCreateOrUpdateResponse contains the response from method RoleAssignmentsClient.CreateOrUpdate.
+type RoleAssignmentsClientCreateOrUpdateResponse struct {
+	// A role assignment grants a role to a principal at a scope. Role assignments are created in a plane or a resource group
+	// and apply to that scope unless a narrower scope is specified.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientDeleteResponse contains the response from method RoleAssignmentsClient.Delete.
+type RoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleAssignmentsClientGetResponse contains the response from method RoleAssignmentsClient.Get.
+type RoleAssignmentsClientGetResponse struct {
+	// A role assignment grants a role to a principal at a scope. Role assignments are created in a plane or a resource group
+	// and apply to that scope unless a narrower scope is specified.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientListResponse contains the response from method RoleAssignmentsClient.NewListPager.
+type RoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
+
+// RoleDefinitionsClientCreateOrUpdateResponse contains the response from method RoleDefinitionsClient.CreateOrUpdate.
+type RoleDefinitionsClientCreateOrUpdateResponse struct {
+	// A role definition is a named set of permissions that can be assigned to principals.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientDeleteResponse contains the response from method RoleDefinitionsClient.Delete.
+type RoleDefinitionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleDefinitionsClientGetResponse contains the response from method RoleDefinitionsClient.Get.
+type RoleDefinitionsClientGetResponse struct {
+	// A role definition is a named set of permissions that can be assigned to principals.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientListResponse contains the response from method RoleDefinitionsClient.NewListPager.
+type RoleDefinitionsClientListResponse struct {
+	// The response of a RoleDefinitionResource list operation.
+	RoleDefinitionResourceListResult
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// RoleReader is the name of the built-in role that allows reading all resources.
+	RoleReader = "Reader"
+	// RoleContributor is the name of the built-in role that allows managing all resources except role assignments and role definitions.
+	RoleContributor = "Contributor"
+	// RoleOwner is the name of the built-in role that allows managing all resources including access.
+	RoleOwner = "Owner"
+
+	// DefaultSuperGroup is the group whose members are always authorized. This matches the Kubernetes cluster-admin group.
+	DefaultSuperGroup = "system:masters"
+
+	// roleAssignmentCacheTTL is how long the role assignments of a scope are cached. Writes through this instance
+	// invalidate the cache immediately, the TTL bounds how long writes through other UCP instances take to apply.
+	roleAssignmentCacheTTL = 30 * time.Second
+
+	// maxCachedScopes is the maximum number of scopes whose role assignments are cached.
+	maxCachedScopes = 10000
+)
+
+// BuiltInRoles are the roles which are available without creating a role definition.
+var BuiltInRoles = map[string][]datamodel.RolePermission{
+	strings.ToLower(RoleReader): {
+		{Actions: []string{"*/read"}},
+	},
+	strings.ToLower(RoleContributor): {
+		{
+			Actions:    []string{"*"},
+			NotActions: []string{"System.Authorization/*/write", "System.Authorization/*/delete"},
+		},
+	},
+	strings.ToLower(RoleOwner): {
+		{Actions: []string{"*"}},
+	},
+}
+
+var _ authorization.Authorizer = (*RBACAuthorizer)(nil)
+
+// RBACAuthorizer authorizes requests using the role assignments and role definitions stored in UCP.
+//
+// Role assignments are stored at plane or resource group level. A role assignment applies to the scope
+// that contains it, or to the narrower scope set in its properties. The role assignments of each scope are
+// cached, call InvalidateRoleAssignments after writing role assignments.
+type RBACAuthorizer struct {
+	databaseClient  database.Client
+	superUsers      map[string]bool
+	superGroups     map[string]bool
+	roleAssignments []datamodel.RoleAssignmentProperties
+
+	mutex      sync.Mutex
+	cache      map[string]cachedRoleAssignments
+	generation uint64
+}
+
+type cachedRoleAssignments struct {
+	assignments []datamodel.RoleAssignment
+	expiresAt   time.Time
+}
+
+// NewRBACAuthorizer creates a new RBACAuthorizer. Principals named in superUsers, or belonging to a group in
+// superGroups, are allowed to perform any action. When superGroups is empty DefaultSuperGroup is used.
+//
+// roleAssignments are built-in role assignments which apply in addition to the role assignments stored in UCP.
+// A built-in role assignment without a scope applies to all planes.
+func NewRBACAuthorizer(databaseClient database.Client, superUsers []string, superGroups []string, roleAssignments []datamodel.RoleAssignmentProperties) *RBACAuthorizer {
+	if len(superGroups) == 0 {
+		superGroups = []string{DefaultSuperGroup}
+	}
+
+	builtIn := []datamodel.RoleAssignmentProperties{}
+	for _, assignment := range roleAssignments {
+		if assignment.Scope == "" {
+			assignment.Scope = "/planes"
+		}
+		builtIn = append(builtIn, assignment)
+	}
+
+	return &RBACAuthorizer{
+		databaseClient:  databaseClient,
+		superUsers:      toSet(superUsers),
+		superGroups:     toSet(superGroups),
+		roleAssignments: builtIn,
+		cache:           map[string]cachedRoleAssignments{},
+	}
+}
+
+// InvalidateRoleAssignments discards the cached role assignments so that the next requests read them from
+// the database.
+func (a *RBACAuthorizer) InvalidateRoleAssignments() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.generation++
+	a.cache = map[string]cachedRoleAssignments{}
+}
+
+// Authorize returns true if one of the role assignments of the principal which apply to id grants the action.
+func (a *RBACAuthorizer) Authorize(ctx context.Context, principal *v1.Principal, action string, id resources.ID) (bool, error) {
+	if principal == nil {
+		return false, nil
+	}
+
+	if a.isSuperUser(principal) {
+		return true, nil
+	}
+
+	for _, assignment := range a.roleAssignments {
+		if !matchesPrincipal(principal, assignment) || !isWithinScope(assignment.Scope, id.String()) {
+			continue
+		}
+
+		permissions, err := a.getPermissions(ctx, assignment.RoleDefinitionID)
+		if err != nil {
+			return false, err
+		}
+
+		if isActionPermitted(permissions, action) {
+			return true, nil
+		}
+	}
+
+	// Role assignments only exist in planes and resource groups, so anything outside of a plane is reserved for super users.
+	if !id.IsUCPQualified() || len(id.ScopeSegments()) == 0 || id.ScopeSegments()[0].Name == "" {
+		return false, nil
+	}
+
+	for _, rootScope := range assignmentScopes(id) {
+		assignments, err := a.getRoleAssignments(ctx, rootScope)
+		if err != nil {
+			return false, err
+		}
+
+		for _, assignment := range assignments {
+			if !matchesPrincipal(principal, assignment.Properties) {
+				continue
+			}
+
+			if !isWithinScope(effectiveScope(rootScope, assignment.Properties.Scope), id.String()) {
+				continue
+			}
+
+			permissions, err := a.getPermissions(ctx, assignment.Properties.RoleDefinitionID)
+			if err != nil {
+				return false, err
+			}
+
+			if isActionPermitted(permissions, action) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (a *RBACAuthorizer) isSuperUser(principal *v1.Principal) bool {
+	if a.superUsers[strings.ToLower(principal.Name)] {
+		return true
+	}
+
+	for _, group := range principal.Groups {
+		if a.superGroups[strings.ToLower(group)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getRoleAssignments returns the role assignments stored in rootScope, from the cache when possible.
+func (a *RBACAuthorizer) getRoleAssignments(ctx context.Context, rootScope string) ([]datamodel.RoleAssignment, error) {
+	key := strings.ToLower(rootScope)
+
+	a.mutex.Lock()
+	cached, ok := a.cache[key]
+	generation := a.generation
+	a.mutex.Unlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.assignments, nil
+	}
+
+	assignments, err := a.listRoleAssignments(ctx, rootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	// Role assignments written while the query ran might be missing from the result, so it is not cached.
+	if generation == a.generation {
+		if len(a.cache) >= maxCachedScopes {
+			a.cache = map[string]cachedRoleAssignments{}
+		}
+		a.cache[key] = cachedRoleAssignments{assignments: assignments, expiresAt: time.Now().Add(roleAssignmentCacheTTL)}
+	}
+
+	return assignments, nil
+}
+
+func (a *RBACAuthorizer) listRoleAssignments(ctx context.Context, rootScope string) ([]datamodel.RoleAssignment, error) {
+	query := database.Query{
+		RootScope:    rootScope,
+		ResourceType: datamodel.RoleAssignmentResourceType,
+	}
+
+	assignments := []datamodel.RoleAssignment{}
+	token := ""
+	for {
+		result, err := a.databaseClient.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			assignment := datamodel.RoleAssignment{}
+			if err := item.As(&assignment); err != nil {
+				return nil, err
+			}
+			assignments = append(assignments, assignment)
+		}
+
+		if result.PaginationToken == "" {
+			return assignments, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+func (a *RBACAuthorizer) getPermissions(ctx context.Context, roleDefinitionID string) ([]datamodel.RolePermission, error) {
+	if permissions, ok := BuiltInRoles[strings.ToLower(roleDefinitionID)]; ok {
+		return permissions, nil
+	}
+
+	obj, err := a.databaseClient.Get(ctx, roleDefinitionID)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		// A dangling role assignment grants nothing.
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get role definition %q: %w", roleDefinitionID, err)
+	}
+
+	roleDefinition := datamodel.RoleDefinition{}
+	if err := obj.As(&roleDefinition); err != nil {
+		return nil, err
+	}
+
+	return roleDefinition.Properties.Permissions, nil
+}
+
+// assignmentScopes returns the scopes whose role assignments apply to id: the plane and, when id is
+// inside a resource group, the resource group.
+func assignmentScopes(id resources.ID) []string {
+	segments := id.ScopeSegments()
+	scopes := []string{"/planes/" + segments[0].Type + "/" + segments[0].Name}
+	if len(segments) >= 2 && strings.EqualFold(segments[1].Type, "resourceGroups") && segments[1].Name != "" {
+		scopes = append(scopes, scopes[0]+"/resourceGroups/"+segments[1].Name)
+	}
+	return scopes
+}
+
+// effectiveScope returns the scope a role assignment stored in rootScope applies to. A scope outside of
+// rootScope is ignored so that a role assignment cannot grant access beyond the scope that contains it.
+func effectiveScope(rootScope string, scope string) string {
+	if scope != "" && isWithinScope(rootScope, scope) {
+		return scope
+	}
+	return rootScope
+}
+
+func matchesPrincipal(principal *v1.Principal, assignment datamodel.RoleAssignmentProperties) bool {
+	if assignment.PrincipalType == v1.PrincipalTypeGroup {
+		for _, group := range principal.Groups {
+			if strings.EqualFold(group, assignment.PrincipalID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return strings.EqualFold(principal.Name, assignment.PrincipalID)
+}
+
+// isWithinScope returns true if id is equal to scope or nested inside of it.
+func isWithinScope(scope string, id string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	id = strings.ToLower(strings.TrimSuffix(id, "/"))
+	return id == scope || strings.HasPrefix(id, scope+"/")
+}
+
+func isActionPermitted(permissions []datamodel.RolePermission, action string) bool {
+	for _, permission := range permissions {
+		if matchesAny(permission.Actions, action) && !matchesAny(permission.NotActions, action) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if matchAction(pattern, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAction matches an action against a pattern where '*' matches any sequence of characters. Matching is
+// case-insensitive.
+func matchAction(pattern string, action string) bool {
+	pattern = strings.ToLower(pattern)
+	action = strings.ToLower(action)
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == action
+	}
+
+	if !strings.HasPrefix(action, parts[0]) {
+		return false
+	}
+	action = action[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(action, part)
+		if index < 0 {
+			return false
+		}
+		action = action[index+len(part):]
+	}
+
+	return strings.HasSuffix(action, parts[len(parts)-1])
+}
+
+func toSet(values []string) map[string]bool {
+	set := map[string]bool{}
+	for _, value := range values {
+		set[strings.ToLower(value)] = true
+	}
+	return set
+}
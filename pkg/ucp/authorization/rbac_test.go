@@ -0,0 +1,357 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPlane         = "/planes/radius/local"
+	testResourceGroup = "/planes/radius/local/resourceGroups/team-a"
+)
+
+func saveRoleAssignment(t *testing.T, client database.Client, id string, properties datamodel.RoleAssignmentProperties) {
+	assignment := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: resources.MustParse(id).Name(),
+				Type: datamodel.RoleAssignmentResourceType,
+			},
+		},
+		Properties: properties,
+	}
+
+	err := client.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: assignment})
+	require.NoError(t, err)
+}
+
+func saveRoleDefinition(t *testing.T, client database.Client, id string, permissions []datamodel.RolePermission) {
+	definition := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: resources.MustParse(id).Name(),
+				Type: datamodel.RoleDefinitionResourceType,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			RoleName:    resources.MustParse(id).Name(),
+			Permissions: permissions,
+		},
+	}
+
+	err := client.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: definition})
+	require.NoError(t, err)
+}
+
+func Test_RBACAuthorizer_Authorize(t *testing.T) {
+	client := inmemory.NewClient()
+
+	saveRoleAssignment(t, client, testPlane+"/providers/System.Authorization/roleAssignments/plane-reader", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: RoleReader,
+		PrincipalID:      "auditors",
+		PrincipalType:    v1.PrincipalTypeGroup,
+	})
+	saveRoleAssignment(t, client, testResourceGroup+"/providers/System.Authorization/roleAssignments/rg-contributor", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: RoleContributor,
+		PrincipalID:      "alice@contoso.com",
+		PrincipalType:    v1.PrincipalTypeUser,
+	})
+	saveRoleAssignment(t, client, testResourceGroup+"/providers/System.Authorization/roleAssignments/app-owner", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: RoleOwner,
+		PrincipalID:      "bob@contoso.com",
+		PrincipalType:    v1.PrincipalTypeUser,
+		Scope:            testResourceGroup + "/providers/Applications.Core/applications/app",
+	})
+	saveRoleAssignment(t, client, testResourceGroup+"/providers/System.Authorization/roleAssignments/escape", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: RoleOwner,
+		PrincipalID:      "mallory@contoso.com",
+		PrincipalType:    v1.PrincipalTypeUser,
+		Scope:            testPlane,
+	})
+
+	customRole := testPlane + "/providers/System.Authorization/roleDefinitions/deployer"
+	saveRoleDefinition(t, client, customRole, []datamodel.RolePermission{
+		{Actions: []string{"Applications.Core/*/read", "Applications.Core/environments/*/action"}},
+	})
+	saveRoleAssignment(t, client, testPlane+"/providers/System.Authorization/roleAssignments/deployer", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: customRole,
+		PrincipalID:      "system:serviceaccount:ci:deployer",
+		PrincipalType:    v1.PrincipalTypeServiceAccount,
+	})
+	saveRoleAssignment(t, client, testPlane+"/providers/System.Authorization/roleAssignments/dangling", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: testPlane + "/providers/System.Authorization/roleDefinitions/deleted",
+		PrincipalID:      "eve@contoso.com",
+		PrincipalType:    v1.PrincipalTypeUser,
+	})
+
+	authorizer := NewRBACAuthorizer(client, []string{"admin@contoso.com"}, nil, nil)
+
+	tests := []struct {
+		name      string
+		principal *v1.Principal
+		action    string
+		id        string
+		allowed   bool
+	}{
+		{
+			name:      "super user",
+			principal: v1.NewPrincipal("admin@contoso.com", nil),
+			action:    "System.Resources/planes/read",
+			id:        "/planes",
+			allowed:   true,
+		},
+		{
+			name:      "super group",
+			principal: v1.NewPrincipal("kubernetes-admin", []string{"system:masters"}),
+			action:    "System.Authorization/roleAssignments/write",
+			id:        testPlane + "/providers/System.Authorization/roleAssignments/test",
+			allowed:   true,
+		},
+		{
+			name:      "no role assignment",
+			principal: v1.NewPrincipal("nobody@contoso.com", nil),
+			action:    "Applications.Core/applications/read",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   false,
+		},
+		{
+			name:      "group reader can read in resource group",
+			principal: v1.NewPrincipal("carol@contoso.com", []string{"auditors"}),
+			action:    "Applications.Core/applications/read",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   true,
+		},
+		{
+			name:      "group reader cannot write",
+			principal: v1.NewPrincipal("carol@contoso.com", []string{"auditors"}),
+			action:    "Applications.Core/applications/write",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   false,
+		},
+		{
+			name:      "contributor can write in resource group",
+			principal: v1.NewPrincipal("alice@contoso.com", nil),
+			action:    "Applications.Core/applications/write",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   true,
+		},
+		{
+			name:      "contributor cannot assign roles",
+			principal: v1.NewPrincipal("alice@contoso.com", nil),
+			action:    "System.Authorization/roleAssignments/write",
+			id:        testResourceGroup + "/providers/System.Authorization/roleAssignments/test",
+			allowed:   false,
+		},
+		{
+			name:      "contributor cannot write in other resource group",
+			principal: v1.NewPrincipal("alice@contoso.com", nil),
+			action:    "Applications.Core/applications/write",
+			id:        "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/applications/app",
+			allowed:   false,
+		},
+		{
+			name:      "resource scoped owner",
+			principal: v1.NewPrincipal("bob@contoso.com", nil),
+			action:    "Applications.Core/applications/delete",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   true,
+		},
+		{
+			name:      "resource scoped owner on other resource",
+			principal: v1.NewPrincipal("bob@contoso.com", nil),
+			action:    "Applications.Core/applications/delete",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app2",
+			allowed:   false,
+		},
+		{
+			name:      "scope cannot escape the containing resource group",
+			principal: v1.NewPrincipal("mallory@contoso.com", nil),
+			action:    "Applications.Core/applications/write",
+			id:        "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/applications/app",
+			allowed:   false,
+		},
+		{
+			name:      "custom role",
+			principal: v1.NewPrincipal("system:serviceaccount:ci:deployer", nil),
+			action:    "Applications.Core/environments/getMetadata/action",
+			id:        testResourceGroup + "/providers/Applications.Core/environments/env",
+			allowed:   true,
+		},
+		{
+			name:      "custom role denied",
+			principal: v1.NewPrincipal("system:serviceaccount:ci:deployer", nil),
+			action:    "Applications.Core/environments/write",
+			id:        testResourceGroup + "/providers/Applications.Core/environments/env",
+			allowed:   false,
+		},
+		{
+			name:      "missing role definition",
+			principal: v1.NewPrincipal("eve@contoso.com", nil),
+			action:    "Applications.Core/environments/read",
+			id:        testResourceGroup + "/providers/Applications.Core/environments/env",
+			allowed:   false,
+		},
+		{
+			name:      "role assignments do not apply to the list of planes",
+			principal: v1.NewPrincipal("carol@contoso.com", []string{"auditors"}),
+			action:    "System.Resources/planes/read",
+			id:        "/planes",
+			allowed:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := resources.Parse(tc.id)
+			require.NoError(t, err)
+
+			allowed, err := authorizer.Authorize(context.Background(), tc.principal, tc.action, id)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func Test_RBACAuthorizer_BuiltInRoleAssignments(t *testing.T) {
+	authorizer := NewRBACAuthorizer(inmemory.NewClient(), nil, nil, []datamodel.RoleAssignmentProperties{
+		{
+			RoleDefinitionID: RoleContributor,
+			PrincipalID:      "system:serviceaccount:radius-system:bicep-de",
+			PrincipalType:    v1.PrincipalTypeServiceAccount,
+		},
+		{
+			RoleDefinitionID: RoleReader,
+			PrincipalID:      "auditors",
+			PrincipalType:    v1.PrincipalTypeGroup,
+			Scope:            testResourceGroup,
+		},
+	})
+
+	tests := []struct {
+		name      string
+		principal *v1.Principal
+		action    string
+		id        string
+		allowed   bool
+	}{
+		{
+			name:      "unscoped assignment applies to all planes",
+			principal: v1.NewPrincipal("system:serviceaccount:radius-system:bicep-de", nil),
+			action:    "Applications.Core/applications/write",
+			id:        "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/test",
+			allowed:   true,
+		},
+		{
+			name:      "built-in role is enforced",
+			principal: v1.NewPrincipal("system:serviceaccount:radius-system:bicep-de", nil),
+			action:    "System.Authorization/roleAssignments/write",
+			id:        testResourceGroup + "/providers/System.Authorization/roleAssignments/test",
+			allowed:   false,
+		},
+		{
+			name:      "scoped assignment",
+			principal: v1.NewPrincipal("carol@contoso.com", []string{"auditors"}),
+			action:    "Applications.Core/applications/read",
+			id:        testResourceGroup + "/providers/Applications.Core/applications/app",
+			allowed:   true,
+		},
+		{
+			name:      "scoped assignment outside of its scope",
+			principal: v1.NewPrincipal("carol@contoso.com", []string{"auditors"}),
+			action:    "Applications.Core/applications/read",
+			id:        "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/applications/app",
+			allowed:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := resources.Parse(tc.id)
+			require.NoError(t, err)
+
+			allowed, err := authorizer.Authorize(context.Background(), tc.principal, tc.action, id)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func Test_RBACAuthorizer_RoleAssignmentCache(t *testing.T) {
+	client := inmemory.NewClient()
+	authorizer := NewRBACAuthorizer(client, nil, nil, nil)
+
+	principal := v1.NewPrincipal("alice@contoso.com", nil)
+	id := resources.MustParse(testResourceGroup + "/providers/Applications.Core/applications/app")
+
+	allowed, err := authorizer.Authorize(context.Background(), principal, "Applications.Core/applications/read", id)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	saveRoleAssignment(t, client, testResourceGroup+"/providers/System.Authorization/roleAssignments/reader", datamodel.RoleAssignmentProperties{
+		RoleDefinitionID: RoleReader,
+		PrincipalID:      "alice@contoso.com",
+		PrincipalType:    v1.PrincipalTypeUser,
+	})
+
+	// The role assignments of the resource group are cached.
+	allowed, err = authorizer.Authorize(context.Background(), principal, "Applications.Core/applications/read", id)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	authorizer.InvalidateRoleAssignments()
+
+	allowed, err = authorizer.Authorize(context.Background(), principal, "Applications.Core/applications/read", id)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func Test_matchAction(t *testing.T) {
+	tests := []struct {
+		pattern string
+		action  string
+		match   bool
+	}{
+		{"*", "Applications.Core/applications/write", true},
+		{"*/read", "Applications.Core/applications/read", true},
+		{"*/read", "Applications.Core/applications/write", false},
+		{"Applications.Core/*", "applications.core/containers/delete", true},
+		{"Applications.Core/*/read", "Applications.Core/containers/read", true},
+		{"Applications.Core/*/read", "Applications.Dapr/stateStores/read", false},
+		{"System.Authorization/*/write", "System.Authorization/roleAssignments/write", true},
+		{"Applications.Core/applications/read", "Applications.Core/applications/read", true},
+		{"Applications.Core/applications/read", "Applications.Core/applications/write", false},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxcyyb", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern+" "+tc.action, func(t *testing.T) {
+			require.Equal(t, tc.match, matchAction(tc.pattern, tc.action))
+		})
+	}
+}
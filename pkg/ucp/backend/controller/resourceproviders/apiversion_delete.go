@@ -20,6 +20,7 @@ import (
 	"context"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
@@ -38,12 +39,7 @@ func (c *APIVersionDeleteController) Run(ctx context.Context, request *ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	err = updateResourceProviderSummaryWithETag(ctx, c.DatabaseClient(), summaryID, summaryNotFoundIgnore, c.updateSummary(id))
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = c.DatabaseClient().Delete(ctx, request.ResourceID)
+	err = updateResourceProviderSummaryWithETag(ctx, c.DatabaseClient(), summaryID, summaryNotFoundIgnore, c.updateSummary(id), database.DeleteOperation(request.ResourceID))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
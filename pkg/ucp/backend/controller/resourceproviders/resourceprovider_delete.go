@@ -48,12 +48,7 @@ func (c *ResourceProviderDeleteController) Run(ctx context.Context, request *ctr
 		return ctrl.Result{}, fmt.Errorf("failed to delete child resources: %w", err)
 	}
 
-	err = c.deleteSummary(ctx, request)
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to delete resource provider summary: %w", err)
-	}
-
-	err = c.DatabaseClient().Delete(ctx, request.ResourceID)
+	err = c.deleteResourceProvider(ctx, request)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -216,19 +211,23 @@ func (c *ResourceProviderDeleteController) deleteResourceType(ctx context.Contex
 	return nil
 }
 
-func (c *ResourceProviderDeleteController) deleteSummary(ctx context.Context, request *ctrl.Request) error {
+// deleteResourceProvider deletes the resource provider and its summary in a single transaction.
+func (c *ResourceProviderDeleteController) deleteResourceProvider(ctx context.Context, request *ctrl.Request) error {
 	_, summaryID, err := resourceProviderSummaryIDFromRequest(request)
 	if err != nil {
 		return err
 	}
 
-	err = c.DatabaseClient().Delete(ctx, summaryID.String())
+	operations := []database.Operation{database.DeleteOperation(request.ResourceID)}
+
+	obj, err := c.DatabaseClient().Get(ctx, summaryID.String())
 	if errors.Is(err, &database.ErrNotFound{}) {
 		// It's OK if the summary was already deleted.
-		return nil
 	} else if err != nil {
-		return err
+		return fmt.Errorf("failed to get resource provider summary: %w", err)
+	} else {
+		operations = append(operations, database.DeleteOperation(summaryID.String(), database.WithETag(obj.ETag)))
 	}
 
-	return nil
+	return c.DatabaseClient().Transact(ctx, operations...)
 }
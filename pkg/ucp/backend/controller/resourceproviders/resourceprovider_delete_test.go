@@ -15,3 +15,48 @@ limitations under the License.
 */
 
 package resourceproviders
+
+import (
+	"context"
+	"testing"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceProviderDeleteController_deleteResourceProvider(t *testing.T) {
+	resourceProviderID := "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test"
+	summaryID := "/planes/radius/local/providers/System.Resources/resourceProviderSummaries/Applications.Test"
+
+	t.Run("existing summary", func(t *testing.T) {
+		client := database.NewMockClient(gomock.NewController(t))
+		client.EXPECT().Get(gomock.Any(), summaryID).Return(&database.Object{Metadata: database.Metadata{ID: summaryID, ETag: "summary-etag"}}, nil)
+		client.EXPECT().Transact(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+			expected := []database.Operation{
+				database.DeleteOperation(resourceProviderID),
+				database.DeleteOperation(summaryID, database.WithETag("summary-etag")),
+			}
+			require.Equal(t, expected, operations)
+			return nil
+		})
+
+		c := &ResourceProviderDeleteController{BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: client})}
+		err := c.deleteResourceProvider(context.Background(), &ctrl.Request{ResourceID: resourceProviderID})
+		require.NoError(t, err)
+	})
+
+	t.Run("missing summary", func(t *testing.T) {
+		client := database.NewMockClient(gomock.NewController(t))
+		client.EXPECT().Get(gomock.Any(), summaryID).Return(nil, &database.ErrNotFound{ID: summaryID})
+		client.EXPECT().Transact(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+			require.Equal(t, []database.Operation{database.DeleteOperation(resourceProviderID)}, operations)
+			return nil
+		})
+
+		c := &ResourceProviderDeleteController{BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: client})}
+		err := c.deleteResourceProvider(context.Background(), &ctrl.Request{ResourceID: resourceProviderID})
+		require.NoError(t, err)
+	})
+}
@@ -24,6 +24,7 @@ import (
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -54,12 +55,7 @@ func (c *ResourceTypeDeleteController) Run(ctx context.Context, request *ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	err = updateResourceProviderSummaryWithETag(ctx, c.DatabaseClient(), summaryID, summaryNotFoundIgnore, c.updateSummary(id))
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	err = c.DatabaseClient().Delete(ctx, request.ResourceID)
+	err = updateResourceProviderSummaryWithETag(ctx, c.DatabaseClient(), summaryID, summaryNotFoundIgnore, c.updateSummary(id), database.DeleteOperation(request.ResourceID))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -64,7 +64,11 @@ func resourceProviderSummaryIDFromRequest(request *ctrl.Request) (resources.ID,
 }
 
 // updateResourceProviderSummaryWithETag updates the summary with the provided function and saves it to the database client.
-func updateResourceProviderSummaryWithETag(ctx context.Context, client database.Client, summaryID resources.ID, policy summaryNotFoundPolicy, update func(summary *datamodel.ResourceProviderSummary) error) error {
+//
+// The summary is saved in the same transaction as the provided operations so that the summary can never be out of sync
+// with the resources it describes. If the summary is not found and the policy is summaryNotFoundIgnore, the operations
+// are still applied.
+func updateResourceProviderSummaryWithETag(ctx context.Context, client database.Client, summaryID resources.ID, policy summaryNotFoundPolicy, update func(summary *datamodel.ResourceProviderSummary) error, operations ...database.Operation) error {
 	// There are a few cases here:
 	// 1. The summary does not exist and we are allowed to create it (in the resource provider).
 	// 2. The summary does not exist and we are not allowed to create it (in the child-types of resource provider).
@@ -84,7 +88,11 @@ func updateResourceProviderSummaryWithETag(ctx context.Context, client database.
 			},
 		}
 	} else if errors.Is(err, &database.ErrNotFound{}) && policy == summaryNotFoundIgnore {
-		return nil
+		if len(operations) == 0 {
+			return nil
+		}
+
+		return client.Transact(ctx, operations...)
 	} else if errors.Is(err, &database.ErrNotFound{}) {
 		return err
 	} else if err != nil {
@@ -110,7 +118,8 @@ func updateResourceProviderSummaryWithETag(ctx context.Context, client database.
 	}
 
 	obj.Data = summary
-	err = client.Save(ctx, obj, options...)
+	operations = append([]database.Operation{database.SaveOperation(obj, options...)}, operations...)
+	err = client.Transact(ctx, operations...)
 	if err != nil {
 		return err
 	}
@@ -89,6 +89,7 @@ func Test_UpdateResourceProviderSummaryWithETag(t *testing.T) {
 		expectedErr  bool
 		expectedSave bool
 		existing     *datamodel.ResourceProviderSummary
+		operations   []database.Operation
 	}{
 		{
 			name:      "create new summary",
@@ -117,6 +118,18 @@ func Test_UpdateResourceProviderSummaryWithETag(t *testing.T) {
 			expectedSave: false,
 			existing:     nil,
 		},
+		{
+			name:      "ignore not found summary with operations",
+			summaryID: summaryID,
+			policy:    summaryNotFoundIgnore,
+			updateFunc: func(summary *datamodel.ResourceProviderSummary) error {
+				panic("Should not be called!")
+			},
+			expectedErr:  false,
+			expectedSave: false,
+			existing:     nil,
+			operations:   []database.Operation{database.DeleteOperation(summaryID.Truncate().String())},
+		},
 		{
 			name:      "fail on not found summary",
 			summaryID: summaryID,
@@ -146,6 +159,25 @@ func Test_UpdateResourceProviderSummaryWithETag(t *testing.T) {
 				BaseResource: v1.BaseResource{},
 			},
 		},
+		{
+			name:      "update existing summary with operations",
+			summaryID: summaryID,
+			policy:    summaryNotFoundIgnore,
+			updateFunc: func(summary *datamodel.ResourceProviderSummary) error {
+				summary.Properties = datamodel.ResourceProviderSummaryProperties{
+					ResourceTypes: map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
+						"testResources": {},
+					},
+				}
+				return nil
+			},
+			expectedErr:  false,
+			expectedSave: true,
+			existing: &datamodel.ResourceProviderSummary{
+				BaseResource: v1.BaseResource{},
+			},
+			operations: []database.Operation{database.DeleteOperation(summaryID.Truncate().String())},
+		},
 	}
 
 	for _, tt := range tests {
@@ -176,23 +208,29 @@ func Test_UpdateResourceProviderSummaryWithETag(t *testing.T) {
 			}
 
 			if tt.expectedSave {
-				client.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o *database.Object, so ...database.SaveOptions) error {
-
-					config := database.NewSaveConfig(so...)
-					require.Equal(t, expectedETag, config.ETag)
+				client.EXPECT().Transact(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+					require.Len(t, operations, len(tt.operations)+1)
+					require.Equal(t, database.OperationTypeSave, operations[0].Type)
+					require.Equal(t, expectedETag, operations[0].ETag)
+					require.ElementsMatch(t, tt.operations, operations[1:])
 
 					expectedResourceTypes := map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
 						"testResources": {},
 					}
 
-					summary := o.Data.(*datamodel.ResourceProviderSummary)
+					summary := operations[0].Object.Data.(*datamodel.ResourceProviderSummary)
 					require.Equal(t, expectedResourceTypes, summary.Properties.ResourceTypes)
 
+					return nil
+				})
+			} else if len(tt.operations) > 0 && !tt.expectedErr {
+				client.EXPECT().Transact(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, operations ...database.Operation) error {
+					require.Equal(t, tt.operations, operations)
 					return nil
 				})
 			}
 
-			err := updateResourceProviderSummaryWithETag(context.Background(), client, tt.summaryID, tt.policy, tt.updateFunc)
+			err := updateResourceProviderSummaryWithETag(context.Background(), client, tt.summaryID, tt.policy, tt.updateFunc, tt.operations...)
 			if tt.expectedErr {
 				assert.Error(t, err)
 			} else {
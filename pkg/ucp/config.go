@@ -20,6 +20,7 @@ import (
 	"bytes"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
@@ -35,9 +36,21 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Audit is the configuration for the audit log of mutating requests.
+	Audit audit.Options `yaml:"audit"`
+
+	// Authentication is the configuration for identifying the callers of the UCP API.
+	Authentication AuthenticationConfig `yaml:"authentication"`
+
+	// Authorization is the configuration for role-based access control of the UCP API.
+	Authorization AuthorizationConfig `yaml:"authorization"`
+
 	// Database is the configuration for the database used for resource data.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
+	// DeadLetters is the configuration for the admin API of dead-lettered async operations.
+	DeadLetters DeadLetterConfig `yaml:"deadLetters"`
+
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
@@ -62,6 +75,15 @@ type Config struct {
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
+	// ProxyCache is the configuration for caching the responses of the resource providers proxied by UCP.
+	ProxyCache ProxyCacheConfig `yaml:"proxyCache"`
+
+	// Quotas is the configuration for the limits on the number of resources.
+	Quotas QuotaConfig `yaml:"quotas"`
+
+	// RateLimit is the configuration for rate limiting the requests to the UCP API.
+	RateLimit RateLimitConfig `yaml:"rateLimit"`
+
 	// Secrets is the configuration for the secret storage system.
 	Secrets secretprovider.SecretProviderOptions `yaml:"secretProvider"`
 
@@ -92,6 +114,169 @@ type IdentityConfig struct {
 	AuthMethod string `yaml:"authMethod"`
 }
 
+// AuthenticationConfig provides configuration for identifying the callers of the UCP API.
+type AuthenticationConfig struct {
+	// OIDC is the configuration for authenticating OIDC bearer tokens issued by an external identity provider.
+	OIDC OIDCConfig `yaml:"oidc"`
+
+	// TokenReview is the configuration for authenticating bearer tokens with the Kubernetes TokenReview API.
+	TokenReview TokenReviewConfig `yaml:"tokenReview"`
+
+	// RequestHeader is the configuration for authenticating requests forwarded by the Kubernetes API server, which
+	// serves the UCP API as an aggregated API.
+	RequestHeader RequestHeaderConfig `yaml:"requestHeader"`
+}
+
+// OIDCConfig provides configuration for authenticating OIDC bearer tokens issued by an external identity provider.
+type OIDCConfig struct {
+	// Enabled determines whether OIDC bearer tokens are authenticated.
+	Enabled bool `yaml:"enabled"`
+
+	// Issuer is the URL of the OIDC issuer. It must match the 'iss' claim of the tokens.
+	Issuer string `yaml:"issuer"`
+
+	// Audiences is the list of accepted audiences, usually the client ID registered with the identity provider.
+	Audiences []string `yaml:"audiences"`
+
+	// JWKSURL is the URL of the key set of the issuer. Defaults to the 'jwks_uri' of the OpenID configuration of the issuer.
+	JWKSURL string `yaml:"jwksURL,omitempty"`
+
+	// UsernameClaim is the claim used as the principal name. Defaults to 'sub'.
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+
+	// UsernamePrefix is prepended to the principal name to avoid clashes with other identities. Optional.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+
+	// GroupsClaim is the claim used as the principal groups. Defaults to 'groups'.
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+
+	// GroupsPrefix is prepended to each of the principal groups. Optional.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+}
+
+// TokenReviewConfig provides configuration for authenticating bearer tokens with the Kubernetes TokenReview API.
+// This supports Kubernetes ServiceAccount tokens and OIDC tokens trusted by the Kubernetes API server.
+type TokenReviewConfig struct {
+	// Enabled determines whether bearer tokens are authenticated with the Kubernetes TokenReview API.
+	Enabled bool `yaml:"enabled"`
+
+	// Audiences is the list of audiences the token must be issued for. Defaults to the audience of the Kubernetes API server.
+	Audiences []string `yaml:"audiences,omitempty"`
+}
+
+// RequestHeaderConfig provides configuration for authenticating requests forwarded by the Kubernetes API server.
+//
+// The Kubernetes API server authenticates the caller, identifies itself to UCP with its front-proxy client
+// certificate and forwards the identity of the caller in the X-Remote-User and X-Remote-Group headers. The headers
+// are only trusted when the request carries a client certificate signed by the front-proxy CA.
+type RequestHeaderConfig struct {
+	// Enabled determines whether requests forwarded by the Kubernetes API server are authenticated.
+	Enabled bool `yaml:"enabled"`
+
+	// ClientCAFile is the path of the front-proxy CA bundle. Defaults to the CA published by the Kubernetes API server
+	// in the kube-system/extension-apiserver-authentication ConfigMap, which is read once at startup.
+	ClientCAFile string `yaml:"clientCAFile,omitempty"`
+
+	// AllowedNames is the list of common names accepted for the front-proxy client certificate. Defaults to the names
+	// published by the Kubernetes API server. Any name signed by the CA is accepted when both are empty.
+	AllowedNames []string `yaml:"allowedNames,omitempty"`
+
+	// UsernameHeaders is the list of headers to read the user name from. Defaults to the headers published by the
+	// Kubernetes API server, or 'X-Remote-User'.
+	UsernameHeaders []string `yaml:"usernameHeaders,omitempty"`
+
+	// GroupHeaders is the list of headers to read the groups from. Defaults to the headers published by the
+	// Kubernetes API server, or 'X-Remote-Group'.
+	GroupHeaders []string `yaml:"groupHeaders,omitempty"`
+
+	// UIDHeaders is the list of headers to read the UID from. Defaults to the headers published by the
+	// Kubernetes API server, or 'X-Remote-Uid'.
+	UIDHeaders []string `yaml:"uidHeaders,omitempty"`
+}
+
+// AuthorizationConfig provides configuration for role-based access control of the UCP API.
+//
+// When enabled every request must be made by an authenticated principal that has a role assignment
+// granting the requested action. Internal callers such as the resource providers reach UCP through the
+// Kubernetes API server and are identified by RequestHeader authentication, or send a ServiceAccount token
+// when they connect directly. They must be listed in SuperUsers, or be granted a role assignment in UCP or in
+// RoleAssignments.
+//
+// UCP is the only enforcement point. Resource providers are not exposed outside of the cluster and trust the
+// principal forwarded by UCP, so they do not authorize requests themselves.
+type AuthorizationConfig struct {
+	// Enabled determines whether role-based access control is enforced.
+	Enabled bool `yaml:"enabled"`
+
+	// SuperUsers is the list of principal names that are allowed to perform any action.
+	SuperUsers []string `yaml:"superUsers,omitempty"`
+
+	// SuperGroups is the list of groups whose members are allowed to perform any action. Defaults to 'system:masters'.
+	SuperGroups []string `yaml:"superGroups,omitempty"`
+
+	// RoleAssignments is the list of built-in role assignments, which apply in addition to the role assignments
+	// stored in UCP. They grant access to internal callers that connect to UCP directly, such as the deployment engine.
+	RoleAssignments []RoleAssignmentConfig `yaml:"roleAssignments,omitempty"`
+}
+
+// RoleAssignmentConfig provides configuration for a built-in role assignment.
+type RoleAssignmentConfig struct {
+	// PrincipalID is the name of the user or ServiceAccount, or the group, the role is assigned to.
+	PrincipalID string `yaml:"principalId"`
+
+	// PrincipalType is the type of the principal: 'User', 'Group' or 'ServiceAccount'. Defaults to 'User'.
+	PrincipalType string `yaml:"principalType,omitempty"`
+
+	// RoleDefinitionID is the name of a built-in role or the resource ID of a role definition.
+	RoleDefinitionID string `yaml:"roleDefinitionId"`
+
+	// Scope is the scope the role is assigned at. Defaults to all planes.
+	Scope string `yaml:"scope,omitempty"`
+}
+
+// RateLimitConfig provides configuration for rate limiting the requests to the UCP API.
+//
+// Requests are limited with a token bucket per principal, plane and resource group. Requests that are not
+// authenticated are limited per client address instead of the principal. Requests that exceed the limit are
+// rejected with 429 and a Retry-After header.
+type RateLimitConfig struct {
+	// Enabled determines whether requests are rate limited.
+	Enabled bool `yaml:"enabled"`
+
+	// RequestsPerSecond is the sustained number of requests per second allowed for each bucket.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+
+	// Burst is the number of requests allowed at once for each bucket.
+	Burst int `yaml:"burst"`
+}
+
+// QuotaConfig provides configuration for the limits on the number of resources.
+type QuotaConfig struct {
+	// MaxResourcesPerResourceGroup is the maximum number of resources in a resource group. Requests creating
+	// resources beyond the limit are rejected. Zero means no limit.
+	MaxResourcesPerResourceGroup int `yaml:"maxResourcesPerResourceGroup,omitempty"`
+}
+
+// ProxyCacheConfig provides configuration for caching the responses of the resource providers proxied by UCP.
+//
+// Successful GET responses are cached per URL and caller for a short time, and are invalidated when UCP proxies
+// a request that modifies resources of the same type. The status of asynchronous operations and resources that
+// are still being provisioned are not cached.
+type ProxyCacheConfig struct {
+	// Enabled determines whether responses are cached.
+	Enabled bool `yaml:"enabled"`
+
+	// TTLSeconds is the number of seconds a response is cached. Defaults to 5 seconds.
+	TTLSeconds int `yaml:"ttlSeconds,omitempty"`
+}
+
+// DeadLetterConfig provides configuration for the admin API of dead-lettered async operations.
+type DeadLetterConfig struct {
+	// Queues is the list of names of the resource provider queues whose dead-lettered operations can be managed. The
+	// queues use the same queue provider as UCP. The queue of UCP is always included.
+	Queues []string `yaml:"queues,omitempty"`
+}
+
 // RoutingConfig provides configuration for UCP routing.
 type RoutingConfig struct {
 	// DefaultDownstreamEndpoint is the default destination when a resource provider does not provide a downstream endpoint.
@@ -48,16 +48,24 @@ type UCPOptions struct {
 type UCPDirectConnectionOptions struct {
 	// Endpoint is the URL endpoint for the connection.
 	Endpoint string `yaml:"endpoint"`
+
+	// TokenFile is the path of a file containing the bearer token sent to UCP, such as a projected Kubernetes
+	// ServiceAccount token. The file is read on every request so that rotated tokens are picked up. Optional.
+	TokenFile string `yaml:"tokenFile,omitempty"`
 }
 
 // NewConnectionFromUCPConfig creates a Connection for UCP endpoint. It checks if the connection kind is direct and if so,
-// checks if the endpoint is provided and returns a direct connection, otherwise it returns a Kubernetes connection from
+// checks if the endpoint is provided and returns a direct connection, which authenticates with the token file when one is
+// configured, otherwise it returns a Kubernetes connection from
 // the provided config. It returns an error if the endpoint is not provided when the connection kind is direct.
 func NewConnectionFromUCPConfig(option *UCPOptions, k8sConfig *rest.Config) (sdk.Connection, error) {
 	if option.Kind == UCPConnectionKindDirect {
 		if option.Direct == nil || option.Direct.Endpoint == "" {
 			return nil, errors.New("the property .ucp.direct.endpoint is required when using a direct connection")
 		}
+		if option.Direct.TokenFile != "" {
+			return sdk.NewDirectConnectionWithBearerToken(option.Direct.Endpoint, sdk.FileTokenSource(option.Direct.TokenFile))
+		}
 		return sdk.NewDirectConnection(option.Direct.Endpoint)
 	} else if option.Kind == UCPConnectionKindKubernetes {
 		return sdk.NewKubernetesConnectionFromConfig(k8sConfig)
@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleAssignmentResourceType is the resource type for a role assignment.
+	RoleAssignmentResourceType = "System.Authorization/roleAssignments"
+)
+
+// RoleAssignment grants a role to a principal at a scope.
+type RoleAssignment struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role assignment.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *RoleAssignment) ResourceTypeName() string {
+	return RoleAssignmentResourceType
+}
+
+// RoleAssignmentProperties stores the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// RoleDefinitionID is the resource ID of the role definition, or the name of a built-in role.
+	RoleDefinitionID string `json:"roleDefinitionId"`
+
+	// PrincipalID is the identifier of the principal.
+	PrincipalID string `json:"principalId"`
+
+	// PrincipalType is the type of the principal.
+	PrincipalType v1.PrincipalType `json:"principalType"`
+
+	// Scope is the scope of the role assignment. When empty, the role assignment applies to the plane
+	// or resource group that contains it.
+	Scope string `json:"scope,omitempty"`
+}
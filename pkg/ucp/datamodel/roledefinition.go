@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleDefinitionResourceType is the resource type for a role definition.
+	RoleDefinitionResourceType = "System.Authorization/roleDefinitions"
+)
+
+// RoleDefinition represents a named set of permissions that can be assigned to principals.
+type RoleDefinition struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role definition.
+	Properties RoleDefinitionProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *RoleDefinition) ResourceTypeName() string {
+	return RoleDefinitionResourceType
+}
+
+// RoleDefinitionProperties stores the properties of a role definition.
+type RoleDefinitionProperties struct {
+	// RoleName is the display name of the role.
+	RoleName string `json:"roleName,omitempty"`
+
+	// Description is the description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Permissions is the list of permissions granted by the role.
+	Permissions []RolePermission `json:"permissions,omitempty"`
+}
+
+// RolePermission is a set of actions allowed by a role.
+type RolePermission struct {
+	// Actions is the list of allowed actions. Actions have the form '{resourceType}/{operation}' and can contain
+	// the '*' wildcard.
+	Actions []string `json:"actions,omitempty"`
+
+	// NotActions is the list of actions excluded from Actions.
+	NotActions []string `json:"notActions,omitempty"`
+}
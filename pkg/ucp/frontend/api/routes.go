@@ -27,6 +27,8 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp"
+	auditlogs_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/auditlogs"
+	deadletters_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
@@ -35,8 +37,11 @@ import (
 )
 
 const (
-	planeCollectionPath     = "/planes"
-	planeTypeCollectionPath = "/planes/{planeType}"
+	planeCollectionPath      = "/planes"
+	planeTypeCollectionPath  = "/planes/{planeType}"
+	deadLetterCollectionPath = "/admin/queues/{" + deadletters_ctrl.QueueNameParam + "}/deadletters"
+	deadLetterPath           = "/{" + deadletters_ctrl.MessageIDParam + "}"
+	auditLogCollectionPath   = "/admin/auditlogs"
 
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
@@ -53,6 +58,15 @@ const (
 
 	// OperationTypePlanes is the operation type for the planes (all types) collection.
 	OperationTypePlanes = "PLANES"
+
+	// OperationTypeDeadLetters is the operation type for the dead-lettered async operations of a queue.
+	OperationTypeDeadLetters = "DEADLETTERS"
+
+	// OperationTypeDeadLetterReplay is the operation type for replaying a dead-lettered async operation.
+	OperationTypeDeadLetterReplay = "DEADLETTERREPLAY"
+
+	// OperationTypeAuditLogs is the operation type for the audit log of mutating requests.
+	OperationTypeAuditLogs = "AUDITLOGS"
 )
 
 func initModules(ctx context.Context, mods []modules.Initializer) (map[string]http.Handler, []string, error) {
@@ -143,6 +157,61 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		},
 	}...)
 
+	// Configures the admin routes to inspect, replay and purge dead-lettered async operations.
+	deadLetterRouter := server.NewSubrouter(router, options.Config.Server.PathBase+deadLetterCollectionPath)
+	queueClientFactory := deadletters_ctrl.NewQueueClientFactory(options.Config.Queue, options.Config.DeadLetters.Queues)
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter:  deadLetterRouter,
+			Method:        v1.OperationList,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+			ResourceType:  OperationTypeDeadLetters,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewListDeadLetters(opts, queueClientFactory)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          deadLetterPath,
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationGet},
+			ResourceType:  OperationTypeDeadLetters,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewGetDeadLetter(opts, queueClientFactory)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          deadLetterPath,
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+			ResourceType:  OperationTypeDeadLetters,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewPurgeDeadLetter(opts, queueClientFactory)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          deadLetterPath + "/replay",
+			Method:        v1.OperationPost,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetterReplay, Method: v1.OperationPost},
+			ResourceType:  OperationTypeDeadLetters,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewReplayDeadLetter(opts, queueClientFactory)
+			},
+		},
+	}...)
+
+	// Configures the admin route to query the audit log.
+	auditLogRouter := server.NewSubrouter(router, options.Config.Server.PathBase+auditLogCollectionPath)
+	handlerOptions = append(handlerOptions, server.HandlerOptions{
+		ParentRouter:      auditLogRouter,
+		Method:            v1.OperationList,
+		OperationType:     &v1.OperationType{Type: OperationTypeAuditLogs, Method: v1.OperationList},
+		ResourceType:      OperationTypeAuditLogs,
+		ControllerFactory: auditlogs_ctrl.NewListAuditRecords,
+	})
+
 	databaseClient, err := options.DatabaseProvider.GetClient(ctx)
 	if err != nil {
 		return err
@@ -60,6 +60,31 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodGet,
 			Path:          "/planes",
 		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/radius/deadletters",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/radius/deadletters/some-message",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/admin/queues/radius/deadletters/some-message",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetterReplay, Method: v1.OperationPost},
+			Method:        http.MethodPost,
+			Path:          "/admin/queues/radius/deadletters/some-message/replay",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeAuditLogs, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/admin/auditlogs",
+		},
 		{
 			// Should be passed to the module.
 			Method: http.MethodGet,
@@ -19,6 +19,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -26,8 +27,11 @@ import (
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/components/hosting"
 	"github.com/radius-project/radius/pkg/middleware"
@@ -99,6 +103,23 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+	if s.options.Authorizer != nil {
+		app = authorization.Authorize(s.options.Authorizer, s.unauthorizedPaths()...)(app)
+	}
+	if s.options.AuditSink != nil {
+		// Audit runs before authorization so that denied requests are recorded.
+		app = middleware.Audit(s.options.AuditSink, middleware.AuditOptions{
+			Service:        "ucp",
+			IncludeChanges: s.options.Config.Audit.IncludeChanges,
+		})(app)
+	}
+	if s.options.RateLimiter != nil {
+		// Rate limiting runs before audit so that throttled requests do not flood the audit log.
+		app = ratelimit.RateLimit(s.options.RateLimiter, s.unauthorizedPaths()...)(app)
+	}
+	if len(s.options.Authenticators) > 0 {
+		app = authentication.Authenticate(s.options.Authenticators...)(app)
+	}
 	app = servicecontext.ARMRequestCtx(s.options.Config.Server.PathBase, s.options.Config.Environment.RoleLocation)(app)
 	app = middleware.WithLogger(app)
 
@@ -122,10 +143,29 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		BaseContext: func(ln net.Listener) context.Context {
 			return ctx
 		},
+		// The Kubernetes API server presents its front-proxy client certificate when it forwards requests to UCP.
+		// The certificate is requested but not required, and it is verified by the request header authenticator.
+		TLSConfig: &tls.Config{
+			ClientAuth: tls.RequestClientCert,
+		},
 	}
 	return server, nil
 }
 
+// unauthorizedPaths returns the paths which do not describe resources and can be called by anyone.
+func (s *Service) unauthorizedPaths() []string {
+	pathBase := s.options.Config.Server.PathBase
+	return []string{
+		// The Kubernetes API Server does not include the base path in the URL for the OpenAPI documents.
+		"/openapi/v2",
+		"/openapi/v3",
+		pathBase,
+		pathBase + "/",
+		"/version",
+		"/healthz",
+	}
+}
+
 // configureDefaultPlanes reads the configuration file specified by the env var to configure default planes into UCP
 func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	for _, plane := range s.options.Config.Initialization.Planes {
@@ -157,11 +157,13 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Method deliberately omitted. This is a catch-all route for proxying.
-			ParentRouter:      planeResourceRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
-			ResourceType:      OperationTypeUCPAzureProxy,
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  planeResourceRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
+			ResourceType:  OperationTypeUCPAzureProxy,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opts, m.options.ProxyCache)
+			},
 		},
 	}
 
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auditlogs implements the UCP admin API to query the audit log of mutating requests.
+//
+// The route is not an ARM resource:
+//
+//	GET {pathBase}/admin/auditlogs?principal={principal}&resourceId={resourceId}&since={RFC3339 time}&limit={count}
+//
+// Records are read from the Radius database, where the database audit sink of every service stores them.
+package auditlogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+)
+
+const (
+	// PrincipalParam is the query parameter to filter the records by principal.
+	PrincipalParam = "principal"
+
+	// ResourceIDParam is the query parameter to filter the records by resource or scope.
+	ResourceIDParam = "resourceId"
+
+	// SinceParam is the query parameter to return only the records created at or after an RFC3339 time.
+	SinceParam = "since"
+
+	// LimitParam is the query parameter for the maximum number of records to return. The 'top' parameter is not
+	// used because its bounds are enforced for every request by the ARM request context.
+	LimitParam = "limit"
+
+	// DefaultLimit is the maximum number of records returned when the limit query parameter is not set.
+	DefaultLimit = 100
+)
+
+var _ armrpc_controller.Controller = (*ListAuditRecords)(nil)
+
+// ListAuditRecords is the controller implementation to query the audit log.
+type ListAuditRecords struct {
+	armrpc_controller.BaseController
+	sink audit.Sink
+}
+
+// NewListAuditRecords creates a new ListAuditRecords controller.
+func NewListAuditRecords(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListAuditRecords{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		// The controller only reads the audit log. Expired records are deleted by the sinks that write them.
+		sink: audit.NewDatabaseSink(opts.DatabaseClient, 0),
+	}, nil
+}
+
+// Run returns the audit records matching the query parameters of the request, most recent first.
+func (c *ListAuditRecords) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	query, resp := parseQuery(req)
+	if resp != nil {
+		return resp, nil
+	}
+
+	records, err := c.sink.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &admin.AuditRecordList{Value: []*admin.AuditRecord{}}
+	for _, record := range records {
+		result.Value = append(result.Value, newAuditRecord(record))
+	}
+
+	return armrpc_rest.NewOKResponse(result), nil
+}
+
+// parseQuery parses the query parameters of the request. The response is non-nil if a parameter is invalid.
+func parseQuery(req *http.Request) (audit.Query, armrpc_rest.Response) {
+	values := req.URL.Query()
+	query := audit.Query{
+		Principal:  values.Get(PrincipalParam),
+		ResourceID: values.Get(ResourceIDParam),
+		Top:        DefaultLimit,
+	}
+
+	if since := values.Get(SinceParam); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return audit.Query{}, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid value %q for %q: the value must be an RFC3339 time", since, SinceParam))
+		}
+		query.Since = t
+	}
+
+	if limit := values.Get(LimitParam); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return audit.Query{}, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid value %q for %q: the value must be a positive integer", limit, LimitParam))
+		}
+		query.Top = n
+	}
+
+	return query, nil
+}
+
+// newAuditRecord converts the audit record to its API representation.
+func newAuditRecord(record *audit.Record) *admin.AuditRecord {
+	result := &admin.AuditRecord{
+		ID:              record.ID,
+		Timestamp:       record.Timestamp,
+		Service:         record.Service,
+		Principal:       record.Principal,
+		Method:          record.Method,
+		Operation:       record.Operation,
+		ResourceID:      record.ResourceID,
+		CorrelationID:   record.CorrelationID,
+		ClientRequestID: record.ClientRequestID,
+		StatusCode:      record.StatusCode,
+		Outcome:         string(record.Outcome),
+	}
+
+	for _, change := range record.Changes {
+		result.Changes = append(result.Changes, &admin.AuditChange{Path: change.Path, Old: change.Old, New: change.New})
+	}
+
+	return result
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlogs
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAuditLog(t *testing.T) armrpc_controller.Controller {
+	client := inmemory.NewClient()
+	sink := audit.NewDatabaseSink(client, 0)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []*audit.Record{
+		{ID: "01", Timestamp: start, Principal: "alice", ResourceID: "/planes/radius/local/resourcegroups/a", Outcome: audit.OutcomeSucceeded},
+		{ID: "02", Timestamp: start.Add(time.Hour), Principal: "bob", ResourceID: "/planes/radius/local/resourcegroups/b", Outcome: audit.OutcomeDenied},
+		{
+			ID:         "03",
+			Timestamp:  start.Add(2 * time.Hour),
+			Principal:  "alice",
+			ResourceID: "/planes/radius/local/resourcegroups/b/providers/Applications.Core/containers/c",
+			Outcome:    audit.OutcomeSucceeded,
+			Changes:    []audit.Change{{Path: "properties.image", Old: "nginx:1", New: "nginx:2"}},
+		},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	ctrl, err := NewListAuditRecords(armrpc_controller.Options{DatabaseClient: client})
+	require.NoError(t, err)
+	return ctrl
+}
+
+func runListAuditRecords(t *testing.T, ctrl armrpc_controller.Controller, query url.Values) armrpc_rest.Response {
+	req, err := http.NewRequest(http.MethodGet, "/admin/auditlogs?"+query.Encode(), nil)
+	require.NoError(t, err)
+
+	resp, err := ctrl.Run(rpctest.NewARMRequestContext(req), nil, req)
+	require.NoError(t, err)
+	return resp
+}
+
+func Test_ListAuditRecords(t *testing.T) {
+	ctrl := setupAuditLog(t)
+
+	resp := runListAuditRecords(t, ctrl, url.Values{})
+	require.IsType(t, &armrpc_rest.OKResponse{}, resp)
+	list := resp.(*armrpc_rest.OKResponse).Body.(*admin.AuditRecordList)
+	require.Len(t, list.Value, 3)
+	require.Equal(t, "03", list.Value[0].ID)
+	require.Equal(t, "Succeeded", list.Value[0].Outcome)
+	require.Equal(t, []*admin.AuditChange{{Path: "properties.image", Old: "nginx:1", New: "nginx:2"}}, list.Value[0].Changes)
+}
+
+func Test_ListAuditRecords_Filters(t *testing.T) {
+	ctrl := setupAuditLog(t)
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		expected []string
+	}{
+		{name: "principal", query: url.Values{PrincipalParam: {"alice"}}, expected: []string{"03", "01"}},
+		{name: "resource id", query: url.Values{ResourceIDParam: {"/planes/radius/local/resourcegroups/b"}}, expected: []string{"03", "02"}},
+		{name: "since", query: url.Values{SinceParam: {"2024-01-01T01:00:00Z"}}, expected: []string{"03", "02"}},
+		{name: "limit", query: url.Values{LimitParam: {"1"}}, expected: []string{"03"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := runListAuditRecords(t, ctrl, tt.query)
+			require.IsType(t, &armrpc_rest.OKResponse{}, resp)
+
+			ids := []string{}
+			for _, record := range resp.(*armrpc_rest.OKResponse).Body.(*admin.AuditRecordList).Value {
+				ids = append(ids, record.ID)
+			}
+			require.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func Test_ListAuditRecords_InvalidQuery(t *testing.T) {
+	ctrl := setupAuditLog(t)
+
+	tests := []url.Values{
+		{SinceParam: {"yesterday"}},
+		{LimitParam: {"many"}},
+		{LimitParam: {"0"}},
+	}
+	for _, query := range tests {
+		t.Run(query.Encode(), func(t *testing.T) {
+			resp := runListAuditRecords(t, ctrl, query)
+			require.IsType(t, &armrpc_rest.BadRequestResponse{}, resp)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"encoding/json"
+	http "net/http"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetDeadLetter(t *testing.T) {
+	factory, msg, _ := setupDeadLetterQueue(t)
+
+	ctrl, err := NewGetDeadLetter(armrpc_controller.Options{}, factory)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		ctx, request := newTestRequest(t, http.MethodGet, map[string]string{QueueNameParam: testQueueName, MessageIDParam: msg.ID})
+		resp, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		require.IsType(t, &armrpc_rest.OKResponse{}, resp)
+		deadLetter := resp.(*armrpc_rest.OKResponse).Body.(*admin.DeadLetter)
+		require.Equal(t, msg.ID, deadLetter.ID)
+		require.Equal(t, json.RawMessage(msg.Data), deadLetter.Data)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctx, request := newTestRequest(t, http.MethodGet, map[string]string{QueueNameParam: testQueueName, MessageIDParam: "unknown"})
+		resp, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+	})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	http "net/http"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+)
+
+var _ armrpc_controller.Controller = (*ListDeadLetters)(nil)
+
+// ListDeadLetters is the controller implementation to list the dead-lettered async operations of a queue.
+type ListDeadLetters struct {
+	armrpc_controller.BaseController
+	queueClientFactory QueueClientFactory
+}
+
+// NewListDeadLetters creates a new ListDeadLetters controller.
+func NewListDeadLetters(opts armrpc_controller.Options, queueClientFactory QueueClientFactory) (armrpc_controller.Controller, error) {
+	return &ListDeadLetters{
+		BaseController:     armrpc_controller.NewBaseController(opts),
+		queueClientFactory: queueClientFactory,
+	}, nil
+}
+
+// Run returns the dead-lettered async operations of the queue in the request. The message data is not included.
+func (c *ListDeadLetters) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, queueName, resp, err := getQueueClient(ctx, req, c.queueClientFactory)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	deadLetters, err := client.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &admin.DeadLetterList{Value: []*admin.DeadLetter{}}
+	for _, deadLetter := range deadLetters {
+		result.Value = append(result.Value, newDeadLetter(queueName, deadLetter, false))
+	}
+
+	return armrpc_rest.NewOKResponse(result), nil
+}
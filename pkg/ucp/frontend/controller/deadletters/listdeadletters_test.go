@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	http "net/http"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDeadLetters(t *testing.T) {
+	factory, msg, req := setupDeadLetterQueue(t)
+
+	ctrl, err := NewListDeadLetters(armrpc_controller.Options{}, factory)
+	require.NoError(t, err)
+
+	ctx, request := newTestRequest(t, http.MethodGet, map[string]string{QueueNameParam: testQueueName})
+	resp, err := ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	require.IsType(t, &armrpc_rest.OKResponse{}, resp)
+	list := resp.(*armrpc_rest.OKResponse).Body.(*admin.DeadLetterList)
+	require.Len(t, list.Value, 1)
+	require.Equal(t, msg.ID, list.Value[0].ID)
+	require.Equal(t, req.OperationID.String(), list.Value[0].OperationID)
+	require.Equal(t, "test reason", list.Value[0].Reason)
+	require.Nil(t, list.Value[0].Data)
+}
+
+func Test_ListDeadLetters_InvalidQueueName(t *testing.T) {
+	factory, _, _ := setupDeadLetterQueue(t)
+
+	ctrl, err := NewListDeadLetters(armrpc_controller.Options{}, factory)
+	require.NoError(t, err)
+
+	ctx, request := newTestRequest(t, http.MethodGet, map[string]string{QueueNameParam: "Invalid_Queue"})
+	resp, err := ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.BadRequestResponse{}, resp)
+}
+
+func Test_ListDeadLetters_UnknownQueue(t *testing.T) {
+	factory := NewQueueClientFactory(queueprovider.QueueProviderOptions{Provider: queueprovider.TypeInmemory, Name: "ucp"}, []string{testQueueName})
+
+	ctrl, err := NewListDeadLetters(armrpc_controller.Options{}, factory)
+	require.NoError(t, err)
+
+	ctx, request := newTestRequest(t, http.MethodGet, map[string]string{QueueNameParam: "unknown"})
+	resp, err := ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"errors"
+	http "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ armrpc_controller.Controller = (*PurgeDeadLetter)(nil)
+
+// PurgeDeadLetter is the controller implementation to permanently delete a dead-lettered async operation.
+type PurgeDeadLetter struct {
+	armrpc_controller.BaseController
+	queueClientFactory QueueClientFactory
+}
+
+// NewPurgeDeadLetter creates a new PurgeDeadLetter controller.
+func NewPurgeDeadLetter(opts armrpc_controller.Options, queueClientFactory QueueClientFactory) (armrpc_controller.Controller, error) {
+	return &PurgeDeadLetter{
+		BaseController:     armrpc_controller.NewBaseController(opts),
+		queueClientFactory: queueClientFactory,
+	}, nil
+}
+
+// Run deletes the dead-lettered async operation in the request from the dead-letter store.
+func (c *PurgeDeadLetter) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, queueName, resp, err := getQueueClient(ctx, req, c.queueClientFactory)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	id := chi.URLParam(req, MessageIDParam)
+	err = client.PurgeDeadLetter(ctx, id)
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return newDeadLetterNotFoundResponse(queueName, id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Purged dead-lettered message", "queue", queueName, "messageId", id)
+	return armrpc_rest.NewNoContentResponse(), nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	http "net/http"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PurgeDeadLetter(t *testing.T) {
+	factory, msg, _ := setupDeadLetterQueue(t)
+
+	ctrl, err := NewPurgeDeadLetter(armrpc_controller.Options{}, factory)
+	require.NoError(t, err)
+
+	ctx, request := newTestRequest(t, http.MethodDelete, map[string]string{QueueNameParam: testQueueName, MessageIDParam: msg.ID})
+	resp, err := ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NoContentResponse{}, resp)
+
+	client, err := factory(ctx, testQueueName)
+	require.NoError(t, err)
+	deadLetters, err := client.ListDeadLetters(ctx)
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+	_, err = client.Dequeue(ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+	resp, err = ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadletters implements the UCP admin API to inspect, replay and purge dead-lettered async operations.
+//
+// The routes are not ARM resources:
+//
+//	GET    {pathBase}/admin/queues/{queueName}/deadletters
+//	GET    {pathBase}/admin/queues/{queueName}/deadletters/{messageId}
+//	POST   {pathBase}/admin/queues/{queueName}/deadletters/{messageId}/replay
+//	DELETE {pathBase}/admin/queues/{queueName}/deadletters/{messageId}
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/go-chi/chi/v5"
+
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/pkg/ucp/api/admin"
+)
+
+const (
+	// QueueNameParam is the route parameter for the queue name.
+	QueueNameParam = "queueName"
+
+	// MessageIDParam is the route parameter for the id of the dead-lettered message.
+	MessageIDParam = "messageId"
+)
+
+var queueNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?$`)
+
+// ErrQueueNotFound is returned by a QueueClientFactory for a queue which is not configured.
+var ErrQueueNotFound = errors.New("queue not found")
+
+// QueueClientFactory returns the queue client for the queue with the given name.
+type QueueClientFactory func(ctx context.Context, queueName string) (queue.Client, error)
+
+// NewQueueClientFactory creates a QueueClientFactory for the queue named in the given queue provider options and the
+// queues named in queueNames, which use the same options. It returns ErrQueueNotFound for any other queue.
+func NewQueueClientFactory(options queueprovider.QueueProviderOptions, queueNames []string) QueueClientFactory {
+	providers := map[string]*queueprovider.QueueProvider{}
+	for _, queueName := range append([]string{options.Name}, queueNames...) {
+		if queueName == "" {
+			continue
+		}
+
+		opts := options
+		opts.Name = queueName
+		providers[queueName] = queueprovider.New(opts)
+	}
+
+	return func(ctx context.Context, queueName string) (queue.Client, error) {
+		provider, ok := providers[queueName]
+		if !ok {
+			return nil, ErrQueueNotFound
+		}
+		return provider.GetClient(ctx)
+	}
+}
+
+// newDeadLetter converts the dead-lettered message to its API representation.
+func newDeadLetter(queueName string, deadLetter *queue.DeadLetter, includeData bool) *admin.DeadLetter {
+	result := &admin.DeadLetter{
+		ID:             deadLetter.ID,
+		Queue:          queueName,
+		DequeueCount:   deadLetter.DequeueCount,
+		ReplayCount:    deadLetter.ReplayCount,
+		EnqueuedAt:     deadLetter.EnqueueAt,
+		DeadLetteredAt: deadLetter.DeadLetteredAt,
+		Reason:         deadLetter.Reason,
+	}
+
+	// The message data is the async operation request. Ignore the error since the message could be malformed,
+	// which is one of the reasons why it could have been dead-lettered.
+	req := &asyncctrl.Request{}
+	if err := json.Unmarshal(deadLetter.Data, req); err == nil {
+		result.OperationID = req.OperationID.String()
+		result.OperationType = req.OperationType
+		result.ResourceID = req.ResourceID
+	}
+
+	if includeData && json.Valid(deadLetter.Data) {
+		result.Data = json.RawMessage(deadLetter.Data)
+	}
+
+	return result
+}
+
+// getQueueClient validates the queue name in the request and returns the queue client. The response is non-nil if
+// the queue name is invalid.
+func getQueueClient(ctx context.Context, req *http.Request, factory QueueClientFactory) (queue.Client, string, armrpc_rest.Response, error) {
+	queueName := chi.URLParam(req, QueueNameParam)
+	if !queueNameRegex.MatchString(queueName) {
+		return nil, "", armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid queue name %q", queueName)), nil
+	}
+
+	client, err := factory(ctx, queueName)
+	if errors.Is(err, ErrQueueNotFound) {
+		return nil, "", armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the queue %q was not found", queueName)), nil
+	} else if err != nil {
+		return nil, "", nil, err
+	}
+
+	return client, queueName, nil, nil
+}
+
+func newDeadLetterNotFoundResponse(queueName string, id string) armrpc_rest.Response {
+	return armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the dead-lettered message %q was not found in queue %q", id, queueName))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	http "net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/inmemory"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testQueueName  = "radius"
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+)
+
+// setupDeadLetterQueue creates an in-memory queue with a single dead-lettered async operation.
+func setupDeadLetterQueue(t *testing.T) (QueueClientFactory, *queue.Message, *asyncctrl.Request) {
+	client := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+	req := &asyncctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		ResourceID:    testResourceID,
+	}
+
+	err := client.Enqueue(context.Background(), queue.NewMessage(req))
+	require.NoError(t, err)
+	msg, err := client.Dequeue(context.Background(), queue.QueueClientConfig{})
+	require.NoError(t, err)
+	err = client.DeadLetterMessage(context.Background(), msg, "test reason")
+	require.NoError(t, err)
+
+	factory := func(ctx context.Context, queueName string) (queue.Client, error) {
+		require.Equal(t, testQueueName, queueName)
+		return client, nil
+	}
+
+	return factory, msg, req
+}
+
+// newTestRequest creates a request with the given route parameters.
+func newTestRequest(t *testing.T, method string, params map[string]string) (context.Context, *http.Request) {
+	req, err := http.NewRequest(method, "/admin/queues/deadletters", nil)
+	require.NoError(t, err)
+
+	routeCtx := chi.NewRouteContext()
+	for k, v := range params {
+		routeCtx.URLParams.Add(k, v)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func Test_NewDeadLetter(t *testing.T) {
+	_, msg, req := setupDeadLetterQueue(t)
+
+	deadLetter := &queue.DeadLetter{Message: *msg, Reason: "test reason", DeadLetteredAt: time.Now()}
+
+	result := newDeadLetter(testQueueName, deadLetter, false)
+	require.Equal(t, msg.ID, result.ID)
+	require.Equal(t, testQueueName, result.Queue)
+	require.Equal(t, req.OperationID.String(), result.OperationID)
+	require.Equal(t, req.OperationType, result.OperationType)
+	require.Equal(t, testResourceID, result.ResourceID)
+	require.Equal(t, "test reason", result.Reason)
+	require.Nil(t, result.Data)
+
+	result = newDeadLetter(testQueueName, deadLetter, true)
+	require.Equal(t, json.RawMessage(msg.Data), result.Data)
+
+	t.Run("malformed data", func(t *testing.T) {
+		deadLetter := &queue.DeadLetter{Message: queue.Message{Data: []byte("not json")}}
+		result := newDeadLetter(testQueueName, deadLetter, true)
+		require.Empty(t, result.OperationID)
+		require.Nil(t, result.Data)
+	})
+}
+
+func Test_NewQueueClientFactory(t *testing.T) {
+	factory := NewQueueClientFactory(queueprovider.QueueProviderOptions{Provider: queueprovider.TypeInmemory, Name: "ucp"}, []string{testQueueName})
+
+	for _, queueName := range []string{"ucp", testQueueName} {
+		client, err := factory(context.Background(), queueName)
+		require.NoError(t, err)
+		require.NotNil(t, client)
+
+		again, err := factory(context.Background(), queueName)
+		require.NoError(t, err)
+		require.Same(t, client, again)
+	}
+
+	_, err := factory(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrQueueNotFound)
+}
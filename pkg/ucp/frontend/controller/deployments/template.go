@@ -0,0 +1,852 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// errUnknownValue is returned when an expression depends on a value that is only known once the deployment runs,
+// like the properties of a resource computed by its resource provider.
+var errUnknownValue = errors.New("the value is not known until the deployment runs")
+
+// templateResource is a resource declared in a deployment template whose expressions have been evaluated.
+type templateResource struct {
+	// SymbolicName is the name of the resource in the template.
+	SymbolicName string
+
+	// ID is the ID of the resource.
+	ID resources.ID
+
+	// APIVersion is the API version of the resource type used by the template.
+	APIVersion string
+
+	// Body is the request body of the resource.
+	Body map[string]any
+
+	// Err is the reason the resource cannot be previewed, if any.
+	Err error
+}
+
+// templateEvaluator evaluates the resources of a compiled Bicep template. Only the subset of the template language
+// needed to compute the request bodies of Radius resources is supported: parameters, variables, string, logical and
+// object functions, and the id, name and type of other resources.
+//
+// Templates with modules, loops or user-defined functions are rejected as a whole, because the resources they declare
+// cannot be previewed. Resources using other functions are reported as unsupported.
+type templateEvaluator struct {
+	template   map[string]any
+	parameters map[string]map[string]any
+	scope      resources.ID
+
+	variables map[string]any
+	ids       map[string]resources.ID
+}
+
+// newTemplateEvaluator creates a templateEvaluator for the template. Resources are deployed to the given scope.
+func newTemplateEvaluator(template map[string]any, parameters map[string]map[string]any, scope resources.ID) *templateEvaluator {
+	return &templateEvaluator{
+		template:   template,
+		parameters: parameters,
+		scope:      scope,
+		variables:  map[string]any{},
+		ids:        map[string]resources.ID{},
+	}
+}
+
+// Resources evaluates the resources of the template. Resources which are declared with the existing keyword or whose
+// condition is false are skipped. An error is returned if the template itself is invalid, while the resources which
+// cannot be evaluated are returned with an error.
+func (e *templateEvaluator) Resources() ([]templateResource, error) {
+	if err := e.validate(); err != nil {
+		return nil, err
+	}
+
+	declared, ok := e.template["resources"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	result := []templateResource{}
+	for _, name := range sortedKeys(declared) {
+		resource, ok := declared[name].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("resource %q is not an object", name)
+		}
+
+		if existing, _ := resource["existing"].(bool); existing {
+			continue
+		}
+
+		if condition, ok := resource["condition"]; ok {
+			value, err := e.evaluate(condition)
+			if err != nil {
+				result = append(result, templateResource{SymbolicName: name, Err: err})
+				continue
+			}
+			if b, ok := value.(bool); ok && !b {
+				continue
+			}
+		}
+
+		result = append(result, e.evaluateResource(name, resource))
+	}
+
+	return result, nil
+}
+
+// validate returns an error if the template uses constructs which cannot be previewed. Modules and loops declare
+// resources which are not evaluated, so previewing the rest of the template would silently omit them.
+func (e *templateEvaluator) validate() error {
+	unsupported := []string{}
+	if _, ok := e.template["resources"].([]any); ok {
+		unsupported = append(unsupported, "resources without symbolic names")
+	}
+
+	if functions, _ := e.template["functions"].([]any); len(functions) > 0 {
+		unsupported = append(unsupported, "user-defined functions")
+	}
+
+	if variables, _ := e.template["variables"].(map[string]any); hasCopyLoop(variables) {
+		unsupported = append(unsupported, "variable loops")
+	}
+
+	declared, _ := e.template["resources"].(map[string]any)
+	for _, name := range sortedKeys(declared) {
+		resource, _ := declared[name].(map[string]any)
+		typeName, _ := resource["type"].(string)
+		switch {
+		case strings.EqualFold(strings.Split(typeName, "@")[0], "Microsoft.Resources/deployments"):
+			unsupported = append(unsupported, fmt.Sprintf("module %q", name))
+		case !e.isRadiusResource(resource):
+			// Resources which are not managed by Radius are reported as unsupported.
+		case resource["copy"] != nil:
+			unsupported = append(unsupported, fmt.Sprintf("resource loop %q", name))
+		case hasCopyLoop(resource["properties"]):
+			unsupported = append(unsupported, fmt.Sprintf("property loop in resource %q", name))
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("what-if does not support templates with %s", strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// hasCopyLoop returns true if the value contains a property or variable loop, which is declared as a "copy" array.
+func hasCopyLoop(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		if _, ok := v["copy"].([]any); ok {
+			return true
+		}
+		for _, item := range v {
+			if hasCopyLoop(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if hasCopyLoop(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (e *templateEvaluator) evaluateResource(name string, resource map[string]any) templateResource {
+	result := templateResource{SymbolicName: name}
+
+	if !e.isRadiusResource(resource) {
+		result.Err = fmt.Errorf("resources of type %q are not managed by Radius", resource["type"])
+		return result
+	}
+
+	id, apiVersion, err := e.resourceID(name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.ID = id
+	result.APIVersion = apiVersion
+
+	body, err := e.evaluate(resource["properties"])
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	bodyMap, ok := body.(map[string]any)
+	if !ok {
+		result.Err = fmt.Errorf("the properties of resource %q are not an object", name)
+		return result
+	}
+
+	// The name is part of the resource ID, and is not sent in the request body.
+	delete(bodyMap, "name")
+	result.Body = bodyMap
+	return result
+}
+
+// isRadiusResource returns true if the resource is declared using the Radius extension, or one of the extensions
+// for resource types defined in Radius.
+func (e *templateEvaluator) isRadiusResource(resource map[string]any) bool {
+	alias, _ := resource["extension"].(string)
+	declarations, _ := e.template["extensions"].(map[string]any)
+	key := "name"
+	if alias == "" {
+		alias, _ = resource["import"].(string)
+		declarations, _ = e.template["imports"].(map[string]any)
+		key = "provider"
+	}
+
+	// Resources without an extension are Azure resources.
+	if alias == "" {
+		return false
+	}
+
+	declaration, _ := declarations[alias].(map[string]any)
+	provider, _ := declaration[key].(string)
+	switch strings.ToLower(provider) {
+	case "aws", "kubernetes", "microsoftgraph":
+		return false
+	}
+
+	typeName, _ := resource["type"].(string)
+	return !strings.EqualFold(strings.Split(typeName, "@")[0], "Microsoft.Resources/deployments")
+}
+
+// resourceID returns the ID and API version of the resource with the given symbolic name.
+func (e *templateEvaluator) resourceID(name string) (resources.ID, string, error) {
+	declared, _ := e.template["resources"].(map[string]any)
+	resource, ok := declared[name].(map[string]any)
+	if !ok {
+		return resources.ID{}, "", fmt.Errorf("resource %q is not declared in the template", name)
+	}
+
+	typeAndVersion, _ := resource["type"].(string)
+	resourceType, apiVersion, _ := strings.Cut(typeAndVersion, "@")
+	if resourceType == "" || apiVersion == "" {
+		return resources.ID{}, "", fmt.Errorf("resource %q has an invalid type %q", name, typeAndVersion)
+	}
+
+	if id, ok := e.ids[name]; ok {
+		return id, apiVersion, nil
+	}
+
+	properties, _ := resource["properties"].(map[string]any)
+	value, err := e.evaluate(properties["name"])
+	if err != nil {
+		return resources.ID{}, "", err
+	}
+
+	resourceName, ok := value.(string)
+	if !ok || resourceName == "" {
+		return resources.ID{}, "", fmt.Errorf("resource %q has no name", name)
+	}
+
+	typeSegments := strings.Split(resourceType, "/")
+	nameSegments := strings.Split(resourceName, "/")
+	if len(typeSegments) != len(nameSegments)+1 {
+		return resources.ID{}, "", fmt.Errorf("the name %q of resource %q does not match its type %q", resourceName, name, resourceType)
+	}
+
+	id := e.scope
+	for i, segment := range nameSegments {
+		id = id.Append(resources.TypeSegment{Type: strings.Join(typeSegments[:i+2], "/"), Name: segment})
+	}
+
+	e.ids[name] = id
+	return id, apiVersion, nil
+}
+
+// evaluate evaluates the template expressions contained in the value.
+func (e *templateEvaluator) evaluate(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return e.evaluateString(v)
+
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = evaluated
+		}
+		return result, nil
+
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, evaluated)
+		}
+		return result, nil
+
+	default:
+		return v, nil
+	}
+}
+
+func (e *templateEvaluator) evaluateString(s string) (any, error) {
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return s, nil
+	}
+
+	// A string starting with "[[" is a literal value starting with "[".
+	if s[1] == '[' {
+		return s[1:], nil
+	}
+
+	p := &expressionParser{input: s[1 : len(s)-1], evaluator: e}
+	result, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %q: %w", s, err)
+	}
+
+	return result, nil
+}
+
+func (e *templateEvaluator) parameter(name string) (any, error) {
+	if value, ok := e.parameters[name]; ok {
+		if v, ok := value["value"]; ok {
+			return v, nil
+		}
+		return nil, fmt.Errorf("parameter %q has no value", name)
+	}
+
+	declared, _ := e.template["parameters"].(map[string]any)
+	declaration, ok := declared[name].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("parameter %q is not declared in the template", name)
+	}
+
+	defaultValue, ok := declaration["defaultValue"]
+	if !ok {
+		return nil, fmt.Errorf("no value was provided for parameter %q", name)
+	}
+
+	return e.evaluate(defaultValue)
+}
+
+func (e *templateEvaluator) variable(name string) (any, error) {
+	if value, ok := e.variables[name]; ok {
+		return value, nil
+	}
+
+	declared, _ := e.template["variables"].(map[string]any)
+	value, ok := declared[name]
+	if !ok {
+		return nil, fmt.Errorf("variable %q is not declared in the template", name)
+	}
+
+	evaluated, err := e.evaluate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	e.variables[name] = evaluated
+	return evaluated, nil
+}
+
+func (e *templateEvaluator) call(name string, args []any) (any, error) {
+	switch strings.ToLower(name) {
+	case "parameters":
+		if len(args) != 1 {
+			return nil, errors.New("parameters() expects one argument")
+		}
+		return e.parameter(fmt.Sprint(args[0]))
+
+	case "variables":
+		if len(args) != 1 {
+			return nil, errors.New("variables() expects one argument")
+		}
+		return e.variable(fmt.Sprint(args[0]))
+
+	case "reference", "resourceinfo":
+		if len(args) < 1 {
+			return nil, fmt.Errorf("%s() expects at least one argument", name)
+		}
+
+		// Only the identity of the resource is known before the deployment runs.
+		id, apiVersion, err := e.resourceID(fmt.Sprint(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"id": id.String(), "name": id.Name(), "type": id.Type(), "apiVersion": apiVersion}, nil
+
+	case "resourcegroup":
+		return map[string]any{"id": e.scope.String(), "name": e.scope.FindScope(resources_radius.ScopeResourceGroups)}, nil
+
+	case "format":
+		if len(args) < 1 {
+			return nil, errors.New("format() expects at least one argument")
+		}
+		return format(fmt.Sprint(args[0]), args[1:])
+
+	case "concat":
+		if len(args) > 0 {
+			if _, ok := args[0].([]any); ok {
+				result := []any{}
+				for _, arg := range args {
+					items, ok := arg.([]any)
+					if !ok {
+						return nil, errors.New("concat() expects all arguments to be arrays or strings")
+					}
+					result = append(result, items...)
+				}
+				return result, nil
+			}
+		}
+
+		builder := strings.Builder{}
+		for _, arg := range args {
+			builder.WriteString(toString(arg))
+		}
+		return builder.String(), nil
+
+	case "string":
+		if len(args) != 1 {
+			return nil, errors.New("string() expects one argument")
+		}
+		return toString(args[0]), nil
+
+	case "tolower":
+		if len(args) != 1 {
+			return nil, errors.New("toLower() expects one argument")
+		}
+		return strings.ToLower(toString(args[0])), nil
+
+	case "toupper":
+		if len(args) != 1 {
+			return nil, errors.New("toUpper() expects one argument")
+		}
+		return strings.ToUpper(toString(args[0])), nil
+
+	case "true":
+		return true, nil
+
+	case "false":
+		return false, nil
+
+	case "null":
+		return nil, nil
+
+	case "if":
+		if len(args) != 3 {
+			return nil, errors.New("if() expects three arguments")
+		}
+		condition, ok := args[0].(bool)
+		if !ok {
+			return nil, errors.New("if() expects a boolean condition")
+		}
+		if condition {
+			return args[1], nil
+		}
+		return args[2], nil
+
+	case "equals":
+		if len(args) != 2 {
+			return nil, errors.New("equals() expects two arguments")
+		}
+		return reflect.DeepEqual(args[0], args[1]), nil
+
+	case "not":
+		if len(args) != 1 {
+			return nil, errors.New("not() expects one argument")
+		}
+		b, ok := args[0].(bool)
+		if !ok {
+			return nil, errors.New("not() expects a boolean argument")
+		}
+		return !b, nil
+
+	case "and", "or":
+		if len(args) < 2 {
+			return nil, fmt.Errorf("%s() expects at least two arguments", name)
+		}
+		isAnd := strings.EqualFold(name, "and")
+		result := isAnd
+		for _, arg := range args {
+			b, ok := arg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s() expects boolean arguments", name)
+			}
+			if isAnd {
+				result = result && b
+			} else {
+				result = result || b
+			}
+		}
+		return result, nil
+
+	case "union":
+		return union(args)
+
+	case "json":
+		if len(args) != 1 {
+			return nil, errors.New("json() expects one argument")
+		}
+		var result any
+		if err := json.Unmarshal([]byte(toString(args[0])), &result); err != nil {
+			return nil, fmt.Errorf("json() failed to parse the argument: %w", err)
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("the function %q is not supported", name)
+	}
+}
+
+// union implements the union() template function. Objects are merged deeply with the later values taking precedence,
+// while arrays are combined without duplicate items.
+func union(args []any) (any, error) {
+	if len(args) == 0 {
+		return nil, errors.New("union() expects at least one argument")
+	}
+
+	switch args[0].(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for _, arg := range args {
+			object, ok := arg.(map[string]any)
+			if !ok {
+				return nil, errors.New("union() expects all arguments to be objects or arrays")
+			}
+			result = mergeObjects(result, object)
+		}
+		return result, nil
+
+	case []any:
+		result := []any{}
+		for _, arg := range args {
+			items, ok := arg.([]any)
+			if !ok {
+				return nil, errors.New("union() expects all arguments to be objects or arrays")
+			}
+			for _, item := range items {
+				if !slices.ContainsFunc(result, func(existing any) bool { return reflect.DeepEqual(existing, item) }) {
+					result = append(result, item)
+				}
+			}
+		}
+		return result, nil
+
+	default:
+		return nil, errors.New("union() expects all arguments to be objects or arrays")
+	}
+}
+
+func mergeObjects(dst map[string]any, src map[string]any) map[string]any {
+	result := map[string]any{}
+	for k, v := range dst {
+		result[k] = v
+	}
+
+	for k, v := range src {
+		existing, existingIsObject := result[k].(map[string]any)
+		object, isObject := v.(map[string]any)
+		if existingIsObject && isObject {
+			result[k] = mergeObjects(existing, object)
+		} else {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// format implements the format() template function, which uses .NET composite formatting with positional arguments.
+func format(f string, args []any) (string, error) {
+	builder := strings.Builder{}
+	for i := 0; i < len(f); i++ {
+		c := f[i]
+		switch {
+		case c == '{' && i+1 < len(f) && f[i+1] == '{':
+			builder.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(f) && f[i+1] == '}':
+			builder.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(f[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("invalid format string %q", f)
+			}
+
+			// Format specifiers like {0:D} are ignored.
+			placeholder, _, _ := strings.Cut(f[i+1:i+end], ":")
+			index, err := strconv.Atoi(strings.TrimSpace(placeholder))
+			if err != nil || index < 0 || index >= len(args) {
+				return "", fmt.Errorf("invalid format string %q", f)
+			}
+
+			builder.WriteString(toString(args[index]))
+			i += end
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// expressionParser parses and evaluates a template language expression, like "format('{0}-app', parameters('name'))".
+type expressionParser struct {
+	input     string
+	pos       int
+	evaluator *templateEvaluator
+}
+
+func (p *expressionParser) parse() (any, error) {
+	value, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected character %q at position %d", p.input[p.pos], p.pos)
+	}
+
+	return value, nil
+}
+
+func (p *expressionParser) parseExpression() (any, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	var value any
+	var err error
+	c := p.input[p.pos]
+	switch {
+	case c == '\'':
+		value, err = p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		value, err = p.parseNumber()
+	case unicode.IsLetter(rune(c)):
+		value, err = p.parseCall()
+	default:
+		err = fmt.Errorf("unexpected character %q at position %d", c, p.pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Property and index accessors.
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return value, nil
+		}
+
+		switch p.input[p.pos] {
+		case '.':
+			p.pos++
+			name := p.parseIdentifier()
+			if name == "" {
+				return nil, fmt.Errorf("expected a property name at position %d", p.pos)
+			}
+			value, err = property(value, name)
+		case '[':
+			p.pos++
+			var index any
+			index, err = p.parseExpression()
+			if err == nil {
+				err = p.expect(']')
+			}
+			if err == nil {
+				value, err = indexValue(value, index)
+			}
+		default:
+			return value, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *expressionParser) parseCall() (any, error) {
+	name := p.parseIdentifier()
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	args := []any{}
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == ')' {
+		p.pos++
+		return p.evaluator.call(name, args)
+	}
+
+	for {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return nil, errors.New("unexpected end of expression")
+		}
+		if p.input[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		if err := p.expect(')'); err != nil {
+			return nil, err
+		}
+		return p.evaluator.call(name, args)
+	}
+}
+
+func (p *expressionParser) parseString() (any, error) {
+	// Skip the opening quote. A quote is escaped by doubling it.
+	p.pos++
+	builder := strings.Builder{}
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		if c != '\'' {
+			builder.WriteByte(c)
+			continue
+		}
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			builder.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		return builder.String(), nil
+	}
+
+	return nil, errors.New("unterminated string literal")
+}
+
+func (p *expressionParser) parseNumber() (any, error) {
+	start := p.pos
+	if p.input[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.input) && (p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+		p.pos++
+	}
+
+	return strconv.ParseFloat(p.input[start:p.pos], 64)
+}
+
+func (p *expressionParser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := rune(p.input[p.pos])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *expressionParser) expect(c byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.input) || p.input[p.pos] != c {
+		return fmt.Errorf("expected %q at position %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *expressionParser) skipSpace() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func property(value any, name string) (any, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot access property %q of a value which is not an object", name)
+	}
+
+	result, ok := object[name]
+	if !ok {
+		return nil, fmt.Errorf("property %q: %w", name, errUnknownValue)
+	}
+
+	return result, nil
+}
+
+func indexValue(value any, index any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		return property(v, toString(index))
+	case []any:
+		i, ok := index.(float64)
+		if !ok || int(i) < 0 || int(i) >= len(v) {
+			return nil, fmt.Errorf("invalid array index %v", index)
+		}
+		return v[int(i)], nil
+	default:
+		return nil, errors.New("cannot index a value which is not an object or an array")
+	}
+}
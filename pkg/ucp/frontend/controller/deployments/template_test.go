@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceGroupID = "/planes/radius/local/resourceGroups/test-rg"
+
+func testTemplate() map[string]any {
+	return map[string]any{
+		"languageVersion": "2.0",
+		"parameters": map[string]any{
+			"image": map[string]any{
+				"type": "string",
+			},
+			"environment": map[string]any{
+				"type":         "string",
+				"defaultValue": "[format('{0}/providers/Applications.Core/environments/{1}', resourceGroup().id, variables('envName'))]",
+			},
+		},
+		"variables": map[string]any{
+			"envName": "default",
+		},
+		"extensions": map[string]any{
+			"radius": map[string]any{"name": "Radius", "version": "latest"},
+			"aws":    map[string]any{"name": "AWS", "version": "latest"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"extension": "radius",
+				"type":      "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[concat('my', '-app')]",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+					},
+				},
+			},
+			"container": map[string]any{
+				"extension": "radius",
+				"type":      "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "frontend",
+					"properties": map[string]any{
+						"application": "[reference('app').id]",
+						"container": map[string]any{
+							"image": "[parameters('image')]",
+							"env": map[string]any{
+								"APP_NAME": "[toUpper(reference('app').name)]",
+								"LITERAL":  "[[not an expression]",
+							},
+						},
+					},
+				},
+				"dependsOn": []any{"app"},
+			},
+			"database": map[string]any{
+				"extension": "radius",
+				"type":      "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "db",
+					"properties": map[string]any{
+						"host": "[reference('app').properties.status.host]",
+					},
+				},
+			},
+			"bucket": map[string]any{
+				"extension": "aws",
+				"type":      "AWS.S3/Bucket@default",
+				"properties": map[string]any{
+					"alias": "bucket",
+				},
+			},
+			"existingEnv": map[string]any{
+				"extension": "radius",
+				"existing":  true,
+				"type":      "Applications.Core/environments@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "default",
+				},
+			},
+			"disabled": map[string]any{
+				"extension": "radius",
+				"condition": "[false()]",
+				"type":      "Applications.Core/gateways@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "gateway",
+				},
+			},
+		},
+	}
+}
+
+func Test_TemplateEvaluator_Resources(t *testing.T) {
+	scope, err := resources.ParseScope(testResourceGroupID)
+	require.NoError(t, err)
+
+	parameters := map[string]map[string]any{
+		"image": {"value": "nginx:latest"},
+	}
+
+	result, err := newTemplateEvaluator(testTemplate(), parameters, scope).Resources()
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+
+	// Resources are sorted by symbolic name.
+	require.Equal(t, "app", result[0].SymbolicName)
+	require.NoError(t, result[0].Err)
+	require.Equal(t, testResourceGroupID+"/providers/Applications.Core/applications/my-app", result[0].ID.String())
+	require.Equal(t, "2023-10-01-preview", result[0].APIVersion)
+	require.Equal(t, map[string]any{
+		"properties": map[string]any{
+			"environment": testResourceGroupID + "/providers/Applications.Core/environments/default",
+		},
+	}, result[0].Body)
+
+	require.Equal(t, "bucket", result[1].SymbolicName)
+	require.ErrorContains(t, result[1].Err, "not managed by Radius")
+
+	require.Equal(t, "container", result[2].SymbolicName)
+	require.NoError(t, result[2].Err)
+	require.Equal(t, testResourceGroupID+"/providers/Applications.Core/containers/frontend", result[2].ID.String())
+	require.Equal(t, map[string]any{
+		"properties": map[string]any{
+			"application": testResourceGroupID + "/providers/Applications.Core/applications/my-app",
+			"container": map[string]any{
+				"image": "nginx:latest",
+				"env": map[string]any{
+					"APP_NAME": "MY-APP",
+					"LITERAL":  "[not an expression]",
+				},
+			},
+		},
+	}, result[2].Body)
+
+	require.Equal(t, "database", result[3].SymbolicName)
+	require.ErrorIs(t, result[3].Err, errUnknownValue)
+}
+
+func Test_TemplateEvaluator_MissingParameter(t *testing.T) {
+	scope, err := resources.ParseScope(testResourceGroupID)
+	require.NoError(t, err)
+
+	result, err := newTemplateEvaluator(testTemplate(), nil, scope).Resources()
+	require.NoError(t, err)
+	require.Equal(t, "container", result[2].SymbolicName)
+	require.ErrorContains(t, result[2].Err, `no value was provided for parameter "image"`)
+}
+
+func Test_TemplateEvaluator_UnsupportedTemplates(t *testing.T) {
+	scope, err := resources.ParseScope(testResourceGroupID)
+	require.NoError(t, err)
+
+	radiusResource := func(extra map[string]any) map[string]any {
+		resource := map[string]any{
+			"extension":  "radius",
+			"type":       "Applications.Core/containers@2023-10-01-preview",
+			"properties": map[string]any{"name": "frontend"},
+		}
+		for k, v := range extra {
+			resource[k] = v
+		}
+		return resource
+	}
+	extensions := map[string]any{"radius": map[string]any{"name": "Radius", "version": "latest"}}
+
+	tests := []struct {
+		name     string
+		template map[string]any
+		err      string
+	}{
+		{
+			name:     "non-symbolic resources",
+			template: map[string]any{"resources": []any{}},
+			err:      "what-if does not support templates with resources without symbolic names",
+		},
+		{
+			name:     "user-defined functions",
+			template: map[string]any{"functions": []any{map[string]any{"namespace": "ns"}}},
+			err:      "what-if does not support templates with user-defined functions",
+		},
+		{
+			name:     "variable loops",
+			template: map[string]any{"variables": map[string]any{"copy": []any{map[string]any{"name": "names", "count": 2}}}},
+			err:      "what-if does not support templates with variable loops",
+		},
+		{
+			name: "modules",
+			template: map[string]any{
+				"resources": map[string]any{
+					"app": map[string]any{"type": "Microsoft.Resources/deployments@2022-09-01", "properties": map[string]any{}},
+				},
+			},
+			err: `what-if does not support templates with module "app"`,
+		},
+		{
+			name: "resource and property loops",
+			template: map[string]any{
+				"extensions": extensions,
+				"resources": map[string]any{
+					"containers": radiusResource(map[string]any{"copy": map[string]any{"name": "containers", "count": 2}}),
+					"frontend": radiusResource(map[string]any{"properties": map[string]any{
+						"name": "frontend",
+						"copy": []any{map[string]any{"name": "ports", "count": 2}},
+					}}),
+				},
+			},
+			err: `what-if does not support templates with resource loop "containers", property loop in resource "frontend"`,
+		},
+		{
+			name: "loops of resources not managed by Radius",
+			template: map[string]any{
+				"resources": map[string]any{
+					"storage": map[string]any{"type": "Microsoft.Storage/storageAccounts@2023-01-01", "copy": map[string]any{"name": "storage", "count": 2}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTemplateEvaluator(tt.template, nil, scope).Resources()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func Test_TemplateEvaluator_Expressions(t *testing.T) {
+	scope, err := resources.ParseScope(testResourceGroupID)
+	require.NoError(t, err)
+
+	evaluator := newTemplateEvaluator(map[string]any{
+		"variables": map[string]any{
+			"list": []any{"a", "b"},
+			"obj":  map[string]any{"key": "value"},
+		},
+	}, nil, scope)
+
+	tests := []struct {
+		expression string
+		expected   any
+		err        string
+	}{
+		{expression: "plain", expected: "plain"},
+		{expression: "['it''s']", expected: "it's"},
+		{expression: "[format('{0}-{1}-{{2}}', 'a', 42)]", expected: "a-42-{2}"},
+		{expression: "[variables('list')[1]]", expected: "b"},
+		{expression: "[variables('obj').key]", expected: "value"},
+		{expression: "[variables('obj')['key']]", expected: "value"},
+		{expression: "[concat(variables('list'), createArray('c'))]", err: `the function "createArray" is not supported`},
+		{expression: "[toLower('ABC')]", expected: "abc"},
+		{expression: "[string(true())]", expected: "true"},
+		{expression: "[format('{0}', 'a']", err: "unexpected end of expression"},
+		{expression: "[variables('missing')]", err: `variable "missing" is not declared in the template`},
+		{expression: "[if(equals(variables('obj').key, 'value'), 'yes', 'no')]", expected: "yes"},
+		{expression: "[if(not(equals(1, 1)), 'yes', 'no')]", expected: "no"},
+		{expression: "[if('true', 'yes', 'no')]", err: "if() expects a boolean condition"},
+		{expression: "[and(true(), or(false(), true()))]", expected: true},
+		{expression: "[union(variables('obj'), json('{\"nested\": {\"a\": 1}}'), json('{\"nested\": {\"b\": 2}}'))]", expected: map[string]any{"key": "value", "nested": map[string]any{"a": float64(1), "b": float64(2)}}},
+		{expression: "[union(variables('list'), json('[\"b\", \"c\"]'))]", expected: []any{"a", "b", "c"}},
+		{expression: "[union(variables('list'), variables('obj'))]", err: "union() expects all arguments to be objects or arrays"},
+		{expression: "[uniqueString(resourceGroup().id)]", err: `the function "uniqueString" is not supported`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := evaluator.evaluate(tt.expression)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DeploymentModeIncremental leaves the resources of the resource group which are not in the template unchanged.
+	DeploymentModeIncremental = "Incremental"
+
+	// DeploymentModeComplete deletes the resources of the resource group which are not in the template.
+	DeploymentModeComplete = "Complete"
+)
+
+// WhatIfDeploymentRequest is the request body of the what-if action of a deployment. It has the same shape as the
+// body of the PUT operation of the deployment.
+type WhatIfDeploymentRequest struct {
+	// Properties is the properties of the deployment.
+	Properties WhatIfDeploymentProperties `json:"properties"`
+}
+
+// WhatIfDeploymentProperties is the properties of a deployment to preview.
+type WhatIfDeploymentProperties struct {
+	// Template is the compiled Bicep template.
+	Template map[string]any `json:"template"`
+
+	// Parameters is the set of parameters passed to the deployment.
+	Parameters map[string]map[string]any `json:"parameters,omitempty"`
+
+	// Mode is the deployment mode, either Incremental (default) or Complete.
+	Mode string `json:"mode,omitempty"`
+}
+
+var _ armrpc_controller.Controller = (*WhatIfDeployment)(nil)
+
+// WhatIfDeployment is the controller implementation to preview the changes of a deployment. Each Radius resource of
+// the template is sent to the what-if action of its resource provider, which validates the resource and compares its
+// properties with the existing resource without persisting it. Resource providers which render output resources,
+// such as the Kubernetes objects of containers, report the changes to the output resources as well. The resources
+// created by recipes are previewed by the plan action of the resource type instead.
+type WhatIfDeployment struct {
+	armrpc_controller.Operation[*datamodel.GenericResource, datamodel.GenericResource]
+
+	// defaultDownstream is the address of the dynamic resource provider.
+	defaultDownstream *url.URL
+
+	// client is the HTTP client used to call the resource providers.
+	client *http.Client
+}
+
+// NewWhatIfDeployment creates a new WhatIfDeployment controller.
+func NewWhatIfDeployment(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (armrpc_controller.Controller, error) {
+	parsedDefaultDownstream, err := url.Parse(defaultDownstream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse default downstream URL: %w", err)
+	}
+
+	return &WhatIfDeployment{
+		Operation:         armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GenericResource]{}),
+		defaultDownstream: parsedDefaultDownstream,
+		client:            &http.Client{Transport: transport},
+	}, nil
+}
+
+// Run evaluates the template of the deployment and returns the changes that the deployment would make to each of its
+// resources.
+func (w *WhatIfDeployment) Run(ctx context.Context, _ http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The ID of a POST request is the ID of the deployment. Resources are deployed to its resource group.
+	resourceGroupID, err := resources.ParseScope(serviceCtx.ResourceID.RootScope())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.DatabaseClient().Get(ctx, resourceGroupID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(resourceGroupID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	content, err := armrpc_controller.ReadJSONBody(req)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	body := WhatIfDeploymentRequest{}
+	if err := json.Unmarshal(content, &body); err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("failed to parse the request body: %v", err)), nil
+	}
+
+	if body.Properties.Template == nil {
+		return armrpc_rest.NewBadRequestResponse("the template of the deployment is required"), nil
+	}
+
+	mode := body.Properties.Mode
+	if mode == "" {
+		mode = DeploymentModeIncremental
+	}
+	if !strings.EqualFold(mode, DeploymentModeIncremental) && !strings.EqualFold(mode, DeploymentModeComplete) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("the deployment mode %q is not supported", mode)), nil
+	}
+
+	evaluator := newTemplateEvaluator(body.Properties.Template, body.Properties.Parameters, resourceGroupID)
+	declared, err := evaluator.Resources()
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	result := v1.WhatIfResult{Changes: []v1.WhatIfResourceChange{}}
+	deployed := map[string]bool{}
+	for _, resource := range declared {
+		if resource.Err != nil {
+			result.Changes = append(result.Changes, unsupportedChange(resource.ID.String(), resource.SymbolicName, resource.Err.Error()))
+			continue
+		}
+
+		deployed[strings.ToLower(resource.ID.String())] = true
+		change, err := w.whatIfResource(ctx, req, resource)
+		if err != nil {
+			return nil, err
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	if strings.EqualFold(mode, DeploymentModeComplete) {
+		deleted, err := w.listDeletedResources(ctx, resourceGroupID, deployed)
+		if err != nil {
+			return nil, err
+		}
+		result.Changes = append(result.Changes, deleted...)
+	}
+
+	return armrpc_rest.NewOKResponse(result), nil
+}
+
+// whatIfResource sends the resource to the what-if action of its resource provider and returns the reported change.
+func (w *WhatIfDeployment) whatIfResource(ctx context.Context, original *http.Request, resource templateResource) (v1.WhatIfResourceChange, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	id := resource.ID.String()
+
+	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, w.DatabaseClient(), resource.ID, v1.LocationGlobal, resource.APIVersion)
+	if errors.Is(err, &resourcegroups.NotFoundError{}) || errors.Is(err, &resourcegroups.InvalidError{}) {
+		return unsupportedChange(id, resource.SymbolicName, err.Error()), nil
+	} else if err != nil {
+		return v1.WhatIfResourceChange{}, fmt.Errorf("failed to validate downstream: %w", err)
+	}
+
+	if downstreamURL == nil {
+		downstreamURL = w.defaultDownstream
+	}
+
+	body, err := json.Marshal(resource.Body)
+	if err != nil {
+		return v1.WhatIfResourceChange{}, err
+	}
+
+	path := id + "/" + v1.WhatIfActionName
+	query := url.Values{"api-version": []string{resource.APIVersion}}.Encode()
+
+	requestURL := *downstreamURL
+	requestURL.Path = path
+	requestURL.RawQuery = query
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return v1.WhatIfResourceChange{}, err
+	}
+
+	// Forward the headers of the original request so that the resource provider sees the same caller.
+	req.Header = original.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	refererURL := url.URL{
+		Scheme:   "http",
+		Host:     original.Host,
+		Path:     w.Options().PathBase + path,
+		RawQuery: query,
+	}
+	if original.TLS != nil {
+		refererURL.Scheme = "https"
+	}
+	req.Header.Set(v1.RefererHeader, refererURL.String())
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		logger.Error(err, "failed to send the what-if request to the resource provider", "resourceId", id)
+		return unsupportedChange(id, resource.SymbolicName, fmt.Sprintf("failed to reach the resource provider: %v", err)), nil
+	}
+	defer resp.Body.Close()
+
+	// Resource types which disable the what-if action do not register it.
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return unsupportedChange(id, resource.SymbolicName, fmt.Sprintf("the resource provider does not support previewing changes to %s resources", resource.ID.Type())), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		errorResponse := v1.ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(&errorResponse); err != nil || errorResponse.Error == nil {
+			return unsupportedChange(id, resource.SymbolicName, fmt.Sprintf("the resource provider returned status code %d", resp.StatusCode)), nil
+		}
+
+		return v1.WhatIfResourceChange{
+			ResourceID: id,
+			ChangeType: v1.WhatIfChangeTypeUnsupported,
+			Error:      errorResponse.Error,
+		}, nil
+	}
+
+	change := v1.WhatIfResourceChange{}
+	if err := json.NewDecoder(resp.Body).Decode(&change); err != nil {
+		return unsupportedChange(id, resource.SymbolicName, fmt.Sprintf("failed to parse the response of the resource provider: %v", err)), nil
+	}
+
+	// Report the ID with the casing used by the template.
+	change.ResourceID = id
+	return change, nil
+}
+
+// listDeletedResources returns the resources of the resource group which are not deployed by the template.
+func (w *WhatIfDeployment) listDeletedResources(ctx context.Context, resourceGroupID resources.ID, deployed map[string]bool) ([]v1.WhatIfResourceChange, error) {
+	query := database.Query{
+		RootScope:    resourceGroupID.String(),
+		ResourceType: datamodel.GenericResourceType,
+	}
+
+	changes := []v1.WhatIfResourceChange{}
+	token := ""
+	for {
+		result, err := w.DatabaseClient().Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			tracked := datamodel.GenericResource{}
+			if err := item.As(&tracked); err != nil {
+				return nil, err
+			}
+
+			if deployed[strings.ToLower(tracked.Properties.ID)] {
+				continue
+			}
+
+			changes = append(changes, v1.WhatIfResourceChange{
+				ResourceID: tracked.Properties.ID,
+				ChangeType: v1.WhatIfChangeTypeDelete,
+			})
+		}
+
+		if result.PaginationToken == "" {
+			return changes, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+func unsupportedChange(id string, symbolicName string, message string) v1.WhatIfResourceChange {
+	return v1.WhatIfResourceChange{
+		ResourceID: id,
+		ChangeType: v1.WhatIfChangeTypeUnsupported,
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: message,
+			Target:  symbolicName,
+		},
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+const (
+	testDeploymentID = testResourceGroupID + "/providers/Microsoft.Resources/deployments/test-deployment"
+	testAPIVersion   = "2023-10-01-preview"
+)
+
+func Test_WhatIfDeployment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, testAPIVersion, r.URL.Query().Get("api-version"))
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/applications/my-app/whatIf"):
+			_ = json.NewEncoder(w).Encode(v1.WhatIfResourceChange{
+				ResourceID: strings.ToLower(strings.TrimSuffix(r.URL.Path, "/whatIf")),
+				ChangeType: v1.WhatIfChangeTypeCreate,
+			})
+		case strings.HasSuffix(r.URL.Path, "/applications/invalid/whatIf"):
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: "invalid application"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	template := map[string]any{
+		"extensions": map[string]any{
+			"radius": map[string]any{"name": "Radius", "version": "latest"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"extension":  "radius",
+				"type":       "Applications.Core/applications@" + testAPIVersion,
+				"properties": map[string]any{"name": "my-app"},
+			},
+			"invalid": map[string]any{
+				"extension":  "radius",
+				"type":       "Applications.Core/applications@" + testAPIVersion,
+				"properties": map[string]any{"name": "invalid"},
+			},
+			"unknown": map[string]any{
+				"extension":  "radius",
+				"type":       "Applications.Unknown/things@" + testAPIVersion,
+				"properties": map[string]any{"name": "thing"},
+			},
+			"unsupported": map[string]any{
+				"extension":  "radius",
+				"type":       "Applications.Core/applications@" + testAPIVersion,
+				"properties": map[string]any{"name": "no-what-if"},
+			},
+		},
+	}
+
+	t.Run("incremental", func(t *testing.T) {
+		controller := setupWhatIfDeployment(t, server.URL)
+
+		response := runWhatIfDeployment(t, controller, WhatIfDeploymentRequest{
+			Properties: WhatIfDeploymentProperties{Template: template},
+		})
+		require.Equal(t, http.StatusOK, response.Code)
+
+		result := v1.WhatIfResult{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &result))
+		require.Len(t, result.Changes, 4)
+
+		require.Equal(t, testResourceGroupID+"/providers/Applications.Core/applications/my-app", result.Changes[0].ResourceID)
+		require.Equal(t, v1.WhatIfChangeTypeCreate, result.Changes[0].ChangeType)
+
+		require.Equal(t, v1.WhatIfChangeTypeUnsupported, result.Changes[1].ChangeType)
+		require.Equal(t, "invalid application", result.Changes[1].Error.Message)
+
+		require.Equal(t, testResourceGroupID+"/providers/Applications.Unknown/things/thing", result.Changes[2].ResourceID)
+		require.Equal(t, v1.WhatIfChangeTypeUnsupported, result.Changes[2].ChangeType)
+		require.NotNil(t, result.Changes[2].Error)
+		require.Equal(t, "unknown", result.Changes[2].Error.Target)
+
+		require.Equal(t, v1.WhatIfChangeTypeUnsupported, result.Changes[3].ChangeType)
+		require.Equal(t, "the resource provider does not support previewing changes to Applications.Core/applications resources", result.Changes[3].Error.Message)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		controller := setupWhatIfDeployment(t, server.URL)
+
+		response := runWhatIfDeployment(t, controller, WhatIfDeploymentRequest{
+			Properties: WhatIfDeploymentProperties{Template: template, Mode: DeploymentModeComplete},
+		})
+		require.Equal(t, http.StatusOK, response.Code)
+
+		result := v1.WhatIfResult{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &result))
+		require.Len(t, result.Changes, 5)
+
+		require.Equal(t, testResourceGroupID+"/providers/Applications.Core/containers/orphan", result.Changes[4].ResourceID)
+		require.Equal(t, v1.WhatIfChangeTypeDelete, result.Changes[4].ChangeType)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		controller := setupWhatIfDeployment(t, server.URL)
+
+		response := runWhatIfDeployment(t, controller, WhatIfDeploymentRequest{
+			Properties: WhatIfDeploymentProperties{Template: template, Mode: "Partial"},
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		controller := setupWhatIfDeployment(t, server.URL)
+
+		response := runWhatIfDeployment(t, controller, WhatIfDeploymentRequest{})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("resource group not found", func(t *testing.T) {
+		controller, err := NewWhatIfDeployment(armrpc_controller.Options{DatabaseClient: inmemory.NewClient()}, http.DefaultTransport, server.URL)
+		require.NoError(t, err)
+
+		response := runWhatIfDeployment(t, controller, WhatIfDeploymentRequest{
+			Properties: WhatIfDeploymentProperties{Template: template},
+		})
+		require.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func setupWhatIfDeployment(t *testing.T, downstream string) armrpc_controller.Controller {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	save := func(id string, data any) {
+		require.NoError(t, databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data}))
+	}
+
+	appID, err := resources.ParseResource(testResourceGroupID + "/providers/Applications.Core/applications/my-app")
+	require.NoError(t, err)
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(appID)
+	require.NoError(t, err)
+
+	locationID, err := datamodel.ResourceProviderLocationIDFromResourceID(appID, v1.LocationGlobal)
+	require.NoError(t, err)
+
+	save(appID.PlaneScope(), &datamodel.RadiusPlane{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: appID.PlaneScope()}},
+	})
+	save(testResourceGroupID, &datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testResourceGroupID}},
+	})
+	save(resourceTypeID.String(), &datamodel.ResourceType{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: resourceTypeID.String(), Name: "applications"}},
+	})
+	save(locationID.String(), &datamodel.Location{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: locationID.String(), Name: v1.LocationGlobal}},
+		Properties: datamodel.LocationProperties{
+			Address: to.Ptr(downstream),
+			ResourceTypes: map[string]datamodel.LocationResourceTypeConfiguration{
+				"applications": {
+					APIVersions: map[string]datamodel.LocationAPIVersionConfiguration{
+						testAPIVersion: {},
+					},
+				},
+			},
+		},
+	})
+
+	// The application is also tracked so that it is not reported as deleted in complete mode.
+	for _, id := range []string{appID.String(), testResourceGroupID + "/providers/Applications.Core/containers/orphan"} {
+		parsed, err := resources.ParseResource(id)
+		require.NoError(t, err)
+
+		trackingID := trackedresource.IDFor(parsed)
+		save(trackingID.String(), &datamodel.GenericResource{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: trackingID.String()}},
+			Properties: datamodel.GenericResourceProperties{
+				ID:         parsed.String(),
+				Name:       parsed.Name(),
+				Type:       parsed.Type(),
+				APIVersion: testAPIVersion,
+			},
+		})
+	}
+
+	controller, err := NewWhatIfDeployment(armrpc_controller.Options{DatabaseClient: databaseClient}, http.DefaultTransport, "http://localhost:8080")
+	require.NoError(t, err)
+
+	return controller
+}
+
+func runWhatIfDeployment(t *testing.T, controller armrpc_controller.Controller, body WhatIfDeploymentRequest) *httptest.ResponseRecorder {
+	content, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPost, "http://localhost:9443"+testDeploymentID+"/whatIf?api-version=2020-10-01", content)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	w := httptest.NewRecorder()
+	response, err := controller.Run(ctx, w, req.WithContext(ctx))
+	require.NoError(t, err)
+	require.NoError(t, response.Apply(ctx, w, req))
+
+	return w
+}
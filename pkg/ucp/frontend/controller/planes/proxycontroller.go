@@ -41,13 +41,17 @@ var _ armrpc_controller.Controller = (*ProxyController)(nil)
 // ProxyController is the controller implementation to proxy requests to Azure.
 type ProxyController struct {
 	armrpc_controller.Operation[*datamodel.AzurePlane, datamodel.AzurePlane]
+
+	// cache is the cache of the responses of the downstream servers. May be nil if caching is disabled.
+	cache *proxy.ResponseCache
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
-// controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// controller cannot be created. The cache is optional.
+func NewProxyController(opts armrpc_controller.Options, cache *proxy.ResponseCache) (armrpc_controller.Controller, error) {
 	return &ProxyController{
 		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AzurePlane]{}),
+		cache:     cache,
 	}, nil
 }
 
@@ -106,8 +110,13 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, err
 	}
 
+	var transport http.RoundTripper = otelhttp.NewTransport(http.DefaultTransport)
+	if p.cache != nil {
+		transport = p.cache.RoundTripper(transport)
+	}
+
 	options := proxy.ReverseProxyOptions{
-		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
+		RoundTripper: transport,
 	}
 
 	refererURL := url.URL{
@@ -72,11 +72,17 @@ type ProxyController struct {
 
 	// updater is used to process tracked resources. Can be overridden for testing.
 	updater updater
+
+	// quotas are the limits on the number of resources that can be created.
+	quotas ResourceQuotas
+
+	// cache is the cache of the responses of the downstream servers. May be nil if caching is disabled.
+	cache *proxy.ResponseCache
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
 // controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (armrpc_controller.Controller, error) {
+func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string, quotas ResourceQuotas, cache *proxy.ResponseCache) (armrpc_controller.Controller, error) {
 	parsedDefaultDownstream, err := url.Parse(defaultDownstream)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse default downstream URL: %w", err)
@@ -88,6 +94,8 @@ func NewProxyController(opts armrpc_controller.Options, transport http.RoundTrip
 		transport:         transport,
 		defaultDownstream: parsedDefaultDownstream,
 		updater:           updater,
+		quotas:            quotas,
+		cache:             cache,
 	}, nil
 }
 
@@ -133,12 +141,25 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return armrpc_rest.NewInternalServerErrorARMResponse(response), nil
 	}
 
+	resp, err := p.CheckResourceQuota(ctx, req.Method, id)
+	if err != nil {
+		return nil, err
+	} else if resp != nil {
+		return resp, nil
+	}
+
 	proxyReq, err := p.PrepareProxyRequest(ctx, req, downstreamURL.String(), relativePath)
 	if err != nil {
 		return nil, err
 	}
 
-	interceptor := &responseInterceptor{Inner: p.transport}
+	// The cache is only used for the proxied request. The tracked resource updates must observe the latest state.
+	transport := p.transport
+	if p.cache != nil {
+		transport = p.cache.RoundTripper(p.transport)
+	}
+
+	interceptor := &responseInterceptor{Inner: transport}
 	sender := proxy.NewARMProxy(proxy.ReverseProxyOptions{RoundTripper: interceptor}, downstreamURL, nil)
 	sender.ServeHTTP(w, proxyReq)
 
@@ -55,7 +55,9 @@ func createController(t *testing.T) (*ProxyController, *database.MockClient, *mo
 	p, err := NewProxyController(
 		controller.Options{DatabaseClient: databaseClient, StatusManager: statusManager},
 		&roundTripper,
-		"http://localhost:1234")
+		"http://localhost:1234",
+		ResourceQuotas{},
+		nil)
 	require.NoError(t, err)
 
 	updater := mockUpdater{}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+// ResourceQuotas are the limits on the number of resources that can be created through the proxy.
+type ResourceQuotas struct {
+	// MaxResourcesPerResourceGroup is the maximum number of resources in a resource group. Zero means no limit.
+	MaxResourcesPerResourceGroup int
+}
+
+// CheckResourceQuota returns a non-nil response if the request would create a resource beyond the quota of its
+// resource group. Resources are counted using the tracked resource entries of the resource group, so only the
+// top-level resources tracked by UCP count towards the quota.
+//
+// The check is best-effort: concurrent requests creating resources in the same resource group can exceed the
+// quota by the number of requests in flight.
+func (p *ProxyController) CheckResourceQuota(ctx context.Context, method string, id resources.ID) (armrpc_rest.Response, error) {
+	limit := p.quotas.MaxResourcesPerResourceGroup
+	if limit <= 0 || method != http.MethodPut || len(id.TypeSegments()) != 1 || !id.IsResource() {
+		return nil, nil
+	}
+
+	if id.FindScope(resources_radius.ScopeResourceGroups) == "" {
+		return nil, nil
+	}
+
+	// Updates of existing resources never change the number of resources.
+	_, err := p.DatabaseClient().Get(ctx, trackedresource.IDFor(id).String())
+	if err == nil {
+		return nil, nil
+	} else if !errors.Is(err, &database.ErrNotFound{}) {
+		return nil, err
+	}
+
+	count, err := p.countTrackedResources(ctx, id.RootScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if count < limit {
+		return nil, nil
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeQuotaExceeded,
+				Message: fmt.Sprintf("The resource group %q has reached its quota of %d resources.", id.RootScope(), limit),
+				Target:  id.String(),
+			},
+		},
+	}, nil
+}
+
+// countTrackedResources returns the number of tracked resources in the resource group.
+func (p *ProxyController) countTrackedResources(ctx context.Context, resourceGroupID string) (int, error) {
+	query := database.Query{
+		RootScope:    resourceGroupID,
+		ResourceType: v20231001preview.ResourceType,
+	}
+
+	count := 0
+	token := ""
+	for {
+		result, err := p.DatabaseClient().Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(result.Items)
+		if result.PaginationToken == "" {
+			return count, nil
+		}
+		token = result.PaginationToken
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckResourceQuota(t *testing.T) {
+	existing := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/existing")
+	created := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/created")
+
+	setup := func(t *testing.T, limit int) *ProxyController {
+		databaseClient := inmemory.NewClient()
+		trackingID := trackedresource.IDFor(existing)
+		err := databaseClient.Save(testcontext.New(t), &database.Object{
+			Metadata: database.Metadata{ID: trackingID.String()},
+			Data:     datamodel.GenericResourceFromID(existing, trackingID),
+		})
+		require.NoError(t, err)
+
+		p, err := NewProxyController(controller.Options{DatabaseClient: databaseClient}, &mockRoundTripper{}, "http://localhost:1234", ResourceQuotas{MaxResourcesPerResourceGroup: limit}, nil)
+		require.NoError(t, err)
+		return p.(*ProxyController)
+	}
+
+	t.Run("no quota", func(t *testing.T) {
+		p := setup(t, 0)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodPut, created)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("within quota", func(t *testing.T) {
+		p := setup(t, 2)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodPut, created)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("quota exceeded", func(t *testing.T) {
+		p := setup(t, 1)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodPut, created)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, resp)
+		require.Equal(t, v1.CodeQuotaExceeded, resp.(*armrpc_rest.ConflictResponse).Body.Error.Code)
+	})
+
+	t.Run("update of existing resource", func(t *testing.T) {
+		p := setup(t, 1)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodPut, existing)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		p := setup(t, 1)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodDelete, created)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("child resource", func(t *testing.T) {
+		p := setup(t, 1)
+		resp, err := p.CheckResourceQuota(testcontext.New(t), http.MethodPut, created.Append(resources.TypeSegment{Type: "children", Name: "child"}))
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+}
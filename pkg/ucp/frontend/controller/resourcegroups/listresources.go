@@ -74,12 +74,13 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		ResourceType: v20231001preview.ResourceType,
 	}
 
-	result, err := r.DatabaseClient().Query(ctx, query)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	result, err := r.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := r.createResponse(ctx, result)
+	response, err := r.createResponse(ctx, req, result)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,7 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 	return armrpc_rest.NewOKResponse(response), nil
 }
 
-func (r *ListResources) createResponse(ctx context.Context, result *database.ObjectQueryResult) (*v1.PaginatedList, error) {
+func (r *ListResources) createResponse(ctx context.Context, req *http.Request, result *database.ObjectQueryResult) (*v1.PaginatedList, error) {
 	items := v1.PaginatedList{}
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -106,5 +107,7 @@ func (r *ListResources) createResponse(ctx context.Context, result *database.Obj
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
@@ -28,12 +28,15 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_authorization "github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	deployments_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/deployments"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -79,6 +82,10 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		ResourceType: "",  // Set dynamically
 	}
 
+	quotas := radius_ctrl.ResourceQuotas{
+		MaxResourcesPerResourceGroup: m.options.Config.Quotas.MaxResourcesPerResourceGroup,
+	}
+
 	// NOTE: we're careful where we use the `apiValidator` middleware. It's not used for the proxy routes.
 	m.router.Route(m.options.Config.Server.PathBase+"/planes/radius", func(r chi.Router) {
 		r.With(apiValidator).Get("/", capture(radiusPlaneListHandler(ctx, ctrlOptions)))
@@ -95,7 +102,9 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 					// Routes for async support: operationResults + operationStatuses
 					r.Route("/locations/{location}", func(r chi.Router) {
+						r.Get("/operationStatuses", capture(operationStatusListHandler(ctx, ctrlOptions)))
 						r.Get("/operationStatuses/{operationId}", capture(operationStatusGetHandler(ctx, ctrlOptions)))
+						r.Post("/operationStatuses/{operationId}/"+defaultoperation.CancelOperationActionName, capture(operationStatusCancelHandler(ctx, ctrlOptions)))
 						r.Get("/operationResults/{operationId}", capture(operationResultGetHandler(ctx, ctrlOptions)))
 					})
 
@@ -136,10 +145,30 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					})
 				})
 
+				r.Route("/System.Authorization", func(r chi.Router) {
+					r.Route("/roledefinitions", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleDefinitionListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleDefinitionName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleDefinitionGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleDefinitionPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleDefinitionDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+
+					r.Route("/roleassignments", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleAssignmentListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleAssignmentName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(m.invalidatesRoleAssignments(roleAssignmentPutHandler(ctx, ctrlOptions))))
+							r.With(apiValidator).Delete("/", capture(m.invalidatesRoleAssignments(roleAssignmentDeleteHandler(ctx, ctrlOptions))))
+						})
+					})
+				})
+
 				// Proxy to plane-scoped ResourceProvider APIs
 				//
 				// NOTE: DO NOT validate schema for proxy routes.
-				r.Handle("/*", capture(planeScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+				r.Handle("/*", capture(planeScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream, quotas, m.options.ProxyCache)))
 			})
 
 			r.Route("/resourcegroups", func(r chi.Router) {
@@ -153,10 +182,23 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					})
 
 					r.Route("/providers", func(r chi.Router) {
+						r.Route("/System.Authorization/roleassignments", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleAssignmentListHandler(ctx, ctrlOptions)))
+							r.Route("/{roleAssignmentName}", func(r chi.Router) {
+								r.With(apiValidator).Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+								r.With(apiValidator).Put("/", capture(m.invalidatesRoleAssignments(roleAssignmentPutHandler(ctx, ctrlOptions))))
+								r.With(apiValidator).Delete("/", capture(m.invalidatesRoleAssignments(roleAssignmentDeleteHandler(ctx, ctrlOptions))))
+							})
+						})
+
+						// Preview the changes of a deployment. The resources of the template are sent to the what-if
+						// action of their resource providers.
+						r.Post("/Microsoft.Resources/deployments/{deploymentName}/"+v1.WhatIfActionName, capture(deploymentWhatIfHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+
 						// Proxy to resource-group-scoped ResourceProvider APIs
 						//
 						// NOTE: DO NOT validate schema for proxy routes.
-						r.Handle("/*", capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+						r.Handle("/*", capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream, quotas, m.options.ProxyCache)))
 					})
 				})
 
@@ -366,15 +408,93 @@ func locationDeleteHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
-func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+var roleDefinitionResourceOptions = controller.ResourceOptions[datamodel.RoleDefinition]{
+	RequestConverter:  converter.RoleDefinitionDataModelFromVersioned,
+	ResponseConverter: converter.RoleDefinitionDataModelToVersioned,
+}
+
+func roleDefinitionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleDefinitionResourceOptions)
+	})
+}
+
+var roleAssignmentResourceOptions = controller.ResourceOptions[datamodel.RoleAssignment]{
+	RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+	ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+}
+
+// invalidatesRoleAssignments wraps a handler that writes role assignments so that the role assignments cached by
+// the authorizer are discarded once the write completes.
+func (m *Module) invalidatesRoleAssignments(handler http.HandlerFunc, err error) (http.HandlerFunc, error) {
+	authorizer, ok := m.options.Authorizer.(*ucp_authorization.RBACAuthorizer)
+	if !ok || err != nil {
+		return handler, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r)
+		authorizer.InvalidateRoleAssignments()
+	}, nil
+}
+
+func roleAssignmentListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string, quotas radius_ctrl.ResourceQuotas, cache *proxy.ResponseCache) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
-		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
+		return radius_ctrl.NewProxyController(o, transport, defaultDownstream, quotas, cache)
 	})
 }
 
-func resourceGroupScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+func resourceGroupScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string, quotas radius_ctrl.ResourceQuotas, cache *proxy.ResponseCache) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
-		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
+		return radius_ctrl.NewProxyController(o, transport, defaultDownstream, quotas, cache)
+	})
+}
+
+func deploymentWhatIfHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "Microsoft.Resources/deployments", v1.OperationPost, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
+		return deployments_ctrl.NewWhatIfDeployment(o, transport, defaultDownstream)
 	})
 }
 
@@ -382,6 +502,14 @@ func operationStatusGetHandler(ctx context.Context, ctrlOptions controller.Optio
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationStatus)
 }
 
+func operationStatusListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationList, ctrlOptions, defaultoperation.NewListOperationStatuses)
+}
+
+func operationStatusCancelHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationPost, ctrlOptions, defaultoperation.NewCancelOperation)
+}
+
 func operationResultGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	// NOTE: The resource type below is CORRECT. operation status and operation result use the same resource type in the database.
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationResult)
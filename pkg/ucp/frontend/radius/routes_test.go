@@ -19,18 +19,24 @@ package radius
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/components/secret"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_authorization "github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -170,6 +176,75 @@ func Test_Routes(t *testing.T) {
 			SkipOperationTypeValidation: true,
 		},
 
+		// Authorization
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roledefinitions",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roledefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/roledefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/roledefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleassignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleassignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleassignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleassignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleassignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleassignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleassignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleassignments/test-assignment",
+		},
+
+		// Deployments
+		{
+			OperationType: v1.OperationType{Type: "Microsoft.Resources/deployments", Method: v1.OperationPost},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/Microsoft.Resources/deployments/test-deployment/whatIf",
+		},
+
 		// Proxy
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
@@ -213,3 +288,42 @@ func Test_Routes(t *testing.T) {
 		return handler.(chi.Router), nil
 	})
 }
+
+func Test_InvalidatesRoleAssignments(t *testing.T) {
+	client := inmemory.NewClient()
+	authorizer := ucp_authorization.NewRBACAuthorizer(client, nil, nil, nil)
+	module := &Module{options: &ucp.Options{Authorizer: authorizer}}
+
+	principal := v1.NewPrincipal("alice@contoso.com", nil)
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app")
+
+	allowed, err := authorizer.Authorize(context.Background(), principal, "Applications.Core/applications/read", id)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	assignmentID := "/planes/radius/local/providers/System.Authorization/roleAssignments/reader"
+	handler, err := module.invalidatesRoleAssignments(func(w http.ResponseWriter, r *http.Request) {
+		err := client.Save(r.Context(), &database.Object{
+			Metadata: database.Metadata{ID: assignmentID},
+			Data: &datamodel.RoleAssignment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{ID: assignmentID, Name: "reader", Type: datamodel.RoleAssignmentResourceType},
+				},
+				Properties: datamodel.RoleAssignmentProperties{
+					RoleDefinitionID: ucp_authorization.RoleReader,
+					PrincipalID:      "alice@contoso.com",
+					PrincipalType:    v1.PrincipalTypeUser,
+				},
+			},
+		})
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+	}, nil)
+	require.NoError(t, err)
+
+	handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, assignmentID, nil))
+
+	allowed, err = authorizer.Authorize(context.Background(), principal, "Applications.Core/applications/read", id)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
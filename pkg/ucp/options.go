@@ -18,26 +18,54 @@ package ucp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/sdk"
+	ucp_authorization "github.com/radius-project/radius/pkg/ucp/authorization"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	kube_rest "k8s.io/client-go/rest"
 )
 
+const (
+	// extensionAuthenticationConfigMap is the ConfigMap in kube-system in which the Kubernetes API server publishes
+	// the front-proxy CA and request headers for aggregated API servers.
+	extensionAuthenticationConfigMap = "extension-apiserver-authentication"
+)
+
 // Options holds the configuration options and shared services for the UCP server.
 //
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// AuditSink records mutating requests to the UCP API. It is nil when audit logging is disabled.
+	AuditSink audit.Sink
+
+	// Authenticators identify the callers of the UCP API.
+	Authenticators []authentication.Authenticator
+
+	// Authorizer authorizes requests to the UCP API. It is nil when authorization is disabled.
+	Authorizer authorization.Authorizer
+
 	// Config is the configuration for the server.
 	Config *Config
 
@@ -51,6 +79,12 @@ type Options struct {
 	// QueueProvider provides access to the message queue client.
 	QueueProvider *queueprovider.QueueProvider
 
+	// ProxyCache caches the responses of the resource providers proxied by UCP. It is nil when caching is disabled.
+	ProxyCache *proxy.ResponseCache
+
+	// RateLimiter limits the rate of requests to the UCP API. It is nil when rate limiting is disabled.
+	RateLimiter *ratelimit.Limiter
+
 	// SecretProvider provides access to secret store used for secret data.
 	SecretProvider *secretprovider.SecretProvider
 
@@ -112,5 +146,140 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
+	var client kubernetes.Interface
+	if config.Authentication.TokenReview.Enabled || (config.Authentication.RequestHeader.Enabled && config.Authentication.RequestHeader.ClientCAFile == "") {
+		if cfg == nil {
+			cfg, err = kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+				QPS:   kubeutil.DefaultServerQPS,
+				Burst: kubeutil.DefaultServerBurst,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+			}
+		}
+
+		client, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+	}
+
+	if config.Authentication.RequestHeader.Enabled {
+		authenticator, err := newRequestHeaderAuthenticator(ctx, config.Authentication.RequestHeader, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure request header authentication: %w", err)
+		}
+
+		// Requests forwarded by the Kubernetes API server are recognized by their client certificate, so they are
+		// identified before any bearer token is inspected.
+		options.Authenticators = append(options.Authenticators, authenticator)
+	}
+
+	if config.Authentication.OIDC.Enabled {
+		oidc := config.Authentication.OIDC
+		authenticator, err := authentication.NewOIDCAuthenticator(authentication.OIDCOptions{
+			Issuer:         oidc.Issuer,
+			Audiences:      oidc.Audiences,
+			JWKSURL:        oidc.JWKSURL,
+			UsernameClaim:  oidc.UsernameClaim,
+			UsernamePrefix: oidc.UsernamePrefix,
+			GroupsClaim:    oidc.GroupsClaim,
+			GroupsPrefix:   oidc.GroupsPrefix,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure OIDC authentication: %w", err)
+		}
+
+		// OIDC tokens are tried first because validating them does not require a call to the Kubernetes API server.
+		options.Authenticators = append(options.Authenticators, authenticator)
+	}
+
+	if config.Authentication.TokenReview.Enabled {
+		options.Authenticators = append(options.Authenticators, authentication.NewTokenReviewAuthenticator(client, config.Authentication.TokenReview.Audiences))
+	}
+
+	if config.Authorization.Enabled {
+		roleAssignments := []datamodel.RoleAssignmentProperties{}
+		for _, assignment := range config.Authorization.RoleAssignments {
+			principalType := v1.PrincipalType(assignment.PrincipalType)
+			if principalType == "" {
+				principalType = v1.PrincipalTypeUser
+			}
+
+			roleAssignments = append(roleAssignments, datamodel.RoleAssignmentProperties{
+				RoleDefinitionID: assignment.RoleDefinitionID,
+				PrincipalID:      assignment.PrincipalID,
+				PrincipalType:    principalType,
+				Scope:            assignment.Scope,
+			})
+		}
+
+		options.Authorizer = ucp_authorization.NewRBACAuthorizer(databaseClient, config.Authorization.SuperUsers, config.Authorization.SuperGroups, roleAssignments)
+	}
+
+	if config.RateLimit.Enabled {
+		options.RateLimiter, err = ratelimit.NewLimiter(ratelimit.Options{
+			RequestsPerSecond: config.RateLimit.RequestsPerSecond,
+			Burst:             config.RateLimit.Burst,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure rate limiting: %w", err)
+		}
+	}
+
+	if config.ProxyCache.Enabled {
+		options.ProxyCache = proxy.NewResponseCache(time.Duration(config.ProxyCache.TTLSeconds) * time.Second)
+	}
+
+	options.AuditSink, err = audit.NewSink(ctx, config.Audit, databaseClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit sink: %w", err)
+	}
+
 	return &options, nil
 }
+
+// newRequestHeaderAuthenticator creates the authenticator for requests forwarded by the Kubernetes API server. Settings
+// which are not configured are read from the kube-system/extension-apiserver-authentication ConfigMap, which the
+// Kubernetes API server publishes for aggregated API servers.
+func newRequestHeaderAuthenticator(ctx context.Context, config RequestHeaderConfig, client kubernetes.Interface) (*authentication.RequestHeaderAuthenticator, error) {
+	options := authentication.RequestHeaderOptions{
+		AllowedNames:    config.AllowedNames,
+		UsernameHeaders: config.UsernameHeaders,
+		GroupHeaders:    config.GroupHeaders,
+		UIDHeaders:      config.UIDHeaders,
+	}
+
+	if config.ClientCAFile != "" {
+		ca, err := os.ReadFile(config.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		options.ClientCA = ca
+		return authentication.NewRequestHeaderAuthenticator(options)
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, extensionAuthenticationConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s/%s ConfigMap: %w", metav1.NamespaceSystem, extensionAuthenticationConfigMap, err)
+	}
+
+	options.ClientCA = []byte(configMap.Data["requestheader-client-ca-file"])
+	for key, target := range map[string]*[]string{
+		"requestheader-allowed-names":    &options.AllowedNames,
+		"requestheader-username-headers": &options.UsernameHeaders,
+		"requestheader-group-headers":    &options.GroupHeaders,
+		"requestheader-uid-headers":      &options.UIDHeaders,
+	} {
+		value := configMap.Data[key]
+		if len(*target) > 0 || value == "" {
+			continue
+		}
+
+		if err := json.Unmarshal([]byte(value), target); err != nil {
+			return nil, fmt.Errorf("failed to parse %q of the %s ConfigMap: %w", key, extensionAuthenticationConfigMap, err)
+		}
+	}
+
+	return authentication.NewRequestHeaderAuthenticator(options)
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// DefaultCacheTTL is the default time a response is kept in the ResponseCache.
+	DefaultCacheTTL = 5 * time.Second
+
+	// maxCacheEntries is the maximum number of responses kept in the ResponseCache.
+	maxCacheEntries = 1000
+
+	// maxCacheEntrySize is the maximum size of a response body kept in the ResponseCache.
+	maxCacheEntrySize = 1 << 20
+)
+
+// ResponseCache is a short-lived cache of the successful GET responses proxied to downstream servers.
+//
+// Responses are cached per URL and caller, and are invalidated when a request that modifies the same type of
+// resource is proxied through the cache. Changes that are not made through the cache, such as the completion of
+// an asynchronous operation, are only observed after the entry expires, so the TTL should be kept short. For the
+// same reason the status of asynchronous operations and resources whose provisioning state is not terminal are
+// never cached.
+//
+// ResponseCache is safe for concurrent use.
+type ResponseCache struct {
+	ttl time.Duration
+
+	// now is the clock used for expiration. Can be overridden for testing.
+	now func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	// scope is the key used to invalidate the entry.
+	scope string
+
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// NewResponseCache creates a new ResponseCache keeping responses for the given TTL. DefaultCacheTTL is used if the
+// TTL is not positive.
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &ResponseCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// RoundTripper returns a http.RoundTripper that serves GET requests from the cache and sends other requests
+// to the inner http.RoundTripper, invalidating the cache when they modify resources.
+func (c *ResponseCache) RoundTripper(inner http.RoundTripper) http.RoundTripper {
+	return &cachingRoundTripper{cache: c, inner: inner}
+}
+
+// Invalidate removes the cached responses that may be affected by a change to the resource at the given URL path.
+func (c *ResponseCache) Invalidate(path string) {
+	scope := cacheScope(path)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, entry := range c.entries {
+		if scope == "" || entry.scope == "" || entry.scope == scope {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *ResponseCache) get(key string) *cacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil
+	}
+
+	return entry
+}
+
+func (c *ResponseCache) set(key string, entry *cacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= maxCacheEntries {
+		for key, existing := range c.entries {
+			if !now.Before(existing.expires) {
+				delete(c.entries, key)
+			}
+		}
+	}
+
+	// Skip caching rather than evicting live entries, the cache is only an optimization.
+	if len(c.entries) >= maxCacheEntries {
+		return
+	}
+
+	entry.expires = now.Add(c.ttl)
+	c.entries[key] = entry
+}
+
+type cachingRoundTripper struct {
+	cache *ResponseCache
+	inner http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cachingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return t.inner.RoundTrip(req)
+	}
+
+	if req.Method != http.MethodGet {
+		// Invalidate before and after the request, so that responses read while the request is processed are
+		// not kept.
+		t.cache.Invalidate(req.URL.Path)
+		resp, err := t.inner.RoundTrip(req)
+		t.cache.Invalidate(req.URL.Path)
+		return resp, err
+	}
+
+	if !isCacheable(req) {
+		return t.inner.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	if entry := t.cache.get(key); entry != nil {
+		return entry.response(req), nil
+	}
+
+	resp, err := t.inner.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return resp, err
+	}
+
+	if resp.ContentLength < 0 || resp.ContentLength > maxCacheEntrySize {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if !isTerminal(body) {
+		return resp, nil
+	}
+
+	t.cache.set(key, &cacheEntry{
+		scope:      cacheScope(req.URL.Path),
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	})
+
+	return resp, nil
+}
+
+// response creates a new http.Response for the request from the cached entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// isCacheable returns true if the response to the request may be served from the cache. Conditional requests are
+// always sent to the downstream server, which is responsible for evaluating them.
+func isCacheable(req *http.Request) bool {
+	if req.Header.Get(v1.IfMatch) != "" || req.Header.Get(v1.IfNoneMatch) != "" {
+		return false
+	}
+
+	// The status of an asynchronous operation changes without any write being proxied through the cache.
+	for _, segment := range strings.Split(strings.ToLower(req.URL.Path), "/") {
+		if segment == "operationstatuses" || segment == "operationresults" {
+			return false
+		}
+	}
+
+	cacheControl := req.Header.Get("Cache-Control")
+	return !strings.Contains(cacheControl, "no-cache") && !strings.Contains(cacheControl, "no-store")
+}
+
+// provisioningStateBody is the part of a resource or a list of resources used to find their provisioning state.
+type provisioningStateBody struct {
+	Properties *struct {
+		ProvisioningState v1.ProvisioningState `json:"provisioningState"`
+	} `json:"properties"`
+	Value []provisioningStateBody `json:"value"`
+}
+
+// isTerminal returns false if the response body is a resource, or a list of resources, with a provisioning state
+// that is not terminal. Bodies which are not JSON objects are treated as terminal.
+func isTerminal(body []byte) bool {
+	payload := provisioningStateBody{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return true
+	}
+
+	if payload.Properties != nil && !payload.Properties.ProvisioningState.IsTerminal() {
+		return false
+	}
+
+	for _, item := range payload.Value {
+		if item.Properties != nil && !item.Properties.ProvisioningState.IsTerminal() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cacheKey returns the key of the request in the cache. The key includes the credentials and the principal of the
+// caller so that responses are never shared between callers.
+func cacheKey(req *http.Request) string {
+	hash := sha256.New()
+	for _, value := range []string{
+		req.URL.String(),
+		req.Header.Get("Authorization"),
+		req.Header.Get(v1.ClientPrincipalNameHeader),
+		req.Header.Get(v1.ClientPrincipalIDHeader),
+	} {
+		_, _ = hash.Write([]byte(value))
+		_, _ = hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// cacheScope returns the key used to invalidate the cached responses for the URL path: the top-level resource type,
+// in lowercase. Writes to a resource or to its child resources invalidate all the cached responses for resources
+// of the same type, including lists. An empty scope is returned if the path cannot be parsed, which invalidates
+// everything.
+func cacheScope(path string) string {
+	id, err := resources.Parse(path)
+	if err != nil || len(id.TypeSegments()) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(id.TypeSegments()[0].Type)
+}
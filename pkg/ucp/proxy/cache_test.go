@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContainerURL   = "http://localhost/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"
+	testContainersURL  = "http://localhost/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers"
+	testEnvironmentURL = "http://localhost/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+)
+
+func newTestCache() (*ResponseCache, http.RoundTripper, *int) {
+	calls := 0
+	inner := &transportFunc{Func: func(req *http.Request) (*http.Response, error) {
+		calls++
+		body := fmt.Sprintf("response %d", calls)
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewBufferString(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}}
+
+	cache := NewResponseCache(time.Minute)
+	return cache, cache.RoundTripper(inner), &calls
+}
+
+func newTestCacheWithBody(body string) (http.RoundTripper, *int) {
+	calls := 0
+	inner := &transportFunc{Func: func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewBufferString(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}}
+
+	return NewResponseCache(time.Minute).RoundTripper(inner), &calls
+}
+
+func send(t *testing.T, transport http.RoundTripper, method string, url string, principal string) string {
+	req := httptest.NewRequest(method, url, nil)
+	req.Header.Set(v1.ClientPrincipalNameHeader, principal)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func Test_ResponseCache(t *testing.T) {
+	t.Run("cache hit", func(t *testing.T) {
+		_, transport, calls := newTestCache()
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("not shared between principals", func(t *testing.T) {
+		_, transport, calls := newTestCache()
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, "response 2", send(t, transport, http.MethodGet, testContainerURL, "bob"))
+		require.Equal(t, 2, *calls)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		cache, transport, calls := newTestCache()
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+
+		now = now.Add(time.Minute)
+		require.Equal(t, "response 2", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, 2, *calls)
+	})
+
+	t.Run("invalidated by write to same type", func(t *testing.T) {
+		_, transport, calls := newTestCache()
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, "response 2", send(t, transport, http.MethodGet, testContainersURL, "alice"))
+		require.Equal(t, "response 3", send(t, transport, http.MethodGet, testEnvironmentURL, "alice"))
+
+		// A write by any principal invalidates the resource and the list, but not other resource types.
+		require.Equal(t, "response 4", send(t, transport, http.MethodPut, testContainerURL, "bob"))
+		require.Equal(t, "response 5", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+		require.Equal(t, "response 6", send(t, transport, http.MethodGet, testContainersURL, "alice"))
+		require.Equal(t, "response 3", send(t, transport, http.MethodGet, testEnvironmentURL, "alice"))
+		require.Equal(t, 6, *calls)
+	})
+
+	t.Run("conditional request bypasses cache", func(t *testing.T) {
+		_, transport, calls := newTestCache()
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+
+		req := httptest.NewRequest(http.MethodGet, testContainerURL, nil)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "alice")
+		req.Header.Set(v1.IfNoneMatch, "test-etag")
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, 2, *calls)
+	})
+
+	t.Run("no-cache bypasses cache", func(t *testing.T) {
+		_, transport, calls := newTestCache()
+
+		require.Equal(t, "response 1", send(t, transport, http.MethodGet, testContainerURL, "alice"))
+
+		req := httptest.NewRequest(http.MethodGet, testContainerURL, nil)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "alice")
+		req.Header.Set("Cache-Control", "no-cache")
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, 2, *calls)
+	})
+}
+
+func Test_ResponseCache_NotCached(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		body   string
+		cached bool
+	}{
+		{
+			name:   "succeeded resource",
+			url:    testContainerURL,
+			body:   `{"properties":{"provisioningState":"Succeeded"}}`,
+			cached: true,
+		},
+		{
+			name:   "resource without provisioning state",
+			url:    testContainerURL,
+			body:   `{"properties":{}}`,
+			cached: true,
+		},
+		{
+			name:   "updating resource",
+			url:    testContainerURL,
+			body:   `{"properties":{"provisioningState":"Updating"}}`,
+			cached: false,
+		},
+		{
+			name:   "list with accepted resource",
+			url:    testContainersURL,
+			body:   `{"value":[{"properties":{"provisioningState":"Succeeded"}},{"properties":{"provisioningState":"Accepted"}}]}`,
+			cached: false,
+		},
+		{
+			name:   "operation status",
+			url:    "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/test-operation",
+			body:   `{"status":"Succeeded"}`,
+			cached: false,
+		},
+		{
+			name:   "operation result",
+			url:    "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationResults/test-operation",
+			body:   `{}`,
+			cached: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport, calls := newTestCacheWithBody(tc.body)
+
+			require.Equal(t, tc.body, send(t, transport, http.MethodGet, tc.url, "alice"))
+			require.Equal(t, tc.body, send(t, transport, http.MethodGet, tc.url, "alice"))
+			if tc.cached {
+				require.Equal(t, 1, *calls)
+			} else {
+				require.Equal(t, 2, *calls)
+			}
+		})
+	}
+}
+
+func Test_cacheScope(t *testing.T) {
+	require.Equal(t, "applications.core/containers", cacheScope("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"))
+	require.Equal(t, "applications.core/containers", cacheScope("/planes/radius/local/providers/Applications.Core/containers"))
+	require.Equal(t, "microsoft.storage/storageaccounts", cacheScope("/subscriptions/sub/resourceGroups/test-group/providers/Microsoft.Storage/storageAccounts/test/blobServices/default"))
+	require.Equal(t, "", cacheScope("/planes/radius/local/resourceGroups/test-group"))
+	require.Equal(t, "", cacheScope("not-a-resource-id"))
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// forwardPrincipal replaces the client principal headers with the principal authenticated by UCP, so that
+// downstream servers cannot be given a spoofed identity. The principal ID header is removed when the authenticator
+// does not know a stable identifier, rather than being filled in with the name. Requests without an authenticated principal are not modified.
+func forwardPrincipal(r *http.Request) {
+	principal := v1.PrincipalFromContext(r.Context())
+	if principal == nil {
+		return
+	}
+
+	r.Header.Set(v1.ClientPrincipalNameHeader, principal.Name)
+	if principal.ID == "" {
+		r.Header.Del(v1.ClientPrincipalIDHeader)
+	} else {
+		r.Header.Set(v1.ClientPrincipalIDHeader, principal.ID)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_forwardPrincipal(t *testing.T) {
+	t.Run("authenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "spoofed")
+		req.Header.Set(v1.ClientPrincipalIDHeader, "spoofed")
+		req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{
+			Principal: &v1.Principal{ID: "9b1deb4d", Name: "alice@contoso.com", Type: v1.PrincipalTypeUser},
+		}))
+
+		forwardPrincipal(req)
+
+		require.Equal(t, "alice@contoso.com", req.Header.Get(v1.ClientPrincipalNameHeader))
+		require.Equal(t, "9b1deb4d", req.Header.Get(v1.ClientPrincipalIDHeader))
+	})
+
+	t.Run("authenticated without id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+		req.Header.Set(v1.ClientPrincipalIDHeader, "spoofed")
+		req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{
+			Principal: v1.NewPrincipal("alice@contoso.com", nil),
+		}))
+
+		forwardPrincipal(req)
+
+		require.Equal(t, "alice@contoso.com", req.Header.Get(v1.ClientPrincipalNameHeader))
+		require.Empty(t, req.Header.Get(v1.ClientPrincipalIDHeader))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "arm-user")
+
+		forwardPrincipal(req)
+
+		require.Equal(t, "arm-user", req.Header.Get(v1.ClientPrincipalNameHeader))
+	})
+}
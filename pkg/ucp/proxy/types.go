@@ -77,8 +77,9 @@ func (builder *ReverseProxyBuilder) Build() ReverseProxy {
 	//
 	// We don't consider workaround28169 optional :-/ the default behavior is just broken.
 	//
-	// We don't want to propagate the Kubernetes authentication headers to the downstream server.
-	directors := []DirectorFunc{rp.Director, workaround28169, filterKubernetesAPIServerHeaders}
+	// We don't want to propagate the Kubernetes authentication headers to the downstream server, and
+	// the downstream server must see the principal authenticated by UCP rather than one set by the client.
+	directors := []DirectorFunc{rp.Director, workaround28169, filterKubernetesAPIServerHeaders, forwardPrincipal}
 	directors = append(directors, builder.Directors...)
 
 	responders := builder.Responders
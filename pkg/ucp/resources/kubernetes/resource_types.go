@@ -47,12 +47,29 @@ const (
 	KindSecretProviderClass = "SecretProviderClass"
 	// ResourceTypeSecretProviderClass is the resource type of a Kubernetes SecretProviderClass.
 	ResourceTypeSecretProviderClass = "secrets-store.csi.x-k8s.io/SecretProviderClass"
+	// KindHorizontalPodAutoscaler is the kind of a Kubernetes HorizontalPodAutoscaler.
+	KindHorizontalPodAutoscaler = "HorizontalPodAutoscaler"
+	// ResourceTypeHorizontalPodAutoscaler is the resource type of a Kubernetes HorizontalPodAutoscaler.
+	ResourceTypeHorizontalPodAutoscaler = "autoscaling/HorizontalPodAutoscaler"
 
 	// KindContourHTTPProxy is the kind of a Contour HTTPProxy.
 	KindContourHTTPProxy = "HTTPProxy"
 	// ResourceTypeContourHTTPProxy is the resource type of a Contour HTTPProxy.
 	ResourceTypeContourHTTPProxy = "projectcontour.io/HTTPProxy"
 
+	// KindGatewayAPIGateway is the kind of a Kubernetes Gateway API Gateway.
+	KindGatewayAPIGateway = "Gateway"
+	// ResourceTypeGatewayAPIGateway is the resource type of a Kubernetes Gateway API Gateway.
+	ResourceTypeGatewayAPIGateway = "gateway.networking.k8s.io/Gateway"
+	// KindGatewayAPIHTTPRoute is the kind of a Kubernetes Gateway API HTTPRoute.
+	KindGatewayAPIHTTPRoute = "HTTPRoute"
+	// ResourceTypeGatewayAPIHTTPRoute is the resource type of a Kubernetes Gateway API HTTPRoute.
+	ResourceTypeGatewayAPIHTTPRoute = "gateway.networking.k8s.io/HTTPRoute"
+	// KindGatewayAPIReferenceGrant is the kind of a Kubernetes Gateway API ReferenceGrant.
+	KindGatewayAPIReferenceGrant = "ReferenceGrant"
+	// ResourceTypeGatewayAPIReferenceGrant is the resource type of a Kubernetes Gateway API ReferenceGrant.
+	ResourceTypeGatewayAPIReferenceGrant = "gateway.networking.k8s.io/ReferenceGrant"
+
 	// ResourceTypeDaprComponent is the resource type of a Dapr component.
 	ResourceTypeDaprComponent = "dapr.io/Component"
 )
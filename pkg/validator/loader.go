@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/fs"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
@@ -121,7 +122,10 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 			path,
 			loads.WithDocLoader(func(path string, _ ...loading.Option) (json.RawMessage, error) {
 				data, err := fs.ReadFile(l.specFiles, path)
-				return json.RawMessage(data), err
+				if err != nil {
+					return nil, err
+				}
+				return relaxExtensibleEnums(data)
 			}))
 		if err != nil {
 			return err
@@ -137,7 +141,7 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 				if err != nil {
 					return nil, err
 				}
-				return json.RawMessage(data), err
+				return relaxExtensibleEnums(data)
 			},
 		})
 		if err != nil {
@@ -171,6 +175,43 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 	return l, nil
 }
 
+// extensibleEnums is the set of "x-ms-enum" names whose values are well-known values rather than the complete set.
+// RecipeKind can be extended by the operator with out-of-process recipe drivers.
+var extensibleEnums = []string{"RecipeKind"}
+
+// relaxExtensibleEnums removes the list of allowed values from the enums named in extensibleEnums that are marked
+// with "x-ms-enum.modelAsString" in the given OpenAPI document, so requests using other values are not rejected.
+// Other enums keep their values, even when they are modelled as strings.
+func relaxExtensibleEnums(data []byte) (json.RawMessage, error) {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var walk func(node any)
+	walk = func(node any) {
+		switch v := node.(type) {
+		case map[string]any:
+			if ext, ok := v["x-ms-enum"].(map[string]any); ok {
+				name, _ := ext["name"].(string)
+				if modelAsString, ok := ext["modelAsString"].(bool); ok && modelAsString && slices.Contains(extensibleEnums, name) {
+					delete(v, "enum")
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+
+	return json.Marshal(doc)
+}
+
 func getValidatorKey(resourceType, version string) string {
 	return strings.ToLower(resourceType + "-" + version)
 }
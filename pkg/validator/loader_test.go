@@ -18,6 +18,7 @@ package validator
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/radius-project/radius/swagger"
@@ -79,3 +80,29 @@ func Test_Loader(t *testing.T) {
 	require.True(t, ok)
 	require.NotNil(t, v)
 }
+
+func Test_RelaxExtensibleEnums(t *testing.T) {
+	spec := `{
+		"definitions": {
+			"Closed": {"type": "string", "enum": ["a", "b"], "x-ms-enum": {"name": "Closed", "modelAsString": false}},
+			"Open": {"type": "string", "enum": ["a", "b"], "x-ms-enum": {"name": "Open", "modelAsString": true}},
+			"RecipeKind": {"type": "string", "enum": ["a", "b"], "x-ms-enum": {"name": "RecipeKind", "modelAsString": true}},
+			"ClosedRecipeKind": {"type": "string", "enum": ["a", "b"], "x-ms-enum": {"name": "RecipeKind", "modelAsString": false}},
+			"Model": {"properties": {"inline": {"type": "string", "enum": ["c"], "x-ms-enum": {"name": "RecipeKind", "modelAsString": true}}}}
+		}
+	}`
+
+	data, err := relaxExtensibleEnums([]byte(spec))
+	require.NoError(t, err)
+
+	doc := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	definitions := doc["definitions"].(map[string]any)
+	require.Equal(t, []any{"a", "b"}, definitions["Closed"].(map[string]any)["enum"])
+	require.Equal(t, []any{"a", "b"}, definitions["Open"].(map[string]any)["enum"])
+	require.Equal(t, []any{"a", "b"}, definitions["ClosedRecipeKind"].(map[string]any)["enum"])
+	require.NotContains(t, definitions["RecipeKind"].(map[string]any), "enum")
+	inline := definitions["Model"].(map[string]any)["properties"].(map[string]any)["inline"].(map[string]any)
+	require.NotContains(t, inline, "enum")
+	require.Contains(t, inline, "x-ms-enum")
+}
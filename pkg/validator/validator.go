@@ -122,17 +122,26 @@ func (v *validator) findParam(req *http.Request) (map[string]spec.Parameter, err
 		}
 	}
 
+	// The what-if action is not defined in the spec. Its request body is the same as the body of the PUT operation
+	// on the resource, so it is validated against the PUT operation.
+	method := req.Method
+	whatIfSuffix := "/" + strings.ToLower(v1.WhatIfActionName)
+	if method == http.MethodPost && strings.HasSuffix(strings.ToLower(scopePath), whatIfSuffix) {
+		method = http.MethodPut
+		scopePath = scopePath[:len(scopePath)-len(whatIfSuffix)]
+	}
+
 	analyzer := v.specDoc.Analyzer
 	// Iterate loaded paths to find the matched route.
 	paths := analyzer.AllPaths()
 	for k := range paths {
 		if strings.EqualFold(k, scopePath) {
 			// Ensure that the current API path and method are defined in the spec.
-			if _, ok := analyzer.OperationFor(req.Method, k); !ok {
+			if _, ok := analyzer.OperationFor(method, k); !ok {
 				return nil, ErrUndefinedRoute
 			}
 
-			v.paramCache[templateKey] = analyzer.ParamsFor(req.Method, k)
+			v.paramCache[templateKey] = analyzer.ParamsFor(method, k)
 			return v.paramCache[templateKey], nil
 		}
 	}
@@ -54,6 +54,31 @@ func Test_FindParam(t *testing.T) {
 	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
 }
 
+func Test_FindParam_WhatIf(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", swagger.SpecFiles, []string{"/subscriptions/{subscriptionID}/resourceGroups/{rgName}"}, "rootScope")
+	require.NoError(t, err)
+	v, ok := l.GetValidator("applications.core/environments", "2023-10-01-preview")
+	require.True(t, ok)
+	validator := v.(*validator)
+
+	w := httptest.NewRecorder()
+	r := chi.NewRouter()
+	req, err := http.NewRequest(http.MethodPost, armResourceGroupScopedResourceURL+"/whatif", nil)
+	require.NoError(t, err)
+
+	r.MethodFunc(http.MethodPost, "/subscriptions/{subscriptionID}/resourceGroups/{rgName}"+environmentResourceRoute+"/whatif", func(w http.ResponseWriter, r *http.Request) {
+		// The what-if action is validated against the PUT operation of the resource.
+		param, err := validator.findParam(r)
+		require.NoError(t, err)
+		require.Contains(t, param, "body#Resource")
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+}
+
 func Test_ToRouteParams(t *testing.T) {
 	v := validator{
 		rootScopeParam: "rootScope",
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vault provides a minimal client of the KV version 2 secrets engine of HashiCorp Vault.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultMountPath is the default mount path of the KV version 2 secrets engine.
+	DefaultMountPath = "secret"
+
+	// TokenKey is the key of the Vault token in the Kubernetes secret used to authenticate to Vault.
+	TokenKey = "token"
+)
+
+var (
+	// ErrSecretNotFound is returned when the secret does not exist in the secrets engine.
+	ErrSecretNotFound = errors.New("vault secret is not found")
+
+	// httpClient is the HTTP client used to communicate with Vault servers.
+	httpClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// Options configures how Radius connects to Vault servers. It is set by the operator in the hosting configuration.
+type Options struct {
+	// AllowInsecureHTTP allows Vault addresses with the http scheme. Vault tokens are sent in clear text over http,
+	// so this must only be enabled for development environments.
+	AllowInsecureHTTP bool `yaml:"allowInsecureHttp,omitempty"`
+
+	// TokenSecretNamespaces is the list of Kubernetes namespaces from which any secret store can read its Vault token
+	// secret. Otherwise, the token secret must be in the namespace of the secret store's application or environment.
+	TokenSecretNamespaces []string `yaml:"tokenSecretNamespaces,omitempty"`
+}
+
+// ValidateAddress returns an error if address is not an absolute https URL. http URLs are accepted only when
+// AllowInsecureHTTP is set.
+func (o Options) ValidateAddress(address string) error {
+	u, err := url.Parse(address)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return fmt.Errorf("'%s' is the invalid vault address. It must be an absolute https URL", address)
+	}
+	if u.Scheme == "http" && !o.AllowInsecureHTTP {
+		return fmt.Errorf("'%s' is the insecure vault address. It must use https unless insecure http is allowed by the operator", address)
+	}
+	return nil
+}
+
+// Client is a client of the KV version 2 secrets engine HTTP API.
+type Client struct {
+	address   string
+	mountPath string
+	token     string
+}
+
+// NewClient creates a Client for the secrets engine mounted at mountPath of the Vault server at address.
+func NewClient(address, mountPath, token string) *Client {
+	mountPath = strings.Trim(mountPath, "/")
+	if mountPath == "" {
+		mountPath = DefaultMountPath
+	}
+
+	return &Client{
+		address:   strings.TrimSuffix(address, "/"),
+		mountPath: mountPath,
+		token:     token,
+	}
+}
+
+// NewClientFromSecret creates a Client using the token stored in the Kubernetes secret referenced by tokenSecret
+// in the "<namespace>/<name>" format. The address is validated with options so that the token is never sent over
+// http unless the operator allows it.
+func NewClientFromSecret(ctx context.Context, kubeClient runtimeclient.Client, address, mountPath, tokenSecret string, options Options) (*Client, error) {
+	if err := options.ValidateAddress(address); err != nil {
+		return nil, err
+	}
+
+	ns, name, ok := strings.Cut(tokenSecret, "/")
+	if !ok || ns == "" || name == "" {
+		return nil, fmt.Errorf("'%s' is the invalid vault token secret. It must be in the format of '<namespace>/<name>'", tokenSecret)
+	}
+
+	ksecret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, runtimeclient.ObjectKey{Namespace: ns, Name: name}, ksecret); err != nil {
+		return nil, fmt.Errorf("failed to get vault token secret '%s': %w", tokenSecret, err)
+	}
+
+	token, ok := ksecret.Data[TokenKey]
+	if !ok || len(token) == 0 {
+		return nil, fmt.Errorf("vault token secret '%s' does not have key, '%s'", tokenSecret, TokenKey)
+	}
+
+	return NewClient(address, mountPath, strings.TrimSpace(string(token))), nil
+}
+
+// Read returns the data of the latest version of the secret at the given path. Non-string values are
+// returned as JSON.
+func (c *Client) Read(ctx context.Context, path string) (map[string]string, error) {
+	resp := struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodGet, "data", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	// A deleted secret version has null data.
+	if resp.Data.Data == nil {
+		return nil, ErrSecretNotFound
+	}
+
+	values := map[string]string{}
+	for k, v := range resp.Data.Data {
+		switch val := v.(type) {
+		case string:
+			values[k] = val
+		default:
+			b, err := json.Marshal(val)
+			if err != nil {
+				return nil, err
+			}
+			values[k] = string(b)
+		}
+	}
+	return values, nil
+}
+
+// Write creates a new version of the secret at the given path.
+func (c *Client) Write(ctx context.Context, path string, values map[string]string) error {
+	return c.do(ctx, http.MethodPost, "data", path, map[string]any{"data": values}, nil)
+}
+
+// ReadMetadata returns the custom metadata of the secret at the given path.
+func (c *Client) ReadMetadata(ctx context.Context, path string) (map[string]string, error) {
+	resp := struct {
+		Data struct {
+			CustomMetadata map[string]string `json:"custom_metadata"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodGet, "metadata", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data.CustomMetadata, nil
+}
+
+// WriteMetadata sets the custom metadata of the secret at the given path.
+func (c *Client) WriteMetadata(ctx context.Context, path string, metadata map[string]string) error {
+	return c.do(ctx, http.MethodPost, "metadata", path, map[string]any{"custom_metadata": metadata}, nil)
+}
+
+// Delete permanently deletes all versions and the metadata of the secret at the given path.
+func (c *Client) Delete(ctx context.Context, path string) error {
+	return c.do(ctx, http.MethodDelete, "metadata", path, nil, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, endpoint, path string, body any, out any) error {
+	u, err := url.JoinPath(c.address, "v1", c.mountPath, endpoint, path)
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrSecretNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errResp := struct {
+			Errors []string `json:"errors"`
+		}{}
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		return fmt.Errorf("vault returned status code %d for %s %s: %s", resp.StatusCode, method, path, strings.Join(errResp.Errors, "; "))
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
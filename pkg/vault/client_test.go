@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewClientFromSecret(t *testing.T) {
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "radius-system"},
+		Data:       map[string][]byte{TokenKey: []byte("s.token\n")},
+	}
+	emptySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "radius-system"},
+		Data:       map[string][]byte{},
+	}
+	kubeClient := k8sutil.NewFakeKubeClient(nil, tokenSecret, emptySecret)
+
+	tests := []struct {
+		name        string
+		address     string
+		tokenSecret string
+		options     Options
+		err         string
+	}{
+		{name: "success", address: "https://vault:8200/", tokenSecret: "radius-system/vault-token"},
+		{name: "insecure http allowed", address: "http://vault:8200/", tokenSecret: "radius-system/vault-token", options: Options{AllowInsecureHTTP: true}},
+		{name: "insecure http not allowed", address: "http://vault:8200/", tokenSecret: "radius-system/vault-token", err: "'http://vault:8200/' is the insecure vault address"},
+		{name: "invalid address", address: "vault:8200", tokenSecret: "radius-system/vault-token", err: "'vault:8200' is the invalid vault address"},
+		{name: "invalid format", address: "https://vault:8200/", tokenSecret: "vault-token", err: "'vault-token' is the invalid vault token secret. It must be in the format of '<namespace>/<name>'"},
+		{name: "secret not found", address: "https://vault:8200/", tokenSecret: "radius-system/missing", err: "failed to get vault token secret 'radius-system/missing'"},
+		{name: "token key not found", address: "https://vault:8200/", tokenSecret: "radius-system/empty", err: "vault token secret 'radius-system/empty' does not have key, 'token'"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewClientFromSecret(context.TODO(), kubeClient, tc.address, "", tc.tokenSecret, tc.options)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &Client{address: strings.TrimSuffix(tc.address, "/"), mountPath: DefaultMountPath, token: "s.token"}, client)
+		})
+	}
+}
+
+func TestClient_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "s.token", r.Header.Get("X-Vault-Token"))
+		switch r.URL.Path {
+		case "/v1/kv/data/app/db":
+			_, _ = w.Write([]byte(`{"data":{"data":{"username":"admin","port":5432}}}`))
+		case "/v1/kv/data/app/deleted":
+			_, _ = w.Write([]byte(`{"data":{"data":null}}`))
+		case "/v1/kv/data/app/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "/kv/", "s.token")
+
+	values, err := client.Read(context.TODO(), "app/db")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"username": "admin", "port": "5432"}, values)
+
+	_, err = client.Read(context.TODO(), "app/deleted")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = client.Read(context.TODO(), "app/missing")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = client.Read(context.TODO(), "app/forbidden")
+	require.EqualError(t, err, "vault returned status code 403 for GET app/forbidden: permission denied")
+}
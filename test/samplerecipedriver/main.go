@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// samplerecipedriver runs the sample out-of-process recipe driver. Register it with Radius by adding an entry with
+// kind "sample" and the socket path to the recipeDrivers section of the server configuration.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/radius-project/radius/pkg/recipes/driver/external"
+	"github.com/radius-project/radius/pkg/recipes/driver/external/sample"
+)
+
+func main() {
+	socket := flag.String("socket", "/var/run/radius/sample.sock", "path of the unix domain socket to listen on")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	log.Printf("Serving %q recipe driver on %s", sample.Kind, *socket)
+	if err := external.Serve(ctx, *socket, sample.NewDriver()); err != nil {
+		log.Fatal(err)
+	}
+}
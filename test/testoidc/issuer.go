@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testoidc provides a local OIDC issuer that stands in for an identity provider in tests.
+package testoidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// DefaultAudience is the audience of the tokens issued by Issuer when no audience is specified.
+	DefaultAudience = "radius"
+
+	keyID = "test-key"
+)
+
+// Issuer is a local OIDC issuer. It serves the OpenID configuration and key set of the issuer and can
+// issue signed tokens.
+type Issuer struct {
+	// Server is the HTTP server of the issuer.
+	Server *httptest.Server
+
+	// URL is the issuer URL. This is the value of the 'iss' claim of the tokens.
+	URL string
+
+	t      *testing.T
+	key    *rsa.PrivateKey
+	signer jose.Signer
+}
+
+// TokenOptions describes the claims of a token issued by Issuer.
+type TokenOptions struct {
+	// Subject is the 'sub' claim.
+	Subject string
+	// Groups is the 'groups' claim. Optional.
+	Groups []string
+	// Audience is the 'aud' claim. Defaults to DefaultAudience.
+	Audience string
+	// Expiry is the 'exp' claim. Defaults to one hour from now.
+	Expiry time.Time
+	// Claims are additional claims. Optional.
+	Claims map[string]any
+}
+
+// NewIssuer starts a new local OIDC issuer. The issuer is stopped when the test completes.
+func NewIssuer(t *testing.T) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID))
+	require.NoError(t, err)
+
+	issuer := &Issuer{t: t, key: key, signer: signer}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                issuer.URL,
+			"jwks_uri":                              issuer.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{Key: &key.PublicKey, KeyID: keyID, Algorithm: string(jose.RS256), Use: "sig"},
+			},
+		})
+	})
+
+	issuer.Server = httptest.NewServer(mux)
+	issuer.URL = issuer.Server.URL
+	t.Cleanup(issuer.Server.Close)
+
+	return issuer
+}
+
+// Token issues a signed token with the given claims.
+func (i *Issuer) Token(options TokenOptions) string {
+	audience := options.Audience
+	if audience == "" {
+		audience = DefaultAudience
+	}
+
+	expiry := options.Expiry
+	if expiry.IsZero() {
+		expiry = time.Now().Add(time.Hour)
+	}
+
+	claims := jwt.Claims{
+		Issuer:    i.URL,
+		Subject:   options.Subject,
+		Audience:  jwt.Audience{audience},
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		NotBefore: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		Expiry:    jwt.NewNumericDate(expiry),
+	}
+
+	custom := map[string]any{}
+	for k, v := range options.Claims {
+		custom[k] = v
+	}
+	if options.Groups != nil {
+		custom["groups"] = options.Groups
+	}
+
+	token, err := jwt.Signed(i.signer).Claims(claims).Claims(custom).Serialize()
+	require.NoError(i.t, err)
+
+	return token
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
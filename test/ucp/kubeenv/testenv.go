@@ -57,7 +57,7 @@ func StartEnvironment(crdPaths []string) (runtimeclient.Client, *envtest.Environ
 		return nil, nil, fmt.Errorf("failed to initialize environment: %w", err)
 	}
 
-	client, err := runtimeclient.New(cfg, runtimeclient.Options{
+	client, err := runtimeclient.NewWithWatch(cfg, runtimeclient.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
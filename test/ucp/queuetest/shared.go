@@ -104,6 +104,28 @@ func RunTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
 		}
 	})
 
+	t.Run("dequeue messages by priority", func(t *testing.T) {
+		clear(t)
+
+		for _, priority := range []queue.Priority{queue.PriorityLow, queue.PriorityNormal, queue.PriorityHigh} {
+			msg := queue.NewMessage(&testQueueMessage{ID: priority.String()})
+			msg.Priority = priority
+			msg.FairnessKey = "test-bucket"
+			err := cli.Enqueue(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		for _, expected := range []queue.Priority{queue.PriorityHigh, queue.PriorityNormal, queue.PriorityLow} {
+			msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+			require.NoError(t, err)
+			require.Equal(t, expected, msg.Priority)
+			require.Equal(t, "test-bucket", msg.FairnessKey)
+
+			err = cli.FinishMessage(ctx, msg)
+			require.NoError(t, err)
+		}
+	})
+
 	t.Run("message lock is expired", func(t *testing.T) {
 		clear(t)
 
@@ -204,6 +226,68 @@ func RunTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, queue.ErrInvalidMessage)
 	})
 
+	t.Run("dead-letter message", func(t *testing.T) {
+		clear(t)
+
+		err := cli.DeadLetterMessage(ctx, nil, "test reason")
+		require.ErrorIs(t, err, queue.ErrEmptyMessage)
+
+		err = queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = cli.DeadLetterMessage(ctx, msg, "test reason")
+		require.NoError(t, err)
+
+		// Dead-lettered messages are never dequeued, even after the lock expires.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		deadLetters, err := cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, msg.ID, deadLetters[0].ID)
+		require.Equal(t, "test reason", deadLetters[0].Reason)
+		require.Equal(t, msg.Data, deadLetters[0].Data)
+		require.Equal(t, 1, deadLetters[0].DequeueCount)
+		require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+		deadLetter, err := cli.GetDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, deadLetters[0].ID, deadLetter.ID)
+		require.Equal(t, deadLetters[0].Reason, deadLetter.Reason)
+
+		err = cli.ReplayDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		_, err = cli.GetDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+
+		replayed, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.ID, replayed.ID)
+		require.Equal(t, 1, replayed.DequeueCount)
+		require.Equal(t, 1, replayed.ReplayCount)
+
+		err = cli.DeadLetterMessage(ctx, replayed, "test reason")
+		require.NoError(t, err)
+
+		err = cli.PurgeDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		deadLetters, err = cli.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+
+		err = cli.PurgeDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+		err = cli.ReplayDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := queue.StartDequeuer(ctx, cli, queue.WithDequeueInterval(defaultTestDequeueInterval))
@@ -18,8 +18,12 @@ limitations under the License.
 package storetest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -549,4 +553,335 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 			CompareObjectLists(t, expected, objs.Items)
 		})
 	})
+
+	t.Run("query_pagination", func(t *testing.T) {
+		clear(t)
+
+		expected := []database.Object{}
+		expectedFiltered := []database.Object{}
+		for i := 0; i < 5; i++ {
+			id := parseOrPanic(fmt.Sprintf("%s/providers/%s/paged%d", ResourceGroup1Scope, ResourceType1, i))
+			data := map[string]any{
+				"value": fmt.Sprintf("%d", i%2),
+			}
+
+			obj := createObject(id, data)
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+
+			expected = append(expected, obj)
+			if i%2 == 0 {
+				expectedFiltered = append(expectedFiltered, obj)
+			}
+		}
+
+		// readAllPages reads every page of the query and returns the items and the number of pages.
+		readAllPages := func(t *testing.T, query database.Query, pageSize int) ([]database.Object, int) {
+			items := []database.Object{}
+			pages := 0
+			token := ""
+			for {
+				result, err := client.Query(ctx, query, database.WithMaxQueryItemCount(pageSize), database.WithPaginationToken(token))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(result.Items), pageSize)
+
+				pages++
+				items = append(items, result.Items...)
+				if result.PaginationToken == "" {
+					return items, pages
+				}
+
+				token = result.PaginationToken
+			}
+		}
+
+		t.Run("query_pagination_all_pages", func(t *testing.T) {
+			items, pages := readAllPages(t, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}, 2)
+			require.Equal(t, 3, pages)
+			CompareObjectLists(t, expected, items)
+		})
+
+		t.Run("query_pagination_exact_page", func(t *testing.T) {
+			items, pages := readAllPages(t, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}, 5)
+			require.Equal(t, 1, pages)
+			CompareObjectLists(t, expected, items)
+		})
+
+		t.Run("query_pagination_with_field_filter", func(t *testing.T) {
+			filters := []database.QueryFilter{{Field: "value", Value: "0"}}
+			items, pages := readAllPages(t, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1, Filters: filters}, 2)
+			require.Equal(t, 2, pages)
+			CompareObjectLists(t, expectedFiltered, items)
+		})
+
+		t.Run("query_pagination_invalid_token", func(t *testing.T) {
+			_, err := client.Query(ctx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}, database.WithPaginationToken("not a valid token!"))
+			require.ErrorIs(t, err, &database.ErrInvalid{})
+		})
+	})
+
+	t.Run("query_filter_operators", func(t *testing.T) {
+		clear(t)
+
+		objA := createObject(parseOrPanic(ResourceGroup1Scope+"/providers/"+ResourceType1+"/a"), map[string]any{
+			"properties": map[string]any{
+				"environment": "env1",
+				"tags":        []any{"x", "y"},
+			},
+		})
+		objB := createObject(parseOrPanic(ResourceGroup1Scope+"/providers/"+ResourceType1+"/b"), map[string]any{
+			"properties": map[string]any{
+				"environment": "env2",
+				"tags":        []any{"y"},
+			},
+		})
+		objC := createObject(parseOrPanic(ResourceGroup1Scope+"/providers/"+ResourceType1+"/c"), map[string]any{
+			"properties": map[string]any{
+				"environment": 3.0,
+				"tags":        "x",
+			},
+		})
+
+		for _, obj := range []*database.Object{&objA, &objB, &objC} {
+			err := client.Save(ctx, obj)
+			require.NoError(t, err)
+		}
+
+		cases := []struct {
+			name     string
+			filters  []database.QueryFilter
+			expected []database.Object
+		}{
+			{
+				name:     "in",
+				filters:  []database.QueryFilter{{Field: "properties.environment", Operator: database.FilterOperatorIn, Values: []string{"env1", "env2", "3"}}},
+				expected: []database.Object{objA, objB},
+			},
+			{
+				name:     "not_equal",
+				filters:  []database.QueryFilter{{Field: "properties.environment", Operator: database.FilterOperatorNotEqual, Value: "env1"}},
+				expected: []database.Object{objB, objC},
+			},
+			{
+				name:     "not_equal_missing_field",
+				filters:  []database.QueryFilter{{Field: "properties.missing", Operator: database.FilterOperatorNotEqual, Value: "env1"}},
+				expected: []database.Object{objA, objB, objC},
+			},
+			{
+				name:     "exists",
+				filters:  []database.QueryFilter{{Field: "properties.tags", Operator: database.FilterOperatorExists}},
+				expected: []database.Object{objA, objB, objC},
+			},
+			{
+				name:     "exists_missing_field",
+				filters:  []database.QueryFilter{{Field: "properties.missing", Operator: database.FilterOperatorExists}},
+				expected: []database.Object{},
+			},
+			{
+				name:     "prefix",
+				filters:  []database.QueryFilter{{Field: "properties.environment", Operator: database.FilterOperatorPrefix, Value: "env"}},
+				expected: []database.Object{objA, objB},
+			},
+			{
+				name:     "greater_or_equal",
+				filters:  []database.QueryFilter{{Field: "properties.environment", Operator: database.FilterOperatorGreaterOrEqual, Value: "env2"}},
+				expected: []database.Object{objB},
+			},
+			{
+				name:     "less",
+				filters:  []database.QueryFilter{{Field: "properties.environment", Operator: database.FilterOperatorLess, Value: "env2"}},
+				expected: []database.Object{objA},
+			},
+			{
+				name:     "contains",
+				filters:  []database.QueryFilter{{Field: "properties.tags", Operator: database.FilterOperatorContains, Value: "x"}},
+				expected: []database.Object{objA},
+			},
+			{
+				name: "combined",
+				filters: []database.QueryFilter{
+					{Field: "properties.tags", Operator: database.FilterOperatorContains, Value: "y"},
+					{Field: "properties.environment", Operator: database.FilterOperatorNotEqual, Value: "env1"},
+				},
+				expected: []database.Object{objB},
+			},
+		}
+
+		for _, tc := range cases {
+			t.Run("query_filter_operators_"+tc.name, func(t *testing.T) {
+				objs, err := client.Query(ctx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1, Filters: tc.filters})
+				require.NoError(t, err)
+				CompareObjectLists(t, tc.expected, objs.Items)
+			})
+		}
+	})
+
+	t.Run("transact", func(t *testing.T) {
+		t.Run("transact_applies_all_operations", func(t *testing.T) {
+			clear(t)
+
+			existing := createObject(Resource3ID, Data3)
+			err := client.Save(ctx, &existing)
+			require.NoError(t, err)
+
+			obj1 := createObject(Resource1ID, Data1)
+			obj2 := createObject(Resource2ID, Data2)
+			err = client.Transact(ctx,
+				database.SaveOperation(&obj1),
+				database.SaveOperation(&obj2),
+				database.DeleteOperation(Resource3ID.String(), database.WithETag(existing.ETag)))
+			require.NoError(t, err)
+			require.NotEmpty(t, obj1.ETag)
+			require.NotEmpty(t, obj2.ETag)
+
+			actual, err := client.Get(ctx, Resource1ID.String())
+			require.NoError(t, err)
+			require.Equal(t, obj1.ETag, actual.ETag)
+			compareObjects(t, &obj1, actual)
+
+			actual, err = client.Get(ctx, Resource2ID.String())
+			require.NoError(t, err)
+			compareObjects(t, &obj2, actual)
+
+			_, err = client.Get(ctx, Resource3ID.String())
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource3ID.String()})
+		})
+
+		t.Run("transact_with_etag", func(t *testing.T) {
+			clear(t)
+
+			obj := createObject(Resource1ID, Data1)
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+
+			obj.Data = Data2
+			err = client.Transact(ctx, database.SaveOperation(&obj, database.WithETag(obj.ETag)))
+			require.NoError(t, err)
+
+			actual, err := client.Get(ctx, Resource1ID.String())
+			require.NoError(t, err)
+			require.Equal(t, obj.ETag, actual.ETag)
+			compareObjects(t, &obj, actual)
+		})
+
+		t.Run("transact_etag_mismatch_applies_nothing", func(t *testing.T) {
+			clear(t)
+
+			obj1 := createObject(Resource1ID, Data1)
+			err := client.Save(ctx, &obj1)
+			require.NoError(t, err)
+
+			obj2 := createObject(Resource2ID, Data2)
+			updated := createObject(Resource1ID, Data3)
+			err = client.Transact(ctx,
+				database.SaveOperation(&obj2),
+				database.SaveOperation(&updated, database.WithETag("not the etag")))
+			require.ErrorIs(t, err, &database.ErrConcurrency{})
+			require.Empty(t, obj2.ETag)
+
+			_, err = client.Get(ctx, Resource2ID.String())
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource2ID.String()})
+
+			actual, err := client.Get(ctx, Resource1ID.String())
+			require.NoError(t, err)
+			require.Equal(t, obj1.ETag, actual.ETag)
+			compareObjects(t, &obj1, actual)
+		})
+
+		t.Run("transact_delete_not_found_applies_nothing", func(t *testing.T) {
+			clear(t)
+
+			obj1 := createObject(Resource1ID, Data1)
+			err := client.Transact(ctx,
+				database.SaveOperation(&obj1),
+				database.DeleteOperation(Resource2ID.String()))
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource2ID.String()})
+
+			_, err = client.Get(ctx, Resource1ID.String())
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource1ID.String()})
+		})
+
+		t.Run("transact_invalid_operation", func(t *testing.T) {
+			clear(t)
+
+			obj1 := createObject(Resource1ID, Data1)
+			err := client.Transact(ctx,
+				database.SaveOperation(&obj1),
+				database.SaveOperation(nil))
+			require.ErrorIs(t, err, &database.ErrInvalid{})
+
+			_, err = client.Get(ctx, Resource1ID.String())
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource1ID.String()})
+		})
+	})
+
+	t.Run("watch", func(t *testing.T) {
+		watcher, ok := client.(database.Watcher)
+		if !ok {
+			t.Skip("client does not implement database.Watcher")
+			return
+		}
+
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		events, err := watcher.Watch(watchCtx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1})
+		require.NoError(t, err)
+
+		next := func(t *testing.T) database.WatchEvent {
+			t.Helper()
+
+			select {
+			case event, ok := <-events:
+				require.True(t, ok, "watch channel was closed unexpectedly")
+				return event
+			case <-time.After(30 * time.Second):
+				require.Fail(t, "timed out waiting for watch event")
+				return database.WatchEvent{}
+			}
+		}
+
+		obj := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj)
+		require.NoError(t, err)
+
+		event := next(t)
+		require.Equal(t, database.WatchEventCreated, event.Type)
+		require.Equal(t, obj.ETag, event.Object.ETag)
+		compareObjects(t, &obj, &event.Object)
+
+		obj.Data = Data2
+		err = client.Save(ctx, &obj)
+		require.NoError(t, err)
+
+		event = next(t)
+		require.Equal(t, database.WatchEventUpdated, event.Type)
+		require.Equal(t, obj.ETag, event.Object.ETag)
+		compareObjects(t, &obj, &event.Object)
+
+		// This is in a different resource group, so it should not be reported.
+		other := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &other)
+		require.NoError(t, err)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		event = next(t)
+		require.Equal(t, database.WatchEventDeleted, event.Type)
+		require.True(t, strings.EqualFold(Resource1ID.String(), event.Object.ID))
+		require.Equal(t, obj.ETag, event.Object.ETag)
+
+		watchCancel()
+		require.Eventually(t, func() bool {
+			select {
+			case _, ok := <-events:
+				return !ok
+			default:
+				return false
+			}
+		}, 30*time.Second, 10*time.Millisecond, "watch channel was not closed after cancellation")
+	})
 }